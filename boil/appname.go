@@ -0,0 +1,80 @@
+package boil
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+)
+
+// ApplicationNameDSN adds name to the data source name of a database/sql
+// driver so the connections made with it identify themselves with name,
+// which database side monitoring shows for every session. It's the
+// application_name of Postgres (pg_stat_activity), the program_name
+// connection attribute of MySQL (performance_schema.session_connect_attrs)
+// and the app name of SQL Server (sys.dm_exec_sessions).
+//
+//	dsn, err := boil.ApplicationNameDSN("postgres", os.Getenv("DATABASE_URL"), "jets-api")
+//	db, err := sql.Open("postgres", dsn)
+//
+// Both url and key/value style dsns are supported for Postgres and SQL
+// Server. MySQL needs github.com/go-sql-driver/mysql v1.8 or newer, older
+// versions send unknown parameters as system variables.
+func ApplicationNameDSN(driverName, dsn, name string) (string, error) {
+	switch driverName {
+	case "postgres", "pgx":
+		if isURLDSN(dsn, "postgres", "postgresql") {
+			return setURLDSNParam(dsn, "application_name", name)
+		}
+		return appendDSNPair(dsn, " ", "application_name='"+pqEscaper.Replace(name)+"'"), nil
+	case "mysql":
+		if strings.ContainsAny(name, ",:") {
+			return "", errors.Errorf("boil: mysql application name %q can't contain commas or colons", name)
+		}
+		param := "connectionAttributes=" + url.QueryEscape("program_name:"+name)
+		if strings.Contains(dsn, "?") {
+			return dsn + "&" + param, nil
+		}
+		return dsn + "?" + param, nil
+	case "mssql", "sqlserver":
+		if isURLDSN(dsn, "sqlserver") {
+			return setURLDSNParam(dsn, "app name", name)
+		}
+		if strings.ContainsAny(name, ";") {
+			return "", errors.Errorf("boil: sql server application name %q can't contain semicolons", name)
+		}
+		return appendDSNPair(strings.TrimSuffix(dsn, ";"), ";", "app name="+name), nil
+	default:
+		return "", errors.Errorf("boil: setting the application name isn't supported for driver %q", driverName)
+	}
+}
+
+var pqEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+func isURLDSN(dsn string, schemes ...string) bool {
+	for _, s := range schemes {
+		if strings.HasPrefix(dsn, s+"://") {
+			return true
+		}
+	}
+	return false
+}
+
+func setURLDSNParam(dsn, key, value string) (string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", errors.Wrap(err, "boil: unable to parse dsn")
+	}
+
+	query := u.Query()
+	query.Set(key, value)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func appendDSNPair(dsn, sep, pair string) string {
+	if len(dsn) == 0 {
+		return pair
+	}
+	return dsn + sep + pair
+}
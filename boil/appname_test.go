@@ -0,0 +1,40 @@
+package boil
+
+import "testing"
+
+func TestApplicationNameDSN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Driver string
+		DSN    string
+		Name   string
+		Want   string
+		Err    bool
+	}{
+		{Driver: "postgres", DSN: "postgres://u@localhost/db?sslmode=disable", Name: "jets api", Want: "postgres://u@localhost/db?application_name=jets+api&sslmode=disable"},
+		{Driver: "postgres", DSN: "user=u dbname=db", Name: `it's`, Want: `user=u dbname=db application_name='it\'s'`},
+		{Driver: "pgx", DSN: "", Name: "jets", Want: "application_name='jets'"},
+		{Driver: "mysql", DSN: "u@tcp(localhost:3306)/db", Name: "jets", Want: "u@tcp(localhost:3306)/db?connectionAttributes=program_name%3Ajets"},
+		{Driver: "mysql", DSN: "u@tcp(localhost:3306)/db?parseTime=true", Name: "jets", Want: "u@tcp(localhost:3306)/db?parseTime=true&connectionAttributes=program_name%3Ajets"},
+		{Driver: "mysql", DSN: "u@/db", Name: "a,b", Err: true},
+		{Driver: "sqlserver", DSN: "sqlserver://u:p@localhost:1433?database=db", Name: "jets", Want: "sqlserver://u:p@localhost:1433?app+name=jets&database=db"},
+		{Driver: "mssql", DSN: "server=localhost;database=db;", Name: "jets", Want: "server=localhost;database=db;app name=jets"},
+		{Driver: "sqlite3", DSN: "file.db", Name: "jets", Err: true},
+	}
+
+	for i, test := range tests {
+		got, err := ApplicationNameDSN(test.Driver, test.DSN, test.Name)
+		if test.Err {
+			if err == nil {
+				t.Errorf("%d: want an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+		} else if got != test.Want {
+			t.Errorf("%d: want %s, got %s", i, test.Want, got)
+		}
+	}
+}
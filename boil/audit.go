@@ -0,0 +1,33 @@
+package boil
+
+import "context"
+
+// WithAuditActor modifies a context so that the changes made with it to
+// audited tables are recorded as made by actor, eg. the id of the user of a
+// request.
+func WithAuditActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, ctxAuditActor, actor)
+}
+
+// AuditActorFrom returns the actor set on the context with WithAuditActor,
+// or an empty string if not set.
+func AuditActorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(ctxAuditActor).(string)
+	return actor
+}
+
+// SkipAudit modifies a context so that the changes made with it to audited
+// tables aren't recorded, while the other hooks still run.
+func SkipAudit(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxSkipAudit, true)
+}
+
+// AuditIsSkipped returns true if the context skips the audit, either
+// because of SkipAudit or because all hooks are skipped.
+func AuditIsSkipped(ctx context.Context) bool {
+	if HooksAreSkipped(ctx) {
+		return true
+	}
+	skip, _ := ctx.Value(ctxSkipAudit).(bool)
+	return skip
+}
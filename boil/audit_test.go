@@ -0,0 +1,35 @@
+package boil
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWithAuditActor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	if AuditActorFrom(ctx) != "" {
+		t.Error("actor should not be set")
+	}
+
+	ctx = WithAuditActor(ctx, "user:42")
+	if got := AuditActorFrom(ctx); got != "user:42" {
+		t.Errorf("want: user:42, got: %s", got)
+	}
+}
+
+func TestSkipAudit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	if AuditIsSkipped(ctx) {
+		t.Error("audit should not be skipped")
+	}
+	if !AuditIsSkipped(SkipAudit(ctx)) {
+		t.Error("audit should be skipped")
+	}
+	if !AuditIsSkipped(SkipHooks(ctx)) {
+		t.Error("audit should be skipped with the hooks")
+	}
+}
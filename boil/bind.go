@@ -0,0 +1,18 @@
+package boil
+
+import "context"
+
+// WithStrictBind modifies a context so that binding query results to a struct
+// fails if a returned column has no matching struct field, or if a struct
+// field is not populated by any of the returned columns. This is useful in
+// tests to catch typos in select lists, which otherwise silently leave fields
+// zero valued.
+func WithStrictBind(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxStrictBind, true)
+}
+
+// IsStrictBind returns true if the context has strict binding enabled
+func IsStrictBind(ctx context.Context) bool {
+	strict := ctx.Value(ctxStrictBind)
+	return strict != nil && strict.(bool)
+}
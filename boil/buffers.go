@@ -0,0 +1,93 @@
+package boil
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultBufferPoolMaxSize is the biggest buffer PutBuffer keeps by default,
+// see SetBufferPoolMaxSize.
+const DefaultBufferPoolMaxSize = 256 << 10
+
+var (
+	bufferPool = sync.Pool{
+		New: func() interface{} {
+			atomic.AddUint64(&bufferPoolMisses, 1)
+			return new(bytes.Buffer)
+		},
+	}
+
+	bufferPoolMaxSize int64 = DefaultBufferPoolMaxSize
+
+	bufferPoolGets      uint64
+	bufferPoolMisses    uint64
+	bufferPoolPuts      uint64
+	bufferPoolDiscarded uint64
+)
+
+// BufferPoolStats are the counters of the buffer pool since the program
+// started, see GetBufferPoolStats.
+type BufferPoolStats struct {
+	// Gets is how many buffers GetBuffer handed out
+	Gets uint64
+	// Misses is how many of those had to be allocated because the pool
+	// was empty
+	Misses uint64
+	// Puts is how many buffers PutBuffer returned to the pool
+	Puts uint64
+	// Discarded is how many buffers PutBuffer dropped because they had
+	// grown bigger than the max size
+	Discarded uint64
+	// MaxSize is the current max size, see SetBufferPoolMaxSize
+	MaxSize int
+}
+
+// HitRate is the fraction of Gets that reused a pooled buffer.
+func (s BufferPoolStats) HitRate() float64 {
+	if s.Gets == 0 {
+		return 0
+	}
+	return float64(s.Gets-s.Misses) / float64(s.Gets)
+}
+
+// GetBuffer returns an empty buffer from the pool that the generated code,
+// the query builders and the generator share. Return it with PutBuffer once
+// it's no longer used.
+func GetBuffer() *bytes.Buffer {
+	atomic.AddUint64(&bufferPoolGets, 1)
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+// PutBuffer returns buf to the pool. Buffers that grew bigger than the max
+// size are dropped instead so a few huge statements or files don't keep
+// their memory around for good.
+func PutBuffer(buf *bytes.Buffer) {
+	if max := atomic.LoadInt64(&bufferPoolMaxSize); max > 0 && int64(buf.Cap()) > max {
+		atomic.AddUint64(&bufferPoolDiscarded, 1)
+		return
+	}
+
+	atomic.AddUint64(&bufferPoolPuts, 1)
+	bufferPool.Put(buf)
+}
+
+// SetBufferPoolMaxSize sets the capacity in bytes above which PutBuffer drops
+// buffers instead of pooling them. Zero keeps every buffer regardless of its
+// size.
+func SetBufferPoolMaxSize(size int) {
+	atomic.StoreInt64(&bufferPoolMaxSize, int64(size))
+}
+
+// GetBufferPoolStats returns the buffer pool's counters.
+func GetBufferPoolStats() BufferPoolStats {
+	return BufferPoolStats{
+		Gets:      atomic.LoadUint64(&bufferPoolGets),
+		Misses:    atomic.LoadUint64(&bufferPoolMisses),
+		Puts:      atomic.LoadUint64(&bufferPoolPuts),
+		Discarded: atomic.LoadUint64(&bufferPoolDiscarded),
+		MaxSize:   int(atomic.LoadInt64(&bufferPoolMaxSize)),
+	}
+}
@@ -0,0 +1,52 @@
+package boil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBufferPool(t *testing.T) {
+	defer SetBufferPoolMaxSize(DefaultBufferPoolMaxSize)
+	SetBufferPoolMaxSize(1024)
+
+	before := GetBufferPoolStats()
+	if before.MaxSize != 1024 {
+		t.Errorf("want max size 1024, got %d", before.MaxSize)
+	}
+
+	buf := GetBuffer()
+	if buf.Len() != 0 {
+		t.Error("want an empty buffer")
+	}
+	buf.WriteString("hello")
+	PutBuffer(buf)
+
+	PutBuffer(bytes.NewBuffer(make([]byte, 0, 2048)))
+
+	after := GetBufferPoolStats()
+	if got := after.Gets - before.Gets; got != 1 {
+		t.Errorf("want 1 get, got %d", got)
+	}
+	if got := after.Puts - before.Puts; got != 1 {
+		t.Errorf("want 1 put, got %d", got)
+	}
+	if got := after.Discarded - before.Discarded; got != 1 {
+		t.Errorf("want the big buffer discarded, got %d discarded", got)
+	}
+
+	if buf = GetBuffer(); buf.Len() != 0 {
+		t.Error("want a reused buffer to be reset")
+	}
+	PutBuffer(buf)
+}
+
+func TestBufferPoolStatsHitRate(t *testing.T) {
+	t.Parallel()
+
+	if rate := (BufferPoolStats{}).HitRate(); rate != 0 {
+		t.Errorf("want 0 without gets, got %f", rate)
+	}
+	if rate := (BufferPoolStats{Gets: 4, Misses: 1}).HitRate(); rate != 0.75 {
+		t.Errorf("want 0.75, got %f", rate)
+	}
+}
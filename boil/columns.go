@@ -25,6 +25,29 @@ const (
 type Columns struct {
 	Kind int
 	Cols []string
+
+	// Exprs are the columns Update sets to an expression instead of the
+	// value of the object's field, see WithExpr.
+	Exprs map[string]Expression
+}
+
+// WithExpr makes Update set the column name to expr instead of the value of
+// the object's field, for values that depend on the row such as incrementing
+// a counter:
+//
+//   pilot.Update(ctx, db, boil.Infer().WithExpr("flights", boil.Expr("flights + ?", 1)))
+//
+// The field isn't changed, use Reload to read the new value. Expressions are
+// only used by Update.
+func (c Columns) WithExpr(name string, expr Expression) Columns {
+	exprs := make(map[string]Expression, len(c.Exprs)+1)
+	for n, e := range c.Exprs {
+		exprs[n] = e
+	}
+	exprs[name] = expr
+	c.Exprs = exprs
+
+	return c
 }
 
 // None creates an empty column list.
@@ -108,7 +131,8 @@ func (c Columns) IsGreylist() bool {
 // types of Columns list are outlined below.
 //
 // Note that a default column's zero value is based on the Go type and does
-// not take into account the default value in the database.
+// not take into account the default value in the database. Columns that are
+// listed more than once are only inserted once.
 //
 //  None:
 //   insert: empty
@@ -143,7 +167,8 @@ func (c Columns) InsertColumnSet(cols, defaults, noDefaults, nonZeroDefaults []s
 		return insert, ret
 
 	case columnsWhitelist:
-		return c.Cols, strmangle.SetComplement(defaults, c.Cols)
+		insert := strmangle.SetMerge(nil, c.Cols)
+		return insert, strmangle.SetComplement(defaults, insert)
 
 	case columnsBlacklist:
 		insert := make([]string, len(noDefaults))
@@ -39,6 +39,27 @@ func TestColumns(t *testing.T) {
 	}
 }
 
+func TestColumnsWithExpr(t *testing.T) {
+	t.Parallel()
+
+	list := Whitelist("a")
+	withB := list.WithExpr("b", Expr("b + ?", 1))
+	withC := withB.WithExpr("c", Expr("now()"))
+
+	if len(list.Exprs) != 0 {
+		t.Error("original columns were changed")
+	}
+	if len(withB.Exprs) != 1 || withB.Exprs["b"].SQL != "b + ?" {
+		t.Errorf("expressions were wrong: %v", withB.Exprs)
+	}
+	if len(withC.Exprs) != 2 || withC.Exprs["c"].SQL != "now()" {
+		t.Errorf("expressions were wrong: %v", withC.Exprs)
+	}
+	if !withC.IsWhitelist() || len(withC.Cols) != 1 {
+		t.Error("columns were wrong")
+	}
+}
+
 func TestInsertColumnSet(t *testing.T) {
 	t.Parallel()
 
@@ -68,6 +89,7 @@ func TestInsertColumnSet(t *testing.T) {
 		{Columns: Whitelist("c"), Set: []string{"c"}, Ret: []string{"a"}},
 		{Columns: Whitelist("a", "c"), Set: []string{"a", "c"}, Ret: []string{}},
 		{Columns: Whitelist("a", "b", "c"), Set: []string{"a", "b", "c"}, Ret: []string{}},
+		{Columns: Whitelist("c", "a", "c"), Set: []string{"c", "a"}, Ret: []string{}},
 
 		// Whitelist + Nonzero defaults (shouldn't care, same results as above)
 		{Columns: Whitelist("a"), NonZeroDefaults: []string{"c"}, Set: []string{"a"}, Ret: []string{"c"}},
@@ -80,6 +102,7 @@ func TestInsertColumnSet(t *testing.T) {
 		// Greylist
 		{Columns: Greylist("c"), NonZeroDefaults: []string{}, Set: []string{"b", "c"}, Ret: []string{"a"}},
 		{Columns: Greylist("a"), NonZeroDefaults: []string{}, Set: []string{"a", "b"}, Ret: []string{"c"}},
+		{Columns: Greylist("b", "a", "a"), NonZeroDefaults: []string{}, Set: []string{"a", "b"}, Ret: []string{"c"}},
 	}
 
 	for i, test := range tests {
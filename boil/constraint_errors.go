@@ -0,0 +1,193 @@
+package boil
+
+import (
+	"regexp"
+	"strings"
+)
+
+// UniqueViolationError is returned when a statement would have created a
+// duplicate value for a unique constraint or index.
+type UniqueViolationError struct {
+	Constraint string
+	Columns    []string
+	Err        error
+}
+
+// ForeignKeyViolationError is returned when a statement would have left a
+// foreign key pointing at a row that doesn't exist.
+type ForeignKeyViolationError struct {
+	Constraint string
+	Columns    []string
+	Err        error
+}
+
+// NotNullViolationError is returned when a statement would have stored NULL
+// in a column that doesn't allow it.
+type NotNullViolationError struct {
+	Column string
+	Err    error
+}
+
+// CheckViolationError is returned when a statement would have violated a
+// check constraint.
+type CheckViolationError struct {
+	Constraint string
+	Err        error
+}
+
+// Error returns the underlying error string
+func (e UniqueViolationError) Error() string { return e.Err.Error() }
+
+// Cause returns the underlying error
+func (e UniqueViolationError) Cause() error { return e.Err }
+
+// Unwrap returns the underlying error
+func (e UniqueViolationError) Unwrap() error { return e.Err }
+
+// Error returns the underlying error string
+func (e ForeignKeyViolationError) Error() string { return e.Err.Error() }
+
+// Cause returns the underlying error
+func (e ForeignKeyViolationError) Cause() error { return e.Err }
+
+// Unwrap returns the underlying error
+func (e ForeignKeyViolationError) Unwrap() error { return e.Err }
+
+// Error returns the underlying error string
+func (e NotNullViolationError) Error() string { return e.Err.Error() }
+
+// Cause returns the underlying error
+func (e NotNullViolationError) Cause() error { return e.Err }
+
+// Unwrap returns the underlying error
+func (e NotNullViolationError) Unwrap() error { return e.Err }
+
+// Error returns the underlying error string
+func (e CheckViolationError) Error() string { return e.Err.Error() }
+
+// Cause returns the underlying error
+func (e CheckViolationError) Cause() error { return e.Err }
+
+// Unwrap returns the underlying error
+func (e CheckViolationError) Unwrap() error { return e.Err }
+
+var (
+	rgxPostgresKeyColumns = regexp.MustCompile(`^Key \((.*?)\)=`)
+
+	rgxMySQLQuoted       = regexp.MustCompile("'([^']*)'")
+	rgxMySQLForeignKey   = regexp.MustCompile("CONSTRAINT `([^`]*)` FOREIGN KEY \\(([^)]*)\\)")
+	rgxMSSQLQuoted       = regexp.MustCompile(`["']([^"']*)["']`)
+	rgxMSSQLUniqueIndex  = regexp.MustCompile(`unique index '([^']*)'`)
+	rgxMSSQLNullColumn   = regexp.MustCompile(`into column '([^']*)'`)
+	rgxMSSQLFKConstraint = regexp.MustCompile(`FOREIGN KEY constraint "([^"]*)"`)
+	rgxMSSQLCKConstraint = regexp.MustCompile(`CHECK constraint "([^"]*)"`)
+)
+
+// WrapConstraintErr converts a driver error reporting a constraint violation
+// into UniqueViolationError, ForeignKeyViolationError, NotNullViolationError
+// or CheckViolationError. Any other error is returned unchanged.
+//
+// Constraint and column names are filled in as far as the driver reports
+// them, MySQL for example doesn't say which columns a unique key covers.
+func WrapConstraintErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch errSQLState(err) {
+	case "23505":
+		return UniqueViolationError{
+			Constraint: errStringField(err, "Constraint"),
+			Columns:    postgresKeyColumns(errStringField(err, "Detail")),
+			Err:        err,
+		}
+	case "23503":
+		return ForeignKeyViolationError{
+			Constraint: errStringField(err, "Constraint"),
+			Columns:    postgresKeyColumns(errStringField(err, "Detail")),
+			Err:        err,
+		}
+	case "23502":
+		return NotNullViolationError{Column: errStringField(err, "Column"), Err: err}
+	case "23514":
+		return CheckViolationError{Constraint: errStringField(err, "Constraint"), Err: err}
+	}
+
+	num, ok := errNumber(err)
+	if !ok {
+		return err
+	}
+
+	msg := errStringField(err, "Message")
+	switch num {
+	// MySQL
+	case 1062, 1586:
+		return UniqueViolationError{Constraint: lastMatch(rgxMySQLQuoted, msg), Err: err}
+	case 1451, 1452:
+		e := ForeignKeyViolationError{Err: err}
+		if m := rgxMySQLForeignKey.FindStringSubmatch(msg); m != nil {
+			e.Constraint = m[1]
+			e.Columns = splitColumns(strings.ReplaceAll(m[2], "`", ""))
+		}
+		return e
+	case 1048:
+		return NotNullViolationError{Column: lastMatch(rgxMySQLQuoted, msg), Err: err}
+	case 3819:
+		return CheckViolationError{Constraint: lastMatch(rgxMySQLQuoted, msg), Err: err}
+
+	// MSSQL
+	case 2627:
+		return UniqueViolationError{Constraint: firstMatch(rgxMSSQLQuoted, msg), Err: err}
+	case 2601:
+		return UniqueViolationError{Constraint: firstMatch(rgxMSSQLUniqueIndex, msg), Err: err}
+	case 547:
+		if strings.Contains(msg, "FOREIGN KEY") || strings.Contains(msg, "REFERENCE") {
+			return ForeignKeyViolationError{Constraint: firstMatch(rgxMSSQLFKConstraint, msg), Err: err}
+		}
+		if strings.Contains(msg, "CHECK") {
+			return CheckViolationError{Constraint: firstMatch(rgxMSSQLCKConstraint, msg), Err: err}
+		}
+	case 515:
+		return NotNullViolationError{Column: firstMatch(rgxMSSQLNullColumn, msg), Err: err}
+	}
+
+	return err
+}
+
+// postgresKeyColumns extracts the column names from the detail of a postgres
+// error, which looks like: Key (a, b)=(1, 2) already exists.
+func postgresKeyColumns(detail string) []string {
+	m := rgxPostgresKeyColumns.FindStringSubmatch(detail)
+	if m == nil {
+		return nil
+	}
+
+	return splitColumns(m[1])
+}
+
+func splitColumns(s string) []string {
+	cols := strings.Split(s, ",")
+	for i, c := range cols {
+		cols[i] = strings.TrimSpace(c)
+	}
+
+	return cols
+}
+
+func firstMatch(rgx *regexp.Regexp, s string) string {
+	m := rgx.FindStringSubmatch(s)
+	if m == nil {
+		return ""
+	}
+
+	return m[1]
+}
+
+func lastMatch(rgx *regexp.Regexp, s string) string {
+	all := rgx.FindAllStringSubmatch(s, -1)
+	if len(all) == 0 {
+		return ""
+	}
+
+	return all[len(all)-1][1]
+}
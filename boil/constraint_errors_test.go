@@ -0,0 +1,138 @@
+package boil
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pkgerrors "github.com/friendsofgo/errors"
+)
+
+type testPQFullError struct {
+	Code       string
+	Detail     string
+	Column     string
+	Constraint string
+}
+
+func (e *testPQFullError) Error() string { return "pq: " + e.Code }
+
+type testMySQLFullError struct {
+	Number  uint16
+	Message string
+}
+
+func (e *testMySQLFullError) Error() string { return e.Message }
+
+type testMSSQLError struct {
+	Number  int32
+	Message string
+}
+
+func (e testMSSQLError) Error() string { return e.Message }
+
+func TestWrapConstraintErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Err  error
+		Want error
+	}{
+		{
+			Err:  &testPQFullError{Code: "23505", Constraint: "pilots_name_key", Detail: "Key (name, age)=(a, 1) already exists."},
+			Want: UniqueViolationError{Constraint: "pilots_name_key", Columns: []string{"name", "age"}},
+		},
+		{
+			Err:  &testPQFullError{Code: "23503", Constraint: "jets_pilot_id_fkey", Detail: `Key (pilot_id)=(5) is not present in table "pilots".`},
+			Want: ForeignKeyViolationError{Constraint: "jets_pilot_id_fkey", Columns: []string{"pilot_id"}},
+		},
+		{
+			Err:  &testPQFullError{Code: "23502", Column: "name"},
+			Want: NotNullViolationError{Column: "name"},
+		},
+		{
+			Err:  &testPQFullError{Code: "23514", Constraint: "age_positive"},
+			Want: CheckViolationError{Constraint: "age_positive"},
+		},
+		{
+			Err:  &testMySQLFullError{Number: 1062, Message: "Duplicate entry 'a' for key 'pilots.name_idx'"},
+			Want: UniqueViolationError{Constraint: "pilots.name_idx"},
+		},
+		{
+			Err: &testMySQLFullError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails " +
+				"(`db`.`jets`, CONSTRAINT `jets_pilot_id_fkey` FOREIGN KEY (`pilot_id`) REFERENCES `pilots` (`id`))"},
+			Want: ForeignKeyViolationError{Constraint: "jets_pilot_id_fkey", Columns: []string{"pilot_id"}},
+		},
+		{
+			Err:  &testMySQLFullError{Number: 1048, Message: "Column 'name' cannot be null"},
+			Want: NotNullViolationError{Column: "name"},
+		},
+		{
+			Err:  &testMySQLFullError{Number: 3819, Message: "Check constraint 'age_positive' is violated."},
+			Want: CheckViolationError{Constraint: "age_positive"},
+		},
+		{
+			Err:  testMSSQLError{Number: 2627, Message: "Violation of UNIQUE KEY constraint 'UQ_name'. Cannot insert duplicate key in object 'dbo.pilots'."},
+			Want: UniqueViolationError{Constraint: "UQ_name"},
+		},
+		{
+			Err:  testMSSQLError{Number: 547, Message: `The INSERT statement conflicted with the FOREIGN KEY constraint "FK_pilot". The conflict occurred in database "db".`},
+			Want: ForeignKeyViolationError{Constraint: "FK_pilot"},
+		},
+		{
+			Err:  testMSSQLError{Number: 547, Message: `The INSERT statement conflicted with the CHECK constraint "CK_age". The conflict occurred in database "db".`},
+			Want: CheckViolationError{Constraint: "CK_age"},
+		},
+		{
+			Err:  testMSSQLError{Number: 515, Message: "Cannot insert the value NULL into column 'name', table 'db.dbo.pilots'; column does not allow nulls. INSERT fails."},
+			Want: NotNullViolationError{Column: "name"},
+		},
+	}
+
+	for i, test := range tests {
+		got := WrapConstraintErr(test.Err)
+
+		// Fill in the wrapped error so the whole struct can be compared
+		want := reflect.New(reflect.TypeOf(test.Want)).Elem()
+		want.Set(reflect.ValueOf(test.Want))
+		want.FieldByName("Err").Set(reflect.ValueOf(test.Err))
+
+		if !reflect.DeepEqual(got, want.Interface()) {
+			t.Errorf("%d) want: %#v, got: %#v", i, want.Interface(), got)
+		}
+	}
+}
+
+func TestWrapConstraintErrPassthrough(t *testing.T) {
+	t.Parallel()
+
+	if WrapConstraintErr(nil) != nil {
+		t.Error("nil should stay nil")
+	}
+
+	errs := []error{
+		errors.New("test error"),
+		&testPQFullError{Code: "40001"},
+		&testMySQLFullError{Number: 1213},
+	}
+	for i, err := range errs {
+		if got := WrapConstraintErr(err); got != err {
+			t.Errorf("%d) error should be returned unchanged, got: %#v", i, got)
+		}
+	}
+}
+
+func TestConstraintErrCause(t *testing.T) {
+	t.Parallel()
+
+	driverErr := &testPQFullError{Code: "23505"}
+	err := pkgerrors.Wrap(WrapConstraintErr(driverErr), "models: unable to insert into pilots")
+
+	var unique UniqueViolationError
+	if !errors.As(err, &unique) {
+		t.Fatalf("expected to find a UniqueViolationError in: %#v", err)
+	}
+	if pkgerrors.Cause(err) != driverErr {
+		t.Error("expected the driver error to be the cause")
+	}
+}
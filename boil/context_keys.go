@@ -7,4 +7,12 @@ const (
 	ctxSkipTimestamps
 	ctxDebug
 	ctxDebugWriter
+	ctxSchema
+	ctxTransaction
+	ctxStrictBind
+	ctxSkipHookPoints
+	ctxSQLComment
+	ctxAuditActor
+	ctxSkipAudit
+	ctxSkipOutbox
 )
@@ -0,0 +1,60 @@
+// Package dbstats exposes the state of the connection pools used with the
+// generated models, and the queries in flight on them, as json over http or
+// through expvar:
+//
+//	inFlight := boil.NewInFlightQueries()
+//	exec := boil.Chain(db, inFlight.Middleware)
+//
+//	dbstats.Publish("db", db, inFlight)
+//	http.Handle("/debug/db", dbstats.Handler(db, inFlight))
+//
+// It's a package of its own as importing expvar adds /debug/vars to
+// http.DefaultServeMux.
+package dbstats
+
+import (
+	"database/sql"
+	"encoding/json"
+	"expvar"
+	"net/http"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Stats is the state of a connection pool, along with the number of
+// queries in flight on it for each table when they're counted.
+type Stats struct {
+	sql.DBStats
+
+	InFlight map[string]int64 `json:",omitempty"`
+}
+
+// Collect returns the state of the pool of db and the queries in flight
+// counted by inFlight, which may be nil.
+func Collect(db *sql.DB, inFlight *boil.InFlightQueries) Stats {
+	stats := Stats{DBStats: db.Stats()}
+	if inFlight != nil {
+		stats.InFlight = inFlight.Tables()
+	}
+	return stats
+}
+
+// Handler serves the Stats of db and inFlight, which may be nil, as json,
+// so the saturation of the pool can be watched:
+//
+//	http.Handle("/debug/db", dbstats.Handler(db, inFlight))
+func Handler(db *sql.DB, inFlight *boil.InFlightQueries) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Collect(db, inFlight))
+	})
+}
+
+// Publish publishes the Stats of db and inFlight, which may be nil, as the
+// expvar name, which makes them part of /debug/vars. Like expvar.Publish it
+// panics if name is already taken.
+func Publish(name string, db *sql.DB, inFlight *boil.InFlightQueries) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return Collect(db, inFlight)
+	}))
+}
@@ -0,0 +1,51 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(7)
+
+	mock.ExpectExec("delete from jets").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	inFlight := boil.NewInFlightQueries()
+	var stats Stats
+	serve := func(next boil.ContextExecutor) boil.ContextExecutor {
+		return boil.ExecutorFuncs{
+			ExecContext: func(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+				rec := httptest.NewRecorder()
+				Handler(db, inFlight).ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+				if err := json.Unmarshal(rec.Body.Bytes(), &stats); err != nil {
+					t.Fatal(err)
+				}
+				return next.ExecContext(ctx, query, args...)
+			},
+		}.Wrap(next)
+	}
+
+	if _, err := boil.Chain(db, inFlight.Middleware, serve).Exec("delete from jets"); err != nil {
+		t.Fatal(err)
+	}
+	if stats.MaxOpenConnections != 7 || stats.InFlight["jets"] != 1 {
+		t.Errorf("wrong stats: %#v", stats)
+	}
+
+	if Collect(db, nil).InFlight != nil {
+		t.Error("there should be no in flight counts without a counter")
+	}
+}
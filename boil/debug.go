@@ -2,8 +2,15 @@ package boil
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"io"
 	"os"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // DebugMode is a flag controlling whether generated sql statements and
@@ -22,18 +29,23 @@ func WithDebug(ctx context.Context, debug bool) context.Context {
 	return context.WithValue(ctx, ctxDebug, debug)
 }
 
-// IsDebug returns true if the context has debugging enabled, or
-// the value of DebugMode if not set.
+// IsDebug returns true if the context has debugging enabled, either with
+// WithDebug or by having a writer set with WithDebugWriter, or the value of
+// DebugMode if neither is set.
 func IsDebug(ctx context.Context) bool {
 	debug, ok := ctx.Value(ctxDebug).(bool)
 	if ok {
 		return debug
 	}
+	if _, ok := ctx.Value(ctxDebugWriter).(io.Writer); ok {
+		return true
+	}
 	return DebugMode
 }
 
-// WithDebugWriter modifies a context to configure the writer written to
-// when debugging is enabled.
+// WithDebugWriter modifies a context to write the queries made using it to
+// writer, which enables debugging for just this context unless it's turned
+// off with WithDebug.
 func WithDebugWriter(ctx context.Context, writer io.Writer) context.Context {
 	return context.WithValue(ctx, ctxDebugWriter, writer)
 }
@@ -47,3 +59,116 @@ func DebugWriterFrom(ctx context.Context) io.Writer {
 	}
 	return DebugWriter
 }
+
+// ExecContext executes query with exec, with the comment of ctx added (see
+// SQLCommentTags). When debugging is enabled for ctx the query, its
+// arguments and how long it took are written to the debug writer of ctx
+// once it returns.
+func ExecContext(ctx context.Context, exec ContextExecutor, query string, args ...interface{}) (sql.Result, error) {
+	query = AppendSQLComment(ctx, query)
+	if !IsDebug(ctx) {
+		return exec.ExecContext(ctx, query, args...)
+	}
+
+	start := time.Now()
+	result, err := exec.ExecContext(ctx, query, args...)
+	writeDebug(ctx, query, args, time.Since(start), err)
+	return result, err
+}
+
+// QueryContext executes query with exec and returns the rows, see
+// ExecContext for the debug output.
+func QueryContext(ctx context.Context, exec ContextExecutor, query string, args ...interface{}) (*sql.Rows, error) {
+	query = AppendSQLComment(ctx, query)
+	if !IsDebug(ctx) {
+		return exec.QueryContext(ctx, query, args...)
+	}
+
+	start := time.Now()
+	rows, err := exec.QueryContext(ctx, query, args...)
+	writeDebug(ctx, query, args, time.Since(start), err)
+	return rows, err
+}
+
+// QueryRowContext executes query with exec and returns the row, see
+// ExecContext for the debug output. Errors only surface when the row is
+// scanned so they aren't written.
+func QueryRowContext(ctx context.Context, exec ContextExecutor, query string, args ...interface{}) *sql.Row {
+	query = AppendSQLComment(ctx, query)
+	if !IsDebug(ctx) {
+		return exec.QueryRowContext(ctx, query, args...)
+	}
+
+	start := time.Now()
+	row := exec.QueryRowContext(ctx, query, args...)
+	writeDebug(ctx, query, args, time.Since(start), nil)
+	return row
+}
+
+// writeDebug writes a query to the debug writer of ctx in a single write, so
+// the output of concurrent queries doesn't interleave.
+func writeDebug(ctx context.Context, query string, args []interface{}, took time.Duration, err error) {
+	var b strings.Builder
+	b.WriteString(query)
+	b.WriteByte('\n')
+	b.WriteString(DebugArgs(args))
+	fmt.Fprintf(&b, "\n-- took %s", took.Round(time.Microsecond))
+	if err != nil {
+		fmt.Fprintf(&b, ", error: %v", err)
+	}
+	b.WriteByte('\n')
+
+	io.WriteString(DebugWriterFrom(ctx), b.String())
+}
+
+// DebugArgs renders the arguments of a query for debug output: the values of
+// driver.Valuers are used, strings and bytes are quoted, times are written
+// in RFC 3339 and nulls, including nil pointers, as NULL.
+func DebugArgs(args []interface{}) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, arg := range args {
+		if i != 0 {
+			b.WriteString(", ")
+		}
+
+		if rv := reflect.ValueOf(arg); rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				b.WriteString("NULL")
+				continue
+			}
+			if _, ok := arg.(driver.Valuer); !ok {
+				arg = rv.Elem().Interface()
+			}
+		}
+
+		if valuer, ok := arg.(driver.Valuer); ok {
+			v, err := valuer.Value()
+			if err != nil {
+				fmt.Fprintf(&b, "<%v>", err)
+				continue
+			}
+			arg = v
+		}
+
+		switch v := arg.(type) {
+		case nil:
+			b.WriteString("NULL")
+		case string:
+			fmt.Fprintf(&b, "%q", v)
+		case []byte:
+			if utf8.Valid(v) {
+				fmt.Fprintf(&b, "%q", v)
+			} else {
+				fmt.Fprintf(&b, "0x%x", v)
+			}
+		case time.Time:
+			b.WriteString(v.Format(time.RFC3339Nano))
+		default:
+			fmt.Fprintf(&b, "%v", v)
+		}
+	}
+	b.WriteByte(']')
+
+	return b.String()
+}
@@ -0,0 +1,92 @@
+package boil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestIsDebug(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	if IsDebug(ctx) {
+		t.Error("debugging should be off by default")
+	}
+
+	var buf bytes.Buffer
+	ctx = WithDebugWriter(ctx, &buf)
+	if !IsDebug(ctx) {
+		t.Error("a debug writer should turn on debugging")
+	}
+	if DebugWriterFrom(ctx) != &buf {
+		t.Error("wrong debug writer")
+	}
+	if IsDebug(WithDebug(ctx, false)) {
+		t.Error("WithDebug should turn debugging off again")
+	}
+}
+
+func TestDebugArgs(t *testing.T) {
+	t.Parallel()
+
+	var nilInt *int
+	five := 5
+	when := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	got := DebugArgs([]interface{}{
+		1, "a\"b", []byte("text"), []byte{0xff, 0x00}, nil, nilInt, &five,
+		when, null.String{}, null.StringFrom("c"), null.IntFrom(7),
+	})
+	want := `[1, "a\"b", "text", 0xff00, NULL, NULL, 5, 2020-01-02T03:04:05Z, NULL, "c", 7]`
+	if got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestDebugExecContext(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("update a set b = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("update a set b = ?").WithArgs(2).WillReturnError(errors.New("boom"))
+	mock.ExpectExec("update a set b = ?").WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	var buf bytes.Buffer
+	ctx := WithDebugWriter(context.Background(), &buf)
+	if _, err := ExecContext(ctx, db, "update a set b = ?", 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ExecContext(ctx, db, "update a set b = ?", 2); err == nil {
+		t.Fatal("want an error")
+	}
+	if _, err := ExecContext(context.Background(), db, "update a set b = ?", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(buf.String(), "\n")
+	if len(lines) != 7 || lines[0] != "update a set b = ?" || lines[1] != "[1]" || lines[4] != "[2]" {
+		t.Fatalf("unexpected output:\n%s", buf.String())
+	}
+	if !regexp.MustCompile(`^-- took \S+$`).MatchString(lines[2]) {
+		t.Errorf("wrong duration: %s", lines[2])
+	}
+	if !regexp.MustCompile(`^-- took \S+, error: boom$`).MatchString(lines[5]) {
+		t.Errorf("wrong error: %s", lines[5])
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
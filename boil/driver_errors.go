@@ -0,0 +1,82 @@
+package boil
+
+import "reflect"
+
+// The database drivers are not imported by this package, so their error
+// types are recognized by their shape instead:
+//
+//   lib/pq             *pq.Error{Code, Constraint, Column, Detail, ...}
+//   pgx                *pgconn.PgError with a SQLState() method
+//   go-sql-driver      *mysql.MySQLError{Number, Message}
+//   go-mssqldb         mssql.Error{Number, Message}
+
+// errSQLState returns the SQLSTATE code reported by a postgres driver error
+func errSQLState(err error) string {
+	if s, ok := err.(interface{ SQLState() string }); ok {
+		return s.SQLState()
+	}
+
+	return errStringField(err, "Code")
+}
+
+// errNumber returns the vendor error number reported by a mysql or mssql
+// driver error
+func errNumber(err error) (uint64, bool) {
+	val := errStruct(err)
+	if !val.IsValid() {
+		return 0, false
+	}
+
+	num := val.FieldByName("Number")
+	if !num.IsValid() {
+		return 0, false
+	}
+
+	switch num.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return num.Uint(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if num.Int() < 0 {
+			return 0, false
+		}
+		return uint64(num.Int()), true
+	}
+
+	return 0, false
+}
+
+// errStringField returns the value of the string field called name on the
+// driver error, or the empty string if there is no such field.
+func errStringField(err error, name string) string {
+	val := errStruct(err)
+	if !val.IsValid() {
+		return ""
+	}
+
+	field := val.FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return ""
+	}
+
+	return field.String()
+}
+
+func errStruct(err error) reflect.Value {
+	if err == nil {
+		return reflect.Value{}
+	}
+
+	val := reflect.ValueOf(err)
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return reflect.Value{}
+		}
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+
+	return val
+}
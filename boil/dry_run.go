@@ -0,0 +1,139 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+)
+
+// DryRunStatement is a statement recorded by a DryRunExecutor.
+type DryRunStatement struct {
+	Query string
+	Args  []interface{}
+}
+
+// DryRunExecutor is a ContextExecutor that records the statements it's
+// given instead of running them, to preview what a code path would run
+// against a database before it's turned on for real:
+//
+//	dry := &boil.DryRunExecutor{Reads: db}
+//	err := archiveOldPilots(ctx, dry)
+//	fmt.Print(dry)
+//
+// Statements that don't return rows report zero rows affected and a zero
+// last insert id. Queries return no rows, so scanning a row returns
+// sql.ErrNoRows, unless Reads is set: selects are then run with it, so the
+// code path sees the real data, and recorded all the same. Selects that
+// lock rows aren't passed on. Transactions can't be begun on it.
+//
+// The zero value is ready to use, and it can be used concurrently.
+type DryRunExecutor struct {
+	// Reads runs selects when it isn't nil.
+	Reads ContextExecutor
+
+	mut        sync.Mutex
+	statements []DryRunStatement
+
+	// empty serves the rows of queries that aren't run
+	once  sync.Once
+	empty *sql.DB
+}
+
+// Exec records a statement that doesn't return rows
+func (d *DryRunExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return d.ExecContext(context.Background(), query, args...)
+}
+
+// Query records a query and returns no rows, or the rows from Reads
+func (d *DryRunExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return d.QueryContext(context.Background(), query, args...)
+}
+
+// QueryRow records a query and returns no row, or the row from Reads
+func (d *DryRunExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return d.QueryRowContext(context.Background(), query, args...)
+}
+
+// ExecContext records a statement that doesn't return rows
+func (d *DryRunExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	d.record(query, args)
+	return dryRunResult{}, nil
+}
+
+// QueryContext records a query and returns no rows, or the rows from Reads
+func (d *DryRunExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	d.record(query, args)
+	if d.isRead(query) {
+		return d.Reads.QueryContext(ctx, query, args...)
+	}
+	return d.emptyDB().QueryContext(ctx, "", &queryCacheEntry{})
+}
+
+// QueryRowContext records a query and returns no row, or the row from Reads
+func (d *DryRunExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	d.record(query, args)
+	if d.isRead(query) {
+		return d.Reads.QueryRowContext(ctx, query, args...)
+	}
+	return d.emptyDB().QueryRowContext(ctx, "", &queryCacheEntry{})
+}
+
+// Statements returns the statements recorded so far, in the order they were
+// given.
+func (d *DryRunExecutor) Statements() []DryRunStatement {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	statements := make([]DryRunStatement, len(d.statements))
+	copy(statements, d.statements)
+	return statements
+}
+
+// Reset forgets the recorded statements.
+func (d *DryRunExecutor) Reset() {
+	d.mut.Lock()
+	d.statements = nil
+	d.mut.Unlock()
+}
+
+// String lists the recorded statements, each followed by its arguments
+// rendered with DebugArgs.
+func (d *DryRunExecutor) String() string {
+	var b strings.Builder
+	for _, s := range d.Statements() {
+		b.WriteString(s.Query)
+		b.WriteByte('\n')
+		b.WriteString(DebugArgs(s.Args))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func (d *DryRunExecutor) record(query string, args []interface{}) {
+	statement := DryRunStatement{Query: query}
+	if len(args) != 0 {
+		statement.Args = append([]interface{}(nil), args...)
+	}
+
+	d.mut.Lock()
+	d.statements = append(d.statements, statement)
+	d.mut.Unlock()
+}
+
+func (d *DryRunExecutor) isRead(query string) bool {
+	return d.Reads != nil && rgxQueryCacheSelect.MatchString(query) && !rgxQueryCacheLock.MatchString(query)
+}
+
+func (d *DryRunExecutor) emptyDB() *sql.DB {
+	d.once.Do(func() {
+		d.empty = sql.OpenDB(queryCacheConnector{})
+	})
+	return d.empty
+}
+
+// dryRunResult is the result of every statement that isn't run.
+type dryRunResult struct{}
+
+func (dryRunResult) LastInsertId() (int64, error) { return 0, nil }
+func (dryRunResult) RowsAffected() (int64, error) { return 0, nil }
@@ -0,0 +1,90 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestDryRunExecutor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dry := &DryRunExecutor{}
+
+	res, err := dry.ExecContext(ctx, "update a set b = ?", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := res.RowsAffected(); n != 0 || err != nil {
+		t.Errorf("want zero rows affected, got %d %v", n, err)
+	}
+	if id, err := res.LastInsertId(); id != 0 || err != nil {
+		t.Errorf("want a zero id, got %d %v", id, err)
+	}
+
+	rows, err := dry.QueryContext(ctx, "select b from a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows.Next() {
+		t.Error("want no rows")
+	}
+	rows.Close()
+
+	var b int
+	if err := dry.QueryRow("insert into a (b) values (?) returning id", "x").Scan(&b); err != sql.ErrNoRows {
+		t.Errorf("want sql.ErrNoRows, got %v", err)
+	}
+
+	want := []DryRunStatement{
+		{Query: "update a set b = ?", Args: []interface{}{1}},
+		{Query: "select b from a"},
+		{Query: "insert into a (b) values (?) returning id", Args: []interface{}{"x"}},
+	}
+	if got := dry.Statements(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want statements %#v, got %#v", want, got)
+	}
+	if got, want := dry.String(), "update a set b = ?\n[1]\nselect b from a\n[]\ninsert into a (b) values (?) returning id\n[\"x\"]\n"; got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+
+	dry.Reset()
+	if len(dry.Statements()) != 0 {
+		t.Error("statements should be forgotten")
+	}
+}
+
+func TestDryRunExecutorReads(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("select b from a").WillReturnRows(sqlmock.NewRows([]string{"b"}).AddRow(5))
+
+	dry := &DryRunExecutor{Reads: db}
+	var b int
+	if err := dry.QueryRow("select b from a").Scan(&b); err != nil || b != 5 {
+		t.Errorf("want the row from the database, got %d %v", b, err)
+	}
+	if err := dry.QueryRow("select b from a for update").Scan(&b); err != sql.ErrNoRows {
+		t.Errorf("locking selects shouldn't be run, got %v", err)
+	}
+	if _, err := dry.Exec("delete from a"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dry.Statements()) != 3 {
+		t.Errorf("every statement should be recorded, got %v", dry.Statements())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,5 +1,10 @@
 package boil
 
+import (
+	"database/sql"
+	"errors"
+)
+
 type boilErr struct {
 	error
 }
@@ -21,3 +26,39 @@ func IsBoilErr(err error) bool {
 	_, ok := err.(boilErr)
 	return ok
 }
+
+// ErrNotFound is matched by errors.Is for every NotFoundError
+var ErrNotFound = errors.New("boil: not found")
+
+// NotFoundError is returned by the generated One and Find methods when no
+// row matched. It wraps sql.ErrNoRows, so both errors.Is(err, ErrNotFound)
+// and errors.Is(err, sql.ErrNoRows) hold, as does
+// errors.Cause(err) == sql.ErrNoRows.
+type NotFoundError struct {
+	Table string
+}
+
+// NewNotFoundError creates a NotFoundError for table
+func NewNotFoundError(table string) error {
+	return NotFoundError{Table: table}
+}
+
+// Error returns the message of sql.ErrNoRows
+func (e NotFoundError) Error() string {
+	return sql.ErrNoRows.Error()
+}
+
+// Is reports whether target is ErrNotFound
+func (e NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// Cause returns sql.ErrNoRows
+func (e NotFoundError) Cause() error {
+	return sql.ErrNoRows
+}
+
+// Unwrap returns sql.ErrNoRows
+func (e NotFoundError) Unwrap() error {
+	return sql.ErrNoRows
+}
@@ -1,8 +1,11 @@
 package boil
 
 import (
+	"database/sql"
 	"errors"
 	"testing"
+
+	pkgerrors "github.com/friendsofgo/errors"
 )
 
 func TestErrors(t *testing.T) {
@@ -22,3 +25,24 @@ func TestErrors(t *testing.T) {
 		t.Errorf("Expected true")
 	}
 }
+
+func TestNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	err := pkgerrors.Wrap(NewNotFoundError("pilots"), "context")
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("expected error to be ErrNotFound")
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Error("expected error to be sql.ErrNoRows")
+	}
+	if pkgerrors.Cause(err) != sql.ErrNoRows {
+		t.Error("expected cause to be sql.ErrNoRows")
+	}
+
+	var nf NotFoundError
+	if !errors.As(err, &nf) || nf.Table != "pilots" {
+		t.Errorf("expected NotFoundError for pilots, got: %#v", nf)
+	}
+}
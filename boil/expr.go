@@ -0,0 +1,19 @@
+package boil
+
+// Expression is a piece of SQL that's used as a column value instead of a
+// bound parameter, see Expr.
+type Expression struct {
+	SQL  string
+	Args []interface{}
+}
+
+// Expr creates an Expression from sql, its question marks are placeholders
+// for args like in qm.Where. It's meant for values that depend on the row,
+// such as incrementing a counter:
+//
+//   models.Pilots(qm.Where("id=?", 1)).UpdateAll(ctx, db, models.M{
+//     "flights": boil.Expr("flights + ?", 1),
+//   })
+func Expr(sql string, args ...interface{}) Expression {
+	return Expression{SQL: sql, Args: args}
+}
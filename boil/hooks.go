@@ -14,6 +14,27 @@ func HooksAreSkipped(ctx context.Context) bool {
 	return skip != nil && skip.(bool)
 }
 
+// SkipHookPoints modifies a context to prevent the hooks of the given points
+// from running for any query it encounters, while the hooks of the other
+// points still run. Points already skipped by ctx stay skipped.
+func SkipHookPoints(ctx context.Context, points ...HookPoint) context.Context {
+	skipped, _ := ctx.Value(ctxSkipHookPoints).(uint32)
+	for _, p := range points {
+		skipped |= 1 << uint(p)
+	}
+	return context.WithValue(ctx, ctxSkipHookPoints, skipped)
+}
+
+// HookIsSkipped returns true if the context skips the hooks of point, either
+// because all hooks are skipped or because of SkipHookPoints.
+func HookIsSkipped(ctx context.Context, point HookPoint) bool {
+	if HooksAreSkipped(ctx) {
+		return true
+	}
+	skipped, _ := ctx.Value(ctxSkipHookPoints).(uint32)
+	return skipped&(1<<uint(point)) != 0
+}
+
 // SkipTimestamps modifies a context to prevent hooks from running for any query
 // it encounters.
 func SkipTimestamps(ctx context.Context) context.Context {
@@ -20,6 +20,23 @@ func TestSkipHooks(t *testing.T) {
 	}
 }
 
+func TestSkipHookPoints(t *testing.T) {
+	t.Parallel()
+
+	ctx := SkipHookPoints(context.Background(), AfterSelectHook)
+	ctx = SkipHookPoints(ctx, BeforeInsertHook)
+
+	if !HookIsSkipped(ctx, AfterSelectHook) || !HookIsSkipped(ctx, BeforeInsertHook) {
+		t.Error("they should be skipped")
+	}
+	if HookIsSkipped(ctx, AfterInsertHook) || HooksAreSkipped(ctx) {
+		t.Error("other hooks should not be skipped")
+	}
+	if !HookIsSkipped(SkipHooks(context.Background()), AfterInsertHook) {
+		t.Error("SkipHooks should skip every hook point")
+	}
+}
+
 func TestSkipTimestamps(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,88 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+)
+
+// InFlightQueries counts the queries that are running, by the table they
+// write to or otherwise the first table they read from. Use its Middleware
+// on the executor the models are used with:
+//
+//	inFlight := boil.NewInFlightQueries()
+//	exec := boil.Chain(db, inFlight.Middleware)
+//
+// The dbstats package exposes the counts along with the stats of the pool.
+//
+// Queries count until they return, the time spent reading their rows isn't
+// included. Statements without a table are counted under "".
+type InFlightQueries struct {
+	mut    sync.Mutex
+	tables map[string]int64
+}
+
+// NewInFlightQueries creates a counter for queries in flight.
+func NewInFlightQueries() *InFlightQueries {
+	return &InFlightQueries{tables: make(map[string]int64)}
+}
+
+// Middleware counts the queries run on next while they're running.
+func (f *InFlightQueries) Middleware(next ContextExecutor) ContextExecutor {
+	return ExecutorFuncs{
+		ExecContext: func(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+			defer f.start(query)()
+			return next.ExecContext(ctx, query, args...)
+		},
+		QueryContext: func(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+			defer f.start(query)()
+			return next.QueryContext(ctx, query, args...)
+		},
+		QueryRowContext: func(ctx context.Context, query string, args ...interface{}) *sql.Row {
+			defer f.start(query)()
+			return next.QueryRowContext(ctx, query, args...)
+		},
+	}.Wrap(next)
+}
+
+// Tables returns the number of queries in flight for each table that has
+// any.
+func (f *InFlightQueries) Tables() map[string]int64 {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	tables := make(map[string]int64, len(f.tables))
+	for t, n := range f.tables {
+		tables[t] = n
+	}
+	return tables
+}
+
+// start counts query as in flight until the returned function is called.
+func (f *InFlightQueries) start(query string) func() {
+	table := inFlightTable(query)
+
+	f.mut.Lock()
+	f.tables[table]++
+	f.mut.Unlock()
+
+	return func() {
+		f.mut.Lock()
+		if f.tables[table]--; f.tables[table] == 0 {
+			delete(f.tables, table)
+		}
+		f.mut.Unlock()
+	}
+}
+
+// inFlightTable returns the table query writes to, or the first table it
+// reads from.
+func inFlightTable(query string) string {
+	if m := rgxQueryCacheWrite.FindStringSubmatch(query); m != nil {
+		return queryCacheTableName(m[1])
+	}
+	if m := rgxQueryCacheRead.FindStringSubmatch(query); m != nil {
+		return queryCacheTableName(m[1])
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestInFlightQueries(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(`update "pilots" set b = ?`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	inFlight := NewInFlightQueries()
+	var during map[string]int64
+	spy := func(next ContextExecutor) ContextExecutor {
+		return ExecutorFuncs{
+			ExecContext: func(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+				during = inFlight.Tables()
+				return next.ExecContext(ctx, query, args...)
+			},
+		}.Wrap(next)
+	}
+
+	exec := Chain(db, inFlight.Middleware, spy)
+	if _, err := exec.Exec(`update "pilots" set b = ?`); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := map[string]int64{"pilots": 1}; !reflect.DeepEqual(during, want) {
+		t.Errorf("want %v in flight, got %v", want, during)
+	}
+	if len(inFlight.Tables()) != 0 {
+		t.Errorf("nothing should be in flight, got %v", inFlight.Tables())
+	}
+
+	for query, want := range map[string]string{
+		"SELECT * FROM `jets` JOIN pilots": "jets",
+		"DELETE FROM public.jets":          "jets",
+		"SELECT 1":                         "",
+	} {
+		if got := inFlightTable(query); got != want {
+			t.Errorf("%s: want %q, got %q", query, want, got)
+		}
+	}
+}
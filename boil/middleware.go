@@ -0,0 +1,98 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Middleware wraps an executor to add behaviour around the queries run on
+// it, like tracing, metrics, retries or logging. The executor it returns
+// should pass queries on to next.
+//
+// Transactions can't be begun on a wrapped executor, to use middleware in
+// a transaction wrap the *sql.Tx instead:
+//
+//	tx, err := db.BeginTx(ctx, nil)
+//	...
+//	exec := boil.Chain(tx, tracing, metrics)
+type Middleware func(next ContextExecutor) ContextExecutor
+
+// Chain wraps exec in the middlewares. The first middleware is the
+// outermost one, it sees a query first and its result last.
+func Chain(exec ContextExecutor, middlewares ...Middleware) ContextExecutor {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		exec = middlewares[i](exec)
+	}
+	return exec
+}
+
+// Compose combines middlewares into a single one that applies them in the
+// same order as Chain.
+func Compose(middlewares ...Middleware) Middleware {
+	return func(next ContextExecutor) ContextExecutor {
+		return Chain(next, middlewares...)
+	}
+}
+
+// ExecutorFuncs implements the methods of an executor with functions, the
+// methods with a nil function are passed on to the executor it wraps. It
+// makes writing a Middleware a matter of providing the functions:
+//
+//	func Timing(next boil.ContextExecutor) boil.ContextExecutor {
+//		return boil.ExecutorFuncs{
+//			ExecContext: func(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+//				defer observe(query, time.Now())
+//				return next.ExecContext(ctx, query, args...)
+//			},
+//		}.Wrap(next)
+//	}
+type ExecutorFuncs struct {
+	ExecContext     func(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext    func(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext func(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Wrap returns an executor that runs queries with the functions, or with
+// next when they're nil. The methods without a context call the ones with
+// a context using context.Background, so the functions see every query.
+func (f ExecutorFuncs) Wrap(next ContextExecutor) ContextExecutor {
+	return funcsExecutor{next: next, funcs: f}
+}
+
+type funcsExecutor struct {
+	next  ContextExecutor
+	funcs ExecutorFuncs
+}
+
+func (e funcsExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return e.ExecContext(context.Background(), query, args...)
+}
+
+func (e funcsExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return e.QueryContext(context.Background(), query, args...)
+}
+
+func (e funcsExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return e.QueryRowContext(context.Background(), query, args...)
+}
+
+func (e funcsExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if e.funcs.ExecContext == nil {
+		return e.next.ExecContext(ctx, query, args...)
+	}
+	return e.funcs.ExecContext(ctx, query, args...)
+}
+
+func (e funcsExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if e.funcs.QueryContext == nil {
+		return e.next.QueryContext(ctx, query, args...)
+	}
+	return e.funcs.QueryContext(ctx, query, args...)
+}
+
+func (e funcsExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if e.funcs.QueryRowContext == nil {
+		return e.next.QueryRowContext(ctx, query, args...)
+	}
+	return e.funcs.QueryRowContext(ctx, query, args...)
+}
@@ -0,0 +1,56 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("update a set b = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("select b from a").WillReturnRows(sqlmock.NewRows([]string{"b"}).AddRow(1))
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next ContextExecutor) ContextExecutor {
+			return ExecutorFuncs{
+				ExecContext: func(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+					calls = append(calls, name)
+					return next.ExecContext(ctx, query, args...)
+				},
+			}.Wrap(next)
+		}
+	}
+
+	exec := Chain(db, record("a"), Compose(record("b"), record("c")))
+	if _, err := exec.Exec("update a set b = ?", 1); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("want calls %v, got %v", want, calls)
+	}
+
+	rows, err := exec.Query("select b from a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+	if len(calls) != 3 {
+		t.Errorf("queries should pass through, got calls %v", calls)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,20 @@
+package boil
+
+import "context"
+
+// SkipOutbox modifies a context so that the changes made with it to tables
+// publishing change events don't add messages to the outbox, while the other
+// hooks still run.
+func SkipOutbox(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxSkipOutbox, true)
+}
+
+// OutboxIsSkipped returns true if the context skips the outbox, either
+// because of SkipOutbox or because all hooks are skipped.
+func OutboxIsSkipped(ctx context.Context) bool {
+	if HooksAreSkipped(ctx) {
+		return true
+	}
+	skip, _ := ctx.Value(ctxSkipOutbox).(bool)
+	return skip
+}
@@ -0,0 +1,24 @@
+package boil
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSkipOutbox(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	if OutboxIsSkipped(ctx) {
+		t.Error("outbox should not be skipped")
+	}
+	if !OutboxIsSkipped(SkipOutbox(ctx)) {
+		t.Error("outbox should be skipped")
+	}
+	if !OutboxIsSkipped(SkipHooks(ctx)) {
+		t.Error("outbox should be skipped with the hooks")
+	}
+	if AuditIsSkipped(SkipOutbox(ctx)) {
+		t.Error("skipping the outbox should not skip the audit")
+	}
+}
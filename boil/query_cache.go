@@ -0,0 +1,506 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+)
+
+var (
+	rgxQueryCacheSelect = regexp.MustCompile(`(?i)^select\b`)
+	rgxQueryCacheReads  = regexp.MustCompile(`(?i)^(?:select|values|table|show|explain|describe|begin|start\s+transaction|commit|rollback|savepoint|release|set)\b`)
+	rgxQueryCacheCTE    = regexp.MustCompile(`(?i)^with(?:\s+recursive)?\s`)
+	rgxQueryCacheLock   = regexp.MustCompile(`(?i)\bfor\s+(?:no\s+key\s+)?(?:update|share|key\s+share)\b`)
+	rgxQueryCacheRead   = regexp.MustCompile(`(?i)\b(?:from|join)\s+([^\s(),;]+)`)
+	rgxQueryCacheWrite  = regexp.MustCompile(`(?i)^(?:insert\s+(?:ignore\s+)?into|replace\s+into|update|delete\s+from|truncate(?:\s+table)?)\s+([^\s(),;]+)`)
+	rgxQueryCacheSpace  = regexp.MustCompile(`\s+`)
+)
+
+// QueryCache is a ContextExecutor that caches the rows of SELECT queries by
+// their SQL and arguments for ttl, a read-through cache for tables that are
+// read a lot more often than they change. Every other statement is passed
+// through to the wrapped executor.
+//
+// Cached rows are dropped when their ttl is up, when a table they were read
+// from is written to through the cache, and when InvalidateTable is called
+// for one of them. The tables of a query are found in its FROM and JOIN
+// clauses. Writes that don't go through the cache, eg. in a transaction,
+// have to invalidate explicitly, the generated AddXQueryCacheHooks functions
+// add hooks to the models that do. Queries that lock rows are never cached.
+//
+// Leading comments and WITH clauses are skipped to tell reads from writes.
+// Statements that are neither a known read nor a write to a single table,
+// eg. CALL or ALTER TABLE, drop every cached row.
+type QueryCache struct {
+	exec ContextExecutor
+	ttl  time.Duration
+
+	// replay serves cached rows as *sql.Rows
+	replay *sql.DB
+
+	mut       sync.Mutex
+	entries   map[string]*queryCacheEntry
+	nextSweep int
+
+	// generations count the invalidations of each table and of the whole
+	// cache, a read that saw one of its tables invalidated while it ran
+	// isn't cached since its rows may be from before the write.
+	generations map[string]uint64
+	generation  uint64
+}
+
+type queryCacheEntry struct {
+	tables  []string
+	expires time.Time
+
+	columns []string
+	rows    [][]driver.Value
+}
+
+// NewQueryCache creates a query cache over exec that keeps rows for ttl.
+func NewQueryCache(exec ContextExecutor, ttl time.Duration) *QueryCache {
+	if ttl <= 0 {
+		panic("boil: query cache ttl must be greater than zero")
+	}
+
+	c := &QueryCache{
+		exec:        exec,
+		ttl:         ttl,
+		entries:     make(map[string]*queryCacheEntry),
+		nextSweep:   64,
+		generations: make(map[string]uint64),
+	}
+	c.replay = sql.OpenDB(queryCacheConnector{})
+	return c
+}
+
+// Exec executes a query that doesn't return rows, invalidating the tables it
+// writes to
+func (c *QueryCache) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.ExecContext(context.Background(), query, args...)
+}
+
+// Query executes a query that returns rows, using cached rows for SELECTs
+func (c *QueryCache) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.QueryContext(context.Background(), query, args...)
+}
+
+// QueryRow executes a query that returns at most one row, using cached rows
+// for SELECTs
+func (c *QueryCache) QueryRow(query string, args ...interface{}) *sql.Row {
+	return c.QueryRowContext(context.Background(), query, args...)
+}
+
+// ExecContext executes a query that doesn't return rows, invalidating the
+// tables it writes to
+func (c *QueryCache) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	res, err := c.exec.ExecContext(ctx, query, args...)
+	c.invalidateWrite(query)
+	return res, err
+}
+
+// QueryContext executes a query that returns rows, using cached rows for
+// SELECTs
+func (c *QueryCache) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	key, tables, ok := queryCacheKey(query, args)
+	if !ok {
+		rows, err := c.exec.QueryContext(ctx, query, args...)
+		c.invalidateWrite(query)
+		return rows, err
+	}
+
+	entry, err := c.get(ctx, key, tables, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return c.replay.QueryContext(ctx, "", entry)
+}
+
+// QueryRowContext executes a query that returns at most one row, using cached
+// rows for SELECTs. If the query fails it's run again directly against the
+// wrapped executor so the error is reported by the returned row.
+func (c *QueryCache) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	key, tables, ok := queryCacheKey(query, args)
+	if !ok {
+		row := c.exec.QueryRowContext(ctx, query, args...)
+		c.invalidateWrite(query)
+		return row
+	}
+
+	entry, err := c.get(ctx, key, tables, query, args)
+	if err != nil {
+		return c.exec.QueryRowContext(ctx, query, args...)
+	}
+	return c.replay.QueryRowContext(ctx, "", entry)
+}
+
+// Len returns the number of queries currently cached, including ones that
+// have expired but haven't been dropped yet
+func (c *QueryCache) Len() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	return len(c.entries)
+}
+
+// InvalidateTable drops the cached rows of every query that reads from table.
+// The name is compared without schema and quotes.
+func (c *QueryCache) InvalidateTable(table string) {
+	table = queryCacheTableName(table)
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	c.generations[table]++
+	for key, entry := range c.entries {
+		for _, t := range entry.tables {
+			if t == table {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+// Invalidate drops every cached row.
+func (c *QueryCache) Invalidate() {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	c.generation++
+	c.entries = make(map[string]*queryCacheEntry)
+}
+
+// get returns the cached rows for key, running the query and caching its rows
+// if there aren't any or they have expired.
+func (c *QueryCache) get(ctx context.Context, key string, tables []string, query string, args []interface{}) (*queryCacheEntry, error) {
+	now := time.Now()
+
+	c.mut.Lock()
+	entry, ok := c.entries[key]
+	generation := c.generationOf(tables)
+	c.mut.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry, nil
+	}
+
+	entry, err := queryCacheRead(ctx, c.exec, query, args)
+	if err != nil {
+		return nil, err
+	}
+	entry.tables = tables
+	entry.expires = now.Add(c.ttl)
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if c.generationOf(tables) != generation {
+		return entry, nil
+	}
+
+	c.entries[key] = entry
+	if len(c.entries) >= c.nextSweep {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.nextSweep = 2 * len(c.entries)
+		if c.nextSweep < 64 {
+			c.nextSweep = 64
+		}
+	}
+
+	return entry, nil
+}
+
+// generationOf sums the generations of tables and of the whole cache, it
+// changes when any of them is invalidated. c.mut must be held.
+func (c *QueryCache) generationOf(tables []string) uint64 {
+	generation := c.generation
+	for _, t := range tables {
+		generation += c.generations[t]
+	}
+	return generation
+}
+
+// invalidateWrite drops the cached rows of the tables query writes to, all
+// of them if that can't be told, and none if it's a read.
+func (c *QueryCache) invalidateWrite(query string) {
+	writes, all := queryCacheWrites(query)
+	if all {
+		c.Invalidate()
+		return
+	}
+	for _, table := range writes {
+		c.InvalidateTable(table)
+	}
+}
+
+// queryCacheWrites returns the tables query writes to, in its main statement
+// or in the statements of its WITH clause, or true if it may write to any
+// table since it's neither a known read nor a write to a single table.
+func queryCacheWrites(query string) ([]string, bool) {
+	main, ctes, ok := queryCacheStatements(query)
+	if !ok {
+		return nil, true
+	}
+
+	var writes []string
+	for _, stmt := range append(ctes, main) {
+		if matches := rgxQueryCacheWrite.FindStringSubmatch(stmt); matches != nil {
+			writes = append(writes, matches[1])
+		} else if !rgxQueryCacheReads.MatchString(stmt) {
+			return nil, true
+		}
+	}
+
+	return writes, false
+}
+
+// queryCacheStatements splits query into its main statement and the
+// statements of its WITH clause, skipping leading comments. It returns
+// false if the WITH clause can't be parsed.
+func queryCacheStatements(query string) (string, []string, bool) {
+	query = queryCacheSkipComments(query)
+	if !rgxQueryCacheCTE.MatchString(query) {
+		return query, nil, true
+	}
+
+	var ctes []string
+	rest := query[len(rgxQueryCacheCTE.FindString(query)):]
+	for {
+		// Skip the name, columns and AS [NOT] MATERIALIZED up to the body,
+		// the column list is skipped as a statement of its own
+		open := strings.IndexByte(rest, '(')
+		if open < 0 {
+			return "", nil, false
+		}
+		end := queryCacheMatchParen(rest, open)
+		if end < 0 {
+			return "", nil, false
+		}
+		body := queryCacheSkipComments(rest[open+1 : end])
+		rest = queryCacheSkipComments(rest[end+1:])
+
+		// A column list is followed by AS, a body by a comma or the main
+		// statement
+		if len(rest) > 2 && strings.EqualFold(rest[:2], "as") && !isQueryCacheWordPart(rest[2]) {
+			continue
+		}
+		ctes = append(ctes, body)
+		if strings.HasPrefix(rest, ",") {
+			rest = rest[1:]
+			continue
+		}
+
+		return rest, ctes, true
+	}
+}
+
+// queryCacheMatchParen returns the index of the parenthesis closing the one
+// at open, skipping quoted strings and identifiers, or -1.
+func queryCacheMatchParen(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		case '\'', '"', '`':
+			j := strings.IndexByte(s[i+1:], c)
+			if j < 0 {
+				return -1
+			}
+			i += j + 1
+		}
+	}
+	return -1
+}
+
+func isQueryCacheWordPart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// queryCacheSkipComments strips leading whitespace and comments.
+func queryCacheSkipComments(s string) string {
+	for {
+		s = strings.TrimLeft(s, " \t\r\n")
+		switch {
+		case strings.HasPrefix(s, "--"):
+			i := strings.IndexByte(s, '\n')
+			if i < 0 {
+				return ""
+			}
+			s = s[i+1:]
+		case strings.HasPrefix(s, "/*"):
+			i := strings.Index(s, "*/")
+			if i < 0 {
+				return ""
+			}
+			s = s[i+2:]
+		default:
+			return s
+		}
+	}
+}
+
+// queryCacheRead runs query and reads all of its rows into memory.
+func queryCacheRead(ctx context.Context, exec ContextExecutor, query string, args []interface{}) (*queryCacheEntry, error) {
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entry := &queryCacheEntry{}
+	if entry.columns, err = rows.Columns(); err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		values := make([]interface{}, len(entry.columns))
+		pointers := make([]interface{}, len(values))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err = rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		row := make([]driver.Value, len(values))
+		for i, v := range values {
+			row[i] = v
+		}
+		entry.rows = append(entry.rows, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// queryCacheKey returns the key query's rows are cached by and the tables it
+// reads from, and false if it can't be cached. The key leaves out the
+// query's sqlcommenter comment, see StripSQLComment.
+func queryCacheKey(query string, args []interface{}) (string, []string, bool) {
+	query = StripSQLComment(query)
+	if rgxQueryCacheLock.MatchString(query) {
+		return "", nil, false
+	}
+	if main, _, ok := queryCacheStatements(query); !ok || !rgxQueryCacheSelect.MatchString(main) {
+		return "", nil, false
+	}
+	if writes, all := queryCacheWrites(query); all || len(writes) != 0 {
+		return "", nil, false
+	}
+
+	matches := rgxQueryCacheRead.FindAllStringSubmatch(query, -1)
+	if len(matches) == 0 {
+		return "", nil, false
+	}
+	tables := make([]string, len(matches))
+	for i, m := range matches {
+		tables[i] = queryCacheTableName(m[1])
+	}
+
+	var key strings.Builder
+	key.WriteString(rgxQueryCacheSpace.ReplaceAllString(strings.TrimSpace(query), " "))
+	for _, arg := range args {
+		// Converting calls Valuers and dereferences pointers, so the key
+		// has the value that's sent to the database and not an address.
+		// Arguments that can't be converted aren't cached.
+		value, err := driver.DefaultParameterConverter.ConvertValue(arg)
+		if err != nil {
+			return "", nil, false
+		}
+		fmt.Fprintf(&key, "\x00%T:%v", value, value)
+	}
+
+	return key.String(), tables, true
+}
+
+// queryCacheTableName strips the schema and quotes from a table name.
+func queryCacheTableName(name string) string {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.Trim(name, "\"`[]")
+}
+
+// queryCacheConnector connects to a database/sql driver that replays the
+// rows of the cache entry a query is given as its argument.
+type queryCacheConnector struct{}
+
+func (queryCacheConnector) Connect(context.Context) (driver.Conn, error) {
+	return queryCacheConn{}, nil
+}
+
+func (queryCacheConnector) Driver() driver.Driver {
+	return queryCacheDriver{}
+}
+
+type queryCacheDriver struct{}
+
+func (queryCacheDriver) Open(string) (driver.Conn, error) {
+	return queryCacheConn{}, nil
+}
+
+type queryCacheConn struct{}
+
+func (queryCacheConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("boil: query cache can't prepare statements")
+}
+
+func (queryCacheConn) Close() error {
+	return nil
+}
+
+func (queryCacheConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("boil: query cache can't begin transactions")
+}
+
+// CheckNamedValue lets the cache entry through as the argument
+func (queryCacheConn) CheckNamedValue(*driver.NamedValue) error {
+	return nil
+}
+
+func (queryCacheConn) QueryContext(_ context.Context, _ string, args []driver.NamedValue) (driver.Rows, error) {
+	return &queryCacheRows{entry: args[0].Value.(*queryCacheEntry)}, nil
+}
+
+type queryCacheRows struct {
+	entry *queryCacheEntry
+	next  int
+}
+
+func (r *queryCacheRows) Columns() []string {
+	return r.entry.columns
+}
+
+func (r *queryCacheRows) Close() error {
+	return nil
+}
+
+func (r *queryCacheRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.entry.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.entry.rows[r.next])
+	r.next++
+	return nil
+}
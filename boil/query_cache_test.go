@@ -0,0 +1,238 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestQueryCache(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	const query = `SELECT * FROM "public"."currencies" WHERE code = $1`
+	mock.ExpectQuery(query).WithArgs("EUR").
+		WillReturnRows(sqlmock.NewRows([]string{"code", "name"}).AddRow("EUR", []byte("Euro")))
+	mock.ExpectExec(`UPDATE "currencies" SET name = $1`).WithArgs("euro").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(query).WithArgs("EUR").
+		WillReturnRows(sqlmock.NewRows([]string{"code", "name"}).AddRow("EUR", []byte("euro")))
+
+	cache := NewQueryCache(db, time.Minute)
+	read := func() string {
+		var code, name string
+		if err := cache.QueryRow(query, "EUR").Scan(&code, &name); err != nil {
+			t.Fatal(err)
+		}
+		return name
+	}
+
+	if name := read(); name != "Euro" {
+		t.Errorf("want Euro, got %s", name)
+	}
+
+	// Served from the cache, the mock only expects the query once
+	rows, err := cache.Query("SELECT *\n\tFROM \"public\".\"currencies\" WHERE code = $1", "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][]string
+	for rows.Next() {
+		var code, name string
+		if err := rows.Scan(&code, &name); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, []string{code, name})
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]string{{"EUR", "Euro"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("want 1 cached query, got %d", cache.Len())
+	}
+
+	if _, err := cache.Exec(`UPDATE "currencies" SET name = $1`, "euro"); err != nil {
+		t.Fatal(err)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("want the update to invalidate the currencies, got %d cached", cache.Len())
+	}
+
+	if name := read(); name != "euro" {
+		t.Errorf("want euro, got %s", name)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQueryCacheKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query  string
+		tables []string
+		ok     bool
+	}{
+		{`SELECT * FROM "a" INNER JOIN "b" ON a.id = b.a_id`, []string{"a", "b"}, true},
+		{"select * from `s`.`a` where id in (select id from c)", []string{"a", "c"}, true},
+		{`SELECT * FROM "a" FOR UPDATE`, nil, false},
+		{`SELECT 1`, nil, false},
+		{`INSERT INTO "a" ("id") VALUES ($1) RETURNING "id"`, nil, false},
+		{"-- comment\n/* another */ SELECT * FROM a", []string{"a"}, true},
+		{`WITH "x" ("id") AS (SELECT id FROM a) SELECT * FROM x`, []string{"a", "x"}, true},
+		{`WITH x AS (DELETE FROM a RETURNING *) SELECT * FROM x`, nil, false},
+	}
+
+	for i, test := range tests {
+		_, tables, ok := queryCacheKey(test.query, nil)
+		if ok != test.ok || !reflect.DeepEqual(tables, test.tables) {
+			t.Errorf("%d) want %v %t, got %v %t", i, test.tables, test.ok, tables, ok)
+		}
+	}
+
+	a, _, _ := queryCacheKey("SELECT * FROM a WHERE id = ?", []interface{}{1})
+	if b, _, _ := queryCacheKey("SELECT * FROM a WHERE id = ?", []interface{}{"1"}); a == b {
+		t.Error("want different keys for arguments of different types")
+	}
+
+	id := 1
+	before, _, _ := queryCacheKey("SELECT * FROM a WHERE id = ?", []interface{}{&id})
+	id = 2
+	after, _, _ := queryCacheKey("SELECT * FROM a WHERE id = ?", []interface{}{&id})
+	if before == after {
+		t.Error("want the keys of pointers to change with the value they point to")
+	}
+	if value, _, _ := queryCacheKey("SELECT * FROM a WHERE id = ?", []interface{}{2}); after != value {
+		t.Error("want a pointer and its value to have the same key")
+	}
+
+	if _, _, ok := queryCacheKey("SELECT * FROM a WHERE id = ?", []interface{}{struct{}{}}); ok {
+		t.Error("arguments that can't be converted should not be cached")
+	}
+
+	a, _, _ = queryCacheKey("SELECT * FROM a /*route='%2Fa'*/", nil)
+	if b, _, _ := queryCacheKey("SELECT * FROM a /*route='%2Fb'*/", nil); a != b {
+		t.Error("want queries that only differ in their sqlcommenter comment to have the same key")
+	}
+}
+
+func TestQueryCacheInvalidateTable(t *testing.T) {
+	t.Parallel()
+
+	cache := NewQueryCache(nil, time.Minute)
+	cache.entries["a"] = &queryCacheEntry{tables: []string{"a", "b"}}
+	cache.entries["c"] = &queryCacheEntry{tables: []string{"c"}}
+
+	cache.InvalidateTable(`"public"."b"`)
+	if _, ok := cache.entries["a"]; ok || cache.Len() != 1 {
+		t.Error("want only the query reading b dropped")
+	}
+
+	cache.invalidateWrite("DELETE FROM `c` WHERE id = ?")
+	if cache.Len() != 0 {
+		t.Error("want the delete to drop the query reading c")
+	}
+}
+
+func TestQueryCacheWrites(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query  string
+		writes []string
+		all    bool
+	}{
+		{"SELECT * FROM a", nil, false},
+		{"-- tag\nSELECT * FROM a", nil, false},
+		{"/* tag */ select * from a for update", nil, false},
+		{"WITH RECURSIVE t(n) AS (SELECT 1 UNION ALL SELECT n+1 FROM t) SELECT n FROM t", nil, false},
+		{"WITH x AS (SELECT ')' FROM a), y AS (SELECT 1) SELECT * FROM x, y", nil, false},
+		{"-- tag\nUPDATE a SET x = 1", []string{"a"}, false},
+		{"WITH x AS (DELETE FROM a RETURNING id) INSERT INTO b SELECT id FROM x", []string{"a", "b"}, false},
+		{"CALL refresh()", nil, true},
+		{"ALTER TABLE a ADD COLUMN x int", nil, true},
+		{"WITH x AS (SELECT 1", nil, true},
+	}
+
+	for i, test := range tests {
+		writes, all := queryCacheWrites(test.query)
+		if all != test.all || !reflect.DeepEqual(writes, test.writes) {
+			t.Errorf("%d) want %v %t, got %v %t", i, test.writes, test.all, writes, all)
+		}
+	}
+}
+
+func TestQueryCacheReadsKeepEntries(t *testing.T) {
+	t.Parallel()
+
+	cache := NewQueryCache(nil, time.Minute)
+	cache.entries["a"] = &queryCacheEntry{tables: []string{"a"}}
+
+	cache.invalidateWrite("-- tag\nSELECT * FROM b FOR UPDATE")
+	cache.invalidateWrite("WITH x AS (SELECT * FROM b) SELECT * FROM x FOR UPDATE")
+	if cache.Len() != 1 {
+		t.Error("want reads to keep the cached rows")
+	}
+}
+
+// invalidatingExecutor invalidates a table of the cache while a query runs,
+// like a concurrent write would.
+type invalidatingExecutor struct {
+	ContextExecutor
+	cache *QueryCache
+	table string
+}
+
+func (e invalidatingExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	rows, err := e.ContextExecutor.QueryContext(ctx, query, args...)
+	e.cache.InvalidateTable(e.table)
+	return rows, err
+}
+
+func TestQueryCacheConcurrentWrite(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	const query = `SELECT * FROM "a"`
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	exec := &invalidatingExecutor{ContextExecutor: db, table: "a"}
+	cache := NewQueryCache(exec, time.Minute)
+	exec.cache = cache
+
+	var id int
+	if err := cache.QueryRow(query).Scan(&id); err != nil || id != 1 {
+		t.Fatal(id, err)
+	}
+	if cache.Len() != 0 {
+		t.Error("want rows read while their table was written to not cached")
+	}
+
+	exec.table = "b"
+	if err := cache.QueryRow(query).Scan(&id); err != nil || id != 2 {
+		t.Fatal(id, err)
+	}
+	if cache.Len() != 1 {
+		t.Error("want rows cached when other tables are written to")
+	}
+}
@@ -0,0 +1,149 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+var (
+	// RetryMaxAttempts is the number of times WithRetryableTx will attempt
+	// to run a transaction before giving up and returning the last error.
+	RetryMaxAttempts = 3
+	// RetryBaseDelay is the delay before the first retry in WithRetryableTx,
+	// each subsequent retry doubles the previous delay.
+	RetryBaseDelay = 10 * time.Millisecond
+)
+
+// retryableSQLStates are the SQLSTATE codes that indicate a transaction
+// was aborted because of concurrent activity and may succeed if retried.
+var retryableSQLStates = map[string]struct{}{
+	"40001": {}, // serialization_failure
+	"40P01": {}, // deadlock_detected
+}
+
+// retryableErrorNumbers are the MySQL error numbers that indicate a
+// transaction was aborted because of concurrent activity.
+var retryableErrorNumbers = map[uint64]struct{}{
+	1205: {}, // ER_LOCK_WAIT_TIMEOUT
+	1213: {}, // ER_LOCK_DEADLOCK
+}
+
+// RetryableError wraps an error that was caused by a transient failure
+// such as a serialization failure or a deadlock. Retrying the entire
+// transaction that produced it may succeed.
+type RetryableError struct {
+	Err error
+}
+
+// Error returns the underlying error string
+func (e RetryableError) Error() string {
+	return e.Err.Error()
+}
+
+// Cause returns the underlying error
+func (e RetryableError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the underlying error
+func (e RetryableError) Unwrap() error {
+	return e.Err
+}
+
+// WrapRetryable wraps err in a RetryableError if the database reported it as
+// a transient failure, otherwise err is returned unchanged.
+func WrapRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(RetryableError); ok {
+		return err
+	}
+	if !isRetryableDriverErr(err) {
+		return err
+	}
+
+	return RetryableError{Err: err}
+}
+
+// IsRetryable checks if err, or any error it wraps, is a transient failure
+// that may succeed if the transaction is retried.
+func IsRetryable(err error) bool {
+	for err != nil {
+		if _, ok := err.(RetryableError); ok {
+			return true
+		}
+		if isRetryableDriverErr(err) {
+			return true
+		}
+
+		switch e := err.(type) {
+		case interface{ Cause() error }:
+			err = e.Cause()
+		case interface{ Unwrap() error }:
+			err = e.Unwrap()
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+// isRetryableDriverErr inspects an error returned by a database driver.
+func isRetryableDriverErr(err error) bool {
+	if _, ok := retryableSQLStates[errSQLState(err)]; ok {
+		return true
+	}
+
+	if num, ok := errNumber(err); ok {
+		_, ok = retryableErrorNumbers[num]
+		return ok
+	}
+
+	return false
+}
+
+// WithRetryableTx runs fn inside a transaction begun on db. The transaction
+// is committed if fn returns nil and rolled back otherwise. When fn or the
+// commit fails with a retryable error (see IsRetryable) the whole transaction
+// is attempted again, up to RetryMaxAttempts times, with exponential backoff
+// starting at RetryBaseDelay.
+//
+// fn may be called several times and must not have side effects outside of
+// the transaction it is given.
+func WithRetryableTx(ctx context.Context, db ContextBeginner, opts *sql.TxOptions, fn func(tx *sql.Tx) error) error {
+	delay := RetryBaseDelay
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = runTx(ctx, db, opts, fn)
+		if err == nil || !IsRetryable(err) || attempt >= RetryMaxAttempts {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+func runTx(ctx context.Context, db ContextBeginner, opts *sql.TxOptions, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,140 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	pkgerrors "github.com/friendsofgo/errors"
+)
+
+type testPQError struct {
+	Code string
+}
+
+func (e *testPQError) Error() string { return "pq: " + e.Code }
+
+type testMySQLError struct {
+	Number uint16
+}
+
+func (e *testMySQLError) Error() string { return "mysql error" }
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Err  error
+		Want bool
+	}{
+		{nil, false},
+		{errors.New("test error"), false},
+		{&testPQError{Code: "40001"}, true},
+		{&testPQError{Code: "40P01"}, true},
+		{&testPQError{Code: "23505"}, false},
+		{&testMySQLError{Number: 1213}, true},
+		{&testMySQLError{Number: 1205}, true},
+		{&testMySQLError{Number: 1062}, false},
+		{pkgerrors.Wrap(&testPQError{Code: "40001"}, "models: unable to update"), true},
+		{RetryableError{Err: errors.New("test error")}, true},
+	}
+
+	for i, test := range tests {
+		if got := IsRetryable(test.Err); got != test.Want {
+			t.Errorf("%d) want: %t, got: %t", i, test.Want, got)
+		}
+	}
+}
+
+func TestWrapRetryable(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("test error")
+	if WrapRetryable(err) != err {
+		t.Error("non-retryable error should be returned unchanged")
+	}
+
+	err = WrapRetryable(&testPQError{Code: "40001"})
+	if _, ok := err.(RetryableError); !ok {
+		t.Errorf("expected RetryableError, got %T", err)
+	}
+	if err.Error() != "pq: 40001" {
+		t.Errorf("wrong message: %s", err.Error())
+	}
+}
+
+func TestWithRetryableTx(t *testing.T) {
+	RetryBaseDelay = 0
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	calls := 0
+	err = WithRetryableTx(context.Background(), db, nil, func(tx *sql.Tx) error {
+		calls++
+		if calls == 1 {
+			return &testPQError{Code: "40001"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWithRetryableTxGivesUp(t *testing.T) {
+	RetryBaseDelay = 0
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for i := 0; i < RetryMaxAttempts; i++ {
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+	}
+
+	calls := 0
+	err = WithRetryableTx(context.Background(), db, nil, func(tx *sql.Tx) error {
+		calls++
+		return &testMySQLError{Number: 1213}
+	})
+	if !IsRetryable(err) {
+		t.Errorf("expected the retryable error to be returned, got: %v", err)
+	}
+	if calls != RetryMaxAttempts {
+		t.Errorf("expected %d calls, got %d", RetryMaxAttempts, calls)
+	}
+
+	calls = 0
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	err = WithRetryableTx(context.Background(), db, nil, func(tx *sql.Tx) error {
+		calls++
+		return errors.New("test error")
+	})
+	if err == nil || calls != 1 {
+		t.Errorf("non-retryable errors should not be retried, calls: %d", calls)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
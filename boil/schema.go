@@ -0,0 +1,198 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+)
+
+// WithSchema modifies a context so that queries run through a SchemaExecutor
+// with its target schema instead of the schema the models were generated
+// against. This allows one set of generated models to serve several
+// identically structured schemas, such as one schema per tenant.
+func WithSchema(ctx context.Context, schema string) context.Context {
+	return context.WithValue(ctx, ctxSchema, schema)
+}
+
+// SchemaFrom returns the schema set on the context with WithSchema, or an
+// empty string if not set.
+func SchemaFrom(ctx context.Context) string {
+	schema, _ := ctx.Value(ctxSchema).(string)
+	return schema
+}
+
+// SchemaExecutor wraps a ContextExecutor and rewrites the schema qualifier on
+// table names in each query to the schema set on the context by WithSchema.
+// Queries run without such a context, or through the non-context methods, are
+// passed through unchanged.
+//
+// When the models were generated against a schema the generated code
+// qualifies table names as lq + schema + rq + ".", which is what gets
+// replaced. Otherwise, eg. for the public schema on Postgres or on MySQL, the
+// quoted names of the given tables are qualified where they follow FROM,
+// JOIN, INTO or UPDATE or qualify a column, which covers the queries the
+// generated code builds. Without a schema or tables nothing can be rewritten
+// and queries with a schema on their context fail.
+//
+// Transactions can't be begun on a SchemaExecutor since the *sql.Tx BeginTx
+// returns wouldn't rewrite its queries, so it doesn't work with
+// WithRetryableTx or as the db of Transact. Wrap the transaction instead:
+//
+//	err := boil.Transact(ctx, db, func(ctx context.Context, exec boil.ContextExecutor) error {
+//		exec = models.NewSchemaExecutor(exec)
+//		...
+//	})
+type SchemaExecutor struct {
+	exec   ContextExecutor
+	lq, rq string
+	from   string
+	tables map[string]struct{}
+}
+
+// ErrSchemaNotRewritable is returned for queries with a schema on their
+// context run through a SchemaExecutor that has neither a schema nor tables.
+var ErrSchemaNotRewritable = errors.New("boil: schema executor has no schema or tables to rewrite")
+
+// NewSchemaExecutor creates a SchemaExecutor over exec. lq and rq are the
+// dialect's identifier quote characters and schema is the schema the models
+// were generated against. For dialects that don't qualify table names schema
+// is empty and tables are the names of the tables to qualify instead.
+func NewSchemaExecutor(exec ContextExecutor, lq, rq, schema string, tables ...string) *SchemaExecutor {
+	s := &SchemaExecutor{
+		exec: exec,
+		lq:   lq,
+		rq:   rq,
+	}
+	if len(schema) != 0 {
+		s.from = lq + schema + rq + "."
+	} else if len(tables) != 0 {
+		s.tables = make(map[string]struct{}, len(tables))
+		for _, t := range tables {
+			s.tables[t] = struct{}{}
+		}
+	}
+
+	return s
+}
+
+// Exec passes the query through unchanged
+func (s *SchemaExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return s.exec.Exec(query, args...)
+}
+
+// Query passes the query through unchanged
+func (s *SchemaExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return s.exec.Query(query, args...)
+}
+
+// QueryRow passes the query through unchanged
+func (s *SchemaExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return s.exec.QueryRow(query, args...)
+}
+
+// ExecContext executes the query against the schema set on ctx
+func (s *SchemaExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	query, err := s.rewrite(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.exec.ExecContext(ctx, query, args...)
+}
+
+// QueryContext executes the query against the schema set on ctx
+func (s *SchemaExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	query, err := s.rewrite(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.exec.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext executes the query against the schema set on ctx. A
+// *sql.Row can't hold an error so it panics with ErrSchemaNotRewritable
+// instead of running the query against the wrong schema.
+func (s *SchemaExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	query, err := s.rewrite(ctx, query)
+	if err != nil {
+		panic(err)
+	}
+	return s.exec.QueryRowContext(ctx, query, args...)
+}
+
+func (s *SchemaExecutor) rewrite(ctx context.Context, query string) (string, error) {
+	schema := SchemaFrom(ctx)
+	if len(schema) == 0 {
+		return query, nil
+	}
+
+	// Escape the closing quote character by doubling it, which is how every
+	// supported database allows it inside a quoted identifier.
+	if len(s.rq) != 0 {
+		schema = strings.ReplaceAll(schema, s.rq, s.rq+s.rq)
+	}
+
+	switch {
+	case len(s.from) != 0:
+		return strings.ReplaceAll(query, s.from, s.lq+schema+s.rq+"."), nil
+	case len(s.tables) != 0 && len(s.lq) != 0 && len(s.rq) != 0:
+		return s.qualify(query, s.lq+schema+s.rq+"."), nil
+	default:
+		return "", ErrSchemaNotRewritable
+	}
+}
+
+// qualify puts prefix in front of the quoted names of s.tables that aren't
+// qualified already and are used as a table, after FROM, JOIN, INTO or
+// UPDATE or in front of a column.
+func (s *SchemaExecutor) qualify(query, prefix string) string {
+	var b strings.Builder
+	b.Grow(len(query) + 4*len(prefix))
+
+	for i := 0; i < len(query); {
+		if !strings.HasPrefix(query[i:], s.lq) {
+			b.WriteByte(query[i])
+			i++
+			continue
+		}
+
+		start := i + len(s.lq)
+		end := strings.Index(query[start:], s.rq)
+		if end < 0 {
+			b.WriteString(query[i:])
+			break
+		}
+		end += start
+		next := end + len(s.rq)
+
+		if _, ok := s.tables[query[start:end]]; ok && (i == 0 || query[i-1] != '.') &&
+			((next < len(query) && query[next] == '.') || followsTableKeyword(query[:i])) {
+			b.WriteString(prefix)
+		}
+		b.WriteString(query[i:next])
+		i = next
+	}
+
+	return b.String()
+}
+
+// followsTableKeyword reports whether before ends with a keyword that's
+// followed by a table name.
+func followsTableKeyword(before string) bool {
+	before = strings.TrimRight(before, " \t\r\n")
+	i := len(before)
+	for i > 0 && isKeywordChar(before[i-1]) {
+		i--
+	}
+
+	switch strings.ToUpper(before[i:]) {
+	case "FROM", "JOIN", "INTO", "UPDATE":
+		return true
+	}
+	return false
+}
+
+func isKeywordChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
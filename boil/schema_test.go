@@ -0,0 +1,138 @@
+package boil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWithSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	if SchemaFrom(ctx) != "" {
+		t.Error("schema should not be set")
+	}
+
+	ctx = WithSchema(ctx, "tenant_42")
+	if got := SchemaFrom(ctx); got != "tenant_42" {
+		t.Errorf("want: tenant_42, got: %s", got)
+	}
+}
+
+func TestSchemaExecutor(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	exec := NewSchemaExecutor(db, `"`, `"`, "public")
+	query := `DELETE FROM "public"."pilots" WHERE "id"=$1`
+
+	mock.ExpectExec(`DELETE FROM "tenant_42"."pilots" WHERE "id"=$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "public"."pilots" WHERE "id"=$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "a""b"."pilots" WHERE "id"=$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if _, err := exec.ExecContext(WithSchema(context.Background(), "tenant_42"), query, 1); err != nil {
+		t.Error(err)
+	}
+	if _, err := exec.ExecContext(context.Background(), query, 1); err != nil {
+		t.Error(err)
+	}
+	if _, err := exec.ExecContext(WithSchema(context.Background(), `a"b`), query, 1); err != nil {
+		t.Error(err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSchemaExecutorTables(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	exec := NewSchemaExecutor(db, "`", "`", "", "pilots", "jets")
+
+	tests := []struct {
+		In  string
+		Out string
+	}{
+		{
+			"SELECT `pilots`.* FROM `pilots` WHERE `pilots`.`id`=?",
+			"SELECT `tenant_42`.`pilots`.* FROM `tenant_42`.`pilots` WHERE `tenant_42`.`pilots`.`id`=?",
+		},
+		{
+			"select `pilots`.`name` from `pilots` inner join `jets` on `jets`.`pilot_id` = `pilots`.`id`",
+			"select `tenant_42`.`pilots`.`name` from `tenant_42`.`pilots` inner join `tenant_42`.`jets` on `tenant_42`.`jets`.`pilot_id` = `tenant_42`.`pilots`.`id`",
+		},
+		{
+			"INSERT INTO `pilots` (`jets`,`name`) VALUES (?,?)",
+			"INSERT INTO `tenant_42`.`pilots` (`jets`,`name`) VALUES (?,?)",
+		},
+		{
+			"UPDATE `jets` SET `pilots` = ? WHERE `id`=?",
+			"UPDATE `tenant_42`.`jets` SET `pilots` = ? WHERE `id`=?",
+		},
+		{
+			"DELETE FROM `other`.`pilots` WHERE `airports`.`id`=?",
+			"DELETE FROM `other`.`pilots` WHERE `airports`.`id`=?",
+		},
+	}
+
+	ctx := WithSchema(context.Background(), "tenant_42")
+	for _, test := range tests {
+		mock.ExpectExec(test.Out).WillReturnResult(sqlmock.NewResult(0, 1))
+		if _, err := exec.ExecContext(ctx, test.In, 1); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSchemaExecutorNotRewritable(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	exec := NewSchemaExecutor(db, `"`, `"`, "")
+	query := `DELETE FROM "pilots" WHERE "id"=$1`
+
+	mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if _, err := exec.ExecContext(context.Background(), query, 1); err != nil {
+		t.Error(err)
+	}
+	if _, err := exec.ExecContext(WithSchema(context.Background(), "tenant_42"), query, 1); err != ErrSchemaNotRewritable {
+		t.Errorf("want: %v, got: %v", ErrSchemaNotRewritable, err)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != ErrSchemaNotRewritable {
+				t.Errorf("want panic: %v, got: %v", ErrSchemaNotRewritable, r)
+			}
+		}()
+		exec.QueryRowContext(WithSchema(context.Background(), "tenant_42"), query, 1)
+	}()
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,249 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSlowQueryLimit is how many different slow queries a
+// SlowQueryCollector keeps by default.
+const DefaultSlowQueryLimit = 1000
+
+var (
+	rgxFingerprintComment     = regexp.MustCompile(`(?m)^\s*--.*$|/\*.*?\*/`)
+	rgxFingerprintString      = regexp.MustCompile(`'(?:[^']|'')*'`)
+	rgxFingerprintNumber      = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	rgxFingerprintPlaceholder = regexp.MustCompile(`\$\d+|@p\d+|:\d+`)
+	rgxFingerprintList        = regexp.MustCompile(`\(\s*\?(?:\s*,\s*\?)*\s*\)`)
+	rgxFingerprintSpace       = regexp.MustCompile(`\s+`)
+)
+
+// SlowQuery sums up the slow runs of a query from one call site.
+type SlowQuery struct {
+	// Fingerprint identifies the query by its shape, see QueryFingerprint.
+	Fingerprint string
+	// Query is the normalized query the fingerprint is made of.
+	Query string
+	// Caller is the function, file and line that ran the query, the first
+	// one on the stack outside of sqlboiler, database/sql and the
+	// collector's SkipPackages.
+	Caller string
+
+	Count    int
+	Total    time.Duration
+	Max      time.Duration
+	LastSeen time.Time
+}
+
+type slowQueryKey struct {
+	fingerprint string
+	caller      string
+}
+
+// SlowQueryCollector records the queries that take longer than a threshold,
+// grouped by their fingerprint and call site, to find the queries that
+// need an index or are run once per row in long running services. Use its
+// Middleware on the executor the models are used with:
+//
+//	slow := boil.NewSlowQueryCollector(100 * time.Millisecond)
+//	slow.SkipPackages = []string{"github.com/me/app/models"}
+//	exec := boil.Chain(db, slow.Middleware)
+//	...
+//	defer slow.WriteTo(os.Stderr)
+//
+// Queries are timed until they return, the time spent reading their rows
+// isn't included. Only the shape of queries is kept, never their arguments.
+type SlowQueryCollector struct {
+	// SkipPackages are packages that aren't reported as the call site of a
+	// query, eg. the generated models and helpers wrapping them.
+	SkipPackages []string
+	// Limit is the number of different slow queries kept, further ones are
+	// only counted in Dropped.
+	Limit int
+
+	threshold time.Duration
+
+	mut     sync.Mutex
+	queries map[slowQueryKey]*SlowQuery
+	dropped int
+}
+
+// NewSlowQueryCollector creates a collector for queries that take at least
+// threshold.
+func NewSlowQueryCollector(threshold time.Duration) *SlowQueryCollector {
+	return &SlowQueryCollector{
+		Limit:     DefaultSlowQueryLimit,
+		threshold: threshold,
+		queries:   make(map[slowQueryKey]*SlowQuery),
+	}
+}
+
+// Middleware times the queries run on next and records the slow ones.
+func (c *SlowQueryCollector) Middleware(next ContextExecutor) ContextExecutor {
+	return ExecutorFuncs{
+		ExecContext: func(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+			defer c.observe(query, time.Now())
+			return next.ExecContext(ctx, query, args...)
+		},
+		QueryContext: func(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+			defer c.observe(query, time.Now())
+			return next.QueryContext(ctx, query, args...)
+		},
+		QueryRowContext: func(ctx context.Context, query string, args ...interface{}) *sql.Row {
+			defer c.observe(query, time.Now())
+			return next.QueryRowContext(ctx, query, args...)
+		},
+	}.Wrap(next)
+}
+
+func (c *SlowQueryCollector) observe(query string, start time.Time) {
+	if took := time.Since(start); took >= c.threshold {
+		c.Record(query, took, c.caller())
+	}
+}
+
+// Record adds a run of query that took took from caller, for queries that
+// aren't run through the Middleware. It's recorded regardless of the
+// threshold.
+func (c *SlowQueryCollector) Record(query string, took time.Duration, caller string) {
+	fingerprint, normalized := QueryFingerprint(query)
+	key := slowQueryKey{fingerprint: fingerprint, caller: caller}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	q, ok := c.queries[key]
+	if !ok {
+		if len(c.queries) >= c.Limit {
+			c.dropped++
+			return
+		}
+		q = &SlowQuery{Fingerprint: fingerprint, Query: normalized, Caller: caller}
+		c.queries[key] = q
+	}
+
+	q.Count++
+	q.Total += took
+	if took > q.Max {
+		q.Max = took
+	}
+	q.LastSeen = time.Now()
+}
+
+// Queries returns the slow queries recorded so far, the ones that took the
+// most time in total first.
+func (c *SlowQueryCollector) Queries() []SlowQuery {
+	c.mut.Lock()
+	queries := make([]SlowQuery, 0, len(c.queries))
+	for _, q := range c.queries {
+		queries = append(queries, *q)
+	}
+	c.mut.Unlock()
+
+	sort.Slice(queries, func(i, j int) bool {
+		if queries[i].Total != queries[j].Total {
+			return queries[i].Total > queries[j].Total
+		}
+		return queries[i].Fingerprint < queries[j].Fingerprint
+	})
+	return queries
+}
+
+// Dropped returns the number of slow runs that weren't recorded because the
+// collector was full.
+func (c *SlowQueryCollector) Dropped() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.dropped
+}
+
+// Reset forgets the recorded queries.
+func (c *SlowQueryCollector) Reset() {
+	c.mut.Lock()
+	c.queries = make(map[slowQueryKey]*SlowQuery)
+	c.dropped = 0
+	c.mut.Unlock()
+}
+
+// WriteTo writes a report of the slow queries to w, eg. on shutdown.
+func (c *SlowQueryCollector) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	for _, q := range c.Queries() {
+		fmt.Fprintf(&b, "%s count=%d total=%s max=%s avg=%s caller=%s\n\t%s\n",
+			q.Fingerprint, q.Count, q.Total, q.Max, q.Total/time.Duration(q.Count), q.Caller, q.Query)
+	}
+	if dropped := c.Dropped(); dropped != 0 {
+		fmt.Fprintf(&b, "%d slow queries weren't recorded\n", dropped)
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// caller finds the call site of a query on the stack.
+func (c *SlowQueryCollector) caller() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !c.skipFrame(frame.Function) {
+			return fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+func (c *SlowQueryCollector) skipFrame(function string) bool {
+	pkg := function
+	if i := strings.LastIndexByte(pkg, '/'); i >= 0 {
+		if j := strings.IndexByte(pkg[i:], '.'); j >= 0 {
+			pkg = pkg[:i+j]
+		}
+	} else if j := strings.IndexByte(pkg, '.'); j >= 0 {
+		pkg = pkg[:j]
+	}
+
+	switch pkg {
+	case "runtime", "database/sql",
+		"github.com/volatiletech/sqlboiler/v4/boil",
+		"github.com/volatiletech/sqlboiler/v4/queries",
+		"github.com/volatiletech/sqlboiler/v4/queries/qm":
+		return true
+	}
+	for _, skip := range c.SkipPackages {
+		if pkg == skip {
+			return true
+		}
+	}
+	return false
+}
+
+// QueryFingerprint returns a hash identifying the shape of query, and the
+// normalized query it's made of: comments are removed, literals and
+// placeholders are replaced with ? and parenthesized lists of them, like the
+// arguments of an IN clause, with "(?...)". Queries built from the same query
+// mods have the same fingerprint regardless of their arguments.
+func QueryFingerprint(query string) (string, string) {
+	normalized := rgxFingerprintComment.ReplaceAllString(query, " ")
+	normalized = rgxFingerprintString.ReplaceAllString(normalized, "?")
+	normalized = rgxFingerprintPlaceholder.ReplaceAllString(normalized, "?")
+	normalized = rgxFingerprintNumber.ReplaceAllString(normalized, "?")
+	normalized = rgxFingerprintList.ReplaceAllString(normalized, "(?...)")
+	normalized = strings.TrimSpace(rgxFingerprintSpace.ReplaceAllString(normalized, " "))
+	normalized = strings.TrimSpace(strings.TrimSuffix(normalized, ";"))
+
+	h := fnv.New64a()
+	io.WriteString(h, normalized)
+	return fmt.Sprintf("%016x", h.Sum64()), normalized
+}
@@ -0,0 +1,92 @@
+package boil_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestQueryFingerprint(t *testing.T) {
+	t.Parallel()
+
+	a, normalized := boil.QueryFingerprint("-- find pilots\nSELECT * FROM \"pilots\" WHERE \"id\" IN ($1,$2,$3) AND name = 'x' LIMIT 10 /*route='a'*/;")
+	if want := `SELECT * FROM "pilots" WHERE "id" IN (?...) AND name = ? LIMIT ?`; normalized != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, normalized)
+	}
+
+	b, _ := boil.QueryFingerprint("SELECT * FROM \"pilots\" WHERE \"id\" IN ($1) AND name = $2 LIMIT 5;")
+	if a != b {
+		t.Error("queries of the same shape should have the same fingerprint")
+	}
+	if c, _ := boil.QueryFingerprint("SELECT * FROM \"jets\" WHERE \"id\" IN ($1);"); a == c {
+		t.Error("different queries should have different fingerprints")
+	}
+}
+
+func TestSlowQueryCollector(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("update a set b = ?").WithArgs(1).WillDelayFor(10 * time.Millisecond).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("update a set b = ?").WithArgs(2).WillDelayFor(10 * time.Millisecond).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("update c set d = ?").WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	slow := boil.NewSlowQueryCollector(5 * time.Millisecond)
+	exec := boil.Chain(db, slow.Middleware)
+	for _, arg := range []int{1, 2} {
+		if _, err := exec.Exec("update a set b = ?", arg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := exec.Exec("update c set d = ?", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	queries := slow.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("want one slow query, got %#v", queries)
+	}
+	q := queries[0]
+	if q.Count != 2 || q.Query != "update a set b = ?" || q.Max < 10*time.Millisecond || q.Total <= q.Max {
+		t.Errorf("wrong slow query: %#v", q)
+	}
+	if !strings.HasPrefix(q.Caller, "github.com/volatiletech/sqlboiler/v4/boil_test.TestSlowQueryCollector (") {
+		t.Errorf("wrong caller: %s", q.Caller)
+	}
+
+	var buf bytes.Buffer
+	if _, err := slow.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), " count=2 ") || !strings.HasSuffix(buf.String(), "\n\tupdate a set b = ?\n") {
+		t.Errorf("unexpected report:\n%s", buf.String())
+	}
+
+	slow.Reset()
+	if len(slow.Queries()) != 0 {
+		t.Error("queries should be forgotten")
+	}
+}
+
+func TestSlowQueryCollectorLimit(t *testing.T) {
+	t.Parallel()
+
+	slow := boil.NewSlowQueryCollector(0)
+	slow.Limit = 1
+	slow.Record("select 1", time.Second, "a")
+	slow.Record("select 1", time.Second, "b")
+	slow.Record("select 1", time.Second, "a")
+
+	if len(slow.Queries()) != 1 || slow.Queries()[0].Count != 2 || slow.Dropped() != 1 {
+		t.Errorf("wrong queries: %#v, dropped %d", slow.Queries(), slow.Dropped())
+	}
+}
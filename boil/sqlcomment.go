@@ -0,0 +1,150 @@
+package boil
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var rgxSQLComment = regexp.MustCompile(`\s*/\*[^=',*/\s]+='[^']*'(?:,[^=',*/\s]+='[^']*')*\*/(\s*;)?\s*$`)
+
+// SQLCommentTags are added as a trailing comment to every query run with a
+// context, in the sqlcommenter format: /*key='value',...*/. Databases keep
+// the comment with the query, eg. in pg_stat_statements and slow query
+// logs, so queries can be traced back to the application. A good place to
+// put the name of the service.
+var SQLCommentTags map[string]string
+
+// SQLCommentFunc is called for every query run with a context to get tags
+// for its comment from the context, like the route of a request or the
+// trace id of a span. Its tags override SQLCommentTags.
+var SQLCommentFunc func(ctx context.Context) map[string]string
+
+// WithSQLComment modifies a context to add tags to the comment of the
+// queries made using it. They override tags with the same key from an
+// enclosing WithSQLComment, SQLCommentFunc and SQLCommentTags.
+func WithSQLComment(ctx context.Context, tags map[string]string) context.Context {
+	if len(tags) == 0 {
+		return ctx
+	}
+
+	parent, _ := ctx.Value(ctxSQLComment).(map[string]string)
+	merged := make(map[string]string, len(parent)+len(tags))
+	for k, v := range parent {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, ctxSQLComment, merged)
+}
+
+// SQLCommentFrom returns the tags of the comment for the queries made using
+// the context, nil if there are none.
+func SQLCommentFrom(ctx context.Context) map[string]string {
+	fromCtx, _ := ctx.Value(ctxSQLComment).(map[string]string)
+	if len(SQLCommentTags) == 0 && SQLCommentFunc == nil {
+		return fromCtx
+	}
+
+	tags := make(map[string]string)
+	for k, v := range SQLCommentTags {
+		tags[k] = v
+	}
+	if SQLCommentFunc != nil {
+		for k, v := range SQLCommentFunc(ctx) {
+			tags[k] = v
+		}
+	}
+	for k, v := range fromCtx {
+		tags[k] = v
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// AppendSQLComment adds the comment with the tags of the context to the end
+// of query, before its trailing semicolon. Queries that already end with a
+// comment are left alone.
+func AppendSQLComment(ctx context.Context, query string) string {
+	tags := SQLCommentFrom(ctx)
+	if len(tags) == 0 {
+		return query
+	}
+	comment := FormatSQLComment(tags)
+	if comment == "/**/" {
+		return query
+	}
+
+	stmt := strings.TrimRight(query, " \t\r\n")
+	semicolon := strings.HasSuffix(stmt, ";")
+	stmt = strings.TrimRight(strings.TrimSuffix(stmt, ";"), " \t\r\n")
+	if strings.HasSuffix(stmt, "*/") {
+		return query
+	}
+
+	stmt += " " + comment
+	if semicolon {
+		stmt += ";"
+	}
+	return stmt
+}
+
+// StripSQLComment removes a trailing comment in the sqlcommenter format, as
+// added by AppendSQLComment, from query. The caches key on the query without
+// it, since tags like a request's route or trace id would otherwise make
+// every query distinct:
+//
+// The QueryCache serves the rows of a query for any comment, the comment is
+// only sent to the database with the queries that miss the cache.
+//
+// The StmtCache prepares the query without the comment, so queries run
+// through it don't carry their comment to the database.
+func StripSQLComment(query string) string {
+	loc := rgxSQLComment.FindStringSubmatchIndex(query)
+	if loc == nil {
+		return query
+	}
+	if loc[2] >= 0 {
+		return query[:loc[0]] + ";"
+	}
+	return query[:loc[0]]
+}
+
+// FormatSQLComment renders tags as a comment in the sqlcommenter format:
+// the keys are sorted, keys and values are url encoded and the values are
+// quoted. Tags with an empty value are left out.
+func FormatSQLComment(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k, v := range tags {
+		if len(v) != 0 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString("/*")
+	for i, k := range keys {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(sqlCommentEscape(k))
+		b.WriteString("='")
+		b.WriteString(sqlCommentEscape(tags[k]))
+		b.WriteByte('\'')
+	}
+	b.WriteString("*/")
+
+	return b.String()
+}
+
+// sqlCommentEscape url encodes s, this also takes care of quotes and of
+// anything that could end the comment.
+func sqlCommentEscape(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
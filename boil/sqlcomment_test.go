@@ -0,0 +1,73 @@
+package boil
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFormatSQLComment(t *testing.T) {
+	t.Parallel()
+
+	got := FormatSQLComment(map[string]string{
+		"route":       "/pilots/{id}",
+		"application": "jets api",
+		"quote":       "it's */",
+		"empty":       "",
+	})
+	want := `/*application='jets%20api',quote='it%27s%20%2A%2F',route='%2Fpilots%2F%7Bid%7D'*/`
+	if got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestAppendSQLComment(t *testing.T) {
+	t.Parallel()
+
+	ctx := WithSQLComment(context.Background(), map[string]string{"a": "1", "b": "2"})
+	ctx = WithSQLComment(ctx, map[string]string{"b": "3"})
+
+	tests := []struct {
+		In   string
+		Want string
+	}{
+		{"select 1", "select 1 /*a='1',b='3'*/"},
+		{"select 1;\n", "select 1 /*a='1',b='3'*/;"},
+		{"select 1 /*c='4'*/;", "select 1 /*c='4'*/;"},
+	}
+
+	for _, test := range tests {
+		if got := AppendSQLComment(ctx, test.In); got != test.Want {
+			t.Errorf("%q: want %q, got %q", test.In, test.Want, got)
+		}
+	}
+
+	if got := AppendSQLComment(context.Background(), "select 1;"); got != "select 1;" {
+		t.Errorf("without tags the query should be left alone, got %q", got)
+	}
+}
+
+func TestStripSQLComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want string
+	}{
+		{"select 1 /*a='1',b='3'*/", "select 1"},
+		{"select 1 /*a='1',b='%2A%2F'*/;\n", "select 1;"},
+		{"select 1", "select 1"},
+		{"select 1 /* hint */", "select 1 /* hint */"},
+		{"select /*a='1'*/ 1", "select /*a='1'*/ 1"},
+	}
+
+	for _, test := range tests {
+		if got := StripSQLComment(test.In); got != test.Want {
+			t.Errorf("%q: want %q, got %q", test.In, test.Want, got)
+		}
+	}
+
+	ctx := WithSQLComment(context.Background(), map[string]string{"route": "/pilots/{id}", "app": "it's */"})
+	if got := StripSQLComment(AppendSQLComment(ctx, "select 1;")); got != "select 1;" {
+		t.Errorf("want the appended comment to be stripped, got %q", got)
+	}
+}
@@ -0,0 +1,205 @@
+package boil
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+
+	"github.com/friendsofgo/errors"
+)
+
+// StmtCache is a ContextExecutor that prepares each distinct query once and
+// reuses the resulting *sql.Stmt for subsequent executions of the same SQL
+// text. Since the generated code produces identical SQL for identical
+// operations this avoids a round trip to prepare the statement on every call.
+//
+// The cache holds at most size statements, when it's full the least recently
+// used statement is closed and evicted. database/sql takes care of preparing
+// a statement again on each pooled connection it's used on, and a statement
+// whose connection turns out to be bad is dropped from the cache so it will
+// be prepared anew next time.
+//
+// Statements are prepared without their sqlcommenter comment so per request
+// tags don't make every query distinct, which means the comments of queries
+// run through the cache don't reach the database, see StripSQLComment.
+type StmtCache struct {
+	db   *sql.DB
+	size int
+
+	mut   sync.Mutex
+	lru   *list.List
+	stmts map[string]*list.Element
+}
+
+type stmtCacheEntry struct {
+	query string
+	stmt  *sql.Stmt
+
+	// inUse counts callers currently executing stmt, an evicted entry is
+	// only closed once the last of them is done with it.
+	inUse   int
+	evicted bool
+}
+
+// NewStmtCache creates a statement cache over db holding at most size
+// prepared statements.
+func NewStmtCache(db *sql.DB, size int) *StmtCache {
+	if size <= 0 {
+		panic("boil: statement cache size must be greater than zero")
+	}
+
+	return &StmtCache{
+		db:    db,
+		size:  size,
+		lru:   list.New(),
+		stmts: make(map[string]*list.Element),
+	}
+}
+
+// Exec executes a query that doesn't return rows using a cached statement
+func (c *StmtCache) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.ExecContext(context.Background(), query, args...)
+}
+
+// Query executes a query that returns rows using a cached statement
+func (c *StmtCache) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.QueryContext(context.Background(), query, args...)
+}
+
+// QueryRow executes a query that returns at most one row using a cached statement
+func (c *StmtCache) QueryRow(query string, args ...interface{}) *sql.Row {
+	return c.QueryRowContext(context.Background(), query, args...)
+}
+
+// ExecContext executes a query that doesn't return rows using a cached statement
+func (c *StmtCache) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	entry, err := c.acquire(ctx, StripSQLComment(query))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := entry.stmt.ExecContext(ctx, args...)
+	c.release(entry, err)
+	return res, err
+}
+
+// QueryContext executes a query that returns rows using a cached statement
+func (c *StmtCache) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	entry, err := c.acquire(ctx, StripSQLComment(query))
+	if err != nil {
+		return nil, err
+	}
+
+	// The rows keep using the statement's connection until they're closed,
+	// database/sql defers closing the statement until then on its own.
+	rows, err := entry.stmt.QueryContext(ctx, args...)
+	c.release(entry, err)
+	return rows, err
+}
+
+// QueryRowContext executes a query that returns at most one row using a
+// cached statement. If the statement cannot be prepared the query is run
+// directly against the database so the error is reported by the returned
+// row.
+func (c *StmtCache) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	entry, err := c.acquire(ctx, StripSQLComment(query))
+	if err != nil {
+		return c.db.QueryRowContext(ctx, query, args...)
+	}
+
+	row := entry.stmt.QueryRowContext(ctx, args...)
+	c.release(entry, nil)
+	return row
+}
+
+// Len returns the number of statements currently cached
+func (c *StmtCache) Len() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	return c.lru.Len()
+}
+
+// Invalidate evicts every cached statement, for example after the schema has
+// changed underneath them. Statements are closed as soon as no caller is
+// using them anymore.
+func (c *StmtCache) Invalidate() {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	for c.lru.Len() > 0 {
+		c.evict(c.lru.Back())
+	}
+}
+
+// acquire returns the cache entry for query, preparing the statement if it
+// isn't cached yet. The entry must be given back with release.
+func (c *StmtCache) acquire(ctx context.Context, query string) (*stmtCacheEntry, error) {
+	c.mut.Lock()
+	if elem, ok := c.stmts[query]; ok {
+		c.lru.MoveToFront(elem)
+		entry := elem.Value.(*stmtCacheEntry)
+		entry.inUse++
+		c.mut.Unlock()
+		return entry, nil
+	}
+	c.mut.Unlock()
+
+	// Prepare outside of the lock so a slow prepare doesn't block every
+	// other caller, if somebody beat us to it we keep theirs.
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if elem, ok := c.stmts[query]; ok {
+		_ = stmt.Close()
+		c.lru.MoveToFront(elem)
+		entry := elem.Value.(*stmtCacheEntry)
+		entry.inUse++
+		return entry, nil
+	}
+
+	entry := &stmtCacheEntry{query: query, stmt: stmt, inUse: 1}
+	c.stmts[query] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.size {
+		c.evict(c.lru.Back())
+	}
+
+	return entry, nil
+}
+
+// release gives back an entry obtained from acquire. If err indicates the
+// connection the statement was used on is no longer usable the statement
+// is evicted so it will be prepared again.
+func (c *StmtCache) release(entry *stmtCacheEntry, err error) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	entry.inUse--
+	if err != nil && errors.Cause(err) == driver.ErrBadConn && !entry.evicted {
+		c.evict(c.stmts[entry.query])
+		return
+	}
+
+	if entry.evicted && entry.inUse == 0 {
+		_ = entry.stmt.Close()
+	}
+}
+
+// evict removes elem from the cache, closing its statement if nobody is
+// using it.
+func (c *StmtCache) evict(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*stmtCacheEntry)
+	delete(c.stmts, entry.query)
+	entry.evicted = true
+
+	if entry.inUse == 0 {
+		_ = entry.stmt.Close()
+	}
+}
@@ -0,0 +1,121 @@
+package boil
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestStmtCacheReuse(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	prep := mock.ExpectPrepare("update a set b = ?")
+	prep.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	cache := NewStmtCache(db, 2)
+	if _, err := cache.Exec("update a set b = ?", 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.Exec("update a set b = ?", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if cache.Len() != 1 {
+		t.Errorf("expected 1 cached statement, got %d", cache.Len())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStmtCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectPrepare("delete from a").WillBeClosed().
+		ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectPrepare("delete from b").
+		ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+
+	cache := NewStmtCache(db, 1)
+	if _, err := cache.Exec("delete from a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.Exec("delete from b"); err != nil {
+		t.Fatal(err)
+	}
+
+	if cache.Len() != 1 {
+		t.Errorf("expected 1 cached statement, got %d", cache.Len())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStmtCacheInvalidate(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectPrepare("delete from a").WillBeClosed().
+		ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+
+	cache := NewStmtCache(db, 2)
+	if _, err := cache.Exec("delete from a"); err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Invalidate()
+	if cache.Len() != 0 {
+		t.Errorf("expected empty cache, got %d cached", cache.Len())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStmtCacheSQLComment(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	prep := mock.ExpectPrepare("update a set b = ?")
+	prep.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	cache := NewStmtCache(db, 2)
+	if _, err := cache.Exec("update a set b = ? /*route='%2Fa'*/", 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.Exec("update a set b = ? /*route='%2Fb'*/", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if cache.Len() != 1 {
+		t.Errorf("expected 1 cached statement, got %d", cache.Len())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
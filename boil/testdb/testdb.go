@@ -0,0 +1,285 @@
+// Package testdb manages throwaway databases for tests. It creates a
+// uniquely named database on a server, loads a schema into it from a dump
+// or a directory of migrations, hands back a connection to it and drops it
+// again when the test is done.
+//
+//	func TestUsers(t *testing.T) {
+//		db := testdb.New(t, testdb.Config{
+//			DriverName: "postgres",
+//			AdminDSN:   "postgres://localhost/postgres?sslmode=disable",
+//			DSN: func(name string) string {
+//				return "postgres://localhost/" + name + "?sslmode=disable"
+//			},
+//			Schema: "../migrations",
+//		})
+//		...
+//	}
+//
+// The generated test suite uses it to create and drop its test database.
+package testdb
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+)
+
+// DefaultPrefix starts the names of databases made by Create when the
+// config has no Prefix.
+const DefaultPrefix = "test"
+
+// Config describes how to reach the server and what to put in the database
+type Config struct {
+	// DriverName is the database/sql driver to use, eg. postgres or mysql
+	DriverName string
+	// AdminDSN connects to a database on the server as a user that may
+	// create and drop databases, eg. the postgres database for PostgreSQL.
+	AdminDSN string
+	// DSN returns the data source name that connects to the database
+	// called name.
+	DSN func(name string) string
+
+	// Name is the name of the database, if empty a random name starting
+	// with Prefix is made up.
+	Name string
+	// Prefix is put in front of the random part of made up names
+	Prefix string
+
+	// Schema is the path of a .sql file, such as the output of pg_dump, or
+	// of a directory of migrations, see Load.
+	Schema string
+	// LoadFunc is called instead of loading Schema if it's set, to fill
+	// the database some other way.
+	LoadFunc func(db *sql.DB, name string) error
+}
+
+// DB is a database made by Create, it embeds the connection to it
+type DB struct {
+	*sql.DB
+
+	// Name of the database on the server
+	Name string
+
+	admin *sql.DB
+}
+
+// Create makes a new database as described by cfg and loads its schema. A
+// database with the same name that is left over from an earlier run is
+// dropped first. Drop must be called once the database isn't needed.
+func Create(cfg Config) (*DB, error) {
+	if len(cfg.DriverName) == 0 || len(cfg.AdminDSN) == 0 || cfg.DSN == nil {
+		return nil, errors.New("testdb: DriverName, AdminDSN and DSN must be set")
+	}
+
+	name := cfg.Name
+	if len(name) == 0 {
+		var err error
+		if name, err = RandomName(cfg.Prefix); err != nil {
+			return nil, err
+		}
+	}
+
+	admin, err := sql.Open(cfg.DriverName, cfg.AdminDSN)
+	if err != nil {
+		return nil, errors.Wrap(err, "testdb: failed to connect to the server")
+	}
+
+	if err = DropDatabase(admin, name); err != nil {
+		_ = admin.Close()
+		return nil, err
+	}
+	if err = CreateDatabase(admin, name); err != nil {
+		_ = admin.Close()
+		return nil, err
+	}
+
+	db := &DB{Name: name, admin: admin}
+	if db.DB, err = sql.Open(cfg.DriverName, cfg.DSN(name)); err != nil {
+		_ = db.Drop()
+		return nil, errors.Wrapf(err, "testdb: failed to connect to %s", name)
+	}
+
+	switch {
+	case cfg.LoadFunc != nil:
+		err = cfg.LoadFunc(db.DB, name)
+	case len(cfg.Schema) != 0:
+		err = Load(db.DB, cfg.Schema)
+	}
+	if err != nil {
+		_ = db.Drop()
+		return nil, errors.Wrapf(err, "testdb: failed to load the schema of %s", name)
+	}
+
+	return db, nil
+}
+
+// Drop closes the connection to the database and drops it
+func (d *DB) Drop() error {
+	if d.DB != nil {
+		if err := d.DB.Close(); err != nil {
+			return errors.Wrapf(err, "testdb: failed to close %s", d.Name)
+		}
+		d.DB = nil
+	}
+
+	err := DropDatabase(d.admin, d.Name)
+	if closeErr := d.admin.Close(); err == nil && closeErr != nil {
+		err = errors.Wrap(closeErr, "testdb: failed to close the admin connection")
+	}
+	return err
+}
+
+// New creates a database for the test t, failing it if that's not possible,
+// and drops the database when t and its subtests are done.
+func New(t testing.TB, cfg Config) *sql.DB {
+	t.Helper()
+
+	db, err := Create(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := db.Drop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return db.DB
+}
+
+// RandomName makes up a database name that is unlikely to be in use. It
+// only contains lowercase letters, digits and underscores so it never needs
+// to be quoted.
+func RandomName(prefix string) (string, error) {
+	if len(prefix) == 0 {
+		prefix = DefaultPrefix
+	}
+
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "testdb: failed to make up a database name")
+	}
+
+	return prefix + "_" + hex.EncodeToString(b), nil
+}
+
+// CreateDatabase creates the database called name using admin
+func CreateDatabase(admin *sql.DB, name string) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+
+	if _, err := admin.Exec("CREATE DATABASE " + name); err != nil {
+		return errors.Wrapf(err, "testdb: failed to create %s", name)
+	}
+	return nil
+}
+
+// DropDatabase drops the database called name using admin if it exists,
+// there can be no open connections to it.
+func DropDatabase(admin *sql.DB, name string) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+
+	if _, err := admin.Exec("DROP DATABASE IF EXISTS " + name); err != nil {
+		return errors.Wrapf(err, "testdb: failed to drop %s", name)
+	}
+	return nil
+}
+
+var rgxName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// checkName makes sure name can go into a statement without quoting, the
+// quotes differ between databases and names never come from users here.
+func checkName(name string) error {
+	if !rgxName.MatchString(name) {
+		return errors.Errorf("testdb: %q is not a valid database name, use letters, digits and underscores", name)
+	}
+	return nil
+}
+
+var (
+	rgxUpMarker   = regexp.MustCompile(`(?m)^--\s*\+(migrate|goose) Up.*$`)
+	rgxDownMarker = regexp.MustCompile(`(?m)^--\s*\+(migrate|goose) Down.*$`)
+)
+
+// Load runs the SQL at path against db. path is either a single .sql file,
+// or a directory whose .sql files are run in the order of their names. For
+// migrations only the up part is run: files named like 1_init.down.sql are
+// skipped and files with sql-migrate or goose markers are cut at their
+// "-- +migrate Down" or "-- +goose Down" line.
+//
+// Each file is sent to the database as one statement, for MySQL the
+// connection needs multiStatements=true for files that contain several.
+func Load(db *sql.DB, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return errors.Wrap(err, "testdb: failed to find the schema")
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		if files, err = migrationFiles(path); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return errors.Wrapf(err, "testdb: failed to read %s", f)
+		}
+
+		query := upMigration(string(b))
+		if len(strings.TrimSpace(query)) == 0 {
+			continue
+		}
+		if _, err = db.Exec(query); err != nil {
+			return errors.Wrapf(err, "testdb: failed to run %s", f)
+		}
+	}
+
+	return nil
+}
+
+// migrationFiles lists the .sql files in dir that migrate up, in order
+func migrationFiles(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "testdb: failed to read %s", dir)
+	}
+
+	var files []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".sql") || strings.HasSuffix(name, ".down.sql") {
+			continue
+		}
+		files = append(files, filepath.Join(dir, name))
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// upMigration returns the part of a migration between its up and down
+// markers, files without markers are returned as they are.
+func upMigration(sql string) string {
+	if loc := rgxUpMarker.FindStringIndex(sql); loc != nil {
+		sql = sql[loc[1]:]
+	}
+	if loc := rgxDownMarker.FindStringIndex(sql); loc != nil {
+		sql = sql[:loc[0]]
+	}
+	return sql
+}
@@ -0,0 +1,161 @@
+package testdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRandomName(t *testing.T) {
+	t.Parallel()
+
+	a, err := RandomName("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := RandomName("models")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(a, DefaultPrefix+"_") || !strings.HasPrefix(b, "models_") {
+		t.Errorf("wrong prefixes: %s %s", a, b)
+	}
+	if err = checkName(a); err != nil {
+		t.Error(err)
+	}
+	if strings.TrimPrefix(a, DefaultPrefix) == strings.TrimPrefix(b, "models") {
+		t.Error("names should be random")
+	}
+}
+
+func TestCheckName(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"test_1", "Models"} {
+		if err := checkName(name); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "1test", "a;DROP DATABASE b", `a"b`} {
+		if err := checkName(name); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestUpMigration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want string
+	}{
+		{"create table a();\n", "create table a();\n"},
+		{"-- +migrate Up\ncreate table a();\n-- +migrate Down\ndrop table a;\n", "\ncreate table a();\n"},
+		{"-- +goose Up\n-- +goose StatementBegin\ncreate table a();\n-- +goose StatementEnd\n\n-- +goose Down\ndrop table a;\n", "\n-- +goose StatementBegin\ncreate table a();\n-- +goose StatementEnd\n\n"},
+	}
+
+	for i, test := range tests {
+		if got := upMigration(test.In); got != test.Want {
+			t.Errorf("%d) want: %q, got: %q", i, test.Want, got)
+		}
+	}
+}
+
+func TestMigrationFiles(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"2_b.up.sql", "2_b.down.sql", "1_a.sql", "README.md"} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = os.Mkdir(filepath.Join(dir, "3_c.sql"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := migrationFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{filepath.Join(dir, "1_a.sql"), filepath.Join(dir, "2_b.up.sql")}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("want: %v, got: %v", want, files)
+	}
+}
+
+func TestCreateDrop(t *testing.T) {
+	t.Parallel()
+
+	schema, err := ioutil.TempFile("", "schema*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(schema.Name())
+	if _, err = schema.WriteString("create table pilots (id int);"); err != nil {
+		t.Fatal(err)
+	}
+	if err = schema.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	adminDB, admin, err := sqlmock.NewWithDSN("testdb_admin", sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminDB.Close()
+	testDB, test, err := sqlmock.NewWithDSN("testdb_test", sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer testDB.Close()
+
+	admin.ExpectExec("DROP DATABASE IF EXISTS models_test").WillReturnResult(sqlmock.NewResult(0, 0))
+	admin.ExpectExec("CREATE DATABASE models_test").WillReturnResult(sqlmock.NewResult(0, 0))
+	test.ExpectExec("create table pilots (id int);").WillReturnResult(sqlmock.NewResult(0, 0))
+	test.ExpectClose()
+	admin.ExpectExec("DROP DATABASE IF EXISTS models_test").WillReturnResult(sqlmock.NewResult(0, 0))
+	admin.ExpectClose()
+
+	db, err := Create(Config{
+		DriverName: "sqlmock",
+		AdminDSN:   "testdb_admin",
+		DSN: func(name string) string {
+			if name != "models_test" {
+				t.Errorf("wrong name: %s", name)
+			}
+			return "testdb_test"
+		},
+		Name:   "models_test",
+		Schema: schema.Name(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Name != "models_test" {
+		t.Errorf("wrong name: %s", db.Name)
+	}
+	if err = db.Drop(); err != nil {
+		t.Error(err)
+	}
+
+	if err = admin.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	if err = test.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
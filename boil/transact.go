@@ -0,0 +1,91 @@
+package boil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/friendsofgo/errors"
+)
+
+// transaction is stored on the context of a function run by Transact so
+// that nested calls can find the transaction they are part of.
+type transaction struct {
+	tx    ContextExecutor
+	depth int
+}
+
+// Transact runs fn inside a transaction. The transaction is committed if fn
+// returns nil and rolled back if it returns an error or panics.
+//
+// Calls to Transact can be nested: if ctx comes from an enclosing Transact,
+// or db is already a transaction (*sql.Tx), no new transaction is begun and
+// fn is instead run inside a SAVEPOINT which is rolled back to if fn fails.
+// This lets code built on the generated models compose across layers without
+// needing to know whether a transaction has been started already. Savepoints
+// use the standard SQL syntax supported by PostgreSQL, MySQL and SQLite.
+//
+// fn receives the context and executor it should use for its queries. If a
+// new transaction is needed db must implement ContextBeginner.
+func Transact(ctx context.Context, db ContextExecutor, fn func(ctx context.Context, exec ContextExecutor) error) error {
+	if t, ok := ctx.Value(ctxTransaction).(transaction); ok {
+		return savepoint(ctx, t.tx, t.depth+1, fn)
+	}
+	if _, ok := db.(ContextTransactor); ok {
+		return savepoint(ctx, db, 1, fn)
+	}
+
+	beginner, ok := db.(ContextBeginner)
+	if !ok {
+		return errors.New("boil: executor does not support context-aware transactions")
+	}
+
+	tx, err := beginner.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "boil: unable to begin transaction")
+	}
+
+	panicked := true
+	defer func() {
+		if panicked {
+			_ = tx.Rollback()
+		}
+	}()
+
+	err = fn(context.WithValue(ctx, ctxTransaction, transaction{tx: tx}), tx)
+	panicked = false
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Wrapf(err, "boil: rollback failed (%v)", rbErr)
+		}
+		return err
+	}
+
+	return errors.Wrap(tx.Commit(), "boil: unable to commit transaction")
+}
+
+func savepoint(ctx context.Context, tx ContextExecutor, depth int, fn func(ctx context.Context, exec ContextExecutor) error) error {
+	name := fmt.Sprintf("sqlboiler_sp_%d", depth)
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return errors.Wrap(err, "boil: unable to create savepoint")
+	}
+
+	panicked := true
+	defer func() {
+		if panicked {
+			_, _ = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+		}
+	}()
+
+	err := fn(context.WithValue(ctx, ctxTransaction, transaction{tx: tx, depth: depth}), tx)
+	panicked = false
+	if err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return errors.Wrapf(err, "boil: rollback to savepoint failed (%v)", rbErr)
+		}
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return errors.Wrap(err, "boil: unable to release savepoint")
+}
@@ -0,0 +1,70 @@
+package boil
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestTransact(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT sqlboiler_sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVEPOINT sqlboiler_sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT sqlboiler_sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT sqlboiler_sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	innerErr := errors.New("inner failed")
+	err = Transact(context.Background(), db, func(ctx context.Context, exec ContextExecutor) error {
+		return Transact(ctx, db, func(ctx context.Context, exec ContextExecutor) error {
+			if err := Transact(ctx, exec, func(ctx context.Context, exec ContextExecutor) error {
+				return innerErr
+			}); err != innerErr {
+				t.Errorf("expected inner error, got: %v", err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransactRollback(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	fnErr := errors.New("failed")
+	err = Transact(context.Background(), db, func(ctx context.Context, exec ContextExecutor) error {
+		return fnErr
+	})
+	if err != fnErr {
+		t.Errorf("expected fn's error, got: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,15 @@
+package boil
+
+// TruncateOptions changes how the generated TruncateX functions empty a
+// table. Asking for an option the database doesn't have is an error.
+type TruncateOptions struct {
+	// RestartIdentity resets the sequences owned by the table's columns.
+	// Postgres only, MySQL and MSSQL always reset auto increment columns.
+	RestartIdentity bool
+	// Cascade also truncates the tables that have foreign keys to the
+	// table. Postgres only.
+	Cascade bool
+	// DisableForeignKeyChecks turns foreign key checks off while truncating,
+	// which MySQL otherwise refuses for tables that are referenced. MySQL only.
+	DisableForeignKeyChecks bool
+}
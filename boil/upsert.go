@@ -0,0 +1,30 @@
+package boil
+
+// UpsertResult is what an upsert did with the row, it's returned by the
+// generated UpsertWithResult methods.
+type UpsertResult int
+
+const (
+	// UpsertNone means the row conflicted with an existing one which was
+	// left as it was.
+	UpsertNone UpsertResult = iota
+	// UpsertInserted means the row was inserted.
+	UpsertInserted
+	// UpsertUpdated means the row conflicted with an existing one which was
+	// updated.
+	UpsertUpdated
+)
+
+// String returns the name of the result.
+func (u UpsertResult) String() string {
+	switch u {
+	case UpsertNone:
+		return "none"
+	case UpsertInserted:
+		return "inserted"
+	case UpsertUpdated:
+		return "updated"
+	default:
+		return "unknown"
+	}
+}
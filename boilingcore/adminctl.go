@@ -0,0 +1,103 @@
+package boilingcore
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+)
+
+// initAdminCLI adds the import of the generated package to the admin CLI,
+// which is generated in a package of its own.
+func (s *State) initAdminCLI() error {
+	importPath := s.Config.PkgImportPath
+	if len(importPath) == 0 {
+		var err error
+		if importPath, err = findImportPath(s.Config.OutFolder); err != nil {
+			return errors.Wrap(err, "unable to find the import path of the output folder, set pkg-import-path")
+		}
+	}
+
+	if s.Config.Imports.Singleton == nil {
+		s.Config.Imports.Singleton = make(map[string]importers.Set)
+	}
+	imps := s.Config.Imports.Singleton["adminctl"]
+	imps.ThirdParty = append(imps.ThirdParty, fmt.Sprintf("%s %q", s.Config.PkgName, importPath))
+	s.Config.Imports.Singleton["adminctl"] = imps
+
+	return nil
+}
+
+// findImportPath finds the go import path of dir from the module path in the
+// go.mod of the module it's in.
+func findImportPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for root := dir; ; {
+		modulePath, err := readModulePath(filepath.Join(root, "go.mod"))
+		if err == nil {
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return "", err
+			}
+			if rel == "." {
+				return modulePath, nil
+			}
+			return modulePath + "/" + filepath.ToSlash(rel), nil
+		}
+		if !os.IsNotExist(errors.Cause(err)) {
+			return "", err
+		}
+
+		parent := filepath.Dir(root)
+		if parent == root {
+			return "", errors.Errorf("%s isn't in a go module", dir)
+		}
+		root = parent
+	}
+}
+
+// readModulePath reads the module path from a go.mod file
+func readModulePath(goMod string) (string, error) {
+	f, err := os.Open(goMod)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+		if !strings.HasPrefix(line, "module") {
+			continue
+		}
+
+		modulePath := strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module")), `"`)
+		if len(modulePath) != 0 {
+			return modulePath, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", errors.Errorf("%s has no module path", goMod)
+}
+
+// isAdminCLITemplate reports whether name is one of the templates of the
+// admin CLI, eg. templates/adminctl/singleton/adminctl.go.tpl, that are only
+// used with AddAdminCLI.
+func isAdminCLITemplate(name string) bool {
+	fragments := strings.Split(name, string(filepath.Separator))
+	return len(fragments) > 2 && fragments[1] == "adminctl"
+}
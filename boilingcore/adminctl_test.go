@@ -0,0 +1,47 @@
+package boilingcore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindImportPath(t *testing.T) {
+	t.Parallel()
+
+	root, err := ioutil.TempDir("", "sqlboiler-import-path")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	goMod := "// the app\nmodule \"github.com/me/app\" // comment\n\ngo 1.16\n"
+	if err := ioutil.WriteFile(filepath.Join(root, "go.mod"), []byte(goMod), 0664); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "internal", "models")
+	if err := os.MkdirAll(dir, 0775); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Dir  string
+		Want string
+	}{
+		{root, "github.com/me/app"},
+		{dir, "github.com/me/app/internal/models"},
+	}
+	for _, test := range tests {
+		got, err := findImportPath(test.Dir)
+		if err != nil {
+			t.Errorf("%s: %v", test.Dir, err)
+		} else if got != test.Want {
+			t.Errorf("%s: want: %s, got: %s", test.Dir, test.Want, got)
+		}
+	}
+
+	if _, err := findImportPath(os.TempDir()); err == nil {
+		t.Error("want an error outside of a module")
+	}
+}
@@ -34,11 +34,19 @@ type RelationshipAlias struct {
 // and fills in aliases where the user has provided none.
 //
 // This leaves us with a complete list of Go names for all tables,
-// columns, and relationships.
+// columns, and relationships. Generated names that would collide with
+// each other or with the methods of the generated models are renamed.
 func FillAliases(a *Aliases, tables []drivers.Table) {
+	fillAliases(a, tables)
+}
+
+// fillAliases is FillAliases but returns a description of every generated
+// name that had to be renamed to avoid a collision.
+func fillAliases(a *Aliases, tables []drivers.Table) []string {
 	if a.Tables == nil {
 		a.Tables = make(map[string]TableAlias)
 	}
+	user := newUserAliases(a)
 
 	for _, t := range tables {
 		if t.IsJoinTable {
@@ -54,16 +62,16 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 		table := a.Tables[t.Name]
 
 		if len(table.UpPlural) == 0 {
-			table.UpPlural = strmangle.TitleCase(strmangle.Plural(t.Name))
+			table.UpPlural = TitleCase(strmangle.Plural(t.Name))
 		}
 		if len(table.UpSingular) == 0 {
-			table.UpSingular = strmangle.TitleCase(strmangle.Singular(t.Name))
+			table.UpSingular = TitleCase(strmangle.Singular(t.Name))
 		}
 		if len(table.DownPlural) == 0 {
-			table.DownPlural = strmangle.CamelCase(strmangle.Plural(t.Name))
+			table.DownPlural = CamelCase(strmangle.Plural(t.Name))
 		}
 		if len(table.DownSingular) == 0 {
-			table.DownSingular = strmangle.CamelCase(strmangle.Singular(t.Name))
+			table.DownSingular = CamelCase(strmangle.Singular(t.Name))
 		}
 
 		if table.Columns == nil {
@@ -75,7 +83,7 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 
 		for _, c := range t.Columns {
 			if _, ok := table.Columns[c.Name]; !ok {
-				table.Columns[c.Name] = strmangle.TitleCase(c.Name)
+				table.Columns[c.Name] = TitleCase(c.Name)
 			}
 		}
 
@@ -98,6 +106,22 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 			table.Relationships[k.Name] = r
 		}
 
+		for _, k := range t.CompositeFKeys {
+			r := table.Relationships[k.Name]
+			if len(r.Local) != 0 && len(r.Foreign) != 0 {
+				continue
+			}
+
+			local, foreign := txtNameToOneComposite(k)
+			if len(r.Local) == 0 {
+				r.Local = local
+			}
+			if len(r.Foreign) == 0 {
+				r.Foreign = foreign
+			}
+
+			table.Relationships[k.Name] = r
+		}
 	}
 
 	for _, t := range tables {
@@ -157,6 +181,8 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 		table.Relationships[lhs.Name] = lhsAlias
 		table.Relationships[rhs.Name] = rhsAlias
 	}
+
+	return resolveCollisions(a, tables, user)
 }
 
 // Table gets a table alias, panics if not found.
@@ -0,0 +1,52 @@
+package boilingcore
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+)
+
+// initAudit checks that the audited tables can be audited, the audit is
+// done by the hooks of the models and needs a context for the actor.
+func (s *State) initAudit() error {
+	return s.checkHookTables("audit", s.Config.AuditTables)
+}
+
+// checkHookTables checks that the tables a feature done by the hooks of the
+// models is enabled for exist and have a primary key to tell their rows
+// apart. Such features need the hooks and a context.
+func (s *State) checkHookTables(feature string, tables []string) error {
+	if len(tables) == 0 {
+		return nil
+	}
+
+	if s.Config.NoHooks {
+		return errors.Errorf("%s tables need hooks, they can't be used with no-hooks", feature)
+	}
+	if s.Config.NoContext {
+		return errors.Errorf("%s tables need a context, they can't be used with no-context", feature)
+	}
+
+	for _, name := range tables {
+		t, ok := findTable(s.Tables, name)
+		if !ok {
+			return errors.Errorf("%s table %s does not exist", feature, name)
+		}
+		if t.IsJoinTable {
+			return errors.Errorf("%s table %s is a join table", feature, name)
+		}
+		if t.PKey == nil {
+			return errors.Errorf("%s table %s has no primary key", feature, name)
+		}
+	}
+
+	return nil
+}
+
+// isAuditTemplate reports whether name is the singleton template of the
+// audit entries, which is only used with AuditTables.
+func isAuditTemplate(name string) bool {
+	fragments := strings.Split(name, string(filepath.Separator))
+	return len(fragments) == 3 && fragments[1] == "singleton" && fragments[2] == "boil_audit.go.tpl"
+}
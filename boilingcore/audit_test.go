@@ -0,0 +1,64 @@
+package boilingcore
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestInitAudit(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{Name: "users", PKey: &drivers.PrimaryKey{Columns: []string{"id"}}},
+		{Name: "logs"},
+		{Name: "user_tags", IsJoinTable: true, PKey: &drivers.PrimaryKey{Columns: []string{"user_id", "tag_id"}}},
+	}
+
+	tests := []struct {
+		Config Config
+		Err    string
+	}{
+		{Config: Config{}},
+		{Config: Config{AuditTables: []string{"users"}}},
+		{Config: Config{AuditTables: []string{"users"}, NoHooks: true}, Err: "need hooks"},
+		{Config: Config{AuditTables: []string{"users"}, NoContext: true}, Err: "need a context"},
+		{Config: Config{AuditTables: []string{"videos"}}, Err: "does not exist"},
+		{Config: Config{AuditTables: []string{"logs"}}, Err: "no primary key"},
+		{Config: Config{AuditTables: []string{"user_tags"}}, Err: "join table"},
+	}
+
+	for i, test := range tests {
+		config := test.Config
+		s := &State{Config: &config, Tables: tables}
+
+		err := s.initAudit()
+		if len(test.Err) == 0 {
+			if err != nil {
+				t.Errorf("%d) unexpected error: %v", i, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.Err) {
+			t.Errorf("%d) want an error containing %q, got: %v", i, test.Err, err)
+		}
+	}
+}
+
+func TestIsAuditTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]bool{
+		filepath.Join("templates", "singleton", "boil_audit.go.tpl"): true,
+		filepath.Join("templates", "singleton", "boil_types.go.tpl"): false,
+		filepath.Join("templates", "31_audit.go.tpl"):                false,
+	}
+
+	for name, want := range tests {
+		if got := isAuditTemplate(name); got != want {
+			t.Errorf("%s: want %t, got %t", name, want, got)
+		}
+	}
+}
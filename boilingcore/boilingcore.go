@@ -5,11 +5,16 @@ package boilingcore
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
+	"runtime"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
@@ -39,13 +44,16 @@ var (
 type State struct {
 	Config *Config
 
-	Driver  drivers.Interface
-	Schema  string
-	Tables  []drivers.Table
-	Dialect drivers.Dialect
+	Driver    drivers.Interface
+	Schema    string
+	Tables    []drivers.Table
+	Sequences []drivers.Sequence
+	Dialect   drivers.Dialect
 
 	Templates     *templateList
 	TestTemplates *templateList
+
+	extraOutputs []extraOutput
 }
 
 // New creates a new state based off of the config
@@ -90,6 +98,10 @@ func New(config *Config) (*State, error) {
 
 	s.Driver = drivers.GetDriver(config.DriverName)
 
+	if err := s.preGenerate(); err != nil {
+		return nil, err
+	}
+
 	err := s.initDBInfo(config.DriverConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to initialize tables")
@@ -102,12 +114,44 @@ func New(config *Config) (*State, error) {
 	if !s.Config.NoContext {
 		s.Config.Imports.All.Standard = append(s.Config.Imports.All.Standard, `"context"`)
 		s.Config.Imports.Test.Standard = append(s.Config.Imports.Test.Standard, `"context"`)
+
+		for _, name := range []string{"boil_schema", "boil_sequences", "boil_snapshot"} {
+			if imps, ok := s.Config.Imports.Singleton[name]; ok {
+				imps.Standard = append(imps.Standard, `"context"`)
+				s.Config.Imports.Singleton[name] = imps
+			}
+		}
+	} else if imps, ok := s.Config.Imports.Singleton["boil_sequences"]; ok {
+		// Only the debug output without a context needs fmt
+		imps.Standard = append(imps.Standard, `"fmt"`)
+		s.Config.Imports.Singleton["boil_sequences"] = imps
+	}
+
+	if s.Config.AddBinaryMarshal {
+		s.Config.Imports.All.Standard = append(s.Config.Imports.All.Standard, `"bytes"`, `"encoding/gob"`)
+	}
+
+	if s.Config.AddAdminCLI {
+		if err := s.initAdminCLI(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.initAudit(); err != nil {
+		return nil, err
+	}
+	if err := s.initOutbox(); err != nil {
+		return nil, err
 	}
 
 	if err := s.processTypeReplacements(); err != nil {
 		return nil, err
 	}
 
+	if s.Config.NullablePointers {
+		s.processNullablePointers()
+	}
+
 	templates, err = s.initTemplates()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to initialize templates")
@@ -118,16 +162,29 @@ func New(config *Config) (*State, error) {
 		return nil, errors.Wrap(err, "unable to initialize the output folders")
 	}
 
+	if err = s.initExtraOutputs(); err != nil {
+		return nil, errors.Wrap(err, "unable to initialize the extra outputs")
+	}
+
 	err = s.initTags(config.Tags)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to initialize struct tags")
 	}
 
+	AddUppercaseWord(s.Config.UppercaseWords...)
+	for abbr, expansion := range s.Config.Abbreviations {
+		AddAbbreviation(abbr, expansion)
+	}
+
 	err = s.initAliases(&config.Aliases)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to initialize aliases")
 	}
 
+	if err = s.initPolymorphic(); err != nil {
+		return nil, errors.Wrap(err, "unable to initialize polymorphic associations")
+	}
+
 	return s, nil
 }
 
@@ -136,6 +193,7 @@ func New(config *Config) (*State, error) {
 func (s *State) Run() error {
 	data := &templateData{
 		Tables:            s.Tables,
+		Sequences:         s.Sequences,
 		Aliases:           s.Config.Aliases,
 		DriverName:        s.Config.DriverName,
 		PkgName:           s.Config.PkgName,
@@ -148,8 +206,22 @@ func (s *State) Run() error {
 		NoRowsAffected:    s.Config.NoRowsAffected,
 		NoDriverTemplates: s.Config.NoDriverTemplates,
 		NoBackReferencing: s.Config.NoBackReferencing,
+		NullablePointers:  s.Config.NullablePointers,
 		StructTagCasing:   s.Config.StructTagCasing,
 		TagIgnore:         make(map[string]struct{}),
+		JSONTagCasing:     s.Config.JSONTagCasing,
+		NoJSONOmitEmpty:   s.Config.NoJSONOmitEmpty,
+		JSONIgnore:        make(map[string]struct{}),
+		AddXMLTags:        s.Config.AddXMLTags,
+		AddBinaryMarshal:  s.Config.AddBinaryMarshal,
+		AddValidateTags:   s.Config.AddValidateTags,
+		AddParquet:        s.Config.AddParquet,
+		AddDataloaders:    s.Config.AddDataloaders,
+		AddQueryFilters:   s.Config.AddQueryFilters,
+		AddRepositories:   s.Config.AddRepositories,
+		AuditTables:       s.Config.AuditTables,
+		OutboxTables:      s.Config.OutboxTables,
+		XMLAttributes:     make(map[string]struct{}),
 		Tags:              s.Config.Tags,
 		RelationTag:       s.Config.RelationTag,
 		Dialect:           s.Dialect,
@@ -157,6 +229,7 @@ func (s *State) Run() error {
 		LQ:                strmangle.QuoteCharacter(s.Dialect.LQ),
 		RQ:                strmangle.QuoteCharacter(s.Dialect.RQ),
 		OutputDirDepth:    s.Config.OutputDirDepth(),
+		Polymorphic:       s.Config.Polymorphic,
 
 		DBTypes:     make(once),
 		StringFuncs: templateStringMappers,
@@ -168,6 +241,18 @@ func (s *State) Run() error {
 		}
 		data.TagIgnore[v] = struct{}{}
 	}
+	for _, v := range s.Config.JSONIgnore {
+		if !rgxValidTableColumn.MatchString(v) {
+			return errors.Errorf("Invalid column name %q supplied, only specify column name or table.column, eg: created_at, user.password", v)
+		}
+		data.JSONIgnore[v] = struct{}{}
+	}
+	for _, v := range s.Config.XMLAttributes {
+		if !rgxValidTableColumn.MatchString(v) {
+			return errors.Errorf("Invalid column name %q supplied, only specify column name or table.column, eg: id, pilots.id", v)
+		}
+		data.XMLAttributes[v] = struct{}{}
+	}
 
 	if err := generateSingletonOutput(s, data); err != nil {
 		return errors.Wrap(err, "singleton template output")
@@ -185,23 +270,82 @@ func (s *State) Run() error {
 		testDirExtMap = groupTemplates(s.TestTemplates)
 	}
 
-	for _, table := range s.Tables {
+	// Each table gets the types the tables before it used, so the output
+	// doesn't depend on the order the tables are generated in
+	tableDBTypes := make([]once, len(s.Tables))
+	dbTypes := data.DBTypes
+	for i, table := range s.Tables {
 		if table.IsJoinTable {
 			continue
 		}
 
-		data.Table = table
-
-		// Generate the regular templates
-		if err := generateOutput(s, regularDirExtMap, data); err != nil {
-			return errors.Wrap(err, "unable to generate output")
+		tableDBTypes[i] = make(once, len(dbTypes))
+		for typ := range dbTypes {
+			tableDBTypes[i][typ] = struct{}{}
+		}
+		for _, column := range table.Columns {
+			dbTypes[column.Type] = struct{}{}
 		}
+	}
 
-		// Generate the test templates
-		if !s.Config.NoTests {
-			if err := generateTestOutput(s, testDirExtMap, data); err != nil {
-				return errors.Wrap(err, "unable to generate test output")
+	errs := make([]error, len(s.Tables))
+	var failed int32
+
+	var wg sync.WaitGroup
+	work := make(chan int)
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buffers := newOutputBuffers()
+			for i := range work {
+				// Once a table failed the rest doesn't matter
+				if atomic.LoadInt32(&failed) != 0 {
+					continue
+				}
+				if errs[i] = s.generateTable(data, s.Tables[i], tableDBTypes[i], regularDirExtMap, testDirExtMap, buffers); errs[i] != nil {
+					atomic.StoreInt32(&failed, 1)
+				}
 			}
+		}()
+	}
+	for i, table := range s.Tables {
+		if !table.IsJoinTable {
+			work <- i
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.generateExtraOutputs(data); err != nil {
+		return errors.Wrap(err, "unable to generate the extra outputs")
+	}
+
+	return nil
+}
+
+// generateTable executes the regular and test templates for table with its
+// own copy of data, writing the files with buffers.
+func (s *State) generateTable(data *templateData, table drivers.Table, dbTypes once, regularDirExtMap, testDirExtMap dirExtMap, buffers *outputBuffers) error {
+	tableData := *data
+	tableData.Table = table
+	tableData.DBTypes = dbTypes
+
+	// Generate the regular templates
+	if err := generateOutput(s, regularDirExtMap, &tableData, buffers); err != nil {
+		return errors.Wrap(err, "unable to generate output")
+	}
+
+	// Generate the test templates
+	if !s.Config.NoTests {
+		if err := generateTestOutput(s, testDirExtMap, &tableData, buffers); err != nil {
+			return errors.Wrap(err, "unable to generate test output")
 		}
 	}
 
@@ -282,6 +426,24 @@ func (s *State) initTemplates() ([]lazyTemplate, error) {
 	// For stability, sort keys to traverse the map and turn it into a slice
 	keys := make([]string, 0, len(templates))
 	for k := range templates {
+		if !s.Config.AddDocs && isDocsTemplate(k) {
+			continue
+		}
+		if !s.Config.AddProtoHelpers && isProtoTemplate(k) {
+			continue
+		}
+		if !s.Config.AddAdminCLI && isAdminCLITemplate(k) {
+			continue
+		}
+		if !s.Config.AddTypeScript && isTypeScriptTemplate(k) {
+			continue
+		}
+		if len(s.Config.AuditTables) == 0 && isAuditTemplate(k) {
+			continue
+		}
+		if len(s.Config.OutboxTables) == 0 && isOutboxTemplate(k) {
+			continue
+		}
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
@@ -309,6 +471,21 @@ func (s *State) initTemplates() ([]lazyTemplate, error) {
 	return lazyTemplates, nil
 }
 
+// isDocsTemplate reports whether name is one of the markdown documentation
+// templates, eg. templates/docs/00_table.md.tpl, that are only used with
+// AddDocs.
+func isDocsTemplate(name string) bool {
+	fragments := strings.Split(name, string(filepath.Separator))
+	return len(fragments) > 2 && fragments[1] == "docs"
+}
+
+// isProtoTemplate reports whether name is the singleton template of the
+// protobuf converters, which is only used with AddProtoHelpers.
+func isProtoTemplate(name string) bool {
+	fragments := strings.Split(name, string(filepath.Separator))
+	return len(fragments) == 3 && fragments[1] == "singleton" && fragments[2] == "boil_proto.go.tpl"
+}
+
 type dirExtMap map[string]map[string][]string
 
 // groupTemplates takes templates and groups them according to their output directory
@@ -379,11 +556,23 @@ func findTemplates(root, base string) (map[string]templateLoader, error) {
 
 // initDBInfo retrieves information about the database
 func (s *State) initDBInfo(config map[string]interface{}) error {
-	dbInfo, err := s.Driver.Assemble(config)
+	var dbInfo *drivers.DBInfo
+	var err error
+	if len(s.Config.SchemaIn) != 0 {
+		dbInfo, err = readSchema(s.Config.SchemaIn)
+	} else {
+		dbInfo, err = s.Driver.Assemble(config)
+	}
 	if err != nil {
 		return errors.Wrap(err, "unable to fetch table data")
 	}
 
+	if len(s.Config.SchemaOut) != 0 {
+		if err := writeSchema(s.Config.SchemaOut, dbInfo); err != nil {
+			return err
+		}
+	}
+
 	if len(dbInfo.Tables) == 0 {
 		return errors.New("no tables found in database")
 	}
@@ -394,11 +583,84 @@ func (s *State) initDBInfo(config map[string]interface{}) error {
 
 	s.Schema = dbInfo.Schema
 	s.Tables = dbInfo.Tables
+	s.Sequences = dbInfo.Sequences
 	s.Dialect = dbInfo.Dialect
 
 	return nil
 }
 
+// preGenerate runs the configured command and hook that prepare the database,
+// eg. by migrating it, before it's read. Neither runs when generating from a
+// schema snapshot because the database isn't used.
+func (s *State) preGenerate() error {
+	if len(s.Config.SchemaIn) != 0 {
+		return nil
+	}
+
+	if len(s.Config.PreGenerate) != 0 {
+		cmd := exec.Command(s.Config.PreGenerate[0], s.Config.PreGenerate[1:]...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return errors.Wrapf(err, "pre-generate command %q failed", strings.Join(s.Config.PreGenerate, " "))
+		}
+	}
+
+	if s.Config.PreGenerateHook != nil {
+		if err := s.Config.PreGenerateHook(s.Config); err != nil {
+			return errors.Wrap(err, "pre-generate hook failed")
+		}
+	}
+
+	return nil
+}
+
+// loadSchema reads the schema snapshot at path, or the database with the
+// config's driver when path is empty.
+func loadSchema(config *Config, path string) (*drivers.DBInfo, error) {
+	if len(path) != 0 {
+		return readSchema(path)
+	}
+
+	dbInfo, err := drivers.GetDriver(config.DriverName).Assemble(config.DriverConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch table data")
+	}
+
+	return dbInfo, nil
+}
+
+// readSchema reads a schema snapshot written by writeSchema, which is used in
+// place of introspecting the database.
+func readSchema(path string) (*drivers.DBInfo, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read schema snapshot")
+	}
+
+	dbInfo := &drivers.DBInfo{}
+	if err := json.Unmarshal(b, dbInfo); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse schema snapshot %s", path)
+	}
+
+	return dbInfo, nil
+}
+
+// writeSchema writes what the driver found in the database to path, before
+// any of the config's type replacements or aliases are applied to it.
+func writeSchema(path string, dbInfo *drivers.DBInfo) error {
+	b, err := json.MarshalIndent(dbInfo, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "unable to encode schema snapshot")
+	}
+
+	if err := ioutil.WriteFile(path, append(b, '\n'), 0664); err != nil {
+		return errors.Wrap(err, "unable to write schema snapshot")
+	}
+
+	return nil
+}
+
 // mergeDriverImports calls the driver and asks for its set
 // of imports, then merges it into the current configuration's
 // imports.
@@ -443,6 +705,56 @@ func (s *State) processTypeReplacements() error {
 	return nil
 }
 
+// nullablePointerTypes maps the null package types the drivers use for
+// nullable columns to the pointer type used in their place when
+// NullablePointers is set.
+var nullablePointerTypes = map[string]string{
+	"null.Bool":         "*bool",
+	"null.Byte":         "*byte",
+	"null.Bytes":        "*[]byte",
+	"null.Float32":      "*float32",
+	"null.Float64":      "*float64",
+	"null.Int":          "*int",
+	"null.Int8":         "*int8",
+	"null.Int16":        "*int16",
+	"null.Int32":        "*int32",
+	"null.Int64":        "*int64",
+	"null.JSON":         "*types.JSON",
+	"null.String":       "*string",
+	"null.Time":         "*time.Time",
+	"null.Uint":         "*uint",
+	"null.Uint8":        "*uint8",
+	"null.Uint16":       "*uint16",
+	"null.Uint32":       "*uint32",
+	"null.Uint64":       "*uint64",
+	"types.NullDecimal": "*types.Decimal",
+}
+
+// processNullablePointers swaps the null package types of nullable columns
+// for pointers. The pointer types import whatever their element type does.
+func (s *State) processNullablePointers() {
+	for i := range s.Tables {
+		t := s.Tables[i]
+
+		for j := range t.Columns {
+			c := t.Columns[j]
+			if !c.Nullable {
+				continue
+			}
+
+			ptrType, ok := nullablePointerTypes[c.Type]
+			if !ok {
+				continue
+			}
+
+			t.Columns[j].Type = ptrType
+			if imps, ok := s.Config.Imports.BasedOnType[ptrType[1:]]; ok {
+				s.Config.Imports.BasedOnType[ptrType] = imps
+			}
+		}
+	}
+}
+
 // matchColumn checks if a column 'c' matches specifiers in 'm'.
 // Anything defined in m is checked against a's values, the
 // match is a done using logical and (all specifiers must match).
@@ -596,7 +908,9 @@ func (s *State) initTags(tags []string) error {
 }
 
 func (s *State) initAliases(a *Aliases) error {
-	FillAliases(a, s.Tables)
+	for _, rename := range fillAliases(a, s.Tables) {
+		fmt.Fprintln(os.Stderr, "warning: name collision,", rename)
+	}
 	return nil
 }
 
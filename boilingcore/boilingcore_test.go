@@ -8,17 +8,18 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strconv"
 	"testing"
 
+	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/importers"
 
 	"github.com/volatiletech/sqlboiler/v4/drivers"
-	_ "github.com/volatiletech/sqlboiler/v4/drivers/mocks"
+	"github.com/volatiletech/sqlboiler/v4/drivers/mocks"
 )
 
-var state *State
 var rgxHasSpaces = regexp.MustCompile(`^\s+`)
 
 func TestNew(t *testing.T) {
@@ -26,35 +27,63 @@ func TestNew(t *testing.T) {
 		t.SkipNow()
 	}
 
-	var err error
-	out, err := ioutil.TempDir("", "boil_templates")
-	if err != nil {
-		t.Fatalf("unable to create tempdir: %s", err)
+	testNew(t, mockConfig(true))
+}
+
+// TestNewNullablePointers checks the models and their tests compile with
+// pointers for the nullable columns.
+func TestNewNullablePointers(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
 	}
 
-	// Defer cleanup of the tmp folder
-	defer func() {
-		if t.Failed() {
-			t.Log("template test output:", state.Config.OutFolder)
-			return
-		}
-		os.RemoveAll(state.Config.OutFolder)
-	}()
+	config := mockConfig(false)
+	config.NullablePointers = true
+	testNew(t, config)
+}
 
-	config := &Config{
+func mockConfig(noTests bool) *Config {
+	return &Config{
 		DriverName: "mock",
 		PkgName:    "models",
-		OutFolder:  out,
-		NoTests:    true,
+		NoTests:    noTests,
 		DriverConfig: map[string]interface{}{
 			drivers.ConfigSchema:    "schema",
-			drivers.ConfigBlacklist: []string{"hangars"},
+			drivers.ConfigBlacklist: []string{},
 		},
 		Imports:   importers.NewDefaultImports(),
 		TagIgnore: []string{"pass"},
+		Polymorphic: []Polymorphic{{
+			Table:      "comments",
+			TypeColumn: "commentable_type",
+			IDColumn:   "commentable_id",
+			Types: []PolymorphicType{
+				{Value: "pilot", Table: "pilots"},
+				{Value: "jet", Table: "jets"},
+			},
+		}},
+	}
+}
+
+// testNew generates the models for config in a temporary folder and checks
+// they compile.
+func testNew(t *testing.T, config *Config) {
+	out, err := ioutil.TempDir("", "boil_templates")
+	if err != nil {
+		t.Fatalf("unable to create tempdir: %s", err)
 	}
+	config.OutFolder = out
+
+	// Defer cleanup of the tmp folder
+	defer func() {
+		if t.Failed() {
+			t.Log("template test output:", out)
+			return
+		}
+		os.RemoveAll(out)
+	}()
 
-	state, err = New(config)
+	state, err := New(config)
 	if err != nil {
 		t.Fatalf("Unable to create State using config: %s", err)
 	}
@@ -303,3 +332,224 @@ func TestProcessTypeReplacements(t *testing.T) {
 		t.Error("imports were not adjusted")
 	}
 }
+
+func TestProcessNullablePointers(t *testing.T) {
+	s := new(State)
+	s.Config = &Config{}
+	s.Config.Imports.BasedOnType = map[string]importers.Set{
+		"time.Time": {Standard: importers.List{`"time"`}},
+	}
+	s.Tables = []drivers.Table{
+		{
+			Columns: []drivers.Column{
+				{Name: "id", Type: "int"},
+				{Name: "name", Type: "null.String", Nullable: true},
+				{Name: "created_at", Type: "null.Time", Nullable: true},
+				{Name: "custom", Type: "custom.Type", Nullable: true},
+			},
+		},
+	}
+
+	s.processNullablePointers()
+
+	want := []string{"int", "*string", "*time.Time", "custom.Type"}
+	for i, c := range s.Tables[0].Columns {
+		if c.Type != want[i] {
+			t.Errorf("%s) want type: %s, got: %s", c.Name, want[i], c.Type)
+		}
+	}
+
+	if imps := s.Config.Imports.BasedOnType["*time.Time"]; len(imps.Standard) != 1 || imps.Standard[0] != `"time"` {
+		t.Errorf("*time.Time should import time, got: %#v", imps)
+	}
+}
+
+// offlineDriver behaves like the mock driver but can't reach a database
+type offlineDriver struct {
+	mocks.MockDriver
+}
+
+func (offlineDriver) Assemble(config drivers.Config) (*drivers.DBInfo, error) {
+	return nil, errors.New("no database available")
+}
+
+func init() {
+	drivers.RegisterFromInit("offline", &offlineDriver{})
+}
+
+func TestSchemaSnapshot(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "boil_schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	snapshot := filepath.Join(dir, "schema.json")
+	newConfig := func(driverName string) *Config {
+		return &Config{
+			DriverName: driverName,
+			PkgName:    "models",
+			OutFolder:  filepath.Join(dir, driverName),
+			NoTests:    true,
+			DriverConfig: map[string]interface{}{
+				drivers.ConfigSchema:    "schema",
+				drivers.ConfigBlacklist: []string{},
+			},
+			Imports: importers.NewDefaultImports(),
+		}
+	}
+
+	config := newConfig("mock")
+	config.SchemaOut = snapshot
+	online, err := New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbInfo, err := readSchema(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dbInfo.Tables) != len(online.Tables) {
+		t.Errorf("want %d tables in the snapshot, got %d", len(online.Tables), len(dbInfo.Tables))
+	}
+
+	if _, err := New(newConfig("offline")); err == nil {
+		t.Error("want an error when the database is used")
+	}
+
+	config = newConfig("offline")
+	config.SchemaIn = snapshot
+	offline, err := New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(online.Tables, offline.Tables) {
+		t.Error("want the same tables from the snapshot as from the database")
+	}
+}
+
+func TestPreGenerate(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "boil_pregenerate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var hookConfig *Config
+	config := &Config{
+		DriverName:  "offline",
+		PkgName:     "models",
+		OutFolder:   dir,
+		PreGenerate: []string{"go", "version"},
+		PreGenerateHook: func(c *Config) error {
+			hookConfig = c
+			return nil
+		},
+		Imports: importers.NewDefaultImports(),
+	}
+
+	// The offline driver fails to read the database, the hook has to have
+	// run before that
+	if _, err := New(config); err == nil || hookConfig != config {
+		t.Error("want the hook called with the config before reading the database, got error:", err)
+	}
+
+	config.PreGenerate = []string{"go", "not-a-go-command"}
+	hookConfig = nil
+	if _, err := New(config); err == nil || hookConfig != nil {
+		t.Error("want a failing command to stop generation before the hook, got error:", err)
+	}
+}
+
+func TestDocs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "boil_docs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := &Config{
+		DriverName: "mock",
+		PkgName:    "models",
+		OutFolder:  dir,
+		NoTests:    true,
+		AddDocs:    true,
+		DriverConfig: map[string]interface{}{
+			drivers.ConfigSchema:    "schema",
+			drivers.ConfigBlacklist: []string{},
+		},
+		Imports: importers.NewDefaultImports(),
+	}
+
+	state, err := New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = state.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	index, err := ioutil.ReadFile(filepath.Join(dir, "docs", "README.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(index, []byte("| [pilots](pilots.md) | `models.Pilot` |")) {
+		t.Errorf("want pilots in the index:\n%s", index)
+	}
+
+	pilots, err := ioutil.ReadFile(filepath.Join(dir, "docs", "pilots.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"| `id` (PK) | integer | no |", "| [jets](jets.md) | `pilot_id` (unique) |"} {
+		if !bytes.Contains(pilots, []byte(want)) {
+			t.Errorf("want %q in the pilots docs:\n%s", want, pilots)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "docs", "pilot_languages.md")); !os.IsNotExist(err) {
+		t.Error("want no docs for join tables, got:", err)
+	}
+}
+
+func TestIsDocsTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]bool{
+		filepath.Join("templates", "docs", "00_table.md.tpl"):            true,
+		filepath.Join("templates", "docs", "singleton", "README.md.tpl"): true,
+		filepath.Join("custom", "docs", "01_extra.md.tpl"):               true,
+		filepath.Join("templates", "00_struct.go.tpl"):                   false,
+		filepath.Join("templates", "singleton", "boil_types.go.tpl"):     false,
+		filepath.Join("templates_test", "docs.go.tpl"):                   false,
+	}
+
+	for name, want := range tests {
+		if got := isDocsTemplate(name); got != want {
+			t.Errorf("%s: want %t, got %t", name, want, got)
+		}
+	}
+}
+
+func TestIsProtoTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]bool{
+		filepath.Join("templates", "singleton", "boil_proto.go.tpl"): true,
+		filepath.Join("custom", "singleton", "boil_proto.go.tpl"):    true,
+		filepath.Join("templates", "singleton", "boil_types.go.tpl"): false,
+		filepath.Join("templates", "boil_proto.go.tpl"):              false,
+	}
+
+	for name, want := range tests {
+		if got := isProtoTemplate(name); got != want {
+			t.Errorf("%s: want %t, got %t", name, want, got)
+		}
+	}
+}
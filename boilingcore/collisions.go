@@ -0,0 +1,241 @@
+package boilingcore
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// modelMembers are the names of the fields and methods every generated model
+// has, a column or relationship mapped to one of them would not compile.
+var modelMembers = []string{
+	"R", "L", "Insert", "Update", "Delete", "Reload", "Upsert", "InsertSQL", "UpdateSQL",
+	"ChangedColumns", "ClearChanges", "DeleteCascade", "UpsertWithResult",
+	"MarshalBinary", "UnmarshalBinary", "AuditEntries",
+}
+
+// memberSuffixes are appended to method names to make the global/panic
+// variants of the generated methods.
+var memberSuffixes = []string{"", "G", "P", "GP"}
+
+// relationshipPrefixes are put in front of a relationship name to make the
+// methods that modify or query the relationship.
+var relationshipPrefixes = []string{"", "Set", "Add", "Remove", "Has"}
+
+// relationshipSuffixes are put after a relationship name to make the methods
+// that query the relationship, like the count of a to-many relationship.
+var relationshipSuffixes = []string{"Count"}
+
+// userAliases remembers which names the user provided in their config, those
+// are never renamed.
+type userAliases struct {
+	columns       map[string]map[string]bool
+	relationships map[string]map[string]RelationshipAlias
+}
+
+func newUserAliases(a *Aliases) userAliases {
+	u := userAliases{
+		columns:       make(map[string]map[string]bool),
+		relationships: make(map[string]map[string]RelationshipAlias),
+	}
+
+	for name, t := range a.Tables {
+		u.columns[name] = make(map[string]bool)
+		for col, alias := range t.Columns {
+			u.columns[name][col] = len(alias) != 0
+		}
+
+		u.relationships[name] = make(map[string]RelationshipAlias)
+		for fkey, r := range t.Relationships {
+			u.relationships[name][fkey] = r
+		}
+	}
+
+	return u
+}
+
+// memberSet is the set of names taken on a single generated model
+type memberSet map[string]bool
+
+func newMemberSet() memberSet {
+	m := make(memberSet)
+	for _, name := range modelMembers {
+		for _, suffix := range memberSuffixes {
+			m[name+suffix] = true
+		}
+	}
+	return m
+}
+
+// columnNames returns the field and the setter that are generated for a
+// column called name.
+func columnNames(name string) []string {
+	return []string{name, "Set" + name}
+}
+
+// relationshipNames returns every method name that is generated for a
+// relationship called name.
+func relationshipNames(name string) []string {
+	names := make([]string, 0, (len(relationshipPrefixes)+len(relationshipSuffixes))*len(memberSuffixes))
+	for _, prefix := range relationshipPrefixes {
+		for _, suffix := range memberSuffixes {
+			names = append(names, prefix+name+suffix)
+		}
+	}
+	for _, relSuffix := range relationshipSuffixes {
+		for _, suffix := range memberSuffixes {
+			names = append(names, name+relSuffix+suffix)
+		}
+	}
+	return names
+}
+
+func (m memberSet) collides(names ...string) bool {
+	for _, n := range names {
+		if m[n] {
+			return true
+		}
+	}
+	return false
+}
+
+func (m memberSet) add(names ...string) {
+	for _, n := range names {
+		m[n] = true
+	}
+}
+
+// resolveCollisions renames the generated column and relationship aliases
+// that would clash with one another, or with the fields and methods every
+// model has, by appending a number to them. Names the user provided are
+// kept as is. It returns a description of each rename.
+func resolveCollisions(a *Aliases, tables []drivers.Table, user userAliases) []string {
+	var renames []string
+	members := make(map[string]memberSet)
+
+	for _, t := range tables {
+		if t.IsJoinTable {
+			continue
+		}
+
+		set := newMemberSet()
+		members[t.Name] = set
+		table := a.Tables[t.Name]
+
+		for _, c := range t.Columns {
+			if user.columns[t.Name][c.Name] {
+				set.add(columnNames(table.Columns[c.Name])...)
+			}
+		}
+
+		for _, c := range t.Columns {
+			if user.columns[t.Name][c.Name] {
+				continue
+			}
+
+			name := table.Columns[c.Name]
+			fixed := validIdentifier(name)
+			for i := 2; set.collides(columnNames(fixed)...); i++ {
+				fixed = validIdentifier(name) + strconv.Itoa(i)
+			}
+			if fixed != name {
+				renames = append(renames, fmt.Sprintf("column %s.%s: field %s renamed to %s", t.Name, c.Name, name, fixed))
+				table.Columns[c.Name] = fixed
+			}
+			set.add(columnNames(fixed)...)
+		}
+	}
+
+	// A foreign key produces methods on two models: Local on the table
+	// it points to and Foreign on the table that has the key. The
+	// relationships the user named are reserved first so that generated
+	// names make way for them.
+	type relName struct {
+		table, fkey, model string
+		local              bool
+	}
+
+	var generated []relName
+	for _, t := range tables {
+		fkeys := append([]drivers.ForeignKey(nil), t.FKeys...)
+		for _, fk := range t.CompositeFKeys {
+			fkeys = append(fkeys, drivers.ForeignKey{Name: fk.Name, ForeignTable: fk.ForeignTable})
+		}
+
+		for _, fk := range fkeys {
+			sides := []relName{{table: t.Name, fkey: fk.Name, model: fk.ForeignTable, local: true}}
+			if !t.IsJoinTable {
+				sides = append(sides, relName{table: t.Name, fkey: fk.Name, model: t.Name})
+			}
+
+			userRel := user.relationships[t.Name][fk.Name]
+			rel := a.Tables[t.Name].Relationships[fk.Name]
+			for _, side := range sides {
+				set, ok := members[side.model]
+				if !ok {
+					continue
+				}
+
+				if side.local && len(userRel.Local) != 0 {
+					set.add(relationshipNames(rel.Local)...)
+				} else if !side.local && len(userRel.Foreign) != 0 {
+					set.add(relationshipNames(rel.Foreign)...)
+				} else {
+					generated = append(generated, side)
+				}
+			}
+		}
+	}
+
+	for _, side := range generated {
+		set := members[side.model]
+		rels := a.Tables[side.table].Relationships
+		rel := rels[side.fkey]
+
+		name := rel.Foreign
+		if side.local {
+			name = rel.Local
+		}
+
+		fixed := name
+		for i := 2; set.collides(relationshipNames(fixed)...); i++ {
+			fixed = name + strconv.Itoa(i)
+		}
+		set.add(relationshipNames(fixed)...)
+
+		if fixed == name {
+			continue
+		}
+
+		renames = append(renames, fmt.Sprintf("relationship %s.%s: %s renamed to %s", side.table, side.fkey, name, fixed))
+		if side.local {
+			rel.Local = fixed
+		} else {
+			rel.Foreign = fixed
+		}
+		rels[side.fkey] = rel
+	}
+
+	return renames
+}
+
+// validIdentifier makes sure name can be used as an exported Go identifier,
+// characters that aren't allowed are replaced with underscores and names
+// that don't start with a letter, like columns starting with a digit, are
+// prefixed with an X.
+func validIdentifier(name string) string {
+	fixed := []rune(name)
+	for i, r := range fixed {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			fixed[i] = '_'
+		}
+	}
+
+	if len(fixed) == 0 || !unicode.IsLetter(fixed[0]) {
+		return "X" + string(fixed)
+	}
+
+	return string(fixed)
+}
@@ -0,0 +1,195 @@
+package boilingcore
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestAliasesCollisions(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id"},
+				{Name: "r"},
+				{Name: "insert"},
+				{Name: "user_id"},
+				{Name: "userID"},
+				{Name: "call-sign"},
+				{Name: "1st_flight"},
+			},
+		},
+		{
+			Name: "jets",
+			Columns: []drivers.Column{
+				{Name: "id"},
+				{Name: "pilot"},
+				{Name: "pilot_id"},
+			},
+			FKeys: []drivers.ForeignKey{
+				{
+					Name:          "jet_pilot_fkey",
+					Table:         "jets",
+					Column:        "pilot_id",
+					ForeignTable:  "pilots",
+					ForeignColumn: "id",
+				},
+			},
+		},
+	}
+
+	a := Aliases{}
+	renames := fillAliases(&a, tables)
+
+	expectCols := map[string]string{
+		"id":         "ID",
+		"r":          "R2",
+		"insert":     "Insert2",
+		"user_id":    "UserID",
+		"userID":     "UserID2",
+		"call-sign":  "Call_sign",
+		"1st_flight": "X1STFlight",
+	}
+	if got := a.Tables["pilots"].Columns; !reflect.DeepEqual(expectCols, got) {
+		t.Errorf("columns were not disambiguated: %#v", got)
+	}
+
+	expectRel := RelationshipAlias{Local: "Jets", Foreign: "Pilot2"}
+	if got := a.Tables["jets"].Relationships["jet_pilot_fkey"]; got != expectRel {
+		t.Errorf("relationship was not disambiguated: %#v", got)
+	}
+
+	if len(renames) != 6 {
+		t.Errorf("expected 6 renames to be reported, got: %#v", renames)
+	}
+}
+
+func TestAliasesCollisionsUserOverride(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id"},
+				{Name: "name"},
+				{Name: "title"},
+			},
+		},
+	}
+
+	a := Aliases{
+		Tables: map[string]TableAlias{
+			"pilots": {Columns: map[string]string{"title": "Name"}},
+		},
+	}
+	renames := fillAliases(&a, tables)
+
+	cols := a.Tables["pilots"].Columns
+	if cols["title"] != "Name" || cols["name"] != "Name2" {
+		t.Errorf("the user's alias should win: %#v", cols)
+	}
+	if len(renames) != 1 {
+		t.Errorf("expected 1 rename to be reported, got: %#v", renames)
+	}
+}
+
+func TestAliasesCollisionsRelationshipMethods(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id"},
+				{Name: "jets_count"},
+				{Name: "changed_columns"},
+				{Name: "set_name"},
+				{Name: "name"},
+				{Name: "set_jets"},
+			},
+		},
+		{
+			Name: "hangars",
+			Columns: []drivers.Column{
+				{Name: "id"},
+				{Name: "has_jets"},
+			},
+		},
+		{
+			Name: "jets",
+			Columns: []drivers.Column{
+				{Name: "id"},
+				{Name: "pilot_id"},
+				{Name: "hangar_id"},
+			},
+			FKeys: []drivers.ForeignKey{
+				{
+					Name:          "jet_pilot_fkey",
+					Table:         "jets",
+					Column:        "pilot_id",
+					ForeignTable:  "pilots",
+					ForeignColumn: "id",
+				},
+				{
+					Name:          "jet_hangar_fkey",
+					Table:         "jets",
+					Column:        "hangar_id",
+					ForeignTable:  "hangars",
+					ForeignColumn: "id",
+				},
+			},
+		},
+	}
+
+	a := Aliases{}
+	fillAliases(&a, tables)
+
+	expectCols := map[string]string{
+		"id":              "ID",
+		"jets_count":      "JetsCount",
+		"changed_columns": "ChangedColumns2",
+		"set_name":        "SetName",
+		"name":            "Name2",
+		"set_jets":        "SetJets",
+	}
+	if got := a.Tables["pilots"].Columns; !reflect.DeepEqual(expectCols, got) {
+		t.Errorf("columns should not collide with the methods or setters: %#v", got)
+	}
+
+	if got := a.Tables["jets"].Relationships["jet_pilot_fkey"].Local; got != "Jets2" {
+		t.Errorf("relationship should not collide with the JetsCount column, got: %s", got)
+	}
+	if got := a.Tables["jets"].Relationships["jet_hangar_fkey"].Local; got != "Jets2" {
+		t.Errorf("relationship should not collide with the HasJets column, got: %s", got)
+	}
+}
+
+func TestAliasesCollisionsModelMembers(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"delete_cascade":     "DeleteCascade2",
+		"upsert_with_result": "UpsertWithResult2",
+		"marshal_binary":     "MarshalBinary2",
+		"audit_entries":      "AuditEntries2",
+	}
+
+	for column, want := range tests {
+		tables := []drivers.Table{{
+			Name:    "pilots",
+			Columns: []drivers.Column{{Name: "id"}, {Name: column}},
+		}}
+
+		a := Aliases{}
+		fillAliases(&a, tables)
+
+		if got := a.Tables["pilots"].Columns[column]; got != want {
+			t.Errorf("%s: want %s, got %s", column, want, got)
+		}
+	}
+}
@@ -15,6 +15,7 @@ type Config struct {
 	DriverConfig drivers.Config `toml:"driver_config,omitempty" json:"driver_config,omitempty"`
 
 	PkgName           string   `toml:"pkg_name,omitempty" json:"pkg_name,omitempty"`
+	PkgImportPath     string   `toml:"pkg_import_path,omitempty" json:"pkg_import_path,omitempty"`
 	OutFolder         string   `toml:"out_folder,omitempty" json:"out_folder,omitempty"`
 	TemplateDirs      []string `toml:"template_dirs,omitempty" json:"template_dirs,omitempty"`
 	Tags              []string `toml:"tags,omitempty" json:"tags,omitempty"`
@@ -23,6 +24,19 @@ type Config struct {
 	AddGlobal         bool     `toml:"add_global,omitempty" json:"add_global,omitempty"`
 	AddPanic          bool     `toml:"add_panic,omitempty" json:"add_panic,omitempty"`
 	AddSoftDeletes    bool     `toml:"add_soft_deletes,omitempty" json:"add_soft_deletes,omitempty"`
+	AddDocs           bool     `toml:"add_docs,omitempty" json:"add_docs,omitempty"`
+	AddXMLTags        bool     `toml:"add_xml_tags,omitempty" json:"add_xml_tags,omitempty"`
+	AddBinaryMarshal  bool     `toml:"add_binary_marshal,omitempty" json:"add_binary_marshal,omitempty"`
+	AddValidateTags   bool     `toml:"add_validate_tags,omitempty" json:"add_validate_tags,omitempty"`
+	AddProtoHelpers   bool     `toml:"add_proto_helpers,omitempty" json:"add_proto_helpers,omitempty"`
+	AddParquet        bool     `toml:"add_parquet,omitempty" json:"add_parquet,omitempty"`
+	AddDataloaders    bool     `toml:"add_dataloaders,omitempty" json:"add_dataloaders,omitempty"`
+	AddQueryFilters   bool     `toml:"add_query_filters,omitempty" json:"add_query_filters,omitempty"`
+	AddAdminCLI       bool     `toml:"add_admin_cli,omitempty" json:"add_admin_cli,omitempty"`
+	AddRepositories   bool     `toml:"add_repositories,omitempty" json:"add_repositories,omitempty"`
+	AddTypeScript     bool     `toml:"add_typescript,omitempty" json:"add_typescript,omitempty"`
+	AuditTables       []string `toml:"audit_tables,omitempty" json:"audit_tables,omitempty"`
+	OutboxTables      []string `toml:"outbox_tables,omitempty" json:"outbox_tables,omitempty"`
 	NoContext         bool     `toml:"no_context,omitempty" json:"no_context,omitempty"`
 	NoTests           bool     `toml:"no_tests,omitempty" json:"no_tests,omitempty"`
 	NoHooks           bool     `toml:"no_hooks,omitempty" json:"no_hooks,omitempty"`
@@ -30,15 +44,33 @@ type Config struct {
 	NoRowsAffected    bool     `toml:"no_rows_affected,omitempty" json:"no_rows_affected,omitempty"`
 	NoDriverTemplates bool     `toml:"no_driver_templates,omitempty" json:"no_driver_templates,omitempty"`
 	NoBackReferencing bool     `toml:"no_back_reference,omitempty" json:"no_back_reference,omitempty"`
+	NullablePointers  bool     `toml:"nullable_pointers,omitempty" json:"nullable_pointers,omitempty"`
 	Wipe              bool     `toml:"wipe,omitempty" json:"wipe,omitempty"`
 	StructTagCasing   string   `toml:"struct_tag_casing,omitempty" json:"struct_tag_casing,omitempty"`
 	RelationTag       string   `toml:"relation_tag,omitempty" json:"relation_tag,omitempty"`
 	TagIgnore         []string `toml:"tag_ignore,omitempty" json:"tag_ignore,omitempty"`
+	JSONTagCasing     string   `toml:"json_tag_casing,omitempty" json:"json_tag_casing,omitempty"`
+	NoJSONOmitEmpty   bool     `toml:"no_json_omitempty,omitempty" json:"no_json_omitempty,omitempty"`
+	JSONIgnore        []string `toml:"json_ignore,omitempty" json:"json_ignore,omitempty"`
+	XMLAttributes     []string `toml:"xml_attributes,omitempty" json:"xml_attributes,omitempty"`
+	UppercaseWords    []string `toml:"uppercase_words,omitempty" json:"uppercase_words,omitempty"`
+	SchemaOut         string   `toml:"schema_out,omitempty" json:"schema_out,omitempty"`
+	SchemaIn          string   `toml:"schema_in,omitempty" json:"schema_in,omitempty"`
+	PreGenerate       []string `toml:"pre_generate,omitempty" json:"pre_generate,omitempty"`
+
+	Abbreviations map[string]string `toml:"abbreviations,omitempty" json:"abbreviations,omitempty"`
 
 	Imports importers.Collection `toml:"imports,omitempty" json:"imports,omitempty"`
 
 	Aliases      Aliases       `toml:"aliases,omitempty" json:"aliases,omitempty"`
 	TypeReplaces []TypeReplace `toml:"type_replaces,omitempty" json:"type_replaces,omitempty"`
+	Polymorphic  []Polymorphic `toml:"polymorphic,omitempty" json:"polymorphic,omitempty"`
+	ExtraOutputs []ExtraOutput `toml:"outputs,omitempty" json:"outputs,omitempty"`
+
+	// PreGenerateHook is called before the database is read, after the
+	// PreGenerate command. It's for programs that run sqlboiler as a library
+	// and want to eg. migrate the database in the same step.
+	PreGenerateHook func(*Config) error `toml:"-" json:"-"`
 
 	Version string `toml:"version" json:"version"`
 }
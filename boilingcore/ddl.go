@@ -0,0 +1,25 @@
+package boilingcore
+
+import (
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// SchemaDDL returns the statements that create the schema from the snapshot
+// at path, or from the database when path is empty. See DDL.
+func SchemaDDL(config *Config, path string) ([]string, error) {
+	dbInfo, err := loadSchema(config, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return DDL(dbInfo), nil
+}
+
+// DDL returns CREATE TABLE statements for every table in dbInfo in the
+// dialect's syntax, sorted by name, followed by the foreign keys so the
+// tables can be created in any order. Columns keep their type, nullability,
+// default and uniqueness, indexes other than primary keys and unique
+// constraints aren't introspected so they're not included.
+func DDL(dbInfo *drivers.DBInfo) []string {
+	return MigrationSQL(&drivers.DBInfo{Dialect: dbInfo.Dialect}, dbInfo)
+}
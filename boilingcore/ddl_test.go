@@ -0,0 +1,79 @@
+package boilingcore
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestDDL(t *testing.T) {
+	t.Parallel()
+
+	dbInfo := &drivers.DBInfo{
+		Schema:  "dbo",
+		Dialect: drivers.Dialect{Name: "mssql", LQ: '[', RQ: ']', UseSchema: true},
+		Tables: []drivers.Table{
+			{
+				Name: "jets",
+				Columns: []drivers.Column{
+					{Name: "id", FullDBType: "int", Default: "auto"},
+					{Name: "pilot_id", FullDBType: "int"},
+					{Name: "version", FullDBType: "rowversion", Default: "auto", AutoGenerated: true},
+				},
+				PKey:  &drivers.PrimaryKey{Columns: []string{"id"}},
+				FKeys: []drivers.ForeignKey{{Name: "fk_pilot", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"}},
+			},
+			{
+				Name: "pilots",
+				Columns: []drivers.Column{
+					{Name: "id", FullDBType: "int"},
+					{Name: "name", FullDBType: "nvarchar(50)", Nullable: true, Default: "(N'')", Unique: true},
+				},
+				PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+			},
+		},
+	}
+
+	expect := []string{
+		"CREATE TABLE [dbo].[jets] (\n  [id] int NOT NULL IDENTITY(1,1),\n  [pilot_id] int NOT NULL,\n  [version] rowversion NOT NULL,\n  PRIMARY KEY ([id])\n)",
+		"CREATE TABLE [dbo].[pilots] (\n  [id] int NOT NULL,\n  [name] nvarchar(50) DEFAULT (N'') UNIQUE,\n  PRIMARY KEY ([id])\n)",
+		"ALTER TABLE [dbo].[jets] ADD CONSTRAINT [fk_pilot] FOREIGN KEY ([pilot_id]) REFERENCES [dbo].[pilots] ([id])",
+	}
+	if ddl := DDL(dbInfo); !reflect.DeepEqual(ddl, expect) {
+		t.Errorf("want:\n%q\ngot:\n%q", expect, ddl)
+	}
+}
+
+func TestSchemaDDL(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		DriverName: "mock",
+		DriverConfig: map[string]interface{}{
+			drivers.ConfigSchema:    "schema",
+			drivers.ConfigBlacklist: []string{},
+		},
+	}
+
+	dbInfo, err := drivers.GetDriver("mock").Assemble(config.DriverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ddl, err := SchemaDDL(config, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creates := 0
+	for _, stmt := range ddl {
+		if strings.HasPrefix(stmt, "CREATE TABLE ") {
+			creates++
+		}
+	}
+	if creates != len(dbInfo.Tables) {
+		t.Errorf("want %d tables created, got %d", len(dbInfo.Tables), creates)
+	}
+}
@@ -0,0 +1,172 @@
+package boilingcore
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/cast"
+)
+
+// ExtraOutput renders a folder of templates into an output folder of its
+// own, so files in other languages, like Kotlin or Python DTOs, are derived
+// from the same schema as the models:
+//
+//	[[outputs]]
+//	templates  = "templates/kotlin"
+//	out_folder = "../android/app/src/main/java/com/example/dto"
+//	file_name  = "{{(.Aliases.Table .Table.Name).UpSingular}}"
+//
+// Every template in the folder, eg. 00_dto.kt.tpl, is run for every table
+// with the same data and functions as the templates of the models, the
+// outputs of the templates with the same extension are written to a file per
+// table. Templates in a singleton folder, eg. singleton/index.py.tpl, are
+// run once. The output is written as it is, without formatting.
+type ExtraOutput struct {
+	Templates string `toml:"templates,omitempty" json:"templates,omitempty"`
+	OutFolder string `toml:"out_folder,omitempty" json:"out_folder,omitempty"`
+
+	// FileName is a template for the name of the file of a table without
+	// its extension, it defaults to the name of the table.
+	FileName string `toml:"file_name,omitempty" json:"file_name,omitempty"`
+}
+
+// extraOutput is an ExtraOutput with its templates loaded
+type extraOutput struct {
+	ExtraOutput
+
+	templates *templateList
+	fileName  *template.Template
+}
+
+// ConvertExtraOutputs is necessary because viper
+func ConvertExtraOutputs(i interface{}) []ExtraOutput {
+	if i == nil {
+		return nil
+	}
+
+	var outputs []ExtraOutput
+	for _, oIntf := range cast.ToSlice(i) {
+		o := cast.ToStringMap(oIntf)
+		outputs = append(outputs, ExtraOutput{
+			Templates: cast.ToString(o["templates"]),
+			OutFolder: cast.ToString(o["out_folder"]),
+			FileName:  cast.ToString(o["file_name"]),
+		})
+	}
+
+	return outputs
+}
+
+// initExtraOutputs loads the templates of the extra outputs and creates
+// their output folders.
+func (s *State) initExtraOutputs() error {
+	for _, o := range s.Config.ExtraOutputs {
+		if len(o.Templates) == 0 || len(o.OutFolder) == 0 {
+			return errors.New("extra outputs need both templates and out_folder")
+		}
+
+		abs, err := filepath.Abs(o.Templates)
+		if err != nil {
+			return errors.Wrapf(err, "could not find abs dir of templates directory %s", o.Templates)
+		}
+		tpls, err := findTemplates(filepath.Dir(abs), filepath.Base(abs))
+		if err != nil {
+			return err
+		}
+		if len(tpls) == 0 {
+			return errors.Errorf("no templates found in %s", o.Templates)
+		}
+
+		names := make([]string, 0, len(tpls))
+		for name := range tpls {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		lazyTemplates := make([]lazyTemplate, len(names))
+		for i, name := range names {
+			lazyTemplates[i] = lazyTemplate{Name: name, Loader: tpls[name]}
+		}
+
+		out := extraOutput{ExtraOutput: o}
+		if out.templates, err = loadTemplates(lazyTemplates, false); err != nil {
+			return err
+		}
+
+		fileName := o.FileName
+		if len(fileName) == 0 {
+			fileName = "{{.Table.Name}}"
+		}
+		if out.fileName, err = template.New("file_name").Funcs(templateFunctions).Parse(fileName); err != nil {
+			return errors.Wrapf(err, "failed to parse the file name of the outputs of %s", o.Templates)
+		}
+
+		if err := os.MkdirAll(o.OutFolder, os.ModePerm); err != nil {
+			return err
+		}
+
+		s.extraOutputs = append(s.extraOutputs, out)
+	}
+
+	return nil
+}
+
+// generateExtraOutputs runs the templates of the extra outputs
+func (s *State) generateExtraOutputs(data *templateData) error {
+	buffers := newOutputBuffers()
+
+	for _, o := range s.extraOutputs {
+		byExt := make(map[string][]string)
+		for _, tplName := range o.templates.Templates() {
+			normalized, isSingleton, _, _ := outputFilenameParts(tplName)
+			if isSingleton {
+				execute := func(out io.Writer) error {
+					return executeTemplate(out, o.templates.Template, tplName, data)
+				}
+				if err := writeFile(o.OutFolder, filepath.Base(normalized), buffers.file, execute); err != nil {
+					return err
+				}
+				continue
+			}
+
+			ext := getLongExt(filepath.Base(normalized))
+			byExt[ext] = append(byExt[ext], tplName)
+		}
+
+		for _, table := range s.Tables {
+			if table.IsJoinTable {
+				continue
+			}
+
+			tableData := *data
+			tableData.Table = table
+			tableData.DBTypes = make(once)
+
+			var fileName bytes.Buffer
+			if err := o.fileName.Execute(&fileName, &tableData); err != nil {
+				return errors.Wrapf(err, "failed to execute the file name of the outputs of %s", o.Templates)
+			}
+
+			for ext, tplNames := range byExt {
+				execute := func(out io.Writer) error {
+					for _, tplName := range tplNames {
+						if err := executeTemplate(out, o.templates.Template, tplName, &tableData); err != nil {
+							return err
+						}
+					}
+					return nil
+				}
+				if err := writeFile(o.OutFolder, strings.TrimSpace(fileName.String())+ext, buffers.file, execute); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
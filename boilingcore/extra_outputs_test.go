@@ -0,0 +1,104 @@
+package boilingcore
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestConvertExtraOutputs(t *testing.T) {
+	t.Parallel()
+
+	var intf interface{} = []interface{}{
+		map[string]interface{}{
+			"templates":  "templates/python",
+			"out_folder": "dto",
+			"file_name":  "{{.Table.Name}}_dto",
+		},
+	}
+
+	outputs := ConvertExtraOutputs(intf)
+	expect := []ExtraOutput{{Templates: "templates/python", OutFolder: "dto", FileName: "{{.Table.Name}}_dto"}}
+
+	if !reflect.DeepEqual(expect, outputs) {
+		t.Errorf("want: %#v\ngot: %#v", expect, outputs)
+	}
+	if ConvertExtraOutputs(nil) != nil {
+		t.Error("nil should convert to nil")
+	}
+}
+
+func TestExtraOutputs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tplDir := filepath.Join(dir, "python")
+	outDir := filepath.Join(dir, "out")
+
+	files := map[string]string{
+		"00_dto.py.tpl":           "class {{titleCase .Table.Name}}:\n",
+		"01_fields.py.tpl":        "{{range .Table.Columns}}    {{.Name}}: {{.Type}}\n{{end}}",
+		"singleton/index.txt.tpl": "{{range .Tables}}{{.Name}}\n{{end}}",
+	}
+	for name, contents := range files {
+		path := filepath.Join(tplDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tables := []drivers.Table{
+		{Name: "pilots", Columns: []drivers.Column{{Name: "id", Type: "int"}, {Name: "name", Type: "string"}}},
+		{Name: "jets", Columns: []drivers.Column{{Name: "id", Type: "int"}}},
+		{Name: "pilot_languages", IsJoinTable: true},
+	}
+
+	s := &State{
+		Config: &Config{ExtraOutputs: []ExtraOutput{{Templates: tplDir, OutFolder: outDir, FileName: "{{.Table.Name}}_dto"}}},
+		Tables: tables,
+	}
+	if err := s.initExtraOutputs(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.generateExtraOutputs(&templateData{Tables: tables}); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := map[string]string{
+		"pilots_dto.py": "class Pilots:\n    id: int\n    name: string\n",
+		"jets_dto.py":   "class Jets:\n    id: int\n",
+		"index.txt":     "pilots\njets\npilot_languages\n",
+	}
+	entries, err := os.ReadDir(outDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(expect) {
+		t.Errorf("want %d files, got %d", len(expect), len(entries))
+	}
+	for name, want := range expect {
+		got, err := os.ReadFile(filepath.Join(outDir, name))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("%s:\nwant: %q\ngot:  %q", name, want, got)
+		}
+	}
+}
+
+func TestExtraOutputsMissingFolder(t *testing.T) {
+	t.Parallel()
+
+	s := &State{Config: &Config{ExtraOutputs: []ExtraOutput{{Templates: "python"}}}}
+	if err := s.initExtraOutputs(); err == nil {
+		t.Error("want an error for an output without an out_folder")
+	}
+}
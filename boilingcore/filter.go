@@ -0,0 +1,36 @@
+package boilingcore
+
+import (
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// filterKind returns the qmhelper.FilterKind a column is filtered with, or an
+// empty string for columns that can't be filtered on, like json, arrays and
+// binary data.
+func filterKind(column drivers.Column) string {
+	goType := strings.TrimPrefix(column.Type, "*")
+
+	switch goType {
+	case "time.Time", "null.Time":
+		return "FilterTime"
+	case "types.Decimal", "types.NullDecimal":
+		return "FilterDecimal"
+	}
+
+	switch strings.ToLower(strings.TrimPrefix(goType, "null.")) {
+	case "string":
+		return "FilterString"
+	case "bool":
+		return "FilterBool"
+	case "int", "int8", "int16", "int32", "int64":
+		return "FilterInt"
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		return "FilterUint"
+	case "float32", "float64":
+		return "FilterFloat"
+	default:
+		return ""
+	}
+}
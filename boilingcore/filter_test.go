@@ -0,0 +1,34 @@
+package boilingcore
+
+import (
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestFilterKind(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Type string
+		Want string
+	}{
+		{"int", "FilterInt"},
+		{"null.Int16", "FilterInt"},
+		{"*uint64", "FilterUint"},
+		{"string", "FilterString"},
+		{"null.Bool", "FilterBool"},
+		{"float32", "FilterFloat"},
+		{"types.NullDecimal", "FilterDecimal"},
+		{"null.Time", "FilterTime"},
+		{"types.JSON", ""},
+		{"[]byte", ""},
+		{"types.StringArray", ""},
+	}
+
+	for _, test := range tests {
+		if got := filterKind(drivers.Column{Name: "c", Type: test.Type}); got != test.Want {
+			t.Errorf("%s: want: %q, got: %q", test.Type, test.Want, got)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package boilingcore
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/drivers/mocks"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+)
+
+var flagUpdateGolden = flag.Bool("update-golden", false, "Rewrite testdata/golden with the current output")
+
+const (
+	goldenSchema = "testdata/golden_schema.json"
+	goldenDir    = "testdata/golden"
+)
+
+func init() {
+	drivers.RegisterFromInit("golden", &snapshotDriver{path: goldenSchema})
+}
+
+// snapshotDriver serves a schema from a json snapshot of drivers.DBInfo, so
+// generation can be tested without a database. The templates and imports
+// are those of the mock driver.
+type snapshotDriver struct {
+	mocks.MockDriver
+	path string
+}
+
+func (s *snapshotDriver) Assemble(config drivers.Config) (*drivers.DBInfo, error) {
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &drivers.DBInfo{}
+	if err = json.Unmarshal(b, info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// TestGolden generates models and tests for the schema snapshot and compares
+// them to the files in testdata/golden, so changes to the templates or the
+// name mangling that alter the generated code are noticed. When the change
+// is intended run: go test ./boilingcore -run TestGolden -update-golden
+func TestGolden(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+
+	out, err := ioutil.TempDir("", "boil_golden")
+	if err != nil {
+		t.Fatalf("unable to create tempdir: %s", err)
+	}
+	defer os.RemoveAll(out)
+
+	config := &Config{
+		DriverName: "golden",
+		PkgName:    "models",
+		OutFolder:  out,
+		Imports:    importers.NewDefaultImports(),
+	}
+
+	s, err := New(config)
+	if err != nil {
+		t.Fatalf("Unable to create State using config: %s", err)
+	}
+	if err = s.Run(); err != nil {
+		t.Fatalf("Unable to execute State.Run: %s", err)
+	}
+
+	if *flagUpdateGolden {
+		if err = os.RemoveAll(goldenDir); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.MkdirAll(goldenDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := goldenFiles(t, out)
+	want := goldenFiles(t, goldenDir)
+
+	for name, b := range got {
+		if *flagUpdateGolden {
+			if err = ioutil.WriteFile(filepath.Join(goldenDir, name), b, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		w, ok := want[name]
+		if !ok {
+			t.Errorf("%s was generated but has no golden file", name)
+			continue
+		}
+		if line, ok := firstDifference(w, b); ok {
+			t.Errorf("%s differs from its golden file at line %d", name, line)
+		}
+	}
+
+	if *flagUpdateGolden {
+		return
+	}
+	for name := range want {
+		if _, ok := got[name]; !ok {
+			t.Errorf("%s has a golden file but was not generated", name)
+		}
+	}
+}
+
+// goldenFiles reads the files in dir by name
+func goldenFiles(t *testing.T, dir string) map[string][]byte {
+	t.Helper()
+
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+
+	files := make(map[string][]byte, len(infos))
+	for _, info := range infos {
+		if info.IsDir() {
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(dir, info.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[info.Name()] = b
+	}
+
+	return files
+}
+
+// firstDifference returns the 1 based number of the first line that differs
+// between want and got.
+func firstDifference(want, got []byte) (int, bool) {
+	if bytes.Equal(want, got) {
+		return 0, false
+	}
+
+	wantLines := bytes.Split(want, []byte{'\n'})
+	gotLines := bytes.Split(got, []byte{'\n'})
+	for i := range wantLines {
+		if i >= len(gotLines) || !bytes.Equal(wantLines[i], gotLines[i]) {
+			return i + 1, true
+		}
+	}
+
+	return len(wantLines) + 1, true
+}
+
+func TestFirstDifference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Want, Got string
+		Line      int
+		Differs   bool
+	}{
+		{"a\nb\nc", "a\nb\nc", 0, false},
+		{"a\nb\nc", "a\nx\nc", 2, true},
+		{"a\nb\nc", "a\nb", 3, true},
+		{"a\nb", "a\nb\nc", 3, true},
+	}
+
+	for i, test := range tests {
+		line, differs := firstDifference([]byte(test.Want), []byte(test.Got))
+		if line != test.Line || differs != test.Differs {
+			t.Errorf("%d) want: %d %t, got: %d %t", i, test.Line, test.Differs, line, differs)
+		}
+	}
+}
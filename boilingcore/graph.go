@@ -0,0 +1,172 @@
+package boilingcore
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/strmangle"
+)
+
+// Graph is the tables of a schema and the foreign keys between them.
+type Graph struct {
+	Tables []GraphTable `json:"tables"`
+	Edges  []GraphEdge  `json:"edges"`
+}
+
+// GraphTable is a node of the graph.
+type GraphTable struct {
+	Name       string        `json:"name"`
+	Columns    []GraphColumn `json:"columns"`
+	PrimaryKey []string      `json:"primary_key,omitempty"`
+	JoinTable  bool          `json:"join_table,omitempty"`
+}
+
+// GraphColumn is a column of a table in the graph.
+type GraphColumn struct {
+	Name     string `json:"name"`
+	DBType   string `json:"db_type"`
+	Nullable bool   `json:"nullable"`
+}
+
+// GraphEdge is a foreign key from Table to ForeignTable.
+type GraphEdge struct {
+	Name           string   `json:"name"`
+	Table          string   `json:"table"`
+	Columns        []string `json:"columns"`
+	ForeignTable   string   `json:"foreign_table"`
+	ForeignColumns []string `json:"foreign_columns"`
+	Nullable       bool     `json:"nullable"`
+}
+
+// SchemaGraph returns the graph of the schema snapshot at path, or of the
+// database when path is empty.
+func SchemaGraph(config *Config, path string) (*Graph, error) {
+	dbInfo, err := loadSchema(config, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGraph(dbInfo.Tables), nil
+}
+
+// SchemaMetadata returns the drivers.Metadata of the schema snapshot at path,
+// or of the database when path is empty.
+func SchemaMetadata(config *Config, path string) (*drivers.Metadata, error) {
+	dbInfo, err := loadSchema(config, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return drivers.NewMetadata(dbInfo), nil
+}
+
+// NewGraph creates the graph of tables, sorted by name.
+func NewGraph(tables []drivers.Table) *Graph {
+	sorted := make([]drivers.Table, len(tables))
+	copy(sorted, tables)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	g := &Graph{Tables: make([]GraphTable, 0, len(sorted))}
+	for _, t := range sorted {
+		table := GraphTable{Name: t.Name, JoinTable: t.IsJoinTable}
+		if t.PKey != nil {
+			table.PrimaryKey = t.PKey.Columns
+		}
+		for _, c := range t.Columns {
+			table.Columns = append(table.Columns, GraphColumn{Name: c.Name, DBType: c.DBType, Nullable: c.Nullable})
+		}
+		g.Tables = append(g.Tables, table)
+
+		for _, fk := range t.FKeys {
+			g.Edges = append(g.Edges, GraphEdge{
+				Name:           fk.Name,
+				Table:          t.Name,
+				Columns:        []string{fk.Column},
+				ForeignTable:   fk.ForeignTable,
+				ForeignColumns: []string{fk.ForeignColumn},
+				Nullable:       fk.Nullable,
+			})
+		}
+		for _, fk := range t.CompositeFKeys {
+			g.Edges = append(g.Edges, GraphEdge{
+				Name:           fk.Name,
+				Table:          t.Name,
+				Columns:        fk.Columns,
+				ForeignTable:   fk.ForeignTable,
+				ForeignColumns: fk.ForeignColumns,
+				Nullable:       fk.Nullable,
+			})
+		}
+	}
+
+	return g
+}
+
+// JSON encodes the graph.
+func (g *Graph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// DOT formats the graph for Graphviz, eg. dot -Tsvg. Tables are records that
+// list their columns, primary key columns are marked with a * and foreign
+// keys are edges labelled with their columns. Edges of nullable foreign keys
+// are dashed.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph schema {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=record, fontname=\"Helvetica\"];\n")
+	b.WriteString("\tedge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	for _, t := range g.Tables {
+		var label strings.Builder
+		label.WriteString(dotRecordEscape(t.Name))
+		label.WriteByte('|')
+		for _, c := range t.Columns {
+			if strmangle.SetInclude(c.Name, t.PrimaryKey) {
+				label.WriteString("* ")
+			}
+			label.WriteString(dotRecordEscape(c.Name + " " + c.DBType))
+			if c.Nullable {
+				label.WriteString(" null")
+			}
+			label.WriteString(`\l`)
+		}
+
+		fmt.Fprintf(&b, "\t%s [label=\"{%s}\"", dotID(t.Name), label.String())
+		if t.JoinTable {
+			b.WriteString(", style=dashed")
+		}
+		b.WriteString("];\n")
+	}
+
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "\t%s -> %s [label=%s", dotID(e.Table), dotID(e.ForeignTable),
+			dotID(strings.Join(e.Columns, ", ")+" -> "+strings.Join(e.ForeignColumns, ", ")))
+		if e.Nullable {
+			b.WriteString(", style=dashed")
+		}
+		b.WriteString("];\n")
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotID quotes s as a DOT identifier.
+func dotID(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+var dotRecordReplacer = strings.NewReplacer(
+	`\`, `\\`, `"`, `\"`, `{`, `\{`, `}`, `\}`, `|`, `\|`, `<`, `\<`, `>`, `\>`,
+)
+
+// dotRecordEscape escapes the characters that are special in record labels,
+// the result goes inside a quoted label.
+func dotRecordEscape(s string) string {
+	return dotRecordReplacer.Replace(s)
+}
@@ -0,0 +1,85 @@
+package boilingcore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestGraph(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id", DBType: "integer"},
+				{Name: "name", DBType: "character varying"},
+			},
+			PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+		},
+		{
+			Name: "jets",
+			Columns: []drivers.Column{
+				{Name: "id", DBType: "integer"},
+				{Name: "pilot_id", DBType: "integer", Nullable: true},
+				{Name: "tag", DBType: "enum.tag('a|b')"},
+			},
+			PKey:  &drivers.PrimaryKey{Columns: []string{"id"}},
+			FKeys: []drivers.ForeignKey{{Name: "jets_pilot_fkey", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id", Nullable: true}},
+		},
+	}
+
+	g := NewGraph(tables)
+
+	want := `digraph schema {
+	rankdir=LR;
+	node [shape=record, fontname="Helvetica"];
+	edge [fontname="Helvetica", fontsize=10];
+	"jets" [label="{jets|* id integer\lpilot_id integer null\ltag enum.tag('a\|b')\l}"];
+	"pilots" [label="{pilots|* id integer\lname character varying\l}"];
+	"jets" -> "pilots" [label="pilot_id -> id", style=dashed];
+}
+`
+	if got := g.DOT(); got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+
+	b, err := g.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Graph
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Tables) != 2 || len(decoded.Edges) != 1 || decoded.Edges[0].ForeignTable != "pilots" {
+		t.Errorf("unexpected graph: %s", b)
+	}
+}
+
+func TestSchemaMetadata(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		DriverName: "mock",
+		DriverConfig: map[string]interface{}{
+			drivers.ConfigSchema:    "schema",
+			drivers.ConfigBlacklist: []string{},
+		},
+	}
+
+	meta, err := SchemaMetadata(config, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if meta.Version != drivers.MetadataVersion {
+		t.Errorf("want version %d, got %d", drivers.MetadataVersion, meta.Version)
+	}
+	if len(meta.Tables) == 0 || len(meta.Sequences) == 0 {
+		t.Errorf("want the mock's tables and sequences, got: %#v", meta)
+	}
+}
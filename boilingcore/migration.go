@@ -0,0 +1,394 @@
+package boilingcore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/strmangle"
+)
+
+const migrationHeader = "-- Generated by sqlboiler from schema differences, review before running.\n"
+
+// Migration returns the statements that migrate a database from the schema
+// snapshot at fromPath to the one at toPath, or to the database itself when
+// toPath is empty, along with the statements that undo them.
+func Migration(config *Config, fromPath, toPath string) (up, down []string, err error) {
+	from, err := readSchema(fromPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	to, err := loadSchema(config, toPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return MigrationSQL(from, to), MigrationSQL(to, from), nil
+}
+
+// WriteMigration writes the up and down statements to version_name.up.sql and
+// version_name.down.sql in dir, which is the layout most migration tools use.
+func WriteMigration(dir, version, name string, up, down []string) (upPath, downPath string, err error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", "", errors.Wrap(err, "unable to create migration folder")
+	}
+
+	base := filepath.Join(dir, version+"_"+name)
+	upPath, downPath = base+".up.sql", base+".down.sql"
+
+	if err := ioutil.WriteFile(upPath, []byte(SQLScript(migrationHeader, up)), 0664); err != nil {
+		return "", "", errors.Wrap(err, "unable to write migration")
+	}
+	if err := ioutil.WriteFile(downPath, []byte(SQLScript(migrationHeader, down)), 0664); err != nil {
+		return "", "", errors.Wrap(err, "unable to write migration")
+	}
+
+	return upPath, downPath, nil
+}
+
+// SQLScript joins statements into a script that starts with header, comments
+// are kept as they are and everything else is terminated with a semicolon.
+func SQLScript(header string, stmts []string) string {
+	var b strings.Builder
+	b.WriteString(header)
+	for _, stmt := range stmts {
+		b.WriteByte('\n')
+		b.WriteString(stmt)
+		if !strings.HasPrefix(stmt, "--") {
+			b.WriteByte(';')
+		}
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// MigrationSQL returns statements that change a database with the schema from
+// into one with the schema to. Changes that can't be expressed without more
+// information than the snapshots have, like the name of a unique constraint,
+// are left as TODO comments.
+func MigrationSQL(from, to *drivers.DBInfo) []string {
+	dialect := to.Dialect
+	if len(dialect.Name) == 0 {
+		dialect = from.Dialect
+	}
+	m := migrator{dialect: dialect, schema: to.Schema}
+
+	fromTables := make(map[string]drivers.Table, len(from.Tables))
+	for _, t := range from.Tables {
+		fromTables[t.Name] = t
+	}
+	toTables := make(map[string]drivers.Table, len(to.Tables))
+	for _, t := range to.Tables {
+		toTables[t.Name] = t
+	}
+
+	names := sortedTableNames(from.Tables, to.Tables)
+
+	// Foreign keys are dropped first and added last so tables and columns
+	// can change freely in between
+	var stmts []string
+	for _, name := range names {
+		f, inFrom := fromTables[name]
+		t, inTo := toTables[name]
+		if inFrom && inTo {
+			stmts = append(stmts, m.dropFKeys(f, t)...)
+		}
+	}
+	for _, name := range names {
+		if _, inFrom := fromTables[name]; !inFrom {
+			stmts = append(stmts, m.createTable(toTables[name]))
+		}
+	}
+	for _, name := range names {
+		f, inFrom := fromTables[name]
+		t, inTo := toTables[name]
+		if inFrom && inTo {
+			stmts = append(stmts, m.alterTable(f, t)...)
+		}
+	}
+	for _, name := range names {
+		t, inTo := toTables[name]
+		if inTo {
+			stmts = append(stmts, m.addFKeys(fromTables[name], t)...)
+		}
+	}
+	for _, name := range names {
+		if _, inTo := toTables[name]; !inTo {
+			stmts = append(stmts, "DROP TABLE "+m.table(name))
+		}
+	}
+
+	return stmts
+}
+
+type migrator struct {
+	dialect drivers.Dialect
+	schema  string
+}
+
+func (m migrator) table(name string) string {
+	return strmangle.SchemaTable(string(m.dialect.LQ), string(m.dialect.RQ), m.dialect.UseSchema, m.schema, name)
+}
+
+func (m migrator) quote(names ...string) string {
+	return strings.Join(m.dialect.QuoteIdentSlice(names), ", ")
+}
+
+func (m migrator) createTable(t drivers.Table) string {
+	lines := make([]string, 0, len(t.Columns)+1)
+	for _, c := range t.Columns {
+		lines = append(lines, "  "+m.columnDef(t, c))
+	}
+	if t.PKey != nil {
+		lines = append(lines, "  PRIMARY KEY ("+m.quote(t.PKey.Columns...)+")")
+	}
+
+	return fmt.Sprintf("CREATE TABLE %s (\n%s\n)", m.table(t.Name), strings.Join(lines, ",\n"))
+}
+
+func (m migrator) alterTable(from, to drivers.Table) []string {
+	var stmts []string
+	alter := "ALTER TABLE " + m.table(to.Name) + " "
+
+	fromCols := make(map[string]drivers.Column, len(from.Columns))
+	for _, c := range from.Columns {
+		fromCols[c.Name] = c
+	}
+	toCols := make(map[string]drivers.Column, len(to.Columns))
+	for _, c := range to.Columns {
+		toCols[c.Name] = c
+	}
+
+	add := "ADD COLUMN "
+	if m.dialect.Name == "mssql" {
+		add = "ADD "
+	}
+
+	for _, c := range to.Columns {
+		old, ok := fromCols[c.Name]
+		switch {
+		case !ok:
+			stmts = append(stmts, alter+add+m.columnDef(to, c))
+		case describeColumn(old) != describeColumn(c):
+			stmts = append(stmts, m.alterColumn(to, old, c)...)
+		}
+	}
+
+	if describePKey(from.PKey) != describePKey(to.PKey) {
+		if from.PKey != nil {
+			switch {
+			case m.dialect.Name == "mysql":
+				stmts = append(stmts, alter+"DROP PRIMARY KEY")
+			case len(from.PKey.Name) != 0:
+				stmts = append(stmts, alter+"DROP CONSTRAINT "+m.quote(from.PKey.Name))
+			default:
+				stmts = append(stmts, fmt.Sprintf("-- TODO: drop the primary key of %s", to.Name))
+			}
+		}
+		if to.PKey != nil {
+			stmts = append(stmts, alter+"ADD PRIMARY KEY ("+m.quote(to.PKey.Columns...)+")")
+		}
+	}
+
+	for _, c := range from.Columns {
+		if _, ok := toCols[c.Name]; !ok {
+			stmts = append(stmts, alter+"DROP COLUMN "+m.quote(c.Name))
+		}
+	}
+
+	return stmts
+}
+
+func (m migrator) alterColumn(t drivers.Table, from, to drivers.Column) []string {
+	var stmts []string
+	alter := "ALTER TABLE " + m.table(t.Name) + " "
+	name := m.quote(to.Name)
+	typeChanged := m.columnType(from) != m.columnType(to)
+
+	switch m.dialect.Name {
+	case "psql":
+		if typeChanged {
+			stmts = append(stmts, alter+"ALTER COLUMN "+name+" TYPE "+m.columnType(to))
+		}
+		if from.Nullable != to.Nullable {
+			if to.Nullable {
+				stmts = append(stmts, alter+"ALTER COLUMN "+name+" DROP NOT NULL")
+			} else {
+				stmts = append(stmts, alter+"ALTER COLUMN "+name+" SET NOT NULL")
+			}
+		}
+		if from.Default != to.Default {
+			switch {
+			case from.Default == "IDENTITY" || to.Default == "IDENTITY":
+				stmts = append(stmts, fmt.Sprintf("-- TODO: change the identity of %s.%s", t.Name, to.Name))
+			case len(to.Default) == 0:
+				stmts = append(stmts, alter+"ALTER COLUMN "+name+" DROP DEFAULT")
+			default:
+				stmts = append(stmts, alter+"ALTER COLUMN "+name+" SET DEFAULT "+to.Default)
+			}
+		}
+	case "mysql":
+		if typeChanged || from.Nullable != to.Nullable || from.Default != to.Default {
+			stmts = append(stmts, alter+"MODIFY COLUMN "+m.columnDef(t, withoutUnique(to)))
+		}
+	default:
+		if typeChanged || from.Nullable != to.Nullable {
+			null := " NULL"
+			if !to.Nullable {
+				null = " NOT NULL"
+			}
+			stmts = append(stmts, alter+"ALTER COLUMN "+name+" "+m.columnType(to)+null)
+		}
+		if from.Default != to.Default {
+			stmts = append(stmts, fmt.Sprintf("-- TODO: change the default of %s.%s to %q", t.Name, to.Name, to.Default))
+		}
+	}
+
+	if from.Unique != to.Unique {
+		if to.Unique {
+			stmts = append(stmts, alter+"ADD UNIQUE ("+name+")")
+		} else {
+			stmts = append(stmts, fmt.Sprintf("-- TODO: drop the unique constraint on %s.%s", t.Name, to.Name))
+		}
+	}
+
+	return stmts
+}
+
+func withoutUnique(c drivers.Column) drivers.Column {
+	c.Unique = false
+	return c
+}
+
+func (m migrator) columnDef(t drivers.Table, c drivers.Column) string {
+	def := m.quote(c.Name) + " " + m.columnType(c)
+	if !c.Nullable {
+		def += " NOT NULL"
+	}
+
+	switch {
+	case len(c.Default) == 0:
+	case m.dialect.Name == "psql" && c.Default == "IDENTITY":
+		def += " GENERATED BY DEFAULT AS IDENTITY"
+	case m.dialect.Name == "mysql" && c.Default == "auto_increment":
+		def += " AUTO_INCREMENT"
+	case m.dialect.Name == "mysql":
+		def += " DEFAULT " + m.mysqlDefault(c.Default)
+	case m.dialect.Name == "mssql" && c.Default == "auto":
+		if !c.AutoGenerated {
+			def += " IDENTITY(1,1)"
+		}
+	default:
+		def += " DEFAULT " + c.Default
+	}
+
+	// A primary key over just this column is unique already
+	if c.Unique && (t.PKey == nil || len(t.PKey.Columns) != 1 || t.PKey.Columns[0] != c.Name) {
+		def += " UNIQUE"
+	}
+
+	return def
+}
+
+func (m migrator) columnType(c drivers.Column) string {
+	if m.dialect.Name == "psql" {
+		switch {
+		case c.DBType == "ARRAY" && c.ArrType != nil:
+			return *c.ArrType + "[]"
+		case c.DomainName != nil:
+			return *c.DomainName
+		}
+	}
+
+	if len(c.FullDBType) != 0 {
+		return c.FullDBType
+	}
+	return c.DBType
+}
+
+// mysqlDefault quotes defaults, which MySQL reports unquoted, unless they're
+// numbers or expressions.
+func (m migrator) mysqlDefault(def string) string {
+	if _, err := strconv.ParseFloat(def, 64); err == nil {
+		return def
+	}
+
+	upper := strings.ToUpper(def)
+	if strings.HasPrefix(upper, "CURRENT_TIMESTAMP") || strings.HasPrefix(def, "(") || strings.HasPrefix(def, "b'") {
+		return def
+	}
+
+	return m.dialect.QuoteLiteral(def)
+}
+
+type migrationFKey struct {
+	name           string
+	columns        []string
+	foreignTable   string
+	foreignColumns []string
+}
+
+func (k migrationFKey) String() string {
+	return fmt.Sprintf("(%s) references %s (%s)",
+		strings.Join(k.columns, ", "), k.foreignTable, strings.Join(k.foreignColumns, ", "))
+}
+
+func migrationFKeys(t drivers.Table) []migrationFKey {
+	keys := make([]migrationFKey, 0, len(t.FKeys)+len(t.CompositeFKeys))
+	for _, fk := range t.FKeys {
+		keys = append(keys, migrationFKey{fk.Name, []string{fk.Column}, fk.ForeignTable, []string{fk.ForeignColumn}})
+	}
+	for _, fk := range t.CompositeFKeys {
+		keys = append(keys, migrationFKey{fk.Name, fk.Columns, fk.ForeignTable, fk.ForeignColumns})
+	}
+
+	return keys
+}
+
+func (m migrator) dropFKeys(from, to drivers.Table) []string {
+	var stmts []string
+	keep := describeFKeys(to)
+	for _, fk := range migrationFKeys(from) {
+		if strmangle.SetInclude(fk.String(), keep) {
+			continue
+		}
+
+		switch {
+		case len(fk.name) == 0:
+			stmts = append(stmts, fmt.Sprintf("-- TODO: drop the foreign key %s %s", from.Name, fk))
+		case m.dialect.Name == "mysql":
+			stmts = append(stmts, "ALTER TABLE "+m.table(from.Name)+" DROP FOREIGN KEY "+m.quote(fk.name))
+		default:
+			stmts = append(stmts, "ALTER TABLE "+m.table(from.Name)+" DROP CONSTRAINT "+m.quote(fk.name))
+		}
+	}
+
+	return stmts
+}
+
+func (m migrator) addFKeys(from, to drivers.Table) []string {
+	var stmts []string
+	existing := describeFKeys(from)
+	for _, fk := range migrationFKeys(to) {
+		if strmangle.SetInclude(fk.String(), existing) {
+			continue
+		}
+
+		stmt := "ALTER TABLE " + m.table(to.Name) + " ADD "
+		if len(fk.name) != 0 {
+			stmt += "CONSTRAINT " + m.quote(fk.name) + " "
+		}
+		stmt += fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)",
+			m.quote(fk.columns...), m.table(fk.foreignTable), m.quote(fk.foreignColumns...))
+		stmts = append(stmts, stmt)
+	}
+
+	return stmts
+}
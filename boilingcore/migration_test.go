@@ -0,0 +1,147 @@
+package boilingcore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func migrationSchemas(dialect drivers.Dialect) (from, to *drivers.DBInfo) {
+	from = &drivers.DBInfo{Dialect: dialect, Tables: []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id", DBType: "integer", Unique: true},
+				{Name: "name", DBType: "text"},
+				{Name: "rank", DBType: "text", Nullable: true},
+			},
+			PKey: &drivers.PrimaryKey{Name: "pilots_pkey", Columns: []string{"id"}},
+		},
+		{
+			Name:    "hangars",
+			Columns: []drivers.Column{{Name: "id", DBType: "integer"}},
+		},
+	}}
+	to = &drivers.DBInfo{Dialect: dialect, Tables: []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id", DBType: "integer", Unique: true},
+				{Name: "name", DBType: "text", Nullable: true, Default: "0"},
+				{Name: "callsign", DBType: "text", Unique: true},
+			},
+			PKey: &drivers.PrimaryKey{Name: "pilots_pkey", Columns: []string{"id"}},
+		},
+		{
+			Name: "jets",
+			Columns: []drivers.Column{
+				{Name: "id", DBType: "integer", Unique: true},
+				{Name: "pilot_id", DBType: "integer", Nullable: true},
+			},
+			PKey:  &drivers.PrimaryKey{Name: "jets_pkey", Columns: []string{"id"}},
+			FKeys: []drivers.ForeignKey{{Name: "jets_pilot_fkey", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"}},
+		},
+	}}
+
+	return from, to
+}
+
+func TestMigrationSQL(t *testing.T) {
+	t.Parallel()
+
+	from, to := migrationSchemas(drivers.Dialect{Name: "psql", LQ: '"', RQ: '"'})
+
+	up := MigrationSQL(from, to)
+	expect := []string{
+		"CREATE TABLE \"jets\" (\n  \"id\" integer NOT NULL,\n  \"pilot_id\" integer,\n  PRIMARY KEY (\"id\")\n)",
+		`ALTER TABLE "pilots" ALTER COLUMN "name" DROP NOT NULL`,
+		`ALTER TABLE "pilots" ALTER COLUMN "name" SET DEFAULT 0`,
+		`ALTER TABLE "pilots" ADD COLUMN "callsign" text NOT NULL UNIQUE`,
+		`ALTER TABLE "pilots" DROP COLUMN "rank"`,
+		`ALTER TABLE "jets" ADD CONSTRAINT "jets_pilot_fkey" FOREIGN KEY ("pilot_id") REFERENCES "pilots" ("id")`,
+		`DROP TABLE "hangars"`,
+	}
+	if !reflect.DeepEqual(up, expect) {
+		t.Errorf("want:\n%q\ngot:\n%q", expect, up)
+	}
+
+	down := MigrationSQL(to, from)
+	expect = []string{
+		"CREATE TABLE \"hangars\" (\n  \"id\" integer NOT NULL\n)",
+		`ALTER TABLE "pilots" ALTER COLUMN "name" SET NOT NULL`,
+		`ALTER TABLE "pilots" ALTER COLUMN "name" DROP DEFAULT`,
+		`ALTER TABLE "pilots" ADD COLUMN "rank" text`,
+		`ALTER TABLE "pilots" DROP COLUMN "callsign"`,
+		`DROP TABLE "jets"`,
+	}
+	if !reflect.DeepEqual(down, expect) {
+		t.Errorf("want:\n%q\ngot:\n%q", expect, down)
+	}
+
+	if stmts := MigrationSQL(to, to); len(stmts) != 0 {
+		t.Errorf("want no statements, got: %q", stmts)
+	}
+}
+
+func TestMigrationSQLMySQL(t *testing.T) {
+	t.Parallel()
+
+	from, to := migrationSchemas(drivers.Dialect{Name: "mysql", LQ: '`', RQ: '`'})
+	to.Tables[0].Columns[1].Default = "abc"
+	jets := to.Tables[1]
+	jets.FKeys = nil
+	from.Tables = append(from.Tables, jets)
+
+	up := MigrationSQL(from, to)
+	expect := "ALTER TABLE `pilots` MODIFY COLUMN `name` text DEFAULT 'abc'"
+	if up[0] != expect {
+		t.Errorf("want:\n%s\ngot:\n%s", expect, up[0])
+	}
+
+	down := MigrationSQL(to, from)
+	expect = "ALTER TABLE `jets` DROP FOREIGN KEY `jets_pilot_fkey`"
+	if down[0] != expect {
+		t.Errorf("want:\n%s\ngot:\n%s", expect, down[0])
+	}
+}
+
+func TestWriteMigration(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "boil_migration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	up := []string{`ALTER TABLE "a" ADD COLUMN "b" text`, "-- TODO: check this"}
+	upPath, downPath, err := WriteMigration(filepath.Join(dir, "migrations"), "20200102030405", "add_b", up, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := filepath.Join(dir, "migrations", "20200102030405_add_b.up.sql"); upPath != want {
+		t.Errorf("want %s, got %s", want, upPath)
+	}
+
+	b, err := ioutil.ReadFile(upPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := migrationHeader + "\n" + `ALTER TABLE "a" ADD COLUMN "b" text;` + "\n\n-- TODO: check this\n"
+	if string(b) != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, b)
+	}
+
+	b, err = ioutil.ReadFile(downPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != migrationHeader {
+		t.Errorf("want just the header, got:\n%s", b)
+	}
+}
@@ -0,0 +1,112 @@
+package boilingcore
+
+import "sync"
+
+const (
+	// nameCacheShards is the number of independently locked parts of a
+	// nameCache, it must be a power of two.
+	nameCacheShards = 16
+
+	// defaultNameCacheSize is big enough to hold every name of very large
+	// schemas while keeping a long running process from growing forever.
+	defaultNameCacheSize = 1 << 16
+)
+
+// nameCache maps names to their cased form. It's split into shards that are
+// locked separately so concurrent generation doesn't fight over one lock,
+// and when a size is set a shard that fills up is emptied before more names
+// are added to it.
+type nameCache struct {
+	shards [nameCacheShards]nameCacheShard
+}
+
+type nameCacheShard struct {
+	mut   sync.RWMutex
+	names map[string]string
+	limit int
+}
+
+func newNameCache(size int) *nameCache {
+	c := &nameCache{}
+	c.resize(size)
+	return c
+}
+
+func (c *nameCache) shard(key string) *nameCacheShard {
+	// FNV-1a, inlined so looking a name up doesn't allocate a hasher
+	h := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		h ^= uint32(key[i])
+		h *= 16777619
+	}
+	return &c.shards[h&(nameCacheShards-1)]
+}
+
+func (c *nameCache) get(key string) (string, bool) {
+	s := c.shard(key)
+	s.mut.RLock()
+	val, ok := s.names[key]
+	s.mut.RUnlock()
+	return val, ok
+}
+
+func (c *nameCache) set(key, val string) {
+	s := c.shard(key)
+	s.mut.Lock()
+	if s.limit > 0 && len(s.names) >= s.limit {
+		s.names = make(map[string]string)
+	}
+	s.names[key] = val
+	s.mut.Unlock()
+}
+
+// len returns the number of cached names
+func (c *nameCache) len() int {
+	n := 0
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mut.RLock()
+		n += len(s.names)
+		s.mut.RUnlock()
+	}
+	return n
+}
+
+func (c *nameCache) reset() {
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mut.Lock()
+		s.names = make(map[string]string)
+		s.mut.Unlock()
+	}
+}
+
+// resize empties the cache and bounds it to roughly size names, a size of 0
+// or less lets it grow without bound.
+func (c *nameCache) resize(size int) {
+	limit := 0
+	if size > 0 {
+		limit = (size + nameCacheShards - 1) / nameCacheShards
+	}
+
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mut.Lock()
+		s.names = make(map[string]string)
+		s.limit = limit
+		s.mut.Unlock()
+	}
+}
+
+// ResetNameCache forgets the names TitleCase has cached. Tools that keep
+// running after generating code, or that generate for many schemas, can use
+// it to release the memory.
+func ResetNameCache() {
+	titleCaseCache.reset()
+}
+
+// SetNameCacheSize bounds the number of names TitleCase keeps cached to
+// roughly size, a size of 0 or less removes the bound. The cache is emptied.
+func SetNameCacheSize(size int) {
+	titleCaseCache.resize(size)
+}
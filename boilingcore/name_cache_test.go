@@ -0,0 +1,51 @@
+package boilingcore
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestNameCacheBounded(t *testing.T) {
+	t.Parallel()
+
+	c := newNameCache(nameCacheShards * 2)
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("name_%d", i)
+		c.set(key, key)
+		if val, ok := c.get(key); !ok || val != key {
+			t.Fatalf("%s was not cached", key)
+		}
+	}
+
+	if n := c.len(); n > nameCacheShards*2 {
+		t.Errorf("cache grew past its bound: %d", n)
+	}
+
+	c.reset()
+	if n := c.len(); n != 0 {
+		t.Errorf("want an empty cache, got %d names", n)
+	}
+}
+
+func TestNameCacheUnbounded(t *testing.T) {
+	t.Parallel()
+
+	c := newNameCache(0)
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				key := fmt.Sprintf("name_%d_%d", g, i)
+				c.set(key, key)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if n := c.len(); n != 1000 {
+		t.Errorf("want 1000 names, got %d", n)
+	}
+}
@@ -0,0 +1,293 @@
+package boilingcore
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// titleCaseCache holds the title cased names. strmangle.TitleCase isn't used
+// even for plain names since its cache is global, unbounded and behind a
+// single lock.
+var titleCaseCache = newNameCache(defaultNameCacheSize)
+
+// strmangleUppercaseWords are the words strmangle fully uppercases, its list
+// isn't exported. Like strmangle they only match lower case words.
+var strmangleUppercaseWords = map[string]struct{}{
+	"acl":   {},
+	"api":   {},
+	"ascii": {},
+	"cpu":   {},
+	"eof":   {},
+	"guid":  {},
+	"id":    {},
+	"ip":    {},
+	"json":  {},
+	"ram":   {},
+	"sla":   {},
+	"udp":   {},
+	"ui":    {},
+	"uid":   {},
+	"uuid":  {},
+	"uri":   {},
+	"url":   {},
+	"utf8":  {},
+}
+
+var (
+	// titleCaseMut guards the word lists below, the cache has locks of its
+	// own so that lookups from many goroutines don't contend on one mutex.
+	titleCaseMut sync.RWMutex
+
+	// uppercaseWords are the words that are uppercased in addition to
+	// the ones strmangle knows about (id, uuid, api etc.)
+	uppercaseWords = map[string]struct{}{}
+
+	// abbreviations maps words to the snake-case words they're short for
+	abbreviations = map[string]string{}
+)
+
+// AddUppercaseWord registers words that TitleCase and CamelCase should
+// fully uppercase, for example "sku" so that "item_sku" becomes "ItemSKU".
+// It must be called before any names are generated since names that are
+// already in use won't change, the cache is cleared so later calls see the
+// new words.
+func AddUppercaseWord(words ...string) {
+	titleCaseMut.Lock()
+	defer titleCaseMut.Unlock()
+
+	for _, w := range words {
+		uppercaseWords[strings.ToLower(w)] = struct{}{}
+	}
+	titleCaseCache.reset()
+}
+
+// AddAbbreviation registers an abbreviation that TitleCase and CamelCase
+// should spell out, for example "qty" to "quantity" so that "order_qty"
+// becomes "OrderQuantity". The expansion may be several words in either
+// snake or title case ("unit_price" or "UnitPrice"). The same restrictions
+// as for AddUppercaseWord apply.
+func AddAbbreviation(abbr, expansion string) {
+	expansion = SnakeCase(expansion)
+
+	titleCaseMut.Lock()
+	defer titleCaseMut.Unlock()
+
+	abbreviations[strings.ToLower(abbr)] = expansion
+	titleCaseCache.reset()
+}
+
+// TitleCase changes a snake-case name into a go styled object name of
+// "ColumnName", fully uppercasing words like "id", see strmangle.TitleCase.
+// Unlike strmangle it works on runes so names containing non-ASCII
+// characters are cased correctly: "über_id" becomes "ÜberID".
+func TitleCase(n string) string {
+	titleCaseMut.RLock()
+	if val, ok := titleCaseCache.get(n); ok {
+		titleCaseMut.RUnlock()
+		return val
+	}
+
+	buf := boil.GetBuffer()
+	for _, word := range strings.Split(n, "_") {
+		if len(word) == 0 {
+			continue
+		}
+		if expansion, ok := abbreviations[strings.ToLower(word)]; ok {
+			for _, w := range strings.Split(expansion, "_") {
+				writeTitleWord(buf, w)
+			}
+			continue
+		}
+		writeTitleWord(buf, word)
+	}
+	ret := buf.String()
+	boil.PutBuffer(buf)
+	titleCaseCache.set(n, ret)
+	titleCaseMut.RUnlock()
+
+	return ret
+}
+
+// CamelCase changes a snake-case name into a go styled variable name of
+// "columnName", see strmangle.CamelCase. Like TitleCase it is safe to use
+// with non-ASCII names.
+func CamelCase(name string) string {
+	name = strings.TrimLeft(name, "_")
+	if len(name) == 0 {
+		return ""
+	}
+
+	// The first word isn't title cased so spell it out here
+	firstWord, others := name, ""
+	if i := strings.IndexByte(name, '_'); i >= 0 {
+		firstWord, others = name[:i], name[i:]
+	}
+	titleCaseMut.RLock()
+	if expansion, ok := abbreviations[strings.ToLower(firstWord)]; ok {
+		name = expansion + others
+	}
+	titleCaseMut.RUnlock()
+
+	first, size := utf8.DecodeRuneInString(name)
+	rest := name[size:]
+
+	var tail string
+	if i := strings.IndexByte(rest, '_'); i >= 0 {
+		rest, tail = rest[:i], TitleCase(rest[i+1:])
+	}
+
+	return string(unicode.ToLower(first)) + rest + tail
+}
+
+// SnakeCase is the reverse of TitleCase, it changes a go styled name like
+// "ColumnNameID" back into "column_name_id". Runs of capitals are split on
+// the words TitleCase uppercases, so "UserUUID" and "APIURL" become
+// "user_uuid" and "api_url".
+func SnakeCase(name string) string {
+	runes := []rune(name)
+	var words []string
+
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		if i < len(runes) && !startsWord(runes, i) {
+			continue
+		}
+
+		if word := strings.Trim(string(runes[start:i]), "_"); len(word) != 0 {
+			words = append(words, splitUppercaseRun(word)...)
+		}
+		start = i
+	}
+
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, "_")
+}
+
+// KebabCase changes a snake-case or go styled name into "column-name-id",
+// for use in URLs and file names.
+func KebabCase(name string) string {
+	return strings.Replace(SnakeCase(name), "_", "-", -1)
+}
+
+// PascalCase changes a snake-case or camel-case name into a go styled
+// exported name. Unlike TitleCase, which expects snake-case, the words of
+// "columnNameId" are recognized and it becomes "ColumnNameID".
+func PascalCase(name string) string {
+	return TitleCase(SnakeCase(name))
+}
+
+// startsWord checks if the rune at i begins a new word: after an underscore,
+// at a lower to upper case change or at the last capital of a run that is
+// followed by a lower case letter ("IDName").
+func startsWord(runes []rune, i int) bool {
+	r, prev := runes[i], runes[i-1]
+	switch {
+	case r == '_' || prev == '_':
+		return true
+	case !unicode.IsUpper(r):
+		return false
+	case unicode.IsLower(prev) || unicode.IsDigit(prev):
+		return true
+	case unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+		return true
+	}
+	return false
+}
+
+// splitUppercaseRun splits a word made of capitals, with optional trailing
+// digits, into the uppercase words it's made of: "UUIDID" is "UUID", "ID".
+// Capitals that aren't part of a known word stay together.
+func splitUppercaseRun(word string) []string {
+	letters := strings.TrimRightFunc(word, unicode.IsDigit)
+	digits := word[len(letters):]
+	if len(letters) < 4 || strings.ToUpper(letters) != letters {
+		return []string{word}
+	}
+
+	titleCaseMut.RLock()
+	defer titleCaseMut.RUnlock()
+
+	var split []string
+	unknown := 0
+	for i := 0; i < len(letters); {
+		j := len(letters)
+		for ; j >= i+2; j-- {
+			if isKnownUppercaseWord(letters[i:j]) {
+				break
+			}
+		}
+		if j < i+2 {
+			i++
+			continue
+		}
+
+		if unknown < i {
+			split = append(split, letters[unknown:i])
+		}
+		split = append(split, letters[i:j])
+		i, unknown = j, j
+	}
+	if unknown < len(letters) {
+		split = append(split, letters[unknown:])
+	}
+
+	split[len(split)-1] += digits
+	return split
+}
+
+// isKnownUppercaseWord checks if word is in the uppercase list. Words that
+// are uppercased only because they have no vowels don't count, any run of
+// consonants would match that. The caller must hold titleCaseMut.
+func isKnownUppercaseWord(word string) bool {
+	lower := strings.ToLower(word)
+	if _, ok := uppercaseWords[lower]; ok {
+		return true
+	}
+	return strings.ContainsAny(lower, "aeiouy") && isUppercaseWord(lower)
+}
+
+// writeTitleWord writes a single word of a snake-case name. Words that are
+// in the uppercase list, or have no vowels (like "sql"), are uppercased
+// completely, the rest only get their first letter uppercased.
+func writeTitleWord(buf *bytes.Buffer, word string) {
+	numStart := len(word)
+	vowels := false
+	for i, r := range word {
+		switch {
+		case r == 'a', r == 'e', r == 'i', r == 'o', r == 'u', r == 'y':
+			vowels = true
+		case r >= utf8.RuneSelf && unicode.IsLetter(r):
+			// We have no idea about the vowels of other alphabets, so
+			// don't risk shouting words written in them.
+			vowels = true
+		case r >= '0' && r <= '9' && numStart == len(word):
+			numStart = i
+		}
+	}
+
+	if !vowels || isUppercaseWord(word[:numStart]) {
+		buf.WriteString(strings.ToUpper(word))
+		return
+	}
+
+	first, size := utf8.DecodeRuneInString(word)
+	buf.WriteRune(unicode.ToUpper(first))
+	buf.WriteString(word[size:])
+}
+
+// isUppercaseWord checks if word was added with AddUppercaseWord or if
+// strmangle would fully uppercase it. The caller must hold titleCaseMut.
+func isUppercaseWord(word string) bool {
+	if _, ok := uppercaseWords[strings.ToLower(word)]; ok {
+		return true
+	}
+	_, ok := strmangleUppercaseWords[word]
+	return ok
+}
@@ -0,0 +1,71 @@
+//go:build go1.18
+// +build go1.18
+
+package boilingcore
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/volatiletech/strmangle"
+)
+
+// Names come straight from the database schema so the casing functions must
+// cope with anything, these make sure they don't panic and keep their basic
+// promises. Run one with: go test ./boilingcore -run x -fuzz FuzzTitleCase
+
+func FuzzTitleCase(f *testing.F) {
+	for _, seed := range []string{"", "_", "user_id", "über_id", "a__b", "__x", "1st_place", "ID", "uuid_uuid", "ǅ_x"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, name string) {
+		if !utf8.ValidString(name) {
+			t.Skip()
+		}
+
+		title := TitleCase(name)
+		if strings.Contains(title, "_") {
+			t.Errorf("TitleCase(%q) = %q contains an underscore", name, title)
+		}
+		camel := CamelCase(name)
+		if strings.Contains(strings.TrimLeft(name, "_"), "_") && strings.Contains(camel, "_") {
+			t.Errorf("CamelCase(%q) = %q contains an underscore", name, camel)
+		}
+
+		_ = PascalCase(name)
+		_ = KebabCase(name)
+	})
+}
+
+func FuzzSnakeCase(f *testing.F) {
+	for _, seed := range []string{"", "UserID", "APIURL", "UUIDID", "ÜberID", "A1B2", "aB", "X__Y"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, name string) {
+		if !utf8.ValidString(name) {
+			t.Skip()
+		}
+
+		snake := SnakeCase(name)
+		if strings.Contains(snake, "__") || strings.HasPrefix(snake, "_") || strings.HasSuffix(snake, "_") {
+			t.Errorf("SnakeCase(%q) = %q has empty words", name, snake)
+		}
+		if strings.ToLower(snake) != snake {
+			t.Errorf("SnakeCase(%q) = %q is not lower case", name, snake)
+		}
+	})
+}
+
+func FuzzPluralSingular(f *testing.F) {
+	for _, seed := range []string{"", "person", "people", "user_address", "data", "s", "_"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, name string) {
+		_ = strmangle.Plural(name)
+		_ = strmangle.Singular(name)
+	})
+}
@@ -0,0 +1,202 @@
+package boilingcore
+
+import (
+	"testing"
+
+	"github.com/volatiletech/strmangle"
+)
+
+func TestTitleCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want string
+	}{
+		{"column_name_id", "ColumnNameID"},
+		{"user_uuid", "UserUUID"},
+		{"über_id", "ÜberID"},
+		{"straße_name", "StraßeName"},
+		{"café_api_url", "CaféAPIURL"},
+		{"ñandú", "Ñandú"},
+		{"名前", "名前"},
+		{"_año__sql", "AñoSQL"},
+		{"ñ_id2", "ÑID2"},
+	}
+
+	for i, test := range tests {
+		if got := TitleCase(test.In); got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+	}
+}
+
+func TestCamelCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want string
+	}{
+		{"column_name_id", "columnNameID"},
+		{"über_id", "überID"},
+		{"Ñandú_name", "ñandúName"},
+		{"__año_sql", "añoSQL"},
+		{"名前_id", "名前ID"},
+	}
+
+	for i, test := range tests {
+		if got := CamelCase(test.In); got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+	}
+}
+
+func TestCasingMatchesStrmangle(t *testing.T) {
+	t.Parallel()
+
+	names := []string{
+		"", "_", "id", "ID", "user_id", "UserID", "api_url_uuid", "Api", "sql_x",
+		"column2_name", "utf8_json", "1st_place", "__a__b__", "x_y_z", "guid2",
+		"the_rhythm", "Id_uuid", "lowerCamel_id",
+	}
+
+	for _, name := range names {
+		if got, want := TitleCase(name), strmangle.TitleCase(name); got != want {
+			t.Errorf("TitleCase(%q) want: %s, got: %s", name, want, got)
+		}
+		if got, want := CamelCase(name), strmangle.CamelCase(name); got != want {
+			t.Errorf("CamelCase(%q) want: %s, got: %s", name, want, got)
+		}
+	}
+}
+
+func TestAddUppercaseWord(t *testing.T) {
+	// Not parallel, this changes the global word list
+	defer func() {
+		titleCaseMut.Lock()
+		uppercaseWords = map[string]struct{}{}
+		titleCaseCache.reset()
+		titleCaseMut.Unlock()
+	}()
+
+	if got := TitleCase("item_sku"); got != "ItemSku" {
+		t.Errorf("got: %s", got)
+	}
+
+	AddUppercaseWord("SKU", "http")
+
+	tests := []struct {
+		In    string
+		Title string
+		Camel string
+	}{
+		{"item_sku", "ItemSKU", "itemSKU"},
+		{"http_url_id", "HTTPURLID", "httpURLID"},
+		{"sku2_ñame", "SKU2Ñame", "sku2Ñame"},
+		{"user_id", "UserID", "userID"},
+	}
+
+	for i, test := range tests {
+		if got := TitleCase(test.In); got != test.Title {
+			t.Errorf("%d) want: %s, got: %s", i, test.Title, got)
+		}
+		if got := CamelCase(test.In); got != test.Camel {
+			t.Errorf("%d) want: %s, got: %s", i, test.Camel, got)
+		}
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want string
+	}{
+		{"ColumnNameID", "column_name_id"},
+		{"columnName", "column_name"},
+		{"UserUUID", "user_uuid"},
+		{"APIURL", "api_url"},
+		{"GUIDID", "guid_id"},
+		{"IDName", "id_name"},
+		{"HTTPServer", "http_server"},
+		{"Utf8Name", "utf8_name"},
+		{"Address2", "address2"},
+		{"ID2", "id2"},
+		{"ÜberID", "über_id"},
+		{"Already_snake", "already_snake"},
+		{"ID", "id"},
+	}
+
+	for i, test := range tests {
+		if got := SnakeCase(test.In); got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+	}
+
+	for _, name := range []string{"column_name_id", "user_uuid", "api_url", "über_id"} {
+		if got := SnakeCase(TitleCase(name)); got != name {
+			t.Errorf("%s did not round trip, got: %s", name, got)
+		}
+	}
+}
+
+func TestKebabAndPascalCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In     string
+		Kebab  string
+		Pascal string
+	}{
+		{"column_name_id", "column-name-id", "ColumnNameID"},
+		{"columnNameId", "column-name-id", "ColumnNameID"},
+		{"UserUUID", "user-uuid", "UserUUID"},
+		{"api_url", "api-url", "APIURL"},
+	}
+
+	for i, test := range tests {
+		if got := KebabCase(test.In); got != test.Kebab {
+			t.Errorf("%d) want: %s, got: %s", i, test.Kebab, got)
+		}
+		if got := PascalCase(test.In); got != test.Pascal {
+			t.Errorf("%d) want: %s, got: %s", i, test.Pascal, got)
+		}
+	}
+}
+
+func TestAddAbbreviation(t *testing.T) {
+	// Not parallel, this changes the global abbreviations
+	defer func() {
+		titleCaseMut.Lock()
+		abbreviations = map[string]string{}
+		titleCaseCache.reset()
+		titleCaseMut.Unlock()
+	}()
+
+	AddAbbreviation("qty", "Quantity")
+	AddAbbreviation("amt", "amount")
+	AddAbbreviation("upr", "UnitPrice")
+
+	tests := []struct {
+		In    string
+		Title string
+		Camel string
+	}{
+		{"order_qty", "OrderQuantity", "orderQuantity"},
+		{"qty_id", "QuantityID", "quantityID"},
+		{"total_amt", "TotalAmount", "totalAmount"},
+		{"upr", "UnitPrice", "unitPrice"},
+		{"qtyx", "Qtyx", "qtyx"},
+	}
+
+	for i, test := range tests {
+		if got := TitleCase(test.In); got != test.Title {
+			t.Errorf("%d) want: %s, got: %s", i, test.Title, got)
+		}
+		if got := CamelCase(test.In); got != test.Camel {
+			t.Errorf("%d) want: %s, got: %s", i, test.Camel, got)
+		}
+	}
+}
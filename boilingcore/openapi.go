@@ -0,0 +1,259 @@
+package boilingcore
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/strmangle"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// OpenAPIVersion is the version of the OpenAPI specification the documents
+// follow.
+const OpenAPIVersion = "3.0.3"
+
+// OpenAPI is an OpenAPI document with a component schema for every model.
+// It has no paths, the schemas are meant to be referenced from the spec of
+// an api, eg. $ref: 'models.yaml#/components/schemas/Pilot'.
+type OpenAPI struct {
+	OpenAPI    string                 `json:"openapi" yaml:"openapi"`
+	Info       OpenAPIInfo            `json:"info" yaml:"info"`
+	Paths      map[string]interface{} `json:"paths" yaml:"paths"`
+	Components OpenAPIComponents      `json:"components" yaml:"components"`
+}
+
+// OpenAPIInfo is the info object of the document.
+type OpenAPIInfo struct {
+	Title   string `json:"title" yaml:"title"`
+	Version string `json:"version" yaml:"version"`
+}
+
+// OpenAPIComponents holds the schemas of the models, by model name.
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas" yaml:"schemas"`
+}
+
+// OpenAPISchema is the subset of the schema object needed to describe a
+// model and its columns. A schema without a type allows any value.
+type OpenAPISchema struct {
+	Type                 string                    `json:"type,omitempty" yaml:"type,omitempty"`
+	Format               string                    `json:"format,omitempty" yaml:"format,omitempty"`
+	Description          string                    `json:"description,omitempty" yaml:"description,omitempty"`
+	Nullable             bool                      `json:"nullable,omitempty" yaml:"nullable,omitempty"`
+	ReadOnly             bool                      `json:"readOnly,omitempty" yaml:"readOnly,omitempty"`
+	MaxLength            *int                      `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	Enum                 []interface{}             `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty" yaml:"items,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty" yaml:"properties,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+	Required             []string                  `json:"required,omitempty" yaml:"required,omitempty"`
+}
+
+// SchemaOpenAPI returns the OpenAPI schemas of the models generated from the
+// schema snapshot at path, or from the database when path is empty. The
+// type replacements, aliases and json tag options of the config are applied
+// like they are when generating, so the schemas describe the json of the
+// models.
+func SchemaOpenAPI(config *Config, path string) (*OpenAPI, error) {
+	dbInfo, err := loadSchema(config, path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &State{Config: config, Tables: dbInfo.Tables}
+	if err := s.processTypeReplacements(); err != nil {
+		return nil, err
+	}
+	if config.NullablePointers {
+		s.processNullablePointers()
+	}
+
+	AddUppercaseWord(config.UppercaseWords...)
+	for abbr, expansion := range config.Abbreviations {
+		AddAbbreviation(abbr, expansion)
+	}
+	if err := s.initAliases(&config.Aliases); err != nil {
+		return nil, err
+	}
+
+	return NewOpenAPI(config, s.Tables), nil
+}
+
+// NewOpenAPI creates the document for the models of tables, the aliases of
+// the tables have to be filled in the config.
+func NewOpenAPI(config *Config, tables []drivers.Table) *OpenAPI {
+	doc := &OpenAPI{
+		OpenAPI: OpenAPIVersion,
+		Info: OpenAPIInfo{
+			Title:   config.PkgName,
+			Version: config.Version,
+		},
+		Paths:      map[string]interface{}{},
+		Components: OpenAPIComponents{Schemas: map[string]*OpenAPISchema{}},
+	}
+	if len(doc.Info.Version) == 0 {
+		doc.Info.Version = "0.0.0"
+	}
+
+	ignored := make(map[string]struct{}, len(config.JSONIgnore))
+	for _, v := range config.JSONIgnore {
+		ignored[v] = struct{}{}
+	}
+	casing := config.JSONTagCasing
+	if len(casing) == 0 {
+		casing = config.StructTagCasing
+	}
+
+	for _, t := range tables {
+		if t.IsJoinTable {
+			continue
+		}
+
+		alias := config.Aliases.Table(t.Name)
+		model := &OpenAPISchema{Type: "object", Properties: map[string]*OpenAPISchema{}}
+		for _, c := range t.Columns {
+			if strmangle.Ignore(t.Name, c.Name, ignored) {
+				continue
+			}
+
+			name := tagName(casing, c.Name, alias.Column(c.Name))
+			model.Properties[name] = openAPIColumn(c)
+			if !c.Nullable {
+				model.Required = append(model.Required, name)
+			}
+		}
+		sort.Strings(model.Required)
+
+		doc.Components.Schemas[alias.UpSingular] = model
+	}
+
+	return doc
+}
+
+// JSON encodes the document.
+func (o *OpenAPI) JSON() ([]byte, error) {
+	return json.MarshalIndent(o, "", "  ")
+}
+
+// YAML encodes the document.
+func (o *OpenAPI) YAML() ([]byte, error) {
+	return yaml.Marshal(o)
+}
+
+// openAPIColumn describes the json a column of a model is encoded as.
+func openAPIColumn(column drivers.Column) *OpenAPISchema {
+	schema := openAPIType(column.Type)
+
+	if schema.Type == "string" && len(schema.Format) == 0 {
+		switch column.DBType {
+		case "uuid", "uniqueidentifier":
+			schema.Format = "uuid"
+		}
+		if m := rgxCharLength.FindStringSubmatch(column.FullDBType); m != nil && schema.MaxLength == nil {
+			if n, err := strconv.Atoi(m[1]); err == nil {
+				schema.MaxLength = &n
+			}
+		}
+	}
+
+	values := column.CheckValues
+	if values == nil && strings.HasPrefix(column.DBType, "enum") {
+		values = strmangle.ParseEnumVals(column.DBType)
+	}
+	schema.Enum = openAPIEnum(schema.Type, values)
+
+	schema.Description = column.Comment
+	schema.ReadOnly = column.AutoGenerated
+	if column.Nullable {
+		schema.Nullable = true
+		if schema.Enum != nil {
+			schema.Enum = append(schema.Enum, nil)
+		}
+	}
+
+	return schema
+}
+
+// openAPIType describes the json a go type of a column is encoded as. Types
+// it doesn't know, like replacements, allow any value.
+func openAPIType(typ string) *OpenAPISchema {
+	typ = strings.TrimPrefix(typ, "*")
+
+	switch typ {
+	case "types.Decimal", "types.NullDecimal":
+		return &OpenAPISchema{Type: "string", Format: "decimal"}
+	case "types.Byte", "null.Byte":
+		one := 1
+		return &OpenAPISchema{Type: "string", MaxLength: &one}
+	case "[]byte", "null.Bytes":
+		return &OpenAPISchema{Type: "string", Format: "byte"}
+	case "time.Time", "null.Time":
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	case "types.HStore":
+		return &OpenAPISchema{Type: "object", AdditionalProperties: &OpenAPISchema{Type: "string", Nullable: true}}
+	case "types.StringArray":
+		return &OpenAPISchema{Type: "array", Items: openAPIType("string")}
+	case "types.Int64Array":
+		return &OpenAPISchema{Type: "array", Items: openAPIType("int64")}
+	case "types.Float64Array":
+		return &OpenAPISchema{Type: "array", Items: openAPIType("float64")}
+	case "types.BoolArray":
+		return &OpenAPISchema{Type: "array", Items: openAPIType("bool")}
+	case "types.BytesArray":
+		return &OpenAPISchema{Type: "array", Items: openAPIType("[]byte")}
+	case "types.DecimalArray":
+		return &OpenAPISchema{Type: "array", Items: openAPIType("types.Decimal")}
+	}
+
+	switch strings.ToLower(strings.TrimPrefix(typ, "null.")) {
+	case "string":
+		return &OpenAPISchema{Type: "string"}
+	case "bool":
+		return &OpenAPISchema{Type: "boolean"}
+	case "int8", "int16", "int32", "uint8", "uint16":
+		return &OpenAPISchema{Type: "integer", Format: "int32"}
+	case "int", "int64", "uint", "uint32", "uint64":
+		return &OpenAPISchema{Type: "integer", Format: "int64"}
+	case "float32":
+		return &OpenAPISchema{Type: "number", Format: "float"}
+	case "float64":
+		return &OpenAPISchema{Type: "number", Format: "double"}
+	default:
+		return &OpenAPISchema{}
+	}
+}
+
+// openAPIEnum converts the values a column is limited to into the type of
+// its schema, it's nil when a value doesn't fit the type.
+func openAPIEnum(typ string, values []string) []interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+
+	enum := make([]interface{}, len(values))
+	for i, v := range values {
+		switch typ {
+		case "string":
+			enum[i] = v
+		case "integer":
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil
+			}
+			enum[i] = n
+		case "number":
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil
+			}
+			enum[i] = f
+		default:
+			return nil
+		}
+	}
+
+	return enum
+}
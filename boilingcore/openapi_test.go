@@ -0,0 +1,101 @@
+package boilingcore
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestOpenAPI(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id", Type: "string", DBType: "uuid"},
+				{Name: "name", Type: "string", DBType: "character varying", FullDBType: "character varying(50)", Comment: "Call sign"},
+				{Name: "rank", Type: "null.String", DBType: "text", Nullable: true, CheckValues: []string{"ace", "rookie"}},
+				{Name: "seats", Type: "int", DBType: "integer", CheckValues: []string{"1", "2"}},
+				{Name: "born_at", Type: "null.Time", DBType: "timestamp", Nullable: true},
+				{Name: "photo", Type: "[]byte", DBType: "bytea"},
+				{Name: "salary", Type: "types.Decimal", DBType: "numeric"},
+				{Name: "tags", Type: "types.StringArray", DBType: "ARRAYtext"},
+				{Name: "extra", Type: "types.JSON", DBType: "jsonb"},
+				{Name: "secret", Type: "string", DBType: "text"},
+			},
+			PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+		},
+		{
+			Name:        "pilot_copilots",
+			Columns:     []drivers.Column{{Name: "pilot_id", Type: "string"}, {Name: "copilot_id", Type: "string"}},
+			IsJoinTable: true,
+			FKeys: []drivers.ForeignKey{
+				{Name: "pilot_fkey", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"},
+				{Name: "copilot_fkey", Column: "copilot_id", ForeignTable: "pilots", ForeignColumn: "id"},
+			},
+		},
+	}
+
+	config := &Config{PkgName: "models", JSONTagCasing: "camel", JSONIgnore: []string{"secret"}}
+	fillAliases(&config.Aliases, tables)
+
+	doc := NewOpenAPI(config, tables)
+	if len(doc.Components.Schemas) != 1 {
+		t.Fatalf("want only the pilot schema, got: %#v", doc.Components.Schemas)
+	}
+
+	pilot := doc.Components.Schemas["Pilot"]
+	if pilot == nil || pilot.Type != "object" {
+		t.Fatalf("pilot schema is wrong: %#v", pilot)
+	}
+
+	wantRequired := []string{"extra", "id", "name", "photo", "salary", "seats", "tags"}
+	if !reflect.DeepEqual(pilot.Required, wantRequired) {
+		t.Errorf("want required %v, got %v", wantRequired, pilot.Required)
+	}
+	if _, ok := pilot.Properties["secret"]; ok {
+		t.Error("ignored column should not be a property")
+	}
+
+	fifty := 50
+	want := map[string]*OpenAPISchema{
+		"id":     {Type: "string", Format: "uuid"},
+		"name":   {Type: "string", Description: "Call sign", MaxLength: &fifty},
+		"rank":   {Type: "string", Nullable: true, Enum: []interface{}{"ace", "rookie", nil}},
+		"seats":  {Type: "integer", Format: "int64", Enum: []interface{}{int64(1), int64(2)}},
+		"bornAt": {Type: "string", Format: "date-time", Nullable: true},
+		"photo":  {Type: "string", Format: "byte"},
+		"salary": {Type: "string", Format: "decimal"},
+		"tags":   {Type: "array", Items: &OpenAPISchema{Type: "string"}},
+		"extra":  {},
+	}
+	for name, schema := range want {
+		if got := pilot.Properties[name]; !reflect.DeepEqual(got, schema) {
+			t.Errorf("%s: want %#v, got %#v", name, schema, got)
+		}
+	}
+
+	b, err := doc.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded["openapi"] != OpenAPIVersion {
+		t.Errorf("unexpected document: %s", b)
+	}
+
+	y, err := doc.YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(y), "openapi: 3.0.3\n") || !strings.Contains(string(y), "    Pilot:\n      type: object\n") {
+		t.Errorf("unexpected yaml:\n%s", y)
+	}
+}
@@ -0,0 +1,19 @@
+package boilingcore
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// initOutbox checks that the tables publishing change events can publish
+// them, the messages are added to the outbox by the hooks of the models.
+func (s *State) initOutbox() error {
+	return s.checkHookTables("outbox", s.Config.OutboxTables)
+}
+
+// isOutboxTemplate reports whether name is the singleton template of the
+// outbox messages, which is only used with OutboxTables.
+func isOutboxTemplate(name string) bool {
+	fragments := strings.Split(name, string(filepath.Separator))
+	return len(fragments) == 3 && fragments[1] == "singleton" && fragments[2] == "boil_outbox.go.tpl"
+}
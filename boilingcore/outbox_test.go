@@ -0,0 +1,48 @@
+package boilingcore
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestInitOutbox(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{Name: "users", PKey: &drivers.PrimaryKey{Columns: []string{"id"}}},
+	}
+
+	s := &State{Config: &Config{OutboxTables: []string{"users"}}, Tables: tables}
+	if err := s.initOutbox(); err != nil {
+		t.Error(err)
+	}
+
+	s = &State{Config: &Config{OutboxTables: []string{"videos"}}, Tables: tables}
+	if err := s.initOutbox(); err == nil || !strings.Contains(err.Error(), "outbox table videos does not exist") {
+		t.Errorf("want an error about the missing table, got: %v", err)
+	}
+
+	s = &State{Config: &Config{OutboxTables: []string{"users"}, NoHooks: true}, Tables: tables}
+	if err := s.initOutbox(); err == nil || !strings.Contains(err.Error(), "outbox tables need hooks") {
+		t.Errorf("want an error about the hooks, got: %v", err)
+	}
+}
+
+func TestIsOutboxTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]bool{
+		filepath.Join("templates", "singleton", "boil_outbox.go.tpl"): true,
+		filepath.Join("templates", "singleton", "boil_audit.go.tpl"):  false,
+		filepath.Join("templates", "32_outbox.go.tpl"):                false,
+	}
+
+	for name, want := range tests {
+		if got := isOutboxTemplate(name); got != want {
+			t.Errorf("%s: want %t, got %t", name, want, got)
+		}
+	}
+}
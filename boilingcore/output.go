@@ -5,7 +5,7 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
-	"io/ioutil"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -26,17 +26,31 @@ var (
 )
 
 var (
-	// templateByteBuffer is re-used by all template construction to avoid
-	// allocating more memory than is needed. This will later be a problem for
-	// concurrency, address it then.
-	templateByteBuffer = &bytes.Buffer{}
-
 	rgxRemoveNumberedPrefix = regexp.MustCompile(`^[0-9]+_`)
 	rgxSyntaxError          = regexp.MustCompile(`(\d+):\d+: `)
 
-	testHarnessWriteFile = ioutil.WriteFile
+	testHarnessCreateFile = func(path string) (io.WriteCloser, error) {
+		return os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0664)
+	}
 )
 
+// outputBuffers are the buffers a goroutine generates files with. They're
+// reused for every file it writes, so memory use doesn't grow with the number
+// or the size of the tables once they've grown to fit the biggest file.
+type outputBuffers struct {
+	// source holds Go files until they're formatted, which needs all of it
+	source *bytes.Buffer
+	// file buffers the writes to the output file
+	file *bufio.Writer
+}
+
+func newOutputBuffers() *outputBuffers {
+	return &outputBuffers{
+		source: &bytes.Buffer{},
+		file:   bufio.NewWriterSize(nil, 64<<10),
+	}
+}
+
 type executeTemplateData struct {
 	state *State
 	data  *templateData
@@ -49,10 +63,12 @@ type executeTemplateData struct {
 
 	combineImportsOnType bool
 	isTest               bool
+
+	buffers *outputBuffers
 }
 
 // generateOutput builds the file output and sends it to outHandler for saving
-func generateOutput(state *State, dirExts dirExtMap, data *templateData) error {
+func generateOutput(state *State, dirExts dirExtMap, data *templateData, buffers *outputBuffers) error {
 	return executeTemplates(executeTemplateData{
 		state:                state,
 		data:                 data,
@@ -60,11 +76,12 @@ func generateOutput(state *State, dirExts dirExtMap, data *templateData) error {
 		importSet:            state.Config.Imports.All,
 		combineImportsOnType: true,
 		dirExtensions:        dirExts,
+		buffers:              buffers,
 	})
 }
 
 // generateTestOutput builds the test file output and sends it to outHandler for saving
-func generateTestOutput(state *State, dirExts dirExtMap, data *templateData) error {
+func generateTestOutput(state *State, dirExts dirExtMap, data *templateData, buffers *outputBuffers) error {
 	return executeTemplates(executeTemplateData{
 		state:                state,
 		data:                 data,
@@ -73,6 +90,7 @@ func generateTestOutput(state *State, dirExts dirExtMap, data *templateData) err
 		combineImportsOnType: false,
 		isTest:               true,
 		dirExtensions:        dirExts,
+		buffers:              buffers,
 	})
 }
 
@@ -84,6 +102,7 @@ func generateSingletonOutput(state *State, data *templateData) error {
 		data:           data,
 		templates:      state.Templates,
 		importNamedSet: state.Config.Imports.Singleton,
+		buffers:        newOutputBuffers(),
 	})
 }
 
@@ -96,6 +115,7 @@ func generateSingletonTestOutput(state *State, data *templateData) error {
 		templates:      state.TestTemplates,
 		importNamedSet: state.Config.Imports.TestSingleton,
 		isTest:         true,
+		buffers:        newOutputBuffers(),
 	})
 }
 
@@ -118,24 +138,10 @@ func executeTemplates(e executeTemplateData) error {
 
 	for dir, dirExts := range e.dirExtensions {
 		for ext, tplNames := range dirExts {
-			out := templateByteBuffer
-			out.Reset()
-
 			isGo := filepath.Ext(ext) == ".go"
-			if isGo {
-				pkgName := e.state.Config.PkgName
-				if len(dir) != 0 {
-					pkgName = filepath.Base(dir)
-				}
-				writeFileDisclaimer(out)
-				writePackageName(out, pkgName)
-				writeImports(out, imps)
-			}
-
-			for _, tplName := range tplNames {
-				if err := executeTemplate(out, e.templates.Template, tplName, e.data); err != nil {
-					return err
-				}
+			pkgName := e.state.Config.PkgName
+			if len(dir) != 0 {
+				pkgName = filepath.Base(dir)
 			}
 
 			fName := e.data.Table.Name
@@ -147,7 +153,21 @@ func executeTemplates(e executeTemplateData) error {
 				fName = filepath.Join(dir, fName)
 			}
 
-			if err := writeFile(e.state.Config.OutFolder, fName, out, isGo); err != nil {
+			err := writeOutput(e, fName, isGo, func(out io.Writer) error {
+				if isGo {
+					writeFileDisclaimer(out)
+					writePackageName(out, pkgName)
+					writeImports(out, imps)
+				}
+
+				for _, tplName := range tplNames {
+					if err := executeTemplate(out, e.templates.Template, tplName, e.data); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
 				return err
 			}
 		}
@@ -161,7 +181,6 @@ func executeSingletonTemplates(e executeTemplateData) error {
 		return nil
 	}
 
-	out := templateByteBuffer
 	for _, tplName := range e.templates.Templates() {
 		normalized, isSingleton, isGo, usePkg := outputFilenameParts(tplName)
 		if !isSingleton {
@@ -171,28 +190,25 @@ func executeSingletonTemplates(e executeTemplateData) error {
 		dir, fName := filepath.Split(normalized)
 		fName = fName[:strings.IndexByte(fName, '.')]
 
-		out.Reset()
-
-		if isGo {
-			imps := importers.Set{
-				Standard:   e.importNamedSet[denormalizeSlashes(fName)].Standard,
-				ThirdParty: e.importNamedSet[denormalizeSlashes(fName)].ThirdParty,
-			}
+		err := writeOutput(e, normalized, isGo, func(out io.Writer) error {
+			if isGo {
+				imps := importers.Set{
+					Standard:   e.importNamedSet[denormalizeSlashes(fName)].Standard,
+					ThirdParty: e.importNamedSet[denormalizeSlashes(fName)].ThirdParty,
+				}
 
-			pkgName := e.state.Config.PkgName
-			if !usePkg {
-				pkgName = filepath.Base(dir)
+				pkgName := e.state.Config.PkgName
+				if !usePkg {
+					pkgName = filepath.Base(dir)
+				}
+				writeFileDisclaimer(out)
+				writePackageName(out, pkgName)
+				writeImports(out, imps)
 			}
-			writeFileDisclaimer(out)
-			writePackageName(out, pkgName)
-			writeImports(out, imps)
-		}
 
-		if err := executeTemplate(out, e.templates.Template, tplName, e.data); err != nil {
-			return err
-		}
-
-		if err := writeFile(e.state.Config.OutFolder, normalized, out, isGo); err != nil {
+			return executeTemplate(out, e.templates.Template, tplName, e.data)
+		})
+		if err != nil {
 			return err
 		}
 	}
@@ -200,42 +216,72 @@ func executeSingletonTemplates(e executeTemplateData) error {
 	return nil
 }
 
+// writeOutput writes the file fileName with what execute writes. Go files
+// are collected in e's source buffer and formatted before they're written,
+// other files are streamed to disk as the templates execute.
+func writeOutput(e executeTemplateData, fileName string, isGo bool, execute func(io.Writer) error) error {
+	if !isGo {
+		return writeFile(e.state.Config.OutFolder, fileName, e.buffers.file, execute)
+	}
+
+	source := e.buffers.source
+	source.Reset()
+	if err := execute(source); err != nil {
+		return err
+	}
+
+	byt, err := formatBuffer(source)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(e.state.Config.OutFolder, fileName, e.buffers.file, func(out io.Writer) error {
+		_, err := out.Write(byt)
+		return err
+	})
+}
+
 // writeFileDisclaimer writes the disclaimer at the top with a trailing
 // newline so the package name doesn't get attached to it.
-func writeFileDisclaimer(out *bytes.Buffer) {
+func writeFileDisclaimer(out io.Writer) {
 	_, _ = out.Write(noEditDisclaimer)
 }
 
-// writePackageName writes the package name correctly, ignores errors
-// since it's to the concrete buffer type which produces none
-func writePackageName(out *bytes.Buffer, pkgName string) {
+// writePackageName writes the package name correctly, errors are reported
+// by the writer when it's flushed
+func writePackageName(out io.Writer, pkgName string) {
 	_, _ = fmt.Fprintf(out, "package %s\n\n", pkgName)
 }
 
-// writeImports writes the package imports correctly, ignores errors
-// since it's to the concrete buffer type which produces none
-func writeImports(out *bytes.Buffer, imps importers.Set) {
+// writeImports writes the package imports correctly, errors are reported
+// by the writer when it's flushed
+func writeImports(out io.Writer, imps importers.Set) {
 	if impStr := imps.Format(); len(impStr) > 0 {
 		_, _ = fmt.Fprintf(out, "%s\n", impStr)
 	}
 }
 
-// writeFile writes to the given folder and filename, formatting the buffer
-// given.
-func writeFile(outFolder string, fileName string, input *bytes.Buffer, format bool) error {
-	var byt []byte
-	var err error
-	if format {
-		byt, err = formatBuffer(input)
-		if err != nil {
-			return err
-		}
-	} else {
-		byt = input.Bytes()
+// writeFile creates the file fileName in outFolder and writes to it what
+// write writes to the buffered writer w, which is reused between files.
+func writeFile(outFolder string, fileName string, w *bufio.Writer, write func(io.Writer) error) error {
+	path := filepath.Join(outFolder, fileName)
+	f, err := testHarnessCreateFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write output file %s", path)
 	}
 
-	path := filepath.Join(outFolder, fileName)
-	if err := testHarnessWriteFile(path, byt, 0664); err != nil {
+	w.Reset(f)
+	defer w.Reset(nil)
+
+	if err = write(w); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = w.Flush(); err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "failed to write output file %s", path)
+	}
+	if err = f.Close(); err != nil {
 		return errors.Wrapf(err, "failed to write output file %s", path)
 	}
 
@@ -244,7 +290,7 @@ func writeFile(outFolder string, fileName string, input *bytes.Buffer, format bo
 
 // executeTemplate takes a template and returns the output of the template
 // execution.
-func executeTemplate(buf *bytes.Buffer, t *template.Template, name string, data *templateData) (err error) {
+func executeTemplate(buf io.Writer, t *template.Template, name string, data *templateData) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = errors.Errorf("failed to execute template: %s\npanic: %+v\n", name, r)
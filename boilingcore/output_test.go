@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 	"testing"
 )
@@ -26,27 +25,42 @@ func TestWriteFile(t *testing.T) {
 
 	// set the function pointer back to its original value
 	// after we modify it for the test
-	saveTestHarnessWriteFile := testHarnessWriteFile
+	saveTestHarnessCreateFile := testHarnessCreateFile
 	defer func() {
-		testHarnessWriteFile = saveTestHarnessWriteFile
+		testHarnessCreateFile = saveTestHarnessCreateFile
 	}()
 
-	var output []byte
-	testHarnessWriteFile = func(_ string, in []byte, _ os.FileMode) error {
-		output = in
+	output := &bytes.Buffer{}
+	testHarnessCreateFile = func(string) (io.WriteCloser, error) {
+		return nopCloser(output), nil
+	}
+
+	e := executeTemplateData{state: &State{Config: &Config{}}, buffers: newOutputBuffers()}
+	err := writeOutput(e, "", true, func(out io.Writer) error {
+		writePackageName(out, "pkg")
+		fmt.Fprintf(out, "func hello() {}\n\n\nfunc world() {\nreturn\n}\n\n\n\n")
 		return nil
+	})
+	if err != nil {
+		t.Error(err)
 	}
 
-	buf := &bytes.Buffer{}
-	writePackageName(buf, "pkg")
-	fmt.Fprintf(buf, "func hello() {}\n\n\nfunc world() {\nreturn\n}\n\n\n\n")
+	if got := output.String(); got != "package pkg\n\nfunc hello() {}\n\nfunc world() {\n\treturn\n}\n" {
+		t.Errorf("Wrong output: %q", got)
+	}
 
-	if err := writeFile("", "", buf, true); err != nil {
+	// Other files are written as they are
+	output.Reset()
+	err = writeOutput(e, "", false, func(out io.Writer) error {
+		fmt.Fprintf(out, "# not go\n\n\n")
+		return nil
+	})
+	if err != nil {
 		t.Error(err)
 	}
 
-	if string(output) != "package pkg\n\nfunc hello() {}\n\nfunc world() {\n\treturn\n}\n" {
-		t.Errorf("Wrong output: %q", output)
+	if got := output.String(); got != "# not go\n\n\n" {
+		t.Errorf("Wrong output: %q", got)
 	}
 }
 
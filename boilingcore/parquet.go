@@ -0,0 +1,91 @@
+package boilingcore
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// rgxDecimalPrecision finds the precision and scale of decimal types in a full
+// db type, eg. numeric(10,2) or decimal(10)
+var rgxDecimalPrecision = regexp.MustCompile(`(?i)(?:numeric|decimal)\s*\(\s*(\d+)\s*(?:,\s*(\d+)\s*)?\)`)
+
+// parquetColumn returns the queries.ParquetColumn literal, without its type,
+// that describes how a column is written to parquet.
+func parquetColumn(column drivers.Column) string {
+	typ, logical := parquetType(column)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "{Name: %q, Type: queries.%s", column.Name, typ)
+	if len(logical) != 0 {
+		fmt.Fprintf(&b, ", Logical: queries.%s", logical)
+	}
+	if column.Nullable {
+		b.WriteString(", Nullable: true")
+	}
+	if logical == "ParquetDecimal" {
+		m := rgxDecimalPrecision.FindStringSubmatch(column.FullDBType)
+		scale := 0
+		if len(m[2]) != 0 {
+			scale, _ = strconv.Atoi(m[2])
+		}
+		fmt.Fprintf(&b, ", Precision: %s, Scale: %d", m[1], scale)
+	}
+	b.WriteByte('}')
+
+	return b.String()
+}
+
+// parquetType picks the physical and logical parquet types of a column from
+// its go type. Decimals without a precision and types it doesn't know, like
+// replacements, are written as text.
+func parquetType(column drivers.Column) (typ, logical string) {
+	goType := strings.TrimPrefix(column.Type, "*")
+
+	switch goType {
+	case "types.Decimal", "types.NullDecimal":
+		if rgxDecimalPrecision.MatchString(column.FullDBType) {
+			return "ParquetByteArray", "ParquetDecimal"
+		}
+		return "ParquetByteArray", "ParquetString"
+	case "time.Time", "null.Time":
+		if column.DBType == "date" {
+			return "ParquetInt32", "ParquetDate"
+		}
+		return "ParquetInt64", "ParquetTimestamp"
+	case "[]byte", "null.Bytes":
+		return "ParquetByteArray", ""
+	case "types.JSON", "null.JSON":
+		return "ParquetByteArray", "ParquetJSON"
+	}
+
+	switch strings.ToLower(strings.TrimPrefix(goType, "null.")) {
+	case "bool":
+		return "ParquetBoolean", ""
+	case "int8":
+		return "ParquetInt32", "ParquetInt8"
+	case "int16":
+		return "ParquetInt32", "ParquetInt16"
+	case "int32":
+		return "ParquetInt32", ""
+	case "int", "int64":
+		return "ParquetInt64", ""
+	case "uint8":
+		return "ParquetInt32", "ParquetUint8"
+	case "uint16":
+		return "ParquetInt32", "ParquetUint16"
+	case "uint32":
+		return "ParquetInt32", "ParquetUint32"
+	case "uint", "uint64":
+		return "ParquetInt64", "ParquetUint64"
+	case "float32":
+		return "ParquetFloat", ""
+	case "float64":
+		return "ParquetDouble", ""
+	default:
+		return "ParquetByteArray", "ParquetString"
+	}
+}
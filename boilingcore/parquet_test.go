@@ -0,0 +1,37 @@
+package boilingcore
+
+import (
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestParquetColumn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Column drivers.Column
+		Want   string
+	}{
+		{drivers.Column{Name: "id", Type: "int"}, `{Name: "id", Type: queries.ParquetInt64}`},
+		{drivers.Column{Name: "n", Type: "null.Int16", Nullable: true}, `{Name: "n", Type: queries.ParquetInt32, Logical: queries.ParquetInt16, Nullable: true}`},
+		{drivers.Column{Name: "n", Type: "*uint64", Nullable: true}, `{Name: "n", Type: queries.ParquetInt64, Logical: queries.ParquetUint64, Nullable: true}`},
+		{drivers.Column{Name: "s", Type: "string"}, `{Name: "s", Type: queries.ParquetByteArray, Logical: queries.ParquetString}`},
+		{drivers.Column{Name: "b", Type: "null.Bool", Nullable: true}, `{Name: "b", Type: queries.ParquetBoolean, Nullable: true}`},
+		{drivers.Column{Name: "f", Type: "float32"}, `{Name: "f", Type: queries.ParquetFloat}`},
+		{drivers.Column{Name: "t", Type: "time.Time", DBType: "timestamp with time zone"}, `{Name: "t", Type: queries.ParquetInt64, Logical: queries.ParquetTimestamp}`},
+		{drivers.Column{Name: "d", Type: "null.Time", DBType: "date", Nullable: true}, `{Name: "d", Type: queries.ParquetInt32, Logical: queries.ParquetDate, Nullable: true}`},
+		{drivers.Column{Name: "p", Type: "types.Decimal", FullDBType: "numeric(10,2)"}, `{Name: "p", Type: queries.ParquetByteArray, Logical: queries.ParquetDecimal, Precision: 10, Scale: 2}`},
+		{drivers.Column{Name: "p", Type: "types.NullDecimal", FullDBType: "DECIMAL(8)", Nullable: true}, `{Name: "p", Type: queries.ParquetByteArray, Logical: queries.ParquetDecimal, Nullable: true, Precision: 8, Scale: 0}`},
+		{drivers.Column{Name: "p", Type: "types.Decimal", FullDBType: "numeric"}, `{Name: "p", Type: queries.ParquetByteArray, Logical: queries.ParquetString}`},
+		{drivers.Column{Name: "j", Type: "types.JSON"}, `{Name: "j", Type: queries.ParquetByteArray, Logical: queries.ParquetJSON}`},
+		{drivers.Column{Name: "raw", Type: "[]byte"}, `{Name: "raw", Type: queries.ParquetByteArray}`},
+		{drivers.Column{Name: "a", Type: "types.StringArray"}, `{Name: "a", Type: queries.ParquetByteArray, Logical: queries.ParquetString}`},
+	}
+
+	for _, test := range tests {
+		if got := parquetColumn(test.Column); got != test.Want {
+			t.Errorf("%s %s:\nwant: %s\ngot:  %s", test.Column.Name, test.Column.Type, test.Want, got)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package boilingcore
+
+import (
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/cast"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// Polymorphic describes a polymorphic association, where a pair of columns
+// refers to a row in one of several tables: the type column says which
+// table and the id column holds the primary key of the row. A foreign key
+// can't express this so it has to be configured:
+//
+//	[[polymorphic]]
+//	table       = "comments"
+//	type_column = "commentable_type"
+//	id_column   = "commentable_id"
+//	  [[polymorphic.types]]
+//	  value = "post"
+//	  table = "posts"
+//	  [[polymorphic.types]]
+//	  value = "video"
+//	  table = "videos"
+//
+// This gives comments CommentablePost and CommentableVideo relationships,
+// posts and videos get CommentableComments.
+type Polymorphic struct {
+	Table      string `toml:"table,omitempty" json:"table,omitempty"`
+	TypeColumn string `toml:"type_column,omitempty" json:"type_column,omitempty"`
+	IDColumn   string `toml:"id_column,omitempty" json:"id_column,omitempty"`
+
+	// Name of the association, defaults to the id column without its
+	// suffix: Commentable
+	Name string `toml:"name,omitempty" json:"name,omitempty"`
+
+	Types []PolymorphicType `toml:"types,omitempty" json:"types,omitempty"`
+}
+
+// PolymorphicType is one of the tables a polymorphic association refers to
+type PolymorphicType struct {
+	// Value of the type column for rows that refer to Table
+	Value string `toml:"value,omitempty" json:"value,omitempty"`
+	Table string `toml:"table,omitempty" json:"table,omitempty"`
+
+	// Local is the name of the relationship on the model with the type and
+	// id columns, Foreign the name on the model of Table. They default to
+	// the name of the association followed by the model: CommentablePost
+	// and CommentableComments.
+	Local   string `toml:"local,omitempty" json:"local,omitempty"`
+	Foreign string `toml:"foreign,omitempty" json:"foreign,omitempty"`
+
+	// Column is the primary key of Table, it's filled in when the tables
+	// are known.
+	Column string `toml:"-" json:"-"`
+}
+
+// ConvertPolymorphic is necessary because viper
+func ConvertPolymorphic(i interface{}) []Polymorphic {
+	if i == nil {
+		return nil
+	}
+
+	var polys []Polymorphic
+	for _, pIntf := range cast.ToSlice(i) {
+		p := cast.ToStringMap(pIntf)
+
+		poly := Polymorphic{
+			Table:      cast.ToString(p["table"]),
+			TypeColumn: cast.ToString(p["type_column"]),
+			IDColumn:   cast.ToString(p["id_column"]),
+			Name:       cast.ToString(p["name"]),
+		}
+
+		for _, tIntf := range cast.ToSlice(p["types"]) {
+			t := cast.ToStringMap(tIntf)
+			poly.Types = append(poly.Types, PolymorphicType{
+				Value:   cast.ToString(t["value"]),
+				Table:   cast.ToString(t["table"]),
+				Local:   cast.ToString(t["local"]),
+				Foreign: cast.ToString(t["foreign"]),
+			})
+		}
+
+		polys = append(polys, poly)
+	}
+
+	return polys
+}
+
+// initPolymorphic checks the configured polymorphic associations against
+// the tables and fills in their names. It must run after the aliases are
+// filled in since the names are made from them.
+func (s *State) initPolymorphic() error {
+	for i := range s.Config.Polymorphic {
+		if err := resolvePolymorphic(&s.Config.Polymorphic[i], s.Tables, s.Config.Aliases); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resolvePolymorphic(p *Polymorphic, tables []drivers.Table, aliases Aliases) error {
+	if len(p.Table) == 0 || len(p.TypeColumn) == 0 || len(p.IDColumn) == 0 {
+		return errors.New("polymorphic associations need a table, type_column and id_column")
+	}
+	if len(p.Types) == 0 {
+		return errors.Errorf("polymorphic %s.%s has no types", p.Table, p.IDColumn)
+	}
+
+	table, ok := findTable(tables, p.Table)
+	if !ok {
+		return errors.Errorf("polymorphic %s.%s: table %s does not exist", p.Table, p.IDColumn, p.Table)
+	}
+
+	typeCol, ok := findColumn(table, p.TypeColumn)
+	if !ok {
+		return errors.Errorf("polymorphic %s.%s: column %s does not exist", p.Table, p.IDColumn, p.TypeColumn)
+	}
+	if !strings.HasSuffix(strings.ToLower(typeCol.Type), "string") {
+		return errors.Errorf("polymorphic %s.%s: type column %s must be a string, it's a %s", p.Table, p.IDColumn, p.TypeColumn, typeCol.Type)
+	}
+	if _, ok := findColumn(table, p.IDColumn); !ok {
+		return errors.Errorf("polymorphic %s.%s: column %s does not exist", p.Table, p.IDColumn, p.IDColumn)
+	}
+
+	if len(p.Name) == 0 {
+		p.Name = TitleCase(trimSuffixes(p.IDColumn))
+	}
+
+	local := aliases.Table(p.Table)
+	values := make(map[string]bool)
+	for i := range p.Types {
+		t := &p.Types[i]
+
+		if len(t.Value) == 0 || len(t.Table) == 0 {
+			return errors.Errorf("polymorphic %s.%s: types need a value and a table", p.Table, p.IDColumn)
+		}
+		if values[t.Value] {
+			return errors.Errorf("polymorphic %s.%s: type %q is used twice", p.Table, p.IDColumn, t.Value)
+		}
+		values[t.Value] = true
+
+		foreign, ok := findTable(tables, t.Table)
+		if !ok {
+			return errors.Errorf("polymorphic %s.%s: table %s does not exist", p.Table, p.IDColumn, t.Table)
+		}
+		if foreign.IsJoinTable || foreign.PKey == nil || len(foreign.PKey.Columns) != 1 {
+			return errors.Errorf("polymorphic %s.%s: table %s must have a single column primary key", p.Table, p.IDColumn, t.Table)
+		}
+		t.Column = foreign.PKey.Columns[0]
+
+		if len(t.Local) == 0 {
+			t.Local = p.Name + aliases.Table(t.Table).UpSingular
+		}
+		if len(t.Foreign) == 0 {
+			t.Foreign = p.Name + local.UpPlural
+		}
+	}
+
+	return nil
+}
+
+func findTable(tables []drivers.Table, name string) (drivers.Table, bool) {
+	for _, t := range tables {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return drivers.Table{}, false
+}
+
+func findColumn(table drivers.Table, name string) (drivers.Column, bool) {
+	for _, c := range table.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return drivers.Column{}, false
+}
+
+// PolymorphicTarget is a type of a polymorphic association that refers to
+// the table being generated.
+type PolymorphicTarget struct {
+	Polymorphic
+	Type PolymorphicType
+}
+
+// PolymorphicSources lists the polymorphic associations whose columns are
+// in the table being generated.
+func (t templateData) PolymorphicSources() []Polymorphic {
+	var sources []Polymorphic
+	for _, p := range t.Polymorphic {
+		if p.Table == t.Table.Name {
+			sources = append(sources, p)
+		}
+	}
+	return sources
+}
+
+// PolymorphicTargets lists the types of polymorphic associations that refer
+// to the table being generated.
+func (t templateData) PolymorphicTargets() []PolymorphicTarget {
+	var targets []PolymorphicTarget
+	for _, p := range t.Polymorphic {
+		for _, typ := range p.Types {
+			if typ.Table == t.Table.Name {
+				targets = append(targets, PolymorphicTarget{Polymorphic: p, Type: typ})
+			}
+		}
+	}
+	return targets
+}
@@ -0,0 +1,126 @@
+package boilingcore
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestConvertPolymorphic(t *testing.T) {
+	t.Parallel()
+
+	var intf interface{} = []interface{}{
+		map[string]interface{}{
+			"table":       "comments",
+			"type_column": "commentable_type",
+			"id_column":   "commentable_id",
+			"name":        "Subject",
+			"types": []interface{}{
+				map[string]interface{}{
+					"value":   "post",
+					"table":   "posts",
+					"local":   "SubjectPost",
+					"foreign": "Comments",
+				},
+			},
+		},
+	}
+
+	polys := ConvertPolymorphic(intf)
+	expect := []Polymorphic{{
+		Table:      "comments",
+		TypeColumn: "commentable_type",
+		IDColumn:   "commentable_id",
+		Name:       "Subject",
+		Types: []PolymorphicType{
+			{Value: "post", Table: "posts", Local: "SubjectPost", Foreign: "Comments"},
+		},
+	}}
+
+	if !reflect.DeepEqual(expect, polys) {
+		t.Errorf("want: %#v\ngot: %#v", expect, polys)
+	}
+	if ConvertPolymorphic(nil) != nil {
+		t.Error("nil should convert to nil")
+	}
+}
+
+func TestResolvePolymorphic(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name: "comments",
+			Columns: []drivers.Column{
+				{Name: "id", Type: "int"},
+				{Name: "commentable_type", Type: "string"},
+				{Name: "commentable_id", Type: "null.Int"},
+			},
+			PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+		},
+		{
+			Name:    "posts",
+			Columns: []drivers.Column{{Name: "post_id", Type: "int"}},
+			PKey:    &drivers.PrimaryKey{Columns: []string{"post_id"}},
+		},
+		{
+			Name:    "tags",
+			Columns: []drivers.Column{{Name: "a", Type: "int"}, {Name: "b", Type: "int"}},
+			PKey:    &drivers.PrimaryKey{Columns: []string{"a", "b"}},
+		},
+	}
+
+	aliases := Aliases{}
+	FillAliases(&aliases, tables)
+
+	p := Polymorphic{
+		Table:      "comments",
+		TypeColumn: "commentable_type",
+		IDColumn:   "commentable_id",
+		Types:      []PolymorphicType{{Value: "post", Table: "posts"}},
+	}
+	if err := resolvePolymorphic(&p, tables, aliases); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Name != "Commentable" {
+		t.Error("name was wrong:", p.Name)
+	}
+	expect := PolymorphicType{Value: "post", Table: "posts", Local: "CommentablePost", Foreign: "CommentableComments", Column: "post_id"}
+	if p.Types[0] != expect {
+		t.Errorf("want: %#v\ngot: %#v", expect, p.Types[0])
+	}
+
+	tests := []struct {
+		Change func(p *Polymorphic)
+		Err    string
+	}{
+		{func(p *Polymorphic) { p.TypeColumn = "" }, "need a table"},
+		{func(p *Polymorphic) { p.Types = nil }, "has no types"},
+		{func(p *Polymorphic) { p.Table = "videos" }, "table videos does not exist"},
+		{func(p *Polymorphic) { p.TypeColumn = "kind" }, "column kind does not exist"},
+		{func(p *Polymorphic) { p.TypeColumn = "id" }, "must be a string"},
+		{func(p *Polymorphic) { p.IDColumn = "post_id" }, "column post_id does not exist"},
+		{func(p *Polymorphic) { p.Types[0].Value = "" }, "need a value and a table"},
+		{func(p *Polymorphic) { p.Types = append(p.Types, PolymorphicType{Value: "post", Table: "posts"}) }, "used twice"},
+		{func(p *Polymorphic) { p.Types[0].Table = "videos" }, "table videos does not exist"},
+		{func(p *Polymorphic) { p.Types[0].Table = "tags" }, "single column primary key"},
+	}
+
+	for i, test := range tests {
+		p := Polymorphic{
+			Table:      "comments",
+			TypeColumn: "commentable_type",
+			IDColumn:   "commentable_id",
+			Types:      []PolymorphicType{{Value: "post", Table: "posts"}},
+		}
+		test.Change(&p)
+
+		err := resolvePolymorphic(&p, tables, aliases)
+		if err == nil || !strings.Contains(err.Error(), test.Err) {
+			t.Errorf("%d) want an error containing %q, got: %v", i, test.Err, err)
+		}
+	}
+}
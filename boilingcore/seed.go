@@ -0,0 +1,661 @@
+package boilingcore
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	vrandomize "github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/randomize"
+	"github.com/volatiletech/strmangle"
+)
+
+// seedBatchSize is the most rows a single INSERT statement of SeedSQL adds
+const seedBatchSize = 100
+
+var (
+	rgxSeedLength = regexp.MustCompile(`\((\d+)\)`)
+
+	seedEpoch = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	seedFirstNames = []string{"Ada", "Alan", "Barbara", "Dennis", "Edsger", "Frances", "Grace", "Ken", "Linus", "Margaret", "Radia", "Tim"}
+	seedLastNames  = []string{"Allen", "Hamilton", "Hopper", "Kernighan", "Knuth", "Liskov", "Lovelace", "Perlman", "Ritchie", "Thompson", "Torvalds", "Turing"}
+	seedCities     = []string{"Amsterdam", "Berlin", "Chicago", "Lagos", "Lima", "Melbourne", "Montreal", "Nairobi", "Osaka", "Seoul", "Toronto", "Vienna"}
+	seedWords      = []string{"amber", "brisk", "cedar", "delta", "ember", "fable", "gamma", "harbor", "ivory", "jade", "kite", "lumen", "maple", "nova", "orbit", "pixel", "quartz", "river", "summit", "tango", "umbra", "velvet", "willow", "zephyr"}
+)
+
+// ParseSeedRows parses the table=count pairs given to the seed command.
+func ParseSeedRows(pairs []string) (map[string]int, error) {
+	rows := make(map[string]int, len(pairs))
+	for _, pair := range pairs {
+		i := strings.IndexByte(pair, '=')
+		if i <= 0 {
+			return nil, errors.Errorf("rows should be table=count, got %q", pair)
+		}
+
+		n, err := strconv.Atoi(pair[i+1:])
+		if err != nil || n <= 0 {
+			return nil, errors.Errorf("rows of %s should be a positive number, got %q", pair[:i], pair[i+1:])
+		}
+		rows[pair[:i]] = n
+	}
+
+	return rows, nil
+}
+
+// SchemaSeed returns the statements that fill the tables of the schema
+// snapshot at path, or of the database when path is empty, with fake data.
+// See SeedSQL.
+func SchemaSeed(config *Config, path string, rows map[string]int, start int64) ([]string, error) {
+	dbInfo, err := loadSchema(config, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return SeedSQL(dbInfo, rows, start)
+}
+
+// SeedSQL returns INSERT statements that add rows[table] rows of fake data to
+// each table, for load testing and demos. The values are derived from start,
+// the same start gives the same values.
+//
+// Tables are filled after the tables their foreign keys refer to, and foreign
+// key columns select a random row of the referenced table, so they can refer
+// to rows that were there before as well. Unique foreign keys and tables
+// whose primary key is made of foreign keys, like join tables, use every
+// referenced row once, so they need at least as many rows in the referenced
+// tables. Auto incremented and generated columns are left to the database,
+// as are columns of types fake values can't be made for if they have a
+// default or are nullable.
+func SeedSQL(dbInfo *drivers.DBInfo, rows map[string]int, start int64) ([]string, error) {
+	tables := make(map[string]drivers.Table, len(dbInfo.Tables))
+	for _, t := range dbInfo.Tables {
+		tables[t.Name] = t
+	}
+	for name := range rows {
+		if _, ok := tables[name]; !ok {
+			return nil, errors.Errorf("unable to seed %s: no such table", name)
+		}
+	}
+
+	s := &seeder{
+		migrator: migrator{dialect: dbInfo.Dialect, schema: dbInfo.Schema},
+		rows:     rows,
+		seed:     randomize.NewSeedFrom(start),
+		rnd:      rand.New(rand.NewSource(start)),
+	}
+
+	var stmts []string
+	for _, name := range seedOrder(tables, rows) {
+		tableStmts, err := s.insert(tables[name], rows[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to seed %s", name)
+		}
+
+		stmts = append(stmts, fmt.Sprintf("-- %d rows for %s", rows[name], name))
+		stmts = append(stmts, tableStmts...)
+	}
+
+	return stmts, nil
+}
+
+// seedOrder sorts the tables to seed so the tables referenced by foreign keys
+// come first, by name where the order doesn't matter. Tables in a cycle are
+// taken by name as well, their foreign keys refer to rows that are there
+// already, or are null.
+func seedOrder(tables map[string]drivers.Table, rows map[string]int) []string {
+	deps := make(map[string]map[string]bool, len(rows))
+	for name := range rows {
+		deps[name] = make(map[string]bool)
+		t := tables[name]
+		for _, fk := range t.FKeys {
+			if _, ok := rows[fk.ForeignTable]; ok && fk.ForeignTable != name {
+				deps[name][fk.ForeignTable] = true
+			}
+		}
+		for _, fk := range t.CompositeFKeys {
+			if _, ok := rows[fk.ForeignTable]; ok && fk.ForeignTable != name {
+				deps[name][fk.ForeignTable] = true
+			}
+		}
+	}
+
+	remaining := make([]string, 0, len(rows))
+	for name := range rows {
+		remaining = append(remaining, name)
+	}
+	sort.Strings(remaining)
+
+	order := make([]string, 0, len(rows))
+	for len(remaining) != 0 {
+		next := 0
+		for i, name := range remaining {
+			if len(deps[name]) == 0 {
+				next = i
+				break
+			}
+		}
+
+		name := remaining[next]
+		remaining = append(remaining[:next], remaining[next+1:]...)
+		order = append(order, name)
+		for _, d := range deps {
+			delete(d, name)
+		}
+	}
+
+	return order
+}
+
+type seeder struct {
+	migrator
+
+	rows map[string]int
+	seed *randomize.Seed
+	rnd  *rand.Rand
+}
+
+// seedValue makes the SQL for the value of a column in row i of the rows
+// being inserted.
+type seedValue func(i int) string
+
+func (s *seeder) insert(t drivers.Table, n int) ([]string, error) {
+	if fkeys, ok := seedKeyedByForeignKeys(t); ok {
+		stmt, err := s.crossJoin(t, n, fkeys)
+		if err != nil {
+			return nil, err
+		}
+		return []string{stmt}, nil
+	}
+
+	var columns []string
+	var values []seedValue
+	for _, c := range t.Columns {
+		value, err := s.column(t, c)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			columns = append(columns, c.Name)
+			values = append(values, value)
+		}
+	}
+
+	var stmts []string
+	if len(columns) == 0 {
+		insert := "INSERT INTO " + s.table(t.Name) + " DEFAULT VALUES"
+		if s.dialect.Name == "mysql" {
+			insert = "INSERT INTO " + s.table(t.Name) + " () VALUES ()"
+		}
+		for i := 0; i < n; i++ {
+			stmts = append(stmts, insert)
+		}
+		return stmts, nil
+	}
+
+	insert := "INSERT INTO " + s.table(t.Name) + " (" + s.quote(columns...) + ") VALUES\n  "
+	for first := 0; first < n; first += seedBatchSize {
+		last := first + seedBatchSize
+		if last > n {
+			last = n
+		}
+
+		tuples := make([]string, 0, last-first)
+		row := make([]string, len(values))
+		for i := first; i < last; i++ {
+			for j, value := range values {
+				row[j] = value(i)
+			}
+			tuples = append(tuples, "("+strings.Join(row, ", ")+")")
+		}
+		stmts = append(stmts, insert+strings.Join(tuples, ",\n  "))
+	}
+
+	return stmts, nil
+}
+
+// crossJoin inserts n distinct combinations of the rows referenced by the
+// foreign keys that make up the primary key of t, the other columns are the
+// same in every row.
+func (s *seeder) crossJoin(t drivers.Table, n int, fkeys []drivers.ForeignKey) (string, error) {
+	var columns, exprs, from []string
+	for i, fk := range fkeys {
+		alias := "s" + strconv.Itoa(i+1)
+		columns = append(columns, fk.Column)
+		exprs = append(exprs, alias+"."+s.dialect.QuoteIdent(fk.ForeignColumn))
+		from = append(from, s.table(fk.ForeignTable)+" AS "+alias)
+	}
+
+	for _, c := range t.Columns {
+		if seedInPKey(t, c.Name) {
+			continue
+		}
+		value, err := s.column(t, c)
+		if err != nil {
+			return "", err
+		}
+		if value != nil {
+			columns = append(columns, c.Name)
+			exprs = append(exprs, value(0))
+		}
+	}
+
+	query := "SELECT " + strings.Join(exprs, ", ") + " FROM " + strings.Join(from, " CROSS JOIN ") + " ORDER BY " + s.random()
+	if s.dialect.Name == "mssql" {
+		query = fmt.Sprintf("SELECT TOP %d ", n) + query[len("SELECT "):]
+	} else {
+		query += fmt.Sprintf(" LIMIT %d", n)
+	}
+
+	return "INSERT INTO " + s.table(t.Name) + " (" + s.quote(columns...) + ")\n" + query, nil
+}
+
+// seedKeyedByForeignKeys returns the foreign keys of the columns of t's
+// primary key if there are several columns and all of them are foreign keys.
+func seedKeyedByForeignKeys(t drivers.Table) ([]drivers.ForeignKey, bool) {
+	if t.PKey == nil || len(t.PKey.Columns) < 2 {
+		return nil, false
+	}
+
+	fkeys := make([]drivers.ForeignKey, 0, len(t.PKey.Columns))
+	for _, name := range t.PKey.Columns {
+		fk, ok := seedForeignKey(t, name)
+		if !ok {
+			return nil, false
+		}
+		fkeys = append(fkeys, fk)
+	}
+
+	return fkeys, true
+}
+
+func seedInPKey(t drivers.Table, column string) bool {
+	if t.PKey == nil {
+		return false
+	}
+	for _, name := range t.PKey.Columns {
+		if name == column {
+			return true
+		}
+	}
+	return false
+}
+
+func seedForeignKey(t drivers.Table, column string) (drivers.ForeignKey, bool) {
+	for _, fk := range t.FKeys {
+		if fk.Column == column {
+			return fk, true
+		}
+	}
+	return drivers.ForeignKey{}, false
+}
+
+// column returns how the values of c are made, nil when c is left to the
+// database.
+func (s *seeder) column(t drivers.Table, c drivers.Column) (seedValue, error) {
+	if seedAutoColumn(c) {
+		return nil, nil
+	}
+
+	unique := c.Unique || seedInPKey(t, c.Name)
+
+	for _, fk := range t.CompositeFKeys {
+		for j, name := range fk.Columns {
+			if name == c.Name {
+				return s.compositeForeignKey(fk, j), nil
+			}
+		}
+	}
+
+	if fk, ok := seedForeignKey(t, c.Name); ok {
+		switch {
+		case fk.ForeignTable == t.Name && c.Nullable:
+			// MySQL can't select from the table being inserted into
+			return seedNull, nil
+		case unique || fk.Unique:
+			return func(i int) string {
+				return s.pick(fk.ForeignTable, fk.ForeignColumn, s.quote(fk.ForeignColumn), i)
+			}, nil
+		default:
+			return func(int) string {
+				return s.pick(fk.ForeignTable, fk.ForeignColumn, s.random(), -1)
+			}, nil
+		}
+	}
+
+	value := s.value(c, unique)
+	switch {
+	case value != nil && c.Nullable && !unique:
+		return func(i int) string {
+			if s.rnd.Intn(10) == 0 {
+				return "NULL"
+			}
+			return value(i)
+		}, nil
+	case value != nil:
+		return value, nil
+	case len(c.Default) != 0:
+		return nil, nil
+	case c.Nullable:
+		return seedNull, nil
+	}
+
+	return nil, errors.Errorf("unable to make values for column %s of type %s, give it a default or make it nullable", c.Name, c.DBType)
+}
+
+// compositeForeignKey refers to the same row of the foreign table with every
+// column of fk, j is the index of the column.
+func (s *seeder) compositeForeignKey(fk drivers.CompositeForeignKey, j int) seedValue {
+	order := s.quote(fk.ForeignColumns...)
+	return func(i int) string {
+		offset := i
+		if !fk.Unique {
+			// Spread over the rows being seeded in the foreign table, the
+			// same for every column of the row
+			offset = i * 7919 % s.foreignRows(fk.ForeignTable)
+		}
+		return s.pick(fk.ForeignTable, fk.ForeignColumns[j], order, offset)
+	}
+}
+
+func (s *seeder) foreignRows(table string) int {
+	if n := s.rows[table]; n > 0 {
+		return n
+	}
+	return 1
+}
+
+func seedNull(int) string {
+	return "NULL"
+}
+
+// seedAutoColumn reports whether the database fills c in itself.
+func seedAutoColumn(c drivers.Column) bool {
+	if c.AutoGenerated {
+		return true
+	}
+
+	switch {
+	case c.Default == "IDENTITY", c.Default == "auto_increment", c.Default == "auto":
+		return true
+	case strings.HasPrefix(c.Default, "nextval("):
+		return true
+	}
+
+	return false
+}
+
+// pick selects column from one row of table in order, the row at offset or
+// a random row when offset is negative.
+func (s *seeder) pick(table, column, order string, offset int) string {
+	column = s.dialect.QuoteIdent(column)
+	table = s.table(table)
+
+	switch {
+	case s.dialect.Name == "mssql" && offset < 0:
+		return "(SELECT TOP 1 " + column + " FROM " + table + " ORDER BY " + order + ")"
+	case s.dialect.Name == "mssql":
+		return fmt.Sprintf("(SELECT %s FROM %s ORDER BY %s OFFSET %d ROWS FETCH NEXT 1 ROWS ONLY)", column, table, order, offset)
+	case offset < 0:
+		return "(SELECT " + column + " FROM " + table + " ORDER BY " + order + " LIMIT 1)"
+	}
+
+	return fmt.Sprintf("(SELECT %s FROM %s ORDER BY %s LIMIT 1 OFFSET %d)", column, table, order, offset)
+}
+
+// random is the function that orders rows randomly in the dialect
+func (s *seeder) random() string {
+	switch s.dialect.Name {
+	case "mysql":
+		return "RAND()"
+	case "mssql":
+		return "NEWID()"
+	}
+	return "random()"
+}
+
+// value returns how fake values of c are made based on its type, nil if it
+// has a type that isn't supported.
+func (s *seeder) value(c drivers.Column, unique bool) seedValue {
+	typ := strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(c.Type, "*"), "null."))
+	dbType := strings.ToLower(c.DBType)
+
+	if len(c.CheckValues) != 0 {
+		return func(int) string {
+			v := c.CheckValues[s.rnd.Intn(len(c.CheckValues))]
+			if _, err := strconv.ParseFloat(v, 64); err == nil && typ != "string" {
+				return v
+			}
+			return s.dialect.QuoteLiteral(v)
+		}
+	}
+
+	switch typ {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		max := seedIntMax(typ, dbType)
+		small := max
+		if small > 1000 {
+			small = 1000
+		}
+		return func(int) string {
+			if unique {
+				return strconv.FormatInt(1+s.seed.NextInt()%max, 10)
+			}
+			return strconv.FormatInt(1+s.rnd.Int63n(small), 10)
+		}
+	case "float32", "float64", "types.decimal", "types.nulldecimal":
+		return func(int) string {
+			if unique {
+				return strconv.FormatInt(s.seed.NextInt()%100000, 10) + ".00"
+			}
+			return strconv.FormatFloat(math.Round(s.rnd.Float64()*100000)/100, 'f', 2, 64)
+		}
+	case "bool":
+		return func(int) string {
+			if s.dialect.Name == "mssql" {
+				return strconv.Itoa(s.rnd.Intn(2))
+			}
+			return strings.ToUpper(strconv.FormatBool(s.rnd.Intn(2) == 0))
+		}
+	case "time", "time.time":
+		if strings.Contains(dbType, "date") && !strings.Contains(dbType, "time") {
+			return func(int) string {
+				return s.dialect.QuoteLiteral(vrandomize.Date(s.seed.NextInt).Format("2006-01-02"))
+			}
+		}
+		return func(int) string {
+			t := seedEpoch.Add(time.Duration(s.rnd.Int63n(5*365*24*60*60)) * time.Second)
+			return s.dialect.QuoteLiteral(t.Format("2006-01-02 15:04:05"))
+		}
+	case "[]byte", "bytes":
+		return func(int) string {
+			b := make([]byte, 16)
+			s.rnd.Read(b)
+			return s.dialect.BytesLiteral(b)
+		}
+	case "byte", "types.byte":
+		return func(int) string {
+			return s.dialect.QuoteLiteral(string(rune('a' + s.rnd.Intn(26))))
+		}
+	case "json", "types.json":
+		return func(int) string {
+			return s.dialect.QuoteLiteral("{}")
+		}
+	case "string":
+		return s.text(c, unique)
+	}
+
+	if s.dialect.Name == "psql" && strings.HasSuffix(typ, "array") {
+		return func(int) string {
+			return s.dialect.QuoteLiteral("{}")
+		}
+	}
+
+	return nil
+}
+
+func seedIntMax(typ, dbType string) int64 {
+	if strings.HasPrefix(dbType, "mediumint") {
+		if strings.HasPrefix(typ, "u") {
+			return 16777215
+		}
+		return 8388607
+	}
+
+	switch typ {
+	case "int8":
+		return math.MaxInt8
+	case "uint8":
+		return math.MaxUint8
+	case "int16":
+		return math.MaxInt16
+	case "uint16":
+		return math.MaxUint16
+	case "int", "int32":
+		return math.MaxInt32
+	case "uint", "uint32":
+		return math.MaxUint32
+	}
+	return math.MaxInt64
+}
+
+// text returns how fake strings for c are made, guessing what kind of text
+// it holds from its name.
+func (s *seeder) text(c drivers.Column, unique bool) seedValue {
+	dbType := strings.ToLower(c.DBType)
+
+	if vals := strmangle.ParseEnumVals(c.DBType); len(vals) != 0 {
+		return func(int) string {
+			return s.dialect.QuoteLiteral(vals[s.rnd.Intn(len(vals))])
+		}
+	}
+
+	switch dbType {
+	case "uuid", "uniqueidentifier":
+		return func(int) string {
+			b := make([]byte, 16)
+			s.rnd.Read(b)
+			b[6] = b[6]&0x0f | 0x40
+			b[8] = b[8]&0x3f | 0x80
+			return s.dialect.QuoteLiteral(fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]))
+		}
+	case "json", "jsonb":
+		return func(int) string {
+			return s.dialect.QuoteLiteral("{}")
+		}
+	}
+
+	if !strings.HasPrefix(dbType, "enum") {
+		if _, ok := vrandomize.FormattedString(s.seed.NextInt, dbType); ok {
+			return func(int) string {
+				v, _ := vrandomize.FormattedString(s.seed.NextInt, dbType)
+				return s.dialect.QuoteLiteral(v)
+			}
+		}
+	}
+
+	maxLen := 0
+	if m := rgxSeedLength.FindStringSubmatch(c.FullDBType + c.DBType); m != nil {
+		maxLen, _ = strconv.Atoi(m[1])
+	}
+
+	fake := s.fakeText(strings.ToLower(c.Name))
+	return func(int) string {
+		var n string
+		if unique {
+			n = strconv.FormatInt(s.seed.NextInt(), 10)
+		}
+		v := fake(n)
+		if maxLen > 0 && len(v) > maxLen {
+			if unique {
+				v = v[len(v)-maxLen:]
+			} else {
+				v = v[:maxLen]
+			}
+		}
+		return s.dialect.QuoteLiteral(v)
+	}
+}
+
+// fakeText returns a function making text for a column called name, which
+// includes n when it's given to make it unique.
+func (s *seeder) fakeText(name string) func(n string) string {
+	has := func(words ...string) bool {
+		for _, w := range words {
+			if strings.Contains(name, w) {
+				return true
+			}
+		}
+		return false
+	}
+	one := func(list []string) string {
+		return list[s.rnd.Intn(len(list))]
+	}
+	words := func(min, max int) []string {
+		ws := make([]string, min+s.rnd.Intn(max-min+1))
+		for i := range ws {
+			ws[i] = one(seedWords)
+		}
+		return ws
+	}
+
+	switch {
+	case has("email"):
+		return func(n string) string {
+			return strings.ToLower(one(seedFirstNames)+"."+one(seedLastNames)) + n + "@example.com"
+		}
+	case has("phone"):
+		return func(n string) string {
+			return withSuffix(fmt.Sprintf("555-%04d", s.rnd.Intn(10000)), n)
+		}
+	case has("url", "website", "link"):
+		return func(n string) string {
+			return withSuffix("https://example.com/"+one(seedWords), n)
+		}
+	case has("first_name", "firstname", "given_name"):
+		return func(n string) string { return withSuffix(one(seedFirstNames), n) }
+	case has("last_name", "lastname", "surname", "family_name"):
+		return func(n string) string { return withSuffix(one(seedLastNames), n) }
+	case has("username", "login", "handle", "slug"):
+		return func(n string) string {
+			return withSuffix(strings.ToLower(one(seedFirstNames))+"_"+one(seedWords), n)
+		}
+	case has("city"):
+		return func(n string) string { return withSuffix(one(seedCities), n) }
+	case has("name"):
+		return func(n string) string {
+			return withSuffix(one(seedFirstNames)+" "+one(seedLastNames), n)
+		}
+	case has("title", "subject", "headline"):
+		return func(n string) string {
+			ws := words(2, 4)
+			for i, w := range ws {
+				ws[i] = strings.ToUpper(w[:1]) + w[1:]
+			}
+			return withSuffix(strings.Join(ws, " "), n)
+		}
+	case has("description", "body", "content", "text", "bio", "summary", "comment", "note", "message"):
+		return func(n string) string {
+			sentence := withSuffix(strings.Join(words(6, 12), " "), n)
+			return strings.ToUpper(sentence[:1]) + sentence[1:] + "."
+		}
+	}
+
+	return func(n string) string {
+		return withSuffix(strings.Join(words(2, 2), " "), n)
+	}
+}
+
+func withSuffix(s, n string) string {
+	if len(n) == 0 {
+		return s
+	}
+	return s + "-" + n
+}
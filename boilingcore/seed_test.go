@@ -0,0 +1,181 @@
+package boilingcore
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func seedTestDBInfo() *drivers.DBInfo {
+	return &drivers.DBInfo{
+		Schema:  "public",
+		Dialect: drivers.Dialect{Name: "psql", LQ: '"', RQ: '"', UseSchema: true},
+		Tables: []drivers.Table{
+			{
+				Name: "orders",
+				Columns: []drivers.Column{
+					{Name: "id", Type: "int", DBType: "integer", Default: "nextval('orders_id_seq'::regclass)"},
+					{Name: "user_id", Type: "int", DBType: "integer"},
+					{Name: "status", Type: "string", DBType: "text", CheckValues: []string{"open", "paid"}},
+					{Name: "total", Type: "types.Decimal", DBType: "numeric"},
+					{Name: "created_at", Type: "time.Time", DBType: "timestamp with time zone", Default: "now()"},
+					{Name: "location", Type: "pgeo.Point", DBType: "point", Nullable: true},
+				},
+				PKey:  &drivers.PrimaryKey{Columns: []string{"id"}},
+				FKeys: []drivers.ForeignKey{{Name: "orders_user_id_fkey", Column: "user_id", ForeignTable: "users", ForeignColumn: "id"}},
+			},
+			{
+				Name: "users",
+				Columns: []drivers.Column{
+					{Name: "id", Type: "int", DBType: "integer", Default: "nextval('users_id_seq'::regclass)"},
+					{Name: "email", Type: "string", DBType: "character varying", Unique: true},
+					{Name: "name", Type: "null.String", DBType: "text", Nullable: true},
+					{Name: "admin", Type: "bool", DBType: "boolean"},
+				},
+				PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+			},
+			{
+				Name: "user_groups",
+				Columns: []drivers.Column{
+					{Name: "user_id", Type: "int", DBType: "integer"},
+					{Name: "group_id", Type: "int", DBType: "integer"},
+				},
+				PKey: &drivers.PrimaryKey{Columns: []string{"user_id", "group_id"}},
+				FKeys: []drivers.ForeignKey{
+					{Name: "user_groups_user_id_fkey", Column: "user_id", ForeignTable: "users", ForeignColumn: "id"},
+					{Name: "user_groups_group_id_fkey", Column: "group_id", ForeignTable: "groups", ForeignColumn: "id"},
+				},
+				IsJoinTable: true,
+			},
+		},
+	}
+}
+
+func TestParseSeedRows(t *testing.T) {
+	t.Parallel()
+
+	rows, err := ParseSeedRows([]string{"users=1000", "orders=5000"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := map[string]int{"users": 1000, "orders": 5000}; !reflect.DeepEqual(rows, expect) {
+		t.Errorf("want: %v, got: %v", expect, rows)
+	}
+
+	for _, bad := range []string{"users", "=10", "users=", "users=0", "users=ten"} {
+		if _, err := ParseSeedRows([]string{bad}); err == nil {
+			t.Errorf("%q should be an error", bad)
+		}
+	}
+}
+
+func TestSeedSQL(t *testing.T) {
+	t.Parallel()
+
+	rows := map[string]int{"orders": 150, "users": 3, "user_groups": 2}
+	stmts, err := SeedSQL(seedTestDBInfo(), rows, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var comments []string
+	for _, stmt := range stmts {
+		if strings.HasPrefix(stmt, "--") {
+			comments = append(comments, stmt)
+		}
+	}
+	expectComments := []string{"-- 3 rows for users", "-- 150 rows for orders", "-- 2 rows for user_groups"}
+	if !reflect.DeepEqual(comments, expectComments) {
+		t.Errorf("want: %q\ngot: %q", expectComments, comments)
+	}
+	if len(stmts) != 7 {
+		t.Fatalf("want 7 statements, got %d:\n%s", len(stmts), strings.Join(stmts, "\n"))
+	}
+
+	users := stmts[1]
+	if !strings.HasPrefix(users, `INSERT INTO "public"."users" ("email", "name", "admin") VALUES`) {
+		t.Errorf("wrong users insert:\n%s", users)
+	}
+	if n := strings.Count(users, "@example.com"); n != 3 {
+		t.Errorf("want 3 emails, got %d:\n%s", n, users)
+	}
+
+	orders := stmts[3]
+	if !strings.HasPrefix(orders, `INSERT INTO "public"."orders" ("user_id", "status", "total", "created_at", "location") VALUES`) {
+		t.Errorf("wrong orders insert:\n%s", orders)
+	}
+	if n := strings.Count(orders, `(SELECT "id" FROM "public"."users" ORDER BY random() LIMIT 1)`); n != 100 {
+		t.Errorf("want a user for each of the 100 orders of the first batch, got %d", n)
+	}
+	if strings.Count(stmts[4], "\n") != 50 {
+		t.Errorf("want 50 orders in the second batch:\n%s", stmts[4])
+	}
+
+	expectJoin := `INSERT INTO "public"."user_groups" ("user_id", "group_id")` + "\n" +
+		`SELECT s1."id", s2."id" FROM "public"."users" AS s1 CROSS JOIN "public"."groups" AS s2 ORDER BY random() LIMIT 2`
+	if stmts[6] != expectJoin {
+		t.Errorf("want:\n%s\ngot:\n%s", expectJoin, stmts[6])
+	}
+
+	again, err := SeedSQL(seedTestDBInfo(), rows, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(stmts, again) {
+		t.Error("the same start should give the same statements")
+	}
+}
+
+func TestSeedSQLErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := SeedSQL(seedTestDBInfo(), map[string]int{"pilots": 1}, 1); err == nil {
+		t.Error("want an error for an unknown table")
+	}
+
+	dbInfo := seedTestDBInfo()
+	dbInfo.Tables[0].Columns[5].Nullable = false
+	if _, err := SeedSQL(dbInfo, map[string]int{"orders": 1}, 1); err == nil {
+		t.Error("want an error for a column no values can be made for")
+	}
+}
+
+func TestSeedSQLUniqueForeignKey(t *testing.T) {
+	t.Parallel()
+
+	dbInfo := &drivers.DBInfo{
+		Dialect: drivers.Dialect{Name: "mssql", LQ: '[', RQ: ']'},
+		Tables: []drivers.Table{
+			{
+				Name: "profiles",
+				Columns: []drivers.Column{
+					{Name: "user_id", Type: "int", DBType: "int"},
+					{Name: "bio", Type: "string", DBType: "nvarchar", FullDBType: "nvarchar(10)"},
+				},
+				PKey:  &drivers.PrimaryKey{Columns: []string{"user_id"}},
+				FKeys: []drivers.ForeignKey{{Column: "user_id", ForeignTable: "users", ForeignColumn: "id", Unique: true}},
+			},
+		},
+	}
+
+	stmts, err := SeedSQL(dbInfo, map[string]int{"profiles": 2}, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(stmts[1], "\n")
+	if len(lines) != 3 {
+		t.Fatalf("want 2 rows:\n%s", stmts[1])
+	}
+	for i, line := range lines[1:] {
+		expect := "((SELECT [id] FROM [users] ORDER BY [id] OFFSET " + string(rune('0'+i)) + " ROWS FETCH NEXT 1 ROWS ONLY), '"
+		if !strings.HasPrefix(strings.TrimSpace(line), expect) {
+			t.Errorf("want row %d to start with %s, got %s", i, expect, line)
+		}
+		if bio := line[strings.Index(line, ", '")+3 : strings.LastIndex(line, "'")]; len(bio) > 10 {
+			t.Errorf("bio should be cut to 10 characters, got %q", bio)
+		}
+	}
+}
@@ -6,9 +6,11 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"text/template"
 
 	"github.com/friendsofgo/errors"
@@ -19,9 +21,10 @@ import (
 
 // templateData for sqlboiler templates
 type templateData struct {
-	Tables  []drivers.Table
-	Table   drivers.Table
-	Aliases Aliases
+	Tables    []drivers.Table
+	Table     drivers.Table
+	Aliases   Aliases
+	Sequences []drivers.Sequence
 
 	// Controls what names are output
 	PkgName string
@@ -46,6 +49,7 @@ type templateData struct {
 	NoRowsAffected    bool
 	NoDriverTemplates bool
 	NoBackReferencing bool
+	NullablePointers  bool
 
 	// Tags control which tags are added to the struct
 	Tags []string
@@ -59,11 +63,50 @@ type templateData struct {
 	// Contains field names that should have tags values set to '-'
 	TagIgnore map[string]struct{}
 
+	// Casing of the json struct tags, StructTagCasing is used if it's empty
+	JSONTagCasing string
+	// Don't add omitempty to the json tags of nullable columns
+	NoJSONOmitEmpty bool
+	// Contains field names that should have their json tag set to '-'
+	JSONIgnore map[string]struct{}
+
+	// Generate xml struct tags
+	AddXMLTags bool
+	// Contains field names that are xml attributes instead of elements
+	XMLAttributes map[string]struct{}
+
+	// Generate MarshalBinary and UnmarshalBinary methods
+	AddBinaryMarshal bool
+
+	// Generate validate struct tags from the column constraints
+	AddValidateTags bool
+
+	// Generate parquet schemas and WriteParquet methods
+	AddParquet bool
+
+	// Generate batch functions for dataloaders
+	AddDataloaders bool
+
+	// Generate parsers turning query parameters into query mods
+	AddQueryFilters bool
+
+	// Generate repository interfaces and their implementations
+	AddRepositories bool
+
+	// Tables whose changes the hooks record in an audit table
+	AuditTables []string
+	// Tables whose changes the hooks publish as events to the outbox
+	OutboxTables []string
+
 	// OutputDirDepth is used to find sqlboiler config file
 	OutputDirDepth int
 
+	// Polymorphic associations configured by the user
+	Polymorphic []Polymorphic
+
 	// Hacky state for where clauses to avoid having to do type-based imports
-	// for singletons
+	// for singletons. Tables are generated concurrently, each gets the types
+	// of the columns of the tables before it.
 	DBTypes once
 
 	// StringFuncs are usable in templates with stringMap
@@ -78,6 +121,78 @@ func (t templateData) SchemaTable(table string) string {
 	return strmangle.SchemaTable(t.LQ, t.RQ, t.Dialect.UseSchema, t.Schema, table)
 }
 
+// WhereClause is the dialect's where clause for cols with placeholders
+// starting at start, quoted so it can be embedded in a Go string literal.
+func (t templateData) WhereClause(start int, cols []string) string {
+	where := make([]string, len(cols))
+	for i, c := range cols {
+		where[i] = t.Quotes(c) + "=" + t.Dialect.Placeholder(start+i)
+	}
+	return strings.Join(where, " AND ")
+}
+
+// CompositeFKeysTo lists the foreign keys over several columns that refer
+// to the table being generated.
+func (t templateData) CompositeFKeysTo() []drivers.CompositeForeignKey {
+	var fkeys []drivers.CompositeForeignKey
+	for _, table := range t.Tables {
+		for _, fk := range table.CompositeFKeys {
+			if fk.ForeignTable == t.Table.Name {
+				fkeys = append(fkeys, fk)
+			}
+		}
+	}
+	return fkeys
+}
+
+// CascadeChild is a foreign key whose rows have to be deleted before the
+// row of the table being generated that they refer to. Columns are in Table,
+// Columns[i] refers to ForeignColumns[i] in the table being generated.
+type CascadeChild struct {
+	Name           string
+	Table          string
+	Columns        []string
+	ForeignColumns []string
+}
+
+// CascadeChildren lists the foreign keys of other models that refer to the
+// table being generated. Join tables are left out, their rows are deleted
+// through the to many relationships.
+func (t templateData) CascadeChildren() []CascadeChild {
+	var children []CascadeChild
+	for _, rel := range t.Table.ToOneRelationships {
+		children = append(children, CascadeChild{
+			Name:           rel.Name,
+			Table:          rel.ForeignTable,
+			Columns:        []string{rel.ForeignColumn},
+			ForeignColumns: []string{rel.Column},
+		})
+	}
+	for _, rel := range t.Table.ToManyRelationships {
+		if rel.ToJoinTable {
+			continue
+		}
+		children = append(children, CascadeChild{
+			Name:           rel.Name,
+			Table:          rel.ForeignTable,
+			Columns:        []string{rel.ForeignColumn},
+			ForeignColumns: []string{rel.Column},
+		})
+	}
+	for _, fk := range t.CompositeFKeysTo() {
+		if drivers.GetTable(t.Tables, fk.Table).IsJoinTable {
+			continue
+		}
+		children = append(children, CascadeChild{
+			Name:           fk.Name,
+			Table:          fk.Table,
+			Columns:        fk.Columns,
+			ForeignColumns: fk.ForeignColumns,
+		})
+	}
+	return children
+}
+
 type templateList struct {
 	*template.Template
 }
@@ -126,16 +241,37 @@ func (t templateList) Templates() []string {
 	return ret
 }
 
-func loadTemplates(lazyTemplates []lazyTemplate, testTemplates bool) (*templateList, error) {
-	tpl := template.New("")
+// parsedTemplates keeps the template lists loadTemplates parsed by what they
+// were loaded from, so generating more than once in a process, eg. through
+// the library, parses each set of templates only once.
+var parsedTemplates = struct {
+	sync.Mutex
+	lists map[string]*templateList
+}{lists: make(map[string]*templateList)}
 
+func loadTemplates(lazyTemplates []lazyTemplate, testTemplates bool) (*templateList, error) {
+	var included []lazyTemplate
 	for _, t := range lazyTemplates {
 		firstDir := strings.Split(t.Name, string(filepath.Separator))[0]
 		isTest := strings.HasSuffix(firstDir, "_test")
 		if testTemplates && !isTest || !testTemplates && isTest {
 			continue
 		}
+		included = append(included, t)
+	}
 
+	key, cacheable := templateListKey(included)
+	if cacheable {
+		parsedTemplates.Lock()
+		list, ok := parsedTemplates.lists[key]
+		parsedTemplates.Unlock()
+		if ok {
+			return list, nil
+		}
+	}
+
+	tpl := template.New("")
+	for _, t := range included {
 		byt, err := t.Loader.Load()
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to load template: %s", t.Name)
@@ -147,7 +283,41 @@ func loadTemplates(lazyTemplates []lazyTemplate, testTemplates bool) (*templateL
 		}
 	}
 
-	return &templateList{Template: tpl}, nil
+	list := &templateList{Template: tpl}
+	if cacheable {
+		parsedTemplates.Lock()
+		parsedTemplates.lists[key] = list
+		parsedTemplates.Unlock()
+	}
+
+	return list, nil
+}
+
+// templateListKey identifies the contents of templates without loading
+// them. Files are identified by their size and modification time, if one
+// can't be found the templates can't be cached and false is returned.
+func templateListKey(templates []lazyTemplate) (string, bool) {
+	var key strings.Builder
+	for _, t := range templates {
+		key.WriteString(t.Name)
+		key.WriteByte(0)
+		switch l := t.Loader.(type) {
+		case fileLoader:
+			fi, err := os.Stat(string(l))
+			if err != nil {
+				return "", false
+			}
+			fmt.Fprintf(&key, "%s@%d:%d", l, fi.Size(), fi.ModTime().UnixNano())
+		case base64Loader:
+			// Its String panics when it's not valid base64, Load reports that
+			fmt.Fprintf(&key, "base64:%x", sha256.Sum256([]byte(l)))
+		default:
+			fmt.Fprint(&key, l)
+		}
+		key.WriteByte(0)
+	}
+
+	return key.String(), true
 }
 
 type lazyTemplate struct {
@@ -246,29 +416,70 @@ var templateStringMappers = map[string]func(string) string{
 	"replaceReserved": strmangle.ReplaceReservedWords,
 
 	// Casing
-	"titleCase": strmangle.TitleCase,
-	"camelCase": strmangle.CamelCase,
+	"titleCase":  TitleCase,
+	"camelCase":  CamelCase,
+	"snakeCase":  SnakeCase,
+	"kebabCase":  KebabCase,
+	"pascalCase": PascalCase,
+}
+
+// tagName returns the struct tag name of a column in the given casing, one of
+// camel, title, alias or snake.
+func tagName(casing, column, alias string) string {
+	switch casing {
+	case "camel":
+		return CamelCase(column)
+	case "title":
+		return TitleCase(column)
+	case "alias":
+		return alias
+	default:
+		return column
+	}
 }
 
-var goVarnameReplacer = strings.NewReplacer("[", "_", "]", "_", ".", "_")
+var goVarnameReplacer = strings.NewReplacer("[", "_", "]", "_", ".", "_", "*", "ptr_")
+
+// markdownCellReplacer escapes text so it stays in a single cell of a
+// markdown table
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
 
 // templateFunctions is a map of all the functions that get passed into the
 // templates. If you wish to pass a new function into your own template,
 // add a function pointer here.
 var templateFunctions = template.FuncMap{
 	// String ops
-	"quoteWrap": func(s string) string { return fmt.Sprintf(`"%s"`, s) },
-	"id":        strmangle.Identifier,
-	"goVarname": goVarnameReplacer.Replace,
+	"quoteWrap":    func(s string) string { return fmt.Sprintf(`"%s"`, s) },
+	"id":           strmangle.Identifier,
+	"goVarname":    goVarnameReplacer.Replace,
+	"hasPrefix":    strings.HasPrefix,
+	"markdownCell": markdownCellReplacer.Replace,
 
 	// Pluralization
 	"singular": strmangle.Singular,
 	"plural":   strmangle.Plural,
 
 	// Casing
-	"titleCase": strmangle.TitleCase,
-	"camelCase": strmangle.CamelCase,
-	"ignore":    strmangle.Ignore,
+	"titleCase":  TitleCase,
+	"camelCase":  CamelCase,
+	"snakeCase":  SnakeCase,
+	"kebabCase":  KebabCase,
+	"pascalCase": PascalCase,
+	"ignore":     strmangle.Ignore,
+
+	// Struct tags
+	"tagName":     tagName,
+	"validateTag": validateTag,
+
+	// Parquet
+	"parquetColumn": parquetColumn,
+
+	// Query filters
+	"filterKind": filterKind,
+
+	// TypeScript
+	"typeScriptField":   typeScriptField,
+	"typeScriptComment": typeScriptComment,
 
 	// String Slice ops
 	"join":               func(sep string, slice []string) string { return strings.Join(slice, sep) },
@@ -314,6 +525,8 @@ var templateFunctions = template.FuncMap{
 	"filterColumnsByDefault": drivers.FilterColumnsByDefault,
 	"filterColumnsByEnum":    drivers.FilterColumnsByEnum,
 	"sqlColDefinitions":      drivers.SQLColDefinitions,
+	"tablesByDependency":     drivers.TablesByDependency,
+	"schemaHash":             drivers.SchemaHash,
 	"columnNames":            drivers.ColumnNames,
 	"columnDBTypes":          drivers.ColumnDBTypes,
 	"getTable":               drivers.GetTable,
@@ -1,9 +1,15 @@
 package boilingcore
 
 import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
 	"sort"
 	"testing"
 	"text/template"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
 )
 
 func TestTemplateNameListSort(t *testing.T) {
@@ -66,3 +72,99 @@ func TestTemplateList_Templates(t *testing.T) {
 		t.Error("don't want not")
 	}
 }
+
+func TestCascadeChildren(t *testing.T) {
+	t.Parallel()
+
+	seats := drivers.Table{
+		Name: "seats",
+		ToManyRelationships: []drivers.ToManyRelationship{
+			{Name: "tags_seat_fk", Column: "id", ForeignTable: "tags", ForeignColumn: "id", ToJoinTable: true, JoinTable: "seat_tags"},
+			{Name: "bags_seat_fk", Column: "id", ForeignTable: "bags", ForeignColumn: "seat_id"},
+		},
+		ToOneRelationships: []drivers.ToOneRelationship{
+			{Name: "belts_seat_fk", Column: "id", ForeignTable: "belts", ForeignColumn: "seat_id"},
+		},
+	}
+	tickets := drivers.Table{
+		Name: "tickets",
+		CompositeFKeys: []drivers.CompositeForeignKey{
+			{Name: "tickets_seat_fk", Table: "tickets", Columns: []string{"jet_id", "seat_number"}, ForeignTable: "seats", ForeignColumns: []string{"jet_id", "number"}},
+		},
+	}
+
+	data := templateData{Table: seats, Tables: []drivers.Table{seats, tickets}}
+	want := []CascadeChild{
+		{Name: "belts_seat_fk", Table: "belts", Columns: []string{"seat_id"}, ForeignColumns: []string{"id"}},
+		{Name: "bags_seat_fk", Table: "bags", Columns: []string{"seat_id"}, ForeignColumns: []string{"id"}},
+		{Name: "tickets_seat_fk", Table: "tickets", Columns: []string{"jet_id", "seat_number"}, ForeignColumns: []string{"jet_id", "number"}},
+	}
+
+	if got := data.CascadeChildren(); !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong children:\nwant: %#v\ngot:  %#v", want, got)
+	}
+}
+
+func TestTagName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		casing string
+		want   string
+	}{
+		{"snake", "pilot_id"},
+		{"", "pilot_id"},
+		{"camel", "pilotID"},
+		{"title", "PilotID"},
+		{"alias", "Pilot"},
+	}
+
+	for _, test := range tests {
+		if got := tagName(test.casing, "pilot_id", "Pilot"); got != test.want {
+			t.Errorf("%q: want %s, got %s", test.casing, test.want, got)
+		}
+	}
+}
+
+func TestLoadTemplatesCached(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "a.go.tpl")
+	if err := ioutil.WriteFile(file, []byte("a"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	lazyTemplates := []lazyTemplate{
+		{Name: "templates/a.go.tpl", Loader: fileLoader(file)},
+		{Name: "templates/00_struct.go.tpl", Loader: assetLoader("templates/00_struct.go.tpl")},
+	}
+
+	first, err := loadTemplates(lazyTemplates, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := loadTemplates(lazyTemplates, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Error("want the same templates to be parsed once")
+	}
+
+	if err := ioutil.WriteFile(file, []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	third, err := loadTemplates(lazyTemplates, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third == first {
+		t.Error("want a changed file to be parsed again")
+	}
+	buf := &bytes.Buffer{}
+	if err := third.ExecuteTemplate(buf, "templates/a.go.tpl", nil); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "changed" {
+		t.Errorf("want the changed template, got %q", buf.String())
+	}
+}
@@ -22,7 +22,7 @@ import (
 // industries - industries : industry_id
 // industries - industries : parent_id
 //
-// fk == table = industry.Industries | industry.Industry
+// fk == table = industry.ChildIndustries | industry.ParentIndustry
 // fk != table = industry.ParentIndustries | industry.Parent
 //
 // = one-to-one
@@ -33,39 +33,75 @@ import (
 // fk != table = user.ProducerVideo | video.Producer
 //
 // = one-to-one
+// industries - industries : industry_id
 // industries - industries : parent_id
 //
-// fk == table = industry.Industry | industry.Industry
-// fk != table = industry.ParentIndustry | industry.Industry
+// fk == table = industry.ChildIndustry | industry.ParentIndustry
+// fk != table = industry.ParentIndustry | industry.Parent
+//
+// A key that refers to its own table and is named after it doesn't say
+// which way it points, both sides would be called Industry, so they're
+// named after the tree it makes instead.
 func txtNameToOne(fk drivers.ForeignKey) (localFn, foreignFn string) {
+	plurality := strmangle.Plural
+	if fk.Unique {
+		plurality = strmangle.Singular
+	}
+
+	if fk.Table == fk.ForeignTable && strmangle.Singular(trimSuffixes(fk.Column)) == strmangle.Singular(fk.Table) {
+		return TitleCase("child_" + plurality(fk.Table)), TitleCase("parent_" + strmangle.Singular(fk.Table))
+	}
+
 	fkColumnTrimmedSuffixes := strmangle.Singular(trimSuffixes(fk.Column))
 	fkNotTableName := fkColumnTrimmedSuffixes != strmangle.Singular(fk.ForeignTable)
 	singularForeignTable := strmangle.Singular(fk.ForeignTable)
 
 	if fkColumnTrimmedSuffixes == singularForeignTable {
-		foreignFn = strmangle.TitleCase(strmangle.Singular(fk.Table) + "_" + fkColumnTrimmedSuffixes)
+		foreignFn = TitleCase(strmangle.Singular(fk.Table) + "_" + fkColumnTrimmedSuffixes)
 		if fk.Column != singularForeignTable {
-			foreignFn = strmangle.TitleCase(fkColumnTrimmedSuffixes)
+			foreignFn = TitleCase(fkColumnTrimmedSuffixes)
 		}
 	} else if fkColumnTrimmedSuffixes == fk.Column {
-		foreignFn = strmangle.TitleCase(fkColumnTrimmedSuffixes + "_" + strmangle.Singular(fk.ForeignTable))
+		foreignFn = TitleCase(fkColumnTrimmedSuffixes + "_" + strmangle.Singular(fk.ForeignTable))
 	} else {
-		foreignFn = strmangle.TitleCase(fkColumnTrimmedSuffixes)
+		foreignFn = TitleCase(fkColumnTrimmedSuffixes)
 	}
 
 	if fkNotTableName {
-		localFn = strmangle.TitleCase(fkColumnTrimmedSuffixes)
+		localFn = TitleCase(fkColumnTrimmedSuffixes)
 	}
 
-	plurality := strmangle.Plural
-	if fk.Unique {
-		plurality = strmangle.Singular
-	}
-	localFn += strmangle.TitleCase(plurality(fk.Table))
+	localFn += TitleCase(plurality(fk.Table))
 
 	return localFn, foreignFn
 }
 
+// txtNameToOneComposite names the relationships of a foreign key over
+// several columns the way txtNameToOne does. The column named after the
+// foreign table picks the names, without one they're named as if the key
+// was a single <foreign table>_id column.
+//
+// tickets - seats : jet_id, seat_number
+//
+// seat.Tickets | ticket.Seat
+func txtNameToOneComposite(fk drivers.CompositeForeignKey) (localFn, foreignFn string) {
+	column := strmangle.Singular(fk.ForeignTable) + "_id"
+	for _, c := range fk.Columns {
+		if strmangle.Singular(trimSuffixes(c)) == strmangle.Singular(fk.ForeignTable) {
+			column = c
+			break
+		}
+	}
+
+	return txtNameToOne(drivers.ForeignKey{
+		Table:        fk.Table,
+		Name:         fk.Name,
+		Column:       column,
+		Unique:       fk.Unique,
+		ForeignTable: fk.ForeignTable,
+	})
+}
+
 // txtNameToMany creates the local and foreign function names for
 // many-to-many relationships where there are two foreign keys involved.
 //
@@ -96,14 +132,14 @@ func txtNameToMany(lhs, rhs drivers.ForeignKey) (lhsFn, rhsFn string) {
 	rhsKey := strmangle.Singular(trimSuffixes(rhs.Column))
 
 	if lhsKey != strmangle.Singular(lhs.ForeignTable) {
-		lhsFn = strmangle.TitleCase(lhsKey)
+		lhsFn = TitleCase(lhsKey)
 	}
-	lhsFn += strmangle.TitleCase(strmangle.Plural(lhs.ForeignTable))
+	lhsFn += TitleCase(strmangle.Plural(lhs.ForeignTable))
 
 	if rhsKey != strmangle.Singular(rhs.ForeignTable) {
-		rhsFn = strmangle.TitleCase(rhsKey)
+		rhsFn = TitleCase(rhsKey)
 	}
-	rhsFn += strmangle.TitleCase(strmangle.Plural(rhs.ForeignTable))
+	rhsFn += TitleCase(strmangle.Plural(rhs.ForeignTable))
 
 	return lhsFn, rhsFn
 }
@@ -29,8 +29,9 @@ func TestTxtNameToOne(t *testing.T) {
 		{"jets", "holiday_airport_id", false, "airports", "id", true, "HolidayAirportJets", "HolidayAirport"},
 		{"jets", "holiday_airport_id", true, "airports", "id", true, "HolidayAirportJet", "HolidayAirport"},
 
-		{"jets", "jet_id", false, "jets", "id", true, "Jets", "Jet"},
-		{"jets", "jet_id", true, "jets", "id", true, "Jet", "Jet"},
+		{"jets", "jet_id", false, "jets", "id", true, "ChildJets", "ParentJet"},
+		{"jets", "jet_id", true, "jets", "id", true, "ChildJet", "ParentJet"},
+		{"jets", "jet", false, "jets", "id", true, "ChildJets", "ParentJet"},
 		{"jets", "plane_id", false, "jets", "id", true, "PlaneJets", "Plane"},
 		{"jets", "plane_id", true, "jets", "id", true, "PlaneJet", "Plane"},
 
@@ -46,11 +47,15 @@ func TestTxtNameToOne(t *testing.T) {
 		{"videos", "created_by", true, "users", "id", true, "CreatedByVideo", "CreatedByUser"},
 		{"videos", "director", true, "users", "id", true, "DirectorVideo", "DirectorUser"},
 
-		{"industries", "industry_id", false, "industries", "id", true, "Industries", "Industry"},
+		{"industries", "industry_id", false, "industries", "id", true, "ChildIndustries", "ParentIndustry"},
 		{"industries", "parent_id", false, "industries", "id", true, "ParentIndustries", "Parent"},
-		{"industries", "industry_id", true, "industries", "id", true, "Industry", "Industry"},
+		{"industries", "industry_id", true, "industries", "id", true, "ChildIndustry", "ParentIndustry"},
 		{"industries", "parent_id", true, "industries", "id", true, "ParentIndustry", "Parent"},
 
+		{"employees", "manager_id", false, "employees", "id", true, "ManagerEmployees", "Manager"},
+		{"employees", "manager_id", true, "employees", "id", true, "ManagerEmployee", "Manager"},
+		{"employees", "employee_id", false, "employees", "id", true, "ChildEmployees", "ParentEmployee"},
+
 		{"race_result_scratchings", "results_id", false, "race_results", "id", true, "ResultRaceResultScratchings", "Result"},
 	}
 
@@ -70,6 +75,43 @@ func TestTxtNameToOne(t *testing.T) {
 	}
 }
 
+func TestTxtNameToOneComposite(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Table        string
+		Columns      []string
+		Unique       bool
+		ForeignTable string
+
+		LocalFn   string
+		ForeignFn string
+	}{
+		{"tickets", []string{"jet_id", "seat_number"}, false, "seats", "Tickets", "Seat"},
+		{"tickets", []string{"tenant_id", "seat_id"}, false, "seats", "Tickets", "Seat"},
+		{"tickets", []string{"tenant_id", "reserved_seat_id"}, false, "seats", "Tickets", "Seat"},
+		{"orders", []string{"tenant_id", "buyer_id"}, false, "customers", "Orders", "Customer"},
+		{"seat_details", []string{"jet_id", "number"}, true, "seats", "SeatDetail", "Seat"},
+	}
+
+	for i, test := range tests {
+		fk := drivers.CompositeForeignKey{
+			Table:        test.Table,
+			Columns:      test.Columns,
+			Unique:       test.Unique,
+			ForeignTable: test.ForeignTable,
+		}
+
+		local, foreign := txtNameToOneComposite(fk)
+		if local != test.LocalFn {
+			t.Error(i, "local wrong:", local, "want:", test.LocalFn)
+		}
+		if foreign != test.ForeignFn {
+			t.Error(i, "foreign wrong:", foreign, "want:", test.ForeignFn)
+		}
+	}
+}
+
 func TestTxtNameToMany(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,88 @@
+package boilingcore
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+var rgxTypeScriptIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// typeScriptField returns the property of a typescript interface for the json
+// field name of column. Nullable columns are unions with null, and only the
+// ones that are left out of the json when they're null are optional, which
+// are the pointers with omitempty.
+func typeScriptField(name string, column drivers.Column, noOmitEmpty bool) string {
+	if !rgxTypeScriptIdentifier.MatchString(name) {
+		b, _ := json.Marshal(name)
+		name = string(b)
+	}
+
+	optional := ""
+	if column.Nullable && !noOmitEmpty && strings.HasPrefix(column.Type, "*") {
+		optional = "?"
+	}
+
+	return fmt.Sprintf("%s%s: %s;", name, optional, typeScriptType(openAPIColumn(column)))
+}
+
+// typeScriptType returns the typescript type of the json described by an
+// OpenAPI schema, so the type definitions and the OpenAPI schemas of the
+// models always agree. Schemas without a type are unknown.
+func typeScriptType(schema *OpenAPISchema) string {
+	if len(schema.Enum) != 0 {
+		values := make([]string, len(schema.Enum))
+		for i, v := range schema.Enum {
+			b, _ := json.Marshal(v)
+			values[i] = string(b)
+		}
+		return strings.Join(values, " | ")
+	}
+
+	var typ string
+	switch schema.Type {
+	case "string":
+		typ = "string"
+	case "integer", "number":
+		typ = "number"
+	case "boolean":
+		typ = "boolean"
+	case "array":
+		typ = typeScriptType(schema.Items)
+		if strings.Contains(typ, " ") {
+			typ = "(" + typ + ")"
+		}
+		typ += "[]"
+	case "object":
+		if schema.AdditionalProperties != nil {
+			typ = "Record<string, " + typeScriptType(schema.AdditionalProperties) + ">"
+		} else {
+			typ = "Record<string, unknown>"
+		}
+	default:
+		return "unknown"
+	}
+
+	if schema.Nullable {
+		typ += " | null"
+	}
+	return typ
+}
+
+// typeScriptComment makes text safe to put in a doc comment on one line
+func typeScriptComment(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	return strings.Replace(text, "*/", "*\\/", -1)
+}
+
+// isTypeScriptTemplate reports whether name is one of the typescript
+// templates, eg. templates/typescript/singleton/models.d.ts.tpl, that are only
+// used with AddTypeScript.
+func isTypeScriptTemplate(name string) bool {
+	fragments := strings.Split(name, string(filepath.Separator))
+	return len(fragments) > 2 && fragments[1] == "typescript"
+}
@@ -0,0 +1,43 @@
+package boilingcore
+
+import (
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestTypeScriptField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Name        string
+		Column      drivers.Column
+		NoOmitEmpty bool
+		Want        string
+	}{
+		{"id", drivers.Column{Type: "int64"}, false, "id: number;"},
+		{"name", drivers.Column{Type: "null.String", Nullable: true}, false, "name: string | null;"},
+		{"name", drivers.Column{Type: "*string", Nullable: true}, false, "name?: string | null;"},
+		{"name", drivers.Column{Type: "*string", Nullable: true}, true, "name: string | null;"},
+		{"seat-class", drivers.Column{Type: "string", DBType: "enum('a','b')"}, false, `"seat-class": "a" | "b";`},
+		{"size", drivers.Column{Type: "null.Int", Nullable: true, CheckValues: []string{"1", "2"}}, false, "size: 1 | 2 | null;"},
+		{"tags", drivers.Column{Type: "types.StringArray"}, false, "tags: string[];"},
+		{"props", drivers.Column{Type: "types.HStore"}, false, "props: Record<string, string | null>;"},
+		{"price", drivers.Column{Type: "types.NullDecimal", Nullable: true}, false, "price: string | null;"},
+		{"data", drivers.Column{Type: "types.JSON"}, false, "data: unknown;"},
+	}
+
+	for _, test := range tests {
+		if got := typeScriptField(test.Name, test.Column, test.NoOmitEmpty); got != test.Want {
+			t.Errorf("%s %s:\nwant: %s\ngot:  %s", test.Name, test.Column.Type, test.Want, got)
+		}
+	}
+}
+
+func TestTypeScriptComment(t *testing.T) {
+	t.Parallel()
+
+	if got, want := typeScriptComment("the */ end\nof it"), `the *\/ end of it`; got != want {
+		t.Errorf("want: %s, got: %s", want, got)
+	}
+}
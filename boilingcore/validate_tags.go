@@ -0,0 +1,88 @@
+package boilingcore
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/strmangle"
+)
+
+// rgxCharLength finds the length of char types in a full db type, eg.
+// character varying(255), varchar(255) or nchar(2)
+var rgxCharLength = regexp.MustCompile(`(?i)char[a-z ]*\((\d+)\)`)
+
+// validateTag derives go-playground/validator rules from the constraints of
+// a column: required for NOT NULL strings and times without a default,
+// max for the length of char types and oneof for enums and CHECK IN lists.
+// Nullable columns get omitempty in front so nulls pass.
+func validateTag(column drivers.Column) string {
+	kind := validateKind(column.Type)
+
+	var rules []string
+	if !column.Nullable && len(column.Default) == 0 && !column.AutoGenerated &&
+		(kind == "string" || kind == "time") {
+		rules = append(rules, "required")
+	}
+
+	if kind == "string" {
+		if m := rgxCharLength.FindStringSubmatch(column.FullDBType); m != nil {
+			rules = append(rules, "max="+m[1])
+		}
+	}
+
+	values := column.CheckValues
+	if values == nil && strings.HasPrefix(column.DBType, "enum") {
+		values = strmangle.ParseEnumVals(column.DBType)
+	}
+	if oneOf := validateOneOf(values); len(oneOf) != 0 && (kind == "string" || kind == "number") {
+		rules = append(rules, "oneof="+oneOf)
+	}
+
+	if len(rules) == 0 {
+		return ""
+	}
+	if column.Nullable {
+		rules = append([]string{"omitempty"}, rules...)
+	}
+
+	return strings.Join(rules, ",")
+}
+
+// validateKind sorts the go type of a column into the kinds of values the
+// rules apply to.
+func validateKind(typ string) string {
+	typ = strings.TrimPrefix(strings.TrimPrefix(typ, "*"), "null.")
+
+	switch lower := strings.ToLower(typ); {
+	case lower == "string":
+		return "string"
+	case typ == "time.Time" || typ == "Time":
+		return "time"
+	case strings.HasPrefix(lower, "int") || strings.HasPrefix(lower, "uint") || strings.HasPrefix(lower, "float"):
+		return "number"
+	default:
+		return ""
+	}
+}
+
+// validateOneOf formats values as the parameter of a oneof rule, values with
+// spaces are quoted. It's empty if a value can't be written in a struct tag.
+func validateOneOf(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		if len(v) == 0 || strings.ContainsAny(v, "'\"`,|\\") {
+			return ""
+		}
+		if strings.ContainsAny(v, " \t") {
+			v = "'" + v + "'"
+		}
+		quoted[i] = v
+	}
+
+	return strings.Join(quoted, " ")
+}
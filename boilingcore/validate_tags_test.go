@@ -0,0 +1,35 @@
+package boilingcore
+
+import (
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestValidateTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		column drivers.Column
+		want   string
+	}{
+		{drivers.Column{Type: "string", FullDBType: "character varying(255)"}, "required,max=255"},
+		{drivers.Column{Type: "null.String", FullDBType: "varchar(20)", Nullable: true}, "omitempty,max=20"},
+		{drivers.Column{Type: "string", FullDBType: "nchar(2)", Default: "'us'"}, "max=2"},
+		{drivers.Column{Type: "string", DBType: "text", FullDBType: "text", CheckValues: []string{"new", "in progress"}}, "required,oneof=new 'in progress'"},
+		{drivers.Column{Type: "string", DBType: "enum.workday('monday','tuesday')", FullDBType: "workday"}, "required,oneof=monday tuesday"},
+		{drivers.Column{Type: "null.Int", CheckValues: []string{"1", "2"}, Nullable: true}, "omitempty,oneof=1 2"},
+		{drivers.Column{Type: "string", CheckValues: []string{"a,b"}}, "required"},
+		{drivers.Column{Type: "time.Time"}, "required"},
+		{drivers.Column{Type: "time.Time", Default: "now()"}, ""},
+		{drivers.Column{Type: "int", Default: "nextval('jets_id_seq'::regclass)"}, ""},
+		{drivers.Column{Type: "bool"}, ""},
+		{drivers.Column{Type: "[]byte", FullDBType: "varbinary(16)"}, ""},
+	}
+
+	for i, test := range tests {
+		if got := validateTag(test.column); got != test.want {
+			t.Errorf("%d) want %q, got %q", i, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package boilingcore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/strmangle"
+)
+
+// VerifySchema reads the database with the config's driver and compares it to
+// the schema snapshot at path, see Config.SchemaOut. It returns a line for
+// every difference, none means the generated code is up to date.
+func VerifySchema(config *Config, path string) ([]string, error) {
+	want, err := readSchema(path)
+	if err != nil {
+		return nil, err
+	}
+
+	got, err := loadSchema(config, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return diffSchema(want, got), nil
+}
+
+// diffSchema describes how got differs from want. Lines start with + for
+// things only in got, - for things only in want and ~ for changes.
+func diffSchema(want, got *drivers.DBInfo) []string {
+	var diff []string
+
+	wantTables := make(map[string]drivers.Table, len(want.Tables))
+	for _, t := range want.Tables {
+		wantTables[t.Name] = t
+	}
+	gotTables := make(map[string]drivers.Table, len(got.Tables))
+	for _, t := range got.Tables {
+		gotTables[t.Name] = t
+	}
+
+	for _, name := range sortedTableNames(want.Tables, got.Tables) {
+		w, inWant := wantTables[name]
+		g, inGot := gotTables[name]
+		switch {
+		case !inGot:
+			diff = append(diff, fmt.Sprintf("- table %s", name))
+		case !inWant:
+			diff = append(diff, fmt.Sprintf("+ table %s", name))
+		default:
+			diff = append(diff, diffTable(w, g)...)
+		}
+	}
+
+	return diff
+}
+
+func diffTable(want, got drivers.Table) []string {
+	var diff []string
+
+	wantCols := make(map[string]drivers.Column, len(want.Columns))
+	for _, c := range want.Columns {
+		wantCols[c.Name] = c
+	}
+	gotCols := make(map[string]drivers.Column, len(got.Columns))
+	for _, c := range got.Columns {
+		gotCols[c.Name] = c
+	}
+
+	// Keep the database's column order, with dropped columns at the end
+	for _, g := range got.Columns {
+		w, ok := wantCols[g.Name]
+		if !ok {
+			diff = append(diff, fmt.Sprintf("+ column %s.%s %s", got.Name, g.Name, describeColumn(g)))
+			continue
+		}
+
+		if describeColumn(w) != describeColumn(g) {
+			diff = append(diff, fmt.Sprintf("~ column %s.%s %s, was %s", got.Name, g.Name, describeColumn(g), describeColumn(w)))
+		}
+	}
+	for _, w := range want.Columns {
+		if _, ok := gotCols[w.Name]; !ok {
+			diff = append(diff, fmt.Sprintf("- column %s.%s %s", want.Name, w.Name, describeColumn(w)))
+		}
+	}
+
+	if w, g := describePKey(want.PKey), describePKey(got.PKey); w != g {
+		diff = append(diff, fmt.Sprintf("~ primary key %s %s, was %s", got.Name, g, w))
+	}
+
+	wantKeys, gotKeys := describeFKeys(want), describeFKeys(got)
+	for _, k := range gotKeys {
+		if !strmangle.SetInclude(k, wantKeys) {
+			diff = append(diff, fmt.Sprintf("+ foreign key %s %s", got.Name, k))
+		}
+	}
+	for _, k := range wantKeys {
+		if !strmangle.SetInclude(k, gotKeys) {
+			diff = append(diff, fmt.Sprintf("- foreign key %s %s", want.Name, k))
+		}
+	}
+
+	return diff
+}
+
+func describeColumn(c drivers.Column) string {
+	dbType := c.FullDBType
+	if len(dbType) == 0 {
+		dbType = c.DBType
+	}
+
+	var b strings.Builder
+	b.WriteString(dbType)
+	if !c.Nullable {
+		b.WriteString(" not null")
+	}
+	if c.Unique {
+		b.WriteString(" unique")
+	}
+	if len(c.Default) != 0 {
+		fmt.Fprintf(&b, " default %s", c.Default)
+	}
+
+	return b.String()
+}
+
+func describePKey(pkey *drivers.PrimaryKey) string {
+	if pkey == nil {
+		return "(none)"
+	}
+	return "(" + strings.Join(pkey.Columns, ", ") + ")"
+}
+
+func describeFKeys(t drivers.Table) []string {
+	fkeys := migrationFKeys(t)
+	keys := make([]string, len(fkeys))
+	for i, fk := range fkeys {
+		keys[i] = fk.String()
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedTableNames(tableSets ...[]drivers.Table) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, tables := range tableSets {
+		for _, t := range tables {
+			if !seen[t.Name] {
+				seen[t.Name] = true
+				names = append(names, t.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,122 @@
+package boilingcore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestDiffSchema(t *testing.T) {
+	t.Parallel()
+
+	want := &drivers.DBInfo{Tables: []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id", DBType: "integer"},
+				{Name: "name", DBType: "text"},
+				{Name: "rank", DBType: "text", Nullable: true},
+			},
+			PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+		},
+		{
+			Name: "jets",
+			Columns: []drivers.Column{
+				{Name: "id", DBType: "integer"},
+				{Name: "pilot_id", DBType: "integer"},
+			},
+			FKeys: []drivers.ForeignKey{{Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"}},
+		},
+		{Name: "hangars"},
+	}}
+	got := &drivers.DBInfo{Tables: []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id", DBType: "integer"},
+				{Name: "name", DBType: "text", Nullable: true, Default: "''"},
+				{Name: "callsign", DBType: "text", Unique: true},
+			},
+			PKey: &drivers.PrimaryKey{Columns: []string{"id", "name"}},
+		},
+		{
+			Name: "jets",
+			Columns: []drivers.Column{
+				{Name: "id", DBType: "integer"},
+				{Name: "pilot_id", DBType: "integer"},
+			},
+		},
+		{Name: "airports"},
+	}}
+
+	diff := diffSchema(want, got)
+	expect := []string{
+		"+ table airports",
+		"- table hangars",
+		"- foreign key jets (pilot_id) references pilots (id)",
+		"~ column pilots.name text default '', was text not null",
+		"+ column pilots.callsign text not null unique",
+		"- column pilots.rank text",
+		"~ primary key pilots (id, name), was (id)",
+	}
+	if !reflect.DeepEqual(diff, expect) {
+		t.Errorf("want:\n%q\ngot:\n%q", expect, diff)
+	}
+
+	if diff := diffSchema(want, want); len(diff) != 0 {
+		t.Errorf("want no differences, got: %q", diff)
+	}
+}
+
+func TestVerifySchema(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "boil_verify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := &Config{
+		DriverName: "mock",
+		DriverConfig: map[string]interface{}{
+			drivers.ConfigSchema:    "schema",
+			drivers.ConfigBlacklist: []string{},
+		},
+	}
+
+	dbInfo, err := drivers.GetDriver("mock").Assemble(config.DriverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot := filepath.Join(dir, "schema.json")
+	if err := writeSchema(snapshot, dbInfo); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := VerifySchema(config, snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff) != 0 {
+		t.Errorf("want no differences, got: %q", diff)
+	}
+
+	dbInfo.Tables = dbInfo.Tables[1:]
+	if err := writeSchema(snapshot, dbInfo); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err = VerifySchema(config, snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff) != 1 {
+		t.Errorf("want the dropped table, got: %q", diff)
+	}
+}
@@ -0,0 +1,154 @@
+package drivers
+
+import (
+	"strings"
+)
+
+// CheckValues returns the values a CHECK constraint definition limits column
+// to, or nil if the constraint is anything else. It understands the forms
+// the databases normalize IN lists to:
+//
+//	CHECK (((status)::text = ANY ((ARRAY['a'::character varying, 'b'::character varying])::text[])))
+//	([status]='b' OR [status]='a')
+//	(`status` in (_utf8mb4'a',_utf8mb4'b'))
+//
+// The definition may only compare column with string and number literals
+// using =, IN, ANY and OR. Anything else, like another column, a NOT or an
+// AND, means the allowed values can't be listed.
+func CheckValues(column, definition string) []string {
+	var values []string
+	sawColumn, sawOp, cast := false, false, false
+
+	for i := 0; i < len(definition); {
+		c := definition[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+			continue
+		case c == ':' && strings.HasPrefix(definition[i:], "::"):
+			// The words that follow name the type of a cast
+			cast = true
+			i += 2
+			continue
+		case isCheckWordStart(c):
+			j := i + 1
+			for j < len(definition) && isCheckWordPart(definition[j]) {
+				j++
+			}
+			word := definition[i:j]
+			i = j
+
+			switch {
+			case cast:
+			case i < len(definition) && definition[i] == '\'' && (word[0] == '_' || word == "N"):
+				// Charset prefix of a string literal
+			case strings.EqualFold(word, column):
+				sawColumn = true
+			default:
+				switch strings.ToUpper(word) {
+				case "CHECK", "ARRAY", "OR":
+				case "IN", "ANY":
+					sawOp = true
+				default:
+					return nil
+				}
+			}
+			continue
+		case c == '\'':
+			value, n, ok := checkQuoted(definition[i:], '\'')
+			if !ok {
+				return nil
+			}
+			values = append(values, value)
+			i += n
+		case c == '"' || c == '`' || (c == '[' && isCheckIdentBracket(definition[i:])):
+			end := c
+			if c == '[' {
+				end = ']'
+			}
+			name, n, ok := checkQuoted(definition[i:], end)
+			if !ok || !strings.EqualFold(name, column) {
+				return nil
+			}
+			sawColumn = true
+			i += n
+		case isCheckDigit(c) || (c == '-' || c == '.') && i+1 < len(definition) && isCheckDigit(definition[i+1]):
+			j := i + 1
+			for j < len(definition) && (isCheckDigit(definition[j]) || definition[j] == '.') {
+				j++
+			}
+			values = append(values, definition[i:j])
+			i = j
+		case c == '=':
+			sawOp = true
+			i++
+		case c == '(' || c == ')' || c == ',' || c == '[' || c == ']':
+			i++
+		default:
+			return nil
+		}
+
+		cast = false
+	}
+
+	if !sawColumn || !sawOp || len(values) == 0 {
+		return nil
+	}
+
+	unique := values[:0]
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		unique = append(unique, v)
+	}
+
+	return unique
+}
+
+// checkQuoted reads the quoted string s starts with, where a doubled end
+// quote stands for the quote itself. It returns the unquoted string and the
+// number of bytes read.
+func checkQuoted(s string, end byte) (string, int, bool) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		if s[i] != end {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == end {
+			b.WriteByte(end)
+			i++
+			continue
+		}
+		return b.String(), i + 1, true
+	}
+
+	return "", 0, false
+}
+
+// isCheckIdentBracket tells a [quoted] identifier apart from the brackets
+// of an array, which hold literals or nothing at all.
+func isCheckIdentBracket(s string) bool {
+	end := strings.IndexByte(s, ']')
+	if end < 2 || !isCheckWordStart(s[1]) {
+		return false
+	}
+
+	return !strings.ContainsAny(s[1:end], "'(),[")
+}
+
+func isCheckWordStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isCheckWordPart(c byte) bool {
+	return isCheckWordStart(c) || isCheckDigit(c) || c == '$'
+}
+
+func isCheckDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
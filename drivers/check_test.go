@@ -0,0 +1,42 @@
+package drivers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Column     string
+		Definition string
+		Want       []string
+	}{
+		{"status", `CHECK (((status)::text = ANY ((ARRAY['active'::character varying, 'it''s'::character varying])::text[])))`, []string{"active", "it's"}},
+		{"kind", `CHECK ((kind = ANY (ARRAY['a'::text, 'b'::text])))`, []string{"a", "b"}},
+		{"level", `CHECK ((level = ANY (ARRAY[1, 2, -3])))`, []string{"1", "2", "-3"}},
+		{"kind", `CHECK ((kind = 'a'::text))`, []string{"a"}},
+		{"status", `([status]='b' OR [status]='a' OR [status]=N'ü')`, []string{"b", "a", "ü"}},
+		{"level", `([Level]=(1) OR [Level]=(2))`, []string{"1", "2"}},
+		{"status", "(`status` in (_utf8mb4'a',_utf8mb4'b'))", []string{"a", "b"}},
+		{"status", `CHECK (("status" IN ('a', 'a', 'b')))`, []string{"a", "b"}},
+
+		{"status", `CHECK (((status)::text <> ''::text))`, nil},
+		{"level", `CHECK ((level > 0))`, nil},
+		{"kind", `CHECK ((kind <> ALL (ARRAY['a'::text])))`, nil},
+		{"kind", `CHECK ((NOT (kind = ANY (ARRAY['a'::text]))))`, nil},
+		{"kind", `CHECK (((kind = 'a'::text) AND (other = 'b'::text)))`, nil},
+		{"kind", `CHECK ((kind = other))`, nil},
+		{"kind", `CHECK ((lower(kind) = ANY (ARRAY['a'::text])))`, nil},
+		{"kind", `CHECK ((other = 'a'::text))`, nil},
+		{"kind", `([other]='a')`, nil},
+		{"kind", `CHECK ((kind = 'a`, nil},
+	}
+
+	for i, test := range tests {
+		if got := CheckValues(test.Column, test.Definition); !reflect.DeepEqual(got, test.Want) {
+			t.Errorf("%d) want: %q, got: %q", i, test.Want, got)
+		}
+	}
+}
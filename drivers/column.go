@@ -18,6 +18,10 @@ type Column struct {
 	Unique    bool   `json:"unique" toml:"unique"`
 	Validated bool   `json:"validated" toml:"validated"`
 
+	// CheckValues are the only values a CHECK constraint on just this column
+	// allows, eg. for CHECK (status IN ('active', 'banned')). See CheckValues.
+	CheckValues []string `json:"check_values,omitempty" toml:"check_values"`
+
 	// Postgres only extension bits
 	// ArrType is the underlying data type of the Postgres
 	// ARRAY type. See here:
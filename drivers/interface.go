@@ -4,6 +4,8 @@ package drivers
 
 import (
 	"sort"
+	"sync"
+	"sync/atomic"
 
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/importers"
@@ -12,9 +14,10 @@ import (
 
 // These constants are used in the config map passed into the driver
 const (
-	ConfigBlacklist = "blacklist"
-	ConfigWhitelist = "whitelist"
-	ConfigSchema    = "schema"
+	ConfigBlacklist   = "blacklist"
+	ConfigWhitelist   = "whitelist"
+	ConfigSchema      = "schema"
+	ConfigConcurrency = "concurrency"
 
 	ConfigUser    = "user"
 	ConfigPass    = "pass"
@@ -24,6 +27,10 @@ const (
 	ConfigSSLMode = "sslmode"
 )
 
+// DefaultConcurrency is how many tables the drivers introspect at once when
+// ConfigConcurrency isn't set.
+const DefaultConcurrency = 10
+
 // Interface abstracts either a side-effect imported driver or a binary
 // that is called in order to produce the data required for generation.
 type Interface interface {
@@ -37,9 +44,10 @@ type Interface interface {
 
 // DBInfo is the database's table data and dialect.
 type DBInfo struct {
-	Schema  string  `json:"schema"`
-	Tables  []Table `json:"tables"`
-	Dialect Dialect `json:"dialect"`
+	Schema    string     `json:"schema"`
+	Tables    []Table    `json:"tables"`
+	Sequences []Sequence `json:"sequences,omitempty"`
+	Dialect   Dialect    `json:"dialect"`
 }
 
 // Dialect describes the databases requirements in terms of which features
@@ -49,6 +57,11 @@ type DBInfo struct {
 // the boil_queries template that is used for users to create queries
 // without having to figure out what their dialect is.
 type Dialect struct {
+	// Name identifies the database the dialect is for, eg. psql, mysql or
+	// mssql. It's used where SQL differs in ways the flags below don't
+	// describe, like how literals are written.
+	Name string `json:"name"`
+
 	LQ rune `json:"lq"`
 	RQ rune `json:"rq"`
 
@@ -56,6 +69,19 @@ type Dialect struct {
 	UseLastInsertID      bool `json:"use_last_insert_id"`
 	UseSchema            bool `json:"use_schema"`
 	UseDefaultKeyword    bool `json:"use_default_keyword"`
+	UseLateralJoin       bool `json:"use_lateral_join"`
+
+	// IndexPlaceholderPrefix is put in front of the argument number when
+	// UseIndexPlaceholders is set, eg. "@p" for @p1, @p2. Defaults to "$".
+	IndexPlaceholderPrefix string `json:"index_placeholder_prefix"`
+	// NamedPlaceholderPrefix is put in front of the parameter name by the
+	// Named* placeholder helpers, eg. "@" for @name. Defaults to ":".
+	NamedPlaceholderPrefix string `json:"named_placeholder_prefix"`
+
+	// MaxParameters is the most bind parameters a single statement can
+	// take. Selects with more are split where that's possible, see
+	// queries.SplitIn. Zero means there's no known limit.
+	MaxParameters int `json:"max_parameters"`
 
 	// The following is mostly for T-SQL/MSSQL, what a show
 	UseAutoColumns          bool `json:"use_auto_columns"`
@@ -80,8 +106,13 @@ type Constructor interface {
 // Tables returns the metadata for all tables, minus the tables
 // specified in the blacklist.
 func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Table, error) {
-	var err error
+	return TablesConcurrently(c, schema, whitelist, blacklist, 1)
+}
 
+// TablesConcurrently is Tables, but it introspects up to concurrency tables
+// at once. The Constructor has to be safe for concurrent use, which it is
+// when it only queries a *sql.DB.
+func TablesConcurrently(c Constructor, schema string, whitelist, blacklist []string, concurrency int) ([]Table, error) {
 	names, err := c.TableNames(schema, whitelist, blacklist)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get table names")
@@ -89,33 +120,41 @@ func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Tabl
 
 	sort.Strings(names)
 
-	var tables []Table
-	for _, name := range names {
-		t := Table{
-			Name: name,
-		}
-
-		if t.Columns, err = c.Columns(schema, name, whitelist, blacklist); err != nil {
-			return nil, errors.Wrapf(err, "unable to fetch table column info (%s)", name)
-		}
+	if concurrency < 1 {
+		concurrency = 1
+	}
 
-		for i, col := range t.Columns {
-			t.Columns[i] = c.TranslateColumnType(col)
-		}
+	tables := make([]Table, len(names))
+	errs := make([]error, len(names))
+	var failed int32
 
-		if t.PKey, err = c.PrimaryKeyInfo(schema, name); err != nil {
-			return nil, errors.Wrapf(err, "unable to fetch table pkey info (%s)", name)
-		}
+	var wg sync.WaitGroup
+	work := make(chan int)
+	for i := 0; i < concurrency && i < len(names); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				// Once a table failed the rest doesn't matter
+				if atomic.LoadInt32(&failed) != 0 {
+					continue
+				}
+				if tables[i], errs[i] = introspectTable(c, schema, names[i], whitelist, blacklist); errs[i] != nil {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+	for i := range names {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
 
-		if t.FKeys, err = c.ForeignKeyInfo(schema, name); err != nil {
-			return nil, errors.Wrapf(err, "unable to fetch table fkey info (%s)", name)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
 		}
-
-		filterForeignKeys(&t, whitelist, blacklist)
-
-		setIsJoinTable(&t)
-
-		tables = append(tables, t)
 	}
 
 	// Relationships have a dependency on foreign key nullability.
@@ -131,6 +170,37 @@ func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Tabl
 	return tables, nil
 }
 
+// introspectTable introspects the table name.
+func introspectTable(c Constructor, schema, name string, whitelist, blacklist []string) (Table, error) {
+	var err error
+	t := Table{
+		Name: name,
+	}
+
+	if t.Columns, err = c.Columns(schema, name, whitelist, blacklist); err != nil {
+		return t, errors.Wrapf(err, "unable to fetch table column info (%s)", name)
+	}
+
+	for i, col := range t.Columns {
+		t.Columns[i] = c.TranslateColumnType(col)
+	}
+
+	if t.PKey, err = c.PrimaryKeyInfo(schema, name); err != nil {
+		return t, errors.Wrapf(err, "unable to fetch table pkey info (%s)", name)
+	}
+
+	if t.FKeys, err = c.ForeignKeyInfo(schema, name); err != nil {
+		return t, errors.Wrapf(err, "unable to fetch table fkey info (%s)", name)
+	}
+	t.FKeys, t.CompositeFKeys = splitCompositeForeignKeys(t.FKeys)
+
+	filterForeignKeys(&t, whitelist, blacklist)
+
+	setIsJoinTable(&t)
+
+	return t, nil
+}
+
 // filterForeignKeys filter FK whose ForeignTable is not in whitelist or in blacklist
 func filterForeignKeys(t *Table, whitelist, blacklist []string) {
 	var fkeys []ForeignKey
@@ -141,6 +211,51 @@ func filterForeignKeys(t *Table, whitelist, blacklist []string) {
 		}
 	}
 	t.FKeys = fkeys
+
+	var composite []CompositeForeignKey
+	for _, fkey := range t.CompositeFKeys {
+		if (len(whitelist) == 0 || strmangle.SetInclude(fkey.ForeignTable, whitelist)) &&
+			(len(blacklist) == 0 || !strmangle.SetInclude(fkey.ForeignTable, blacklist)) {
+			composite = append(composite, fkey)
+		}
+	}
+	t.CompositeFKeys = composite
+}
+
+// splitCompositeForeignKeys gathers the columns of foreign keys that span
+// several columns, drivers return one ForeignKey for each pair of columns
+// in the order the constraint lists them.
+func splitCompositeForeignKeys(fkeys []ForeignKey) ([]ForeignKey, []CompositeForeignKey) {
+	count := make(map[string]int)
+	for _, fkey := range fkeys {
+		count[fkey.Name]++
+	}
+
+	var single []ForeignKey
+	var composite []CompositeForeignKey
+	index := make(map[string]int)
+	for _, fkey := range fkeys {
+		if count[fkey.Name] == 1 {
+			single = append(single, fkey)
+			continue
+		}
+
+		i, ok := index[fkey.Name]
+		if !ok {
+			i = len(composite)
+			index[fkey.Name] = i
+			composite = append(composite, CompositeForeignKey{
+				Table:        fkey.Table,
+				Name:         fkey.Name,
+				ForeignTable: fkey.ForeignTable,
+			})
+		}
+
+		composite[i].Columns = append(composite[i].Columns, fkey.Column)
+		composite[i].ForeignColumns = append(composite[i].ForeignColumns, fkey.ForeignColumn)
+	}
+
+	return single, composite
 }
 
 // setIsJoinTable if there are:
@@ -178,6 +293,29 @@ func setForeignKeyConstraints(t *Table, tables []Table) {
 		t.FKeys[i].ForeignColumnNullable = foreignColumn.Nullable
 		t.FKeys[i].ForeignColumnUnique = foreignColumn.Unique
 	}
+
+	for i, fkey := range t.CompositeFKeys {
+		t.CompositeFKeys[i].Nullable = false
+		for _, c := range fkey.Columns {
+			if t.GetColumn(c).Nullable {
+				t.CompositeFKeys[i].Nullable = true
+			}
+		}
+		t.CompositeFKeys[i].Unique = t.PKey != nil && sameColumns(t.PKey.Columns, fkey.Columns)
+	}
+}
+
+// sameColumns checks if a and b hold the same columns in any order
+func sameColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, c := range a {
+		if !strmangle.SetInclude(c, b) {
+			return false
+		}
+	}
+	return true
 }
 
 func setRelationships(t *Table, tables []Table) {
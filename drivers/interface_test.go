@@ -1,8 +1,11 @@
 package drivers
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/strmangle"
 )
 
@@ -165,6 +168,41 @@ func TestTables(t *testing.T) {
 	}
 }
 
+type failingColumnsDriver struct {
+	testMockDriver
+}
+
+func (f failingColumnsDriver) Columns(schema, tableName string, whitelist, blacklist []string) ([]Column, error) {
+	if tableName == "jets" {
+		return nil, errors.New("no columns for you")
+	}
+	return f.testMockDriver.Columns(schema, tableName, whitelist, blacklist)
+}
+
+func TestTablesConcurrently(t *testing.T) {
+	t.Parallel()
+
+	want, err := Tables(testMockDriver{}, "public", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, concurrency := range []int{0, 3, 100} {
+		got, err := TablesConcurrently(testMockDriver{}, "public", nil, nil, concurrency)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("concurrency %d: want the same tables as Tables", concurrency)
+		}
+	}
+
+	_, err = TablesConcurrently(failingColumnsDriver{}, "public", nil, nil, 3)
+	if err == nil || !strings.Contains(err.Error(), "(jets)") {
+		t.Error("want the error of the jets table, got:", err)
+	}
+}
+
 func TestFilterForeignKeys(t *testing.T) {
 	t.Parallel()
 
@@ -223,6 +261,32 @@ func TestFilterForeignKeys(t *testing.T) {
 	}
 }
 
+func TestSplitCompositeForeignKeys(t *testing.T) {
+	t.Parallel()
+
+	fkeys := []ForeignKey{
+		{Table: "tickets", Name: "tickets_seat_fk", Column: "jet_id", ForeignTable: "seats", ForeignColumn: "jet_id"},
+		{Table: "tickets", Name: "tickets_seat_fk", Column: "seat_number", ForeignTable: "seats", ForeignColumn: "number"},
+		{Table: "tickets", Name: "tickets_pilot_fk", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"},
+	}
+
+	single, composite := splitCompositeForeignKeys(fkeys)
+	if len(single) != 1 || single[0].Name != "tickets_pilot_fk" {
+		t.Errorf("wrong single column keys: %#v", single)
+	}
+
+	want := []CompositeForeignKey{{
+		Table:          "tickets",
+		Name:           "tickets_seat_fk",
+		Columns:        []string{"jet_id", "seat_number"},
+		ForeignTable:   "seats",
+		ForeignColumns: []string{"jet_id", "number"},
+	}}
+	if !reflect.DeepEqual(composite, want) {
+		t.Errorf("want: %#v\ngot: %#v", want, composite)
+	}
+}
+
 func TestSetIsJoinTable(t *testing.T) {
 	t.Parallel()
 
@@ -280,9 +344,26 @@ func TestSetForeignKeyConstraints(t *testing.T) {
 		},
 	}
 
+	tables[1].CompositeFKeys = []CompositeForeignKey{
+		{Columns: []string{"one_id_1", "one_id_2"}, ForeignTable: "one", ForeignColumns: []string{"id1", "id2"}},
+	}
+
 	setForeignKeyConstraints(&tables[0], tables)
 	setForeignKeyConstraints(&tables[1], tables)
 
+	if !tables[1].CompositeFKeys[0].Nullable {
+		t.Error("composite key should be nullable")
+	}
+	if tables[1].CompositeFKeys[0].Unique {
+		t.Error("composite key should not be unique without a primary key")
+	}
+
+	tables[1].PKey = &PrimaryKey{Columns: []string{"one_id_2", "one_id_1"}}
+	setForeignKeyConstraints(&tables[1], tables)
+	if !tables[1].CompositeFKeys[0].Unique {
+		t.Error("composite key over the primary key should be unique")
+	}
+
 	first := tables[1].FKeys[0]
 	second := tables[1].FKeys[1]
 	if first.Nullable {
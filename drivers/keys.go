@@ -22,6 +22,21 @@ type ForeignKey struct {
 	ForeignColumnUnique   bool   `json:"foreign_column_unique"`
 }
 
+// CompositeForeignKey is a foreign key constraint over several columns,
+// Columns[i] refers to ForeignColumns[i]. Nullable is set when any of the
+// local columns is nullable. Unique is set when the columns are the primary
+// key of the table, which makes the relationship one-to-one.
+type CompositeForeignKey struct {
+	Table    string   `json:"table"`
+	Name     string   `json:"name"`
+	Columns  []string `json:"columns"`
+	Nullable bool     `json:"nullable"`
+	Unique   bool     `json:"unique"`
+
+	ForeignTable   string   `json:"foreign_table"`
+	ForeignColumns []string `json:"foreign_columns"`
+}
+
 // SQLColumnDef formats a column name and type like an SQL column definition.
 type SQLColumnDef struct {
 	Name string
@@ -0,0 +1,178 @@
+package drivers
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// QuoteLiteral renders s as a string literal for the dialect so it can be
+// inlined into SQL, for example a column default in a test fixture or DDL.
+// Single quotes are always doubled, beyond that:
+//
+//	psql:  strings containing backslashes use the E'' form so they're read
+//	       the same regardless of standard_conforming_strings
+//	mysql: backslashes and NUL are escaped since MySQL treats \ specially
+//	mssql: strings containing non-ASCII characters use the N'' form
+//
+// Use bind parameters for values that come from users, this is meant for
+// values that are known when the code is generated.
+func (d Dialect) QuoteLiteral(s string) string {
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	switch d.Name {
+	case "psql":
+		if strings.ContainsRune(s, '\\') {
+			buf.WriteByte('E')
+		}
+	case "mssql":
+		if !isASCIIString(s) {
+			buf.WriteByte('N')
+		}
+	}
+
+	buf.WriteByte('\'')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\'':
+			buf.WriteString("''")
+		case c == '\\' && (d.Name == "psql" || d.Name == "mysql"):
+			buf.WriteString(`\\`)
+		case c == 0 && d.Name == "mysql":
+			buf.WriteString(`\0`)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte('\'')
+
+	return buf.String()
+}
+
+// BytesLiteral renders b as a binary literal for the dialect:
+// '\x0102'::bytea for psql, 0x0102 for mssql and X'0102' otherwise.
+func (d Dialect) BytesLiteral(b []byte) string {
+	h := hex.EncodeToString(b)
+
+	switch d.Name {
+	case "psql":
+		return `'\x` + h + `'::bytea`
+	case "mssql":
+		return "0x" + h
+	default:
+		return "X'" + h + "'"
+	}
+}
+
+// Literal renders a Go value as a SQL literal for the dialect. nil (and
+// Valuers producing nil) become NULL, strings and byte slices are escaped
+// with QuoteLiteral and BytesLiteral, times are written as quoted
+// timestamps, see TimeLiteral. NaN and infinite floats, and values of other
+// types are an error.
+func (d Dialect) Literal(v interface{}) (string, error) {
+	if valuer, ok := v.(driver.Valuer); ok {
+		if isNullArg(v) {
+			return "NULL", nil
+		}
+		val, err := valuer.Value()
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get value for sql literal")
+		}
+		v = val
+	}
+
+	switch val := v.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return d.QuoteLiteral(val), nil
+	case []byte:
+		if val == nil {
+			return "NULL", nil
+		}
+		return d.BytesLiteral(val), nil
+	case bool:
+		if d.Name == "mssql" {
+			if val {
+				return "1", nil
+			}
+			return "0", nil
+		}
+		if val {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case int:
+		return strconv.FormatInt(int64(val), 10), nil
+	case int8:
+		return strconv.FormatInt(int64(val), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(val), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(val), 10), nil
+	case int64:
+		return strconv.FormatInt(val, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(val), 10), nil
+	case uint8:
+		return strconv.FormatUint(uint64(val), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(val), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(val), 10), nil
+	case uint64:
+		return strconv.FormatUint(val, 10), nil
+	case float32:
+		if math.IsNaN(float64(val)) || math.IsInf(float64(val), 0) {
+			return "", errors.Errorf("cannot render %v as a sql literal", val)
+		}
+		return strconv.FormatFloat(float64(val), 'g', -1, 32), nil
+	case float64:
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			return "", errors.Errorf("cannot render %v as a sql literal", val)
+		}
+		return strconv.FormatFloat(val, 'g', -1, 64), nil
+	case time.Time:
+		return d.TimeLiteral(val), nil
+	}
+
+	return "", errors.Errorf("cannot render %T as a sql literal", v)
+}
+
+// TimeLiteral renders t as a quoted timestamp the dialect's time columns
+// accept:
+//
+//	psql:  with the offset and up to nanoseconds, which are rounded
+//	mysql: in UTC without an offset and up to microseconds, which is what
+//	       DATETIME and TIMESTAMP take
+//	mssql: in UTC in the ISO 8601 form without an offset, which datetime2
+//	       reads regardless of the language and date format settings
+//
+// Other dialects get the psql form.
+func (d Dialect) TimeLiteral(t time.Time) string {
+	switch d.Name {
+	case "mysql":
+		return d.QuoteLiteral(t.UTC().Format("2006-01-02 15:04:05.999999"))
+	case "mssql":
+		return d.QuoteLiteral(t.UTC().Format("2006-01-02T15:04:05.9999999"))
+	default:
+		return d.QuoteLiteral(t.Format("2006-01-02 15:04:05.999999999Z07:00"))
+	}
+}
+
+func isASCIIString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package drivers
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func TestDialectQuoteLiteral(t *testing.T) {
+	t.Parallel()
+
+	psql := Dialect{Name: "psql"}
+	mysql := Dialect{Name: "mysql"}
+	mssql := Dialect{Name: "mssql"}
+
+	tests := []struct {
+		Got  string
+		Want string
+	}{
+		{psql.QuoteLiteral("it's"), `'it''s'`},
+		{psql.QuoteLiteral(`a\b`), `E'a\\b'`},
+		{mysql.QuoteLiteral(`it's a\b`), `'it''s a\\b'`},
+		{mysql.QuoteLiteral("a\x00b"), `'a\0b'`},
+		{mssql.QuoteLiteral(`it's a\b`), `'it''s a\b'`},
+		{mssql.QuoteLiteral("über"), `N'über'`},
+		{Dialect{}.QuoteLiteral(`'\`), `'''\'`},
+		{psql.BytesLiteral([]byte{1, 0xab}), `'\x01ab'::bytea`},
+		{mysql.BytesLiteral([]byte{1, 0xab}), `X'01ab'`},
+		{mssql.BytesLiteral([]byte{1, 0xab}), `0x01ab`},
+	}
+
+	for i, test := range tests {
+		if test.Got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, test.Got)
+		}
+	}
+}
+
+func TestDialectLiteral(t *testing.T) {
+	t.Parallel()
+
+	psql := Dialect{Name: "psql"}
+	mysql := Dialect{Name: "mysql"}
+	mssql := Dialect{Name: "mssql"}
+	when := time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.FixedZone("", 3600))
+
+	tests := []struct {
+		Dialect Dialect
+		Value   interface{}
+		Want    string
+	}{
+		{psql, nil, "NULL"},
+		{psql, null.String{}, "NULL"},
+		{psql, null.StringFrom("o'k"), `'o''k'`},
+		{psql, true, "TRUE"},
+		{mssql, true, "1"},
+		{psql, int64(-5), "-5"},
+		{psql, uint8(5), "5"},
+		{psql, 1.5, "1.5"},
+		{psql, []byte(nil), "NULL"},
+		{psql, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), `'2020-01-02 03:04:05Z'`},
+		{psql, when, `'2020-01-02 03:04:05.123456789+01:00'`},
+		{mysql, when, `'2020-01-02 02:04:05.123456'`},
+		{mssql, when, `'2020-01-02T02:04:05.1234567'`},
+	}
+
+	for i, test := range tests {
+		got, err := test.Dialect.Literal(test.Value)
+		if err != nil {
+			t.Errorf("%d) %v", i, err)
+			continue
+		}
+		if got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+	}
+
+	if _, err := psql.Literal(struct{}{}); err == nil {
+		t.Error("expected an error for an unsupported type")
+	}
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := psql.Literal(f); err == nil {
+			t.Errorf("expected an error for %v", f)
+		}
+	}
+	if _, err := psql.Literal(float32(math.Inf(1))); err == nil {
+		t.Error("expected an error for a float32 infinity")
+	}
+}
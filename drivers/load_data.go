@@ -0,0 +1,119 @@
+package drivers
+
+import (
+	"bufio"
+	"database/sql/driver"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/friendsofgo/errors"
+)
+
+// LoadDataWriter writes rows in the format MySQL's LOAD DATA INFILE reads
+// with its default options: fields separated by tabs, rows ended by
+// newlines, special characters escaped with a backslash and \N for NULL.
+type LoadDataWriter struct {
+	// Location is the time zone times are written in, it should match the
+	// loc parameter of the connection. UTC when nil.
+	Location *time.Location
+
+	w       *bufio.Writer
+	scratch []byte
+}
+
+// NewLoadDataWriter creates a LoadDataWriter that buffers its output to w,
+// call Flush once all rows are written.
+func NewLoadDataWriter(w io.Writer) *LoadDataWriter {
+	return &LoadDataWriter{w: bufio.NewWriter(w)}
+}
+
+// WriteRow writes one row. The values are converted like query arguments,
+// so driver.Valuer types such as the null package's work.
+func (l *LoadDataWriter) WriteRow(values ...interface{}) error {
+	for i, v := range values {
+		if i != 0 {
+			l.w.WriteByte('\t')
+		}
+		if err := l.writeValue(v); err != nil {
+			return errors.Wrapf(err, "failed to write value %d for load data", i)
+		}
+	}
+
+	return l.w.WriteByte('\n')
+}
+
+// Flush writes out anything that's still buffered.
+func (l *LoadDataWriter) Flush() error {
+	return l.w.Flush()
+}
+
+func (l *LoadDataWriter) writeValue(v interface{}) error {
+	// DefaultParameterConverter refuses uint64s with the high bit set
+	if u, ok := v.(uint64); ok {
+		_, err := l.w.WriteString(strconv.FormatUint(u, 10))
+		return err
+	}
+
+	val, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		return err
+	}
+
+	b := l.scratch[:0]
+	switch val := val.(type) {
+	case nil:
+		b = append(b, `\N`...)
+	case string:
+		b = appendLoadDataEscaped(b, val)
+	case []byte:
+		if val == nil {
+			b = append(b, `\N`...)
+		} else {
+			b = appendLoadDataEscaped(b, string(val))
+		}
+	case bool:
+		if val {
+			b = append(b, '1')
+		} else {
+			b = append(b, '0')
+		}
+	case int64:
+		b = strconv.AppendInt(b, val, 10)
+	case float64:
+		b = strconv.AppendFloat(b, val, 'g', -1, 64)
+	case time.Time:
+		loc := l.Location
+		if loc == nil {
+			loc = time.UTC
+		}
+		b = val.In(loc).AppendFormat(b, "2006-01-02 15:04:05.999999")
+	default:
+		return errors.Errorf("cannot write %T for load data", val)
+	}
+	l.scratch = b
+
+	_, err = l.w.Write(b)
+	return err
+}
+
+func appendLoadDataEscaped(b []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			b = append(b, `\\`...)
+		case '\t':
+			b = append(b, `\t`...)
+		case '\n':
+			b = append(b, `\n`...)
+		case '\r':
+			b = append(b, `\r`...)
+		case 0:
+			b = append(b, `\0`...)
+		default:
+			b = append(b, c)
+		}
+	}
+
+	return b
+}
@@ -0,0 +1,45 @@
+package drivers
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func TestLoadDataWriter(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	w := NewLoadDataWriter(buf)
+
+	when := time.Date(2020, 1, 2, 3, 4, 5, 600000000, time.FixedZone("", 3600))
+	rows := [][]interface{}{
+		{1, "plain", true, 1.5},
+		{int64(-2), "tab\there\nnewline\r\\ \x00", false, null.Float64{}},
+		{uint64(math.MaxUint64), `\N`, []byte("a\tb"), []byte(nil)},
+		{null.IntFrom(3), null.String{}, when, (*string)(nil)},
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "1\tplain\t1\t1.5\n" +
+		`-2` + "\t" + `tab\there\nnewline\r\\ \0` + "\t0\t" + `\N` + "\n" +
+		"18446744073709551615\t" + `\\N` + "\t" + `a\tb` + "\t" + `\N` + "\n" +
+		"3\t" + `\N` + "\t2020-01-02 02:04:05.6\t" + `\N` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("want:\n%q\ngot:\n%q", want, got)
+	}
+
+	if err := w.WriteRow(struct{}{}); err == nil {
+		t.Error("want an error for an unsupported type")
+	}
+}
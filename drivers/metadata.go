@@ -0,0 +1,160 @@
+package drivers
+
+import (
+	"fmt"
+
+	"github.com/friendsofgo/errors"
+)
+
+// MetadataVersion is the version of the Metadata format. Fields may be added
+// without changing it, it's increased when a change would break readers.
+const MetadataVersion = 1
+
+// Metadata is the introspected schema in a stable format for tools that
+// build on sqlboiler's introspection, eg. to render documentation or check
+// conventions. Unlike DBInfo, which follows what the templates need, its
+// fields only change in a backwards compatible way unless MetadataVersion
+// changes.
+type Metadata struct {
+	Version   int             `json:"version"`
+	Dialect   string          `json:"dialect"`
+	Schema    string          `json:"schema"`
+	Tables    []MetadataTable `json:"tables"`
+	Sequences []Sequence      `json:"sequences,omitempty"`
+}
+
+// MetadataTable is a table of the schema.
+type MetadataTable struct {
+	Name        string               `json:"name"`
+	Columns     []MetadataColumn     `json:"columns"`
+	PrimaryKey  *MetadataKey         `json:"primary_key,omitempty"`
+	Uniques     []MetadataKey        `json:"uniques,omitempty"`
+	ForeignKeys []MetadataForeignKey `json:"foreign_keys,omitempty"`
+	JoinTable   bool                 `json:"join_table"`
+}
+
+// MetadataColumn is a column of a table. DBType is the type as the database
+// names it and FullDBType includes its parameters, eg. character varying and
+// character varying(255). GoType is the type sqlboiler generates for it.
+type MetadataColumn struct {
+	Name          string `json:"name"`
+	DBType        string `json:"db_type"`
+	FullDBType    string `json:"full_db_type,omitempty"`
+	GoType        string `json:"go_type"`
+	Nullable      bool   `json:"nullable"`
+	Unique        bool   `json:"unique"`
+	Default       string `json:"default,omitempty"`
+	Comment       string `json:"comment,omitempty"`
+	AutoGenerated bool   `json:"auto_generated"`
+}
+
+// MetadataKey is a primary key or unique constraint.
+type MetadataKey struct {
+	Name    string   `json:"name,omitempty"`
+	Columns []string `json:"columns"`
+}
+
+// MetadataForeignKey is a foreign key, Columns[i] references
+// ForeignColumns[i].
+type MetadataForeignKey struct {
+	Name           string   `json:"name"`
+	Columns        []string `json:"columns"`
+	ForeignTable   string   `json:"foreign_table"`
+	ForeignColumns []string `json:"foreign_columns"`
+	Nullable       bool     `json:"nullable"`
+	Unique         bool     `json:"unique"`
+}
+
+// Introspect reads the schema with the registered driver name, see
+// RegisterFromInit, and returns it as Metadata. The config is the same as the
+// driver's section of sqlboiler's config file, eg.
+//
+//	import _ "github.com/volatiletech/sqlboiler/v4/drivers/sqlboiler-psql/driver"
+//
+//	meta, err := drivers.Introspect("psql", drivers.Config{
+//	  "dbname": "app", "host": "localhost", "user": "app", "sslmode": "disable",
+//	})
+func Introspect(name string, config Config) (meta *Metadata, err error) {
+	defer func() {
+		// GetDriver panics for unregistered drivers
+		if r := recover(); r != nil {
+			meta = nil
+			err = errors.New(fmt.Sprint(r))
+		}
+	}()
+
+	dbInfo, err := GetDriver(name).Assemble(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch table data")
+	}
+
+	return NewMetadata(dbInfo), nil
+}
+
+// NewMetadata converts dbInfo to Metadata.
+func NewMetadata(dbInfo *DBInfo) *Metadata {
+	meta := &Metadata{
+		Version:   MetadataVersion,
+		Dialect:   dbInfo.Dialect.Name,
+		Schema:    dbInfo.Schema,
+		Tables:    make([]MetadataTable, len(dbInfo.Tables)),
+		Sequences: dbInfo.Sequences,
+	}
+
+	for i, t := range dbInfo.Tables {
+		table := MetadataTable{
+			Name:      t.Name,
+			Columns:   make([]MetadataColumn, len(t.Columns)),
+			JoinTable: t.IsJoinTable,
+		}
+
+		for j, c := range t.Columns {
+			table.Columns[j] = MetadataColumn{
+				Name:          c.Name,
+				DBType:        c.DBType,
+				FullDBType:    c.FullDBType,
+				GoType:        c.Type,
+				Nullable:      c.Nullable,
+				Unique:        c.Unique,
+				Default:       c.Default,
+				Comment:       c.Comment,
+				AutoGenerated: c.AutoGenerated,
+			}
+
+			// Only unique constraints over a single column are introspected,
+			// and a primary key over just this column is one already
+			if c.Unique && (t.PKey == nil || len(t.PKey.Columns) != 1 || t.PKey.Columns[0] != c.Name) {
+				table.Uniques = append(table.Uniques, MetadataKey{Columns: []string{c.Name}})
+			}
+		}
+
+		if t.PKey != nil {
+			table.PrimaryKey = &MetadataKey{Name: t.PKey.Name, Columns: t.PKey.Columns}
+		}
+
+		for _, fk := range t.FKeys {
+			table.ForeignKeys = append(table.ForeignKeys, MetadataForeignKey{
+				Name:           fk.Name,
+				Columns:        []string{fk.Column},
+				ForeignTable:   fk.ForeignTable,
+				ForeignColumns: []string{fk.ForeignColumn},
+				Nullable:       fk.Nullable,
+				Unique:         fk.Unique,
+			})
+		}
+		for _, fk := range t.CompositeFKeys {
+			table.ForeignKeys = append(table.ForeignKeys, MetadataForeignKey{
+				Name:           fk.Name,
+				Columns:        fk.Columns,
+				ForeignTable:   fk.ForeignTable,
+				ForeignColumns: fk.ForeignColumns,
+				Nullable:       fk.Nullable,
+				Unique:         fk.Unique,
+			})
+		}
+
+		meta.Tables[i] = table
+	}
+
+	return meta
+}
@@ -0,0 +1,66 @@
+package drivers
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewMetadata(t *testing.T) {
+	t.Parallel()
+
+	dbInfo := &DBInfo{
+		Schema:  "public",
+		Dialect: Dialect{Name: "psql"},
+		Tables: []Table{
+			{
+				Name: "jets",
+				Columns: []Column{
+					{Name: "id", Type: "int", DBType: "integer", Unique: true, Default: "nextval('jets_id_seq'::regclass)"},
+					{Name: "pilot_id", Type: "null.Int", DBType: "integer", Nullable: true},
+					{Name: "serial", Type: "string", DBType: "character varying", FullDBType: "character varying(20)", Unique: true, Comment: "painted on the tail"},
+				},
+				PKey:  &PrimaryKey{Name: "jets_pkey", Columns: []string{"id"}},
+				FKeys: []ForeignKey{{Name: "jets_pilot_fkey", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id", Nullable: true}},
+			},
+		},
+		Sequences: []Sequence{{Name: "jets_id_seq", Table: "jets", Column: "id"}},
+	}
+
+	meta := NewMetadata(dbInfo)
+	if meta.Version != MetadataVersion || meta.Dialect != "psql" || meta.Schema != "public" {
+		t.Errorf("unexpected header: %#v", meta)
+	}
+
+	table := meta.Tables[0]
+	if !reflect.DeepEqual(table.Uniques, []MetadataKey{{Columns: []string{"serial"}}}) {
+		t.Errorf("want only the serial column unique, got: %#v", table.Uniques)
+	}
+	if table.Columns[1].GoType != "null.Int" || table.Columns[2].FullDBType != "character varying(20)" || table.Columns[2].Comment != "painted on the tail" {
+		t.Errorf("unexpected columns: %#v", table.Columns)
+	}
+	want := []MetadataForeignKey{{Name: "jets_pilot_fkey", Columns: []string{"pilot_id"}, ForeignTable: "pilots", ForeignColumns: []string{"id"}, Nullable: true}}
+	if !reflect.DeepEqual(table.ForeignKeys, want) {
+		t.Errorf("want: %#v, got: %#v", want, table.ForeignKeys)
+	}
+
+	b, err := json.Marshal(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Metadata
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, meta) {
+		t.Errorf("want the metadata to survive a round trip through json:\n%s", b)
+	}
+}
+
+func TestIntrospectUnregistered(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Introspect("not-a-driver", Config{}); err == nil {
+		t.Error("want an error for a driver that isn't registered")
+	}
+}
@@ -65,8 +65,14 @@ func (m *MockDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, er
 	schema := config.MustString(drivers.ConfigSchema)
 	whitelist, _ := config.StringSlice(drivers.ConfigWhitelist)
 	blacklist, _ := config.StringSlice(drivers.ConfigBlacklist)
+	concurrency := config.DefaultInt(drivers.ConfigConcurrency, drivers.DefaultConcurrency)
 
-	dbinfo.Tables, err = drivers.Tables(m, schema, whitelist, blacklist)
+	dbinfo.Tables, err = drivers.TablesConcurrently(m, schema, whitelist, blacklist, concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	dbinfo.Sequences, err = drivers.Sequences(m, schema, dbinfo.Tables)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +85,7 @@ func (m *MockDriver) TableNames(schema string, whitelist, blacklist []string) ([
 	if len(whitelist) > 0 {
 		return whitelist, nil
 	}
-	tables := []string{"pilots", "jets", "airports", "licenses", "hangars", "languages", "pilot_languages"}
+	tables := []string{"pilots", "jets", "airports", "licenses", "hangars", "languages", "pilot_languages", "comments", "seats", "tickets", "seat_details"}
 	return strmangle.SetComplement(tables, blacklist), nil
 }
 
@@ -112,6 +118,7 @@ func (m *MockDriver) Columns(schema, tableName string, whitelist, blacklist []st
 		"hangars": {
 			{Name: "id", Type: "int", DBType: "integer"},
 			{Name: "name", Type: "string", DBType: "character", Nullable: true, Unique: true},
+			{Name: "hangar_id", Type: "int", DBType: "integer", Nullable: true},
 		},
 		"languages": {
 			{Name: "id", Type: "int", DBType: "integer"},
@@ -121,6 +128,28 @@ func (m *MockDriver) Columns(schema, tableName string, whitelist, blacklist []st
 			{Name: "pilot_id", Type: "int", DBType: "integer"},
 			{Name: "language_id", Type: "int", DBType: "integer"},
 		},
+		"comments": {
+			{Name: "id", Type: "int", DBType: "integer"},
+			{Name: "commentable_type", Type: "string", DBType: "character"},
+			{Name: "commentable_id", Type: "int", DBType: "integer", Nullable: true},
+			{Name: "body", Type: "string", DBType: "character"},
+		},
+		"seats": {
+			{Name: "jet_id", Type: "int", DBType: "integer"},
+			{Name: "number", Type: "int", DBType: "integer"},
+			{Name: "class", Type: "string", DBType: "character"},
+		},
+		"tickets": {
+			{Name: "id", Type: "int", DBType: "integer"},
+			{Name: "jet_id", Type: "int", DBType: "integer", Nullable: true},
+			{Name: "seat_number", Type: "int", DBType: "integer", Nullable: true},
+			{Name: "passenger", Type: "string", DBType: "character"},
+		},
+		"seat_details": {
+			{Name: "jet_id", Type: "int", DBType: "integer"},
+			{Name: "number", Type: "int", DBType: "integer"},
+			{Name: "notes", Type: "null.String", DBType: "character", Nullable: true},
+		},
 	}[tableName], nil
 }
 
@@ -131,6 +160,9 @@ func (m *MockDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreign
 			{Table: "jets", Name: "jets_pilot_id_fk", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id", ForeignColumnUnique: true},
 			{Table: "jets", Name: "jets_airport_id_fk", Column: "airport_id", ForeignTable: "airports", ForeignColumn: "id"},
 		},
+		"hangars": {
+			{Table: "hangars", Name: "hangars_hangar_id_fk", Column: "hangar_id", ForeignTable: "hangars", ForeignColumn: "id", ForeignColumnUnique: true},
+		},
 		"licenses": {
 			{Table: "licenses", Name: "licenses_pilot_id_fk", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"},
 		},
@@ -138,6 +170,17 @@ func (m *MockDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreign
 			{Table: "pilot_languages", Name: "pilot_id_fk", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"},
 			{Table: "pilot_languages", Name: "jet_id_fk", Column: "language_id", ForeignTable: "languages", ForeignColumn: "id"},
 		},
+		"seats": {
+			{Table: "seats", Name: "seats_jet_id_fk", Column: "jet_id", ForeignTable: "jets", ForeignColumn: "id"},
+		},
+		"tickets": {
+			{Table: "tickets", Name: "tickets_seat_fk", Column: "jet_id", ForeignTable: "seats", ForeignColumn: "jet_id"},
+			{Table: "tickets", Name: "tickets_seat_fk", Column: "seat_number", ForeignTable: "seats", ForeignColumn: "number"},
+		},
+		"seat_details": {
+			{Table: "seat_details", Name: "seat_details_seat_fk", Column: "jet_id", ForeignTable: "seats", ForeignColumn: "jet_id"},
+			{Table: "seat_details", Name: "seat_details_seat_fk", Column: "number", ForeignTable: "seats", ForeignColumn: "number"},
+		},
 	}[tableName], nil
 }
 
@@ -190,6 +233,14 @@ func (m *MockDriver) TranslateColumnType(c drivers.Column) drivers.Column {
 	return c
 }
 
+// Sequences returns a list of mock sequences
+func (m *MockDriver) Sequences(schema string) ([]drivers.Sequence, error) {
+	return []drivers.Sequence{
+		{Name: "pilots_id_seq", Table: "pilots", Column: "id"},
+		{Name: "ticket_numbers"},
+	}, nil
+}
+
 // PrimaryKeyInfo returns mock primary key info for the passed in table name
 func (m *MockDriver) PrimaryKeyInfo(schema, tableName string) (*drivers.PrimaryKey, error) {
 	return map[string]*drivers.PrimaryKey{
@@ -221,6 +272,22 @@ func (m *MockDriver) PrimaryKeyInfo(schema, tableName string) (*drivers.PrimaryK
 			Name:    "pilot_languages_pkey",
 			Columns: []string{"pilot_id", "language_id"},
 		},
+		"comments": {
+			Name:    "comment_id_pkey",
+			Columns: []string{"id"},
+		},
+		"seats": {
+			Name:    "seats_pkey",
+			Columns: []string{"jet_id", "number"},
+		},
+		"tickets": {
+			Name:    "ticket_id_pkey",
+			Columns: []string{"id"},
+		},
+		"seat_details": {
+			Name:    "seat_details_pkey",
+			Columns: []string{"jet_id", "number"},
+		},
 	}[tableName], nil
 }
 
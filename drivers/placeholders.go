@@ -0,0 +1,299 @@
+package drivers
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"reflect"
+	"strconv"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// DefaultIndexPlaceholderPrefix is used to build numbered placeholders
+// ($1, $2, ...) when a dialect uses index placeholders but doesn't specify
+// a prefix of its own.
+const DefaultIndexPlaceholderPrefix = "$"
+
+// DefaultNamedPlaceholderPrefix is used to build named placeholders (:name)
+// when a dialect doesn't specify a prefix of its own.
+const DefaultNamedPlaceholderPrefix = ":"
+
+// Placeholder returns the bind parameter for the n'th argument (1 based) of
+// a query. Dialects that don't use index placeholders, or an n of 0, always
+// produce a question mark.
+func (d Dialect) Placeholder(n int) string {
+	if !d.UseIndexPlaceholders || n == 0 {
+		return "?"
+	}
+
+	var scratch [24]byte
+	return string(strconv.AppendInt(append(scratch[:0], d.indexPlaceholderPrefix()...), int64(n), 10))
+}
+
+// WritePlaceholder writes Placeholder(n) to buf without allocating, for
+// building statements in a buffer.
+func (d Dialect) WritePlaceholder(buf *bytes.Buffer, n int) {
+	if !d.UseIndexPlaceholders || n == 0 {
+		buf.WriteByte('?')
+		return
+	}
+
+	var scratch [20]byte
+	buf.WriteString(d.indexPlaceholderPrefix())
+	buf.Write(strconv.AppendInt(scratch[:0], int64(n), 10))
+}
+
+func (d Dialect) indexPlaceholderPrefix() string {
+	if len(d.IndexPlaceholderPrefix) == 0 {
+		return DefaultIndexPlaceholderPrefix
+	}
+	return d.IndexPlaceholderPrefix
+}
+
+// Placeholders generates count placeholders starting at start. If group is
+// greater than 1 the placeholders are wrapped in parens in sets of group,
+// for example count 4, start 1 and group 2 produces: ($1,$2),($3,$4)
+func (d Dialect) Placeholders(count, start, group int) string {
+	if start == 0 || group == 0 {
+		panic("Invalid start or group numbers supplied.")
+	}
+
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	if group > 1 {
+		buf.WriteByte('(')
+	}
+	for i := 0; i < count; i++ {
+		if i != 0 {
+			if group > 1 && i%group == 0 {
+				buf.WriteString("),(")
+			} else {
+				buf.WriteByte(',')
+			}
+		}
+		d.WritePlaceholder(buf, start+i)
+	}
+	if group > 1 {
+		buf.WriteByte(')')
+	}
+
+	return buf.String()
+}
+
+// WhereClause returns the where clause for cols, each compared to a
+// placeholder starting at start, for example: "a"=$1 AND "b"=$2
+func (d Dialect) WhereClause(start int, cols []string) string {
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	d.writeWhereClause(buf, start, cols)
+	return buf.String()
+}
+
+// WhereClauseArgs is like WhereClause but takes the value each of cols will
+// be compared to. Comparing to NULL with = never matches, so columns whose
+// value is nil, a nil pointer or a null type (a driver.Valuer producing nil)
+// are checked with IS NULL instead and their value is left out of the
+// returned args: "a"=$1 AND "b" IS NULL
+func (d Dialect) WhereClauseArgs(start int, cols []string, args []interface{}) (string, []interface{}) {
+	if len(cols) != len(args) {
+		panic("WhereClauseArgs: the number of columns and args must match")
+	}
+
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	bound := make([]interface{}, 0, len(args))
+	for i, c := range cols {
+		if i != 0 {
+			buf.WriteString(" AND ")
+		}
+
+		if isNullArg(args[i]) {
+			buf.WriteRune(d.LQ)
+			buf.WriteString(c)
+			buf.WriteRune(d.RQ)
+			buf.WriteString(" IS NULL")
+			continue
+		}
+
+		d.writeAssignment(buf, c, start, len(bound))
+		bound = append(bound, args[i])
+	}
+
+	return buf.String(), bound
+}
+
+// isNullArg checks if arg would be sent to the database as NULL
+func isNullArg(arg interface{}) bool {
+	if arg == nil {
+		return true
+	}
+
+	if valuer, ok := arg.(driver.Valuer); ok {
+		rv := reflect.ValueOf(arg)
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return true
+		}
+		val, err := valuer.Value()
+		return err == nil && val == nil
+	}
+
+	rv := reflect.ValueOf(arg)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		return rv.IsNil()
+	}
+
+	return false
+}
+
+// WhereClauseRepeated returns the where clause for cols repeated count
+// times with OR in between, each repetition continuing the placeholder
+// numbering of the one before it:
+// ("a"=$1 AND "b"=$2) OR ("a"=$3 AND "b"=$4)
+func (d Dialect) WhereClauseRepeated(start int, cols []string, count int) string {
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	buf.WriteByte('(')
+	for i := 0; i < count; i++ {
+		if i != 0 {
+			buf.WriteString(") OR (")
+		}
+
+		startIndex := 0
+		if start > 0 {
+			startIndex = start + i*len(cols)
+		}
+		d.writeWhereClause(buf, startIndex, cols)
+	}
+	buf.WriteByte(')')
+
+	return buf.String()
+}
+
+// SetParamNames returns the set clause for an update of cols, with
+// placeholders starting at start, for example: "a"=$1,"b"=$2
+func (d Dialect) SetParamNames(start int, cols []string) string {
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	for i, c := range cols {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		d.writeAssignment(buf, c, start, i)
+	}
+
+	return buf.String()
+}
+
+// NamedPlaceholder returns the bind parameter for the argument called name
+func (d Dialect) NamedPlaceholder(name string) string {
+	prefix := d.NamedPlaceholderPrefix
+	if len(prefix) == 0 {
+		prefix = DefaultNamedPlaceholderPrefix
+	}
+
+	return prefix + name
+}
+
+// NamedPlaceholders is like Placeholders but produces a named parameter
+// for each of cols, eg. :a,:b. The parameter names are returned in the
+// order they appear in the SQL so the arguments can be bound to them.
+func (d Dialect) NamedPlaceholders(cols []string) (string, []string) {
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	for i, c := range cols {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		d.writeNamedPlaceholder(buf, c)
+	}
+
+	return buf.String(), paramNames(cols)
+}
+
+// NamedWhereClause is like WhereClause but compares each of cols to a
+// named parameter: "a"=:a AND "b"=:b. The parameter names are returned in
+// the order they appear in the SQL.
+func (d Dialect) NamedWhereClause(cols []string) (string, []string) {
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	for i, c := range cols {
+		if i != 0 {
+			buf.WriteString(" AND ")
+		}
+		d.writeNamedAssignment(buf, c)
+	}
+
+	return buf.String(), paramNames(cols)
+}
+
+// NamedSetParamNames is like SetParamNames but assigns each of cols from a
+// named parameter: "a"=:a,"b"=:b. The parameter names are returned in the
+// order they appear in the SQL.
+func (d Dialect) NamedSetParamNames(cols []string) (string, []string) {
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	for i, c := range cols {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		d.writeNamedAssignment(buf, c)
+	}
+
+	return buf.String(), paramNames(cols)
+}
+
+func paramNames(cols []string) []string {
+	names := make([]string, len(cols))
+	copy(names, cols)
+	return names
+}
+
+func (d Dialect) writeNamedAssignment(buf *bytes.Buffer, col string) {
+	buf.WriteRune(d.LQ)
+	buf.WriteString(col)
+	buf.WriteRune(d.RQ)
+	buf.WriteByte('=')
+	d.writeNamedPlaceholder(buf, col)
+}
+
+func (d Dialect) writeNamedPlaceholder(buf *bytes.Buffer, name string) {
+	if len(d.NamedPlaceholderPrefix) == 0 {
+		buf.WriteString(DefaultNamedPlaceholderPrefix)
+	} else {
+		buf.WriteString(d.NamedPlaceholderPrefix)
+	}
+	buf.WriteString(name)
+}
+
+func (d Dialect) writeWhereClause(buf *bytes.Buffer, start int, cols []string) {
+	for i, c := range cols {
+		if i != 0 {
+			buf.WriteString(" AND ")
+		}
+		d.writeAssignment(buf, c, start, i)
+	}
+}
+
+// writeAssignment writes col=placeholder for the i'th column of a clause
+// whose placeholders begin at start. A start of 0 means question marks
+// regardless of the dialect, the same as the strmangle helpers.
+func (d Dialect) writeAssignment(buf *bytes.Buffer, col string, start, i int) {
+	buf.WriteRune(d.LQ)
+	buf.WriteString(col)
+	buf.WriteRune(d.RQ)
+	buf.WriteByte('=')
+	if start == 0 {
+		buf.WriteByte('?')
+	} else {
+		d.WritePlaceholder(buf, start+i)
+	}
+}
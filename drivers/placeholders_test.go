@@ -0,0 +1,151 @@
+package drivers
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func TestDialectPlaceholders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Dialect Dialect
+		Count   int
+		Start   int
+		Group   int
+		Want    string
+	}{
+		{Dialect{}, 3, 1, 1, "?,?,?"},
+		{Dialect{UseIndexPlaceholders: true}, 3, 2, 1, "$2,$3,$4"},
+		{Dialect{UseIndexPlaceholders: true}, 3, 1, 2, "($1,$2),($3)"},
+		{Dialect{UseIndexPlaceholders: true, IndexPlaceholderPrefix: "@p"}, 2, 1, 1, "@p1,@p2"},
+		{Dialect{UseIndexPlaceholders: true, IndexPlaceholderPrefix: ":"}, 4, 1, 2, "(:1,:2),(:3,:4)"},
+	}
+
+	for i, test := range tests {
+		if got := test.Dialect.Placeholders(test.Count, test.Start, test.Group); got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+	}
+}
+
+func TestDialectWritePlaceholder(t *testing.T) {
+	tests := []struct {
+		Dialect Dialect
+		N       int
+		Want    string
+	}{
+		{Dialect{}, 3, "?"},
+		{Dialect{UseIndexPlaceholders: true}, 0, "?"},
+		{Dialect{UseIndexPlaceholders: true}, 1234, "$1234"},
+		{Dialect{UseIndexPlaceholders: true, IndexPlaceholderPrefix: "@p"}, 7, "@p7"},
+	}
+
+	buf := &bytes.Buffer{}
+	for i, test := range tests {
+		buf.Reset()
+		test.Dialect.WritePlaceholder(buf, test.N)
+		if got := buf.String(); got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+		if got := test.Dialect.Placeholder(test.N); got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+	}
+
+	// Not parallel so nothing else allocates while this is measured
+	dialect := Dialect{UseIndexPlaceholders: true}
+	buf.Grow(64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf.Reset()
+		dialect.WritePlaceholder(buf, 65535)
+	})
+	if allocs != 0 {
+		t.Errorf("want no allocations, got %v", allocs)
+	}
+}
+
+func TestDialectClauses(t *testing.T) {
+	t.Parallel()
+
+	psql := Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	mssql := Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, IndexPlaceholderPrefix: "@p"}
+	mysql := Dialect{LQ: '`', RQ: '`'}
+	cols := []string{"a", "b"}
+
+	tests := []struct {
+		Got  string
+		Want string
+	}{
+		{psql.WhereClause(2, cols), `"a"=$2 AND "b"=$3`},
+		{psql.WhereClause(0, cols), `"a"=? AND "b"=?`},
+		{mssql.WhereClause(1, cols), `[a]=@p1 AND [b]=@p2`},
+		{mysql.WhereClause(1, cols), "`a`=? AND `b`=?"},
+		{psql.WhereClauseRepeated(1, cols, 2), `("a"=$1 AND "b"=$2) OR ("a"=$3 AND "b"=$4)`},
+		{mssql.WhereClauseRepeated(3, cols, 2), `([a]=@p3 AND [b]=@p4) OR ([a]=@p5 AND [b]=@p6)`},
+		{psql.SetParamNames(1, cols), `"a"=$1,"b"=$2`},
+		{mssql.SetParamNames(1, cols), `[a]=@p1,[b]=@p2`},
+		{mysql.SetParamNames(1, cols), "`a`=?,`b`=?"},
+	}
+
+	for i, test := range tests {
+		if test.Got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, test.Got)
+		}
+	}
+}
+
+func TestDialectNamedPlaceholders(t *testing.T) {
+	t.Parallel()
+
+	psql := Dialect{LQ: '"', RQ: '"'}
+	mssql := Dialect{LQ: '[', RQ: ']', NamedPlaceholderPrefix: "@"}
+	cols := []string{"a", "b_c"}
+
+	check := func(want, got string, names []string) {
+		t.Helper()
+		if got != want {
+			t.Errorf("want: %s, got: %s", want, got)
+		}
+		if len(names) != 2 || names[0] != "a" || names[1] != "b_c" {
+			t.Errorf("wrong names: %v", names)
+		}
+	}
+
+	got, names := psql.NamedPlaceholders(cols)
+	check(":a,:b_c", got, names)
+	got, names = mssql.NamedPlaceholders(cols)
+	check("@a,@b_c", got, names)
+	got, names = psql.NamedWhereClause(cols)
+	check(`"a"=:a AND "b_c"=:b_c`, got, names)
+	got, names = mssql.NamedSetParamNames(cols)
+	check("[a]=@a,[b_c]=@b_c", got, names)
+}
+
+func TestDialectWhereClauseArgs(t *testing.T) {
+	t.Parallel()
+
+	psql := Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	mysql := Dialect{LQ: '`', RQ: '`'}
+	cols := []string{"a", "b", "c", "d"}
+	var nilPtr *int
+
+	where, args := psql.WhereClauseArgs(2, cols, []interface{}{1, null.Int{}, nilPtr, null.IntFrom(5)})
+	if want := `"a"=$2 AND "b" IS NULL AND "c" IS NULL AND "d"=$3`; where != want {
+		t.Errorf("want: %s, got: %s", want, where)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1, null.IntFrom(5)}) {
+		t.Errorf("wrong args: %#v", args)
+	}
+
+	where, args = mysql.WhereClauseArgs(1, cols[:2], []interface{}{nil, "x"})
+	if want := "`a` IS NULL AND `b`=?"; where != want {
+		t.Errorf("want: %s, got: %s", want, where)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"x"}) {
+		t.Errorf("wrong args: %#v", args)
+	}
+}
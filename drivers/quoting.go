@@ -0,0 +1,80 @@
+package drivers
+
+import "strings"
+
+// QuoteIdent quotes each part of a possibly qualified identifier such as
+// table.column with the dialect's quote characters. Parts that are already
+// quoted with them, a trailing *, null and ? are left alone, as is anything
+// that isn't a plain identifier like expressions and function calls.
+func (d Dialect) QuoteIdent(s string) string {
+	if strings.EqualFold(s, "null") || s == "?" {
+		return s
+	}
+
+	parts := strings.Split(s, ".")
+	for i, part := range parts {
+		switch {
+		case part == "*" && i == len(parts)-1 && i != 0:
+		case d.isQuoted(part):
+		case isBareIdent(part, i == 0):
+			parts[i] = string(d.LQ) + part + string(d.RQ)
+		default:
+			return s
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// QuoteIdentSlice applies QuoteIdent to each element of s
+func (d Dialect) QuoteIdentSlice(s []string) []string {
+	if len(s) == 0 {
+		return s
+	}
+
+	quoted := make([]string, len(s))
+	for i, str := range s {
+		quoted[i] = d.QuoteIdent(str)
+	}
+
+	return quoted
+}
+
+// Unquote removes the dialect's quote characters from around each part of
+// an identifier, eg. "a"."b" becomes a.b
+func (d Dialect) Unquote(s string) string {
+	parts := strings.Split(s, ".")
+	for i, part := range parts {
+		if d.isQuoted(part) {
+			parts[i] = part[len(string(d.LQ)) : len(part)-len(string(d.RQ))]
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
+
+func (d Dialect) isQuoted(part string) bool {
+	lq, rq := string(d.LQ), string(d.RQ)
+	return len(part) > len(lq)+len(rq) && strings.HasPrefix(part, lq) && strings.HasSuffix(part, rq)
+}
+
+// isBareIdent checks for an unquoted identifier, the first part of a
+// qualified name may also contain dashes, the same as strmangle.IdentQuote
+// allows.
+func isBareIdent(part string, first bool) bool {
+	if len(part) == 0 {
+		return false
+	}
+
+	for i, c := range part {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case i != 0 && c >= '0' && c <= '9':
+		case i != 0 && first && c == '-':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
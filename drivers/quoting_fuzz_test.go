@@ -0,0 +1,30 @@
+//go:build go1.18
+// +build go1.18
+
+package drivers
+
+import (
+	"testing"
+)
+
+func FuzzQuoteIdent(f *testing.F) {
+	for _, seed := range []string{"", "a", "a.b", `"a".b`, "a.*", "*", "?", "null", "a b", "schema.t-1", `"`, "[x]"} {
+		f.Add(seed)
+	}
+
+	dialects := []Dialect{
+		{LQ: '"', RQ: '"'},
+		{LQ: '`', RQ: '`'},
+		{LQ: '[', RQ: ']'},
+	}
+
+	f.Fuzz(func(t *testing.T, ident string) {
+		for _, d := range dialects {
+			quoted := d.QuoteIdent(ident)
+			if again := d.QuoteIdent(quoted); again != quoted {
+				t.Errorf("quoting %q twice gives %q then %q", ident, quoted, again)
+			}
+			_ = d.Unquote(quoted)
+		}
+	})
+}
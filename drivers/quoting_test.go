@@ -0,0 +1,50 @@
+package drivers
+
+import "testing"
+
+func TestDialectQuoteIdent(t *testing.T) {
+	t.Parallel()
+
+	psql := Dialect{LQ: '"', RQ: '"'}
+	mysql := Dialect{LQ: '`', RQ: '`'}
+	mssql := Dialect{LQ: '[', RQ: ']'}
+
+	tests := []struct {
+		Dialect Dialect
+		In      string
+		Want    string
+	}{
+		{psql, "thing", `"thing"`},
+		{psql, `"thing"`, `"thing"`},
+		{psql, "a.b", `"a"."b"`},
+		{psql, `"a".b`, `"a"."b"`},
+		{psql, "a.*", `"a".*`},
+		{psql, "null", "null"},
+		{psql, "?", "?"},
+		{psql, "count(*)", "count(*)"},
+		{psql, "a b", "a b"},
+		{psql, "my-table.id", `"my-table"."id"`},
+		{mysql, "a.b", "`a`.`b`"},
+		{mysql, "`a`.b", "`a`.`b`"},
+		{mysql, `"a"`, `"a"`},
+		{mssql, "dbo.thing", "[dbo].[thing]"},
+		{mssql, "[dbo].thing", "[dbo].[thing]"},
+	}
+
+	for i, test := range tests {
+		if got := test.Dialect.QuoteIdent(test.In); got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+	}
+}
+
+func TestDialectUnquote(t *testing.T) {
+	t.Parallel()
+
+	if got := (Dialect{LQ: '"', RQ: '"'}).Unquote(`"a"."b"`); got != "a.b" {
+		t.Errorf("got: %s", got)
+	}
+	if got := (Dialect{LQ: '[', RQ: ']'}).Unquote("[a].b"); got != "a.b" {
+		t.Errorf("got: %s", got)
+	}
+}
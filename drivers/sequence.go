@@ -0,0 +1,50 @@
+package drivers
+
+import "github.com/friendsofgo/errors"
+
+// Sequence is a database sequence. Table and Column are set when a column
+// owns the sequence, like the ones behind serial and identity columns.
+type Sequence struct {
+	Name   string `json:"name"`
+	Table  string `json:"table,omitempty"`
+	Column string `json:"column,omitempty"`
+}
+
+// SequenceConstructor is implemented by drivers for databases that have
+// sequences, in addition to Constructor.
+type SequenceConstructor interface {
+	Sequences(schema string) ([]Sequence, error)
+}
+
+// Sequences returns the schema's sequences, minus the ones owned by columns
+// that aren't in tables, eg. because of the whitelist or blacklist.
+func Sequences(c SequenceConstructor, schema string, tables []Table) ([]Sequence, error) {
+	seqs, err := c.Sequences(schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch sequences")
+	}
+
+	var kept []Sequence
+	for _, seq := range seqs {
+		if len(seq.Table) != 0 && !hasColumn(tables, seq.Table, seq.Column) {
+			continue
+		}
+		kept = append(kept, seq)
+	}
+
+	return kept, nil
+}
+
+func hasColumn(tables []Table, table, column string) bool {
+	for _, t := range tables {
+		if t.Name != table {
+			continue
+		}
+		for _, c := range t.Columns {
+			if c.Name == column {
+				return true
+			}
+		}
+	}
+	return false
+}
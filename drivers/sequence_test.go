@@ -0,0 +1,34 @@
+package drivers
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testSequenceConstructor []Sequence
+
+func (t testSequenceConstructor) Sequences(schema string) ([]Sequence, error) {
+	return t, nil
+}
+
+func TestSequences(t *testing.T) {
+	t.Parallel()
+
+	c := testSequenceConstructor{
+		{Name: "pilots_id_seq", Table: "pilots", Column: "id"},
+		{Name: "jets_id_seq", Table: "jets", Column: "id"},
+		{Name: "pilots_number_seq", Table: "pilots", Column: "number"},
+		{Name: "ticket_numbers"},
+	}
+	tables := []Table{{Name: "pilots", Columns: []Column{{Name: "id"}}}}
+
+	seqs, err := Sequences(c, "public", tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Sequence{c[0], c[3]}
+	if !reflect.DeepEqual(seqs, want) {
+		t.Errorf("want: %v, got: %v", want, seqs)
+	}
+}
@@ -2,7 +2,7 @@ package driver
 
 import (
 	"database/sql"
-	"encoding/base64"
+	"embed"
 	"fmt"
 	"net/url"
 	"strings"
@@ -11,6 +11,7 @@ import (
 	_ "github.com/denisenkom/go-mssqldb"
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/importers"
 	"github.com/volatiletech/strmangle"
 )
@@ -19,7 +20,8 @@ func init() {
 	drivers.RegisterFromInit("mssql", &MSSQLDriver{})
 }
 
-//go:generate go-bindata -nometadata -pkg driver -prefix override override/...
+//go:embed override
+var templates embed.FS
 
 // Assemble is more useful for calling into the library so you don't
 // have to instantiate an empty type.
@@ -37,18 +39,7 @@ type MSSQLDriver struct {
 
 // Templates that should be added/overridden
 func (MSSQLDriver) Templates() (map[string]string, error) {
-	names := AssetNames()
-	tpls := make(map[string]string)
-	for _, n := range names {
-		b, err := Asset(n)
-		if err != nil {
-			return nil, err
-		}
-
-		tpls[n] = base64.StdEncoding.EncodeToString(b)
-	}
-
-	return tpls, nil
+	return drivers.TemplatesFromFS(templates, "override")
 }
 
 // Assemble all the information we need to provide back to the driver
@@ -70,8 +61,14 @@ func (m *MSSQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 	schema := config.DefaultString(drivers.ConfigSchema, "dbo")
 	whitelist, _ := config.StringSlice(drivers.ConfigWhitelist)
 	blacklist, _ := config.StringSlice(drivers.ConfigBlacklist)
+	concurrency := config.DefaultInt(drivers.ConfigConcurrency, drivers.DefaultConcurrency)
 
 	m.connStr = MSSQLBuildQueryString(user, pass, dbname, host, port, sslmode)
+	// Name the session so it can be told apart from the application's ones
+	m.connStr, err = boil.ApplicationNameDSN("mssql", m.connStr, "sqlboiler")
+	if err != nil {
+		return nil, err
+	}
 	m.conn, err = sql.Open("mssql", m.connStr)
 	if err != nil {
 		return nil, errors.Wrap(err, "sqlboiler-mssql failed to connect to database")
@@ -87,6 +84,8 @@ func (m *MSSQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 	dbinfo = &drivers.DBInfo{
 		Schema: schema,
 		Dialect: drivers.Dialect{
+			Name: "mssql",
+
 			LQ: '[',
 			RQ: ']',
 
@@ -94,13 +93,21 @@ func (m *MSSQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 			UseSchema:            true,
 			UseDefaultKeyword:    true,
 
+			// sp_executesql takes two of the 2100 parameters for itself
+			MaxParameters: 2098,
+
 			UseAutoColumns:          true,
 			UseTopClause:            true,
 			UseOutputClause:         true,
 			UseCaseWhenExistsClause: true,
 		},
 	}
-	dbinfo.Tables, err = drivers.Tables(m, schema, whitelist, blacklist)
+	dbinfo.Tables, err = drivers.TablesConcurrently(m, schema, whitelist, blacklist, concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	dbinfo.Sequences, err = drivers.Sequences(m, schema, dbinfo.Tables)
 	if err != nil {
 		return nil, err
 	}
@@ -274,9 +281,52 @@ func (m *MSSQLDriver) Columns(schema, tableName string, whitelist, blacklist []s
 		columns = append(columns, column)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	checks, err := m.checkValues(schema, tableName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read check constraints of table %s", tableName)
+	}
+	for i := range columns {
+		columns[i].CheckValues = checks[columns[i].Name]
+	}
+
 	return columns, nil
 }
 
+// checkValues finds the values the column check constraints of the table
+// allow, see drivers.CheckValues.
+func (m *MSSQLDriver) checkValues(schema, tableName string) (map[string][]string, error) {
+	query := `
+	SELECT c.name, cc.definition
+	FROM sys.check_constraints cc
+	INNER JOIN sys.columns c ON c.object_id = cc.parent_object_id AND c.column_id = cc.parent_column_id
+	WHERE cc.parent_object_id = object_id($1 + '.' + $2)
+	ORDER BY cc.name;`
+
+	rows, err := m.conn.Query(query, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	checks := make(map[string][]string)
+	for rows.Next() {
+		var column, definition string
+		if err := rows.Scan(&column, &definition); err != nil {
+			return nil, err
+		}
+
+		if values := drivers.CheckValues(column, definition); values != nil {
+			checks[column] = values
+		}
+	}
+
+	return checks, rows.Err()
+}
+
 // PrimaryKeyInfo looks up the primary key for a table.
 func (m *MSSQLDriver) PrimaryKeyInfo(schema, tableName string) (*drivers.PrimaryKey, error) {
 	pkey := &drivers.PrimaryKey{}
@@ -333,23 +383,21 @@ func (m *MSSQLDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreig
 	var fkeys []drivers.ForeignKey
 
 	query := `
-	SELECT ccu.constraint_name ,
-		ccu.table_name AS local_table ,
-		ccu.column_name AS local_column ,
-		kcu.table_name AS foreign_table ,
-		kcu.column_name AS foreign_column
-	FROM information_schema.constraint_column_usage ccu
-	INNER JOIN information_schema.referential_constraints rc ON ccu.constraint_name = rc.constraint_name
-	INNER JOIN information_schema.key_column_usage kcu ON kcu.constraint_name = rc.unique_constraint_name
-	WHERE ccu.table_schema = ?
-	  AND ccu.constraint_schema = ?
-	  AND ccu.table_name = ?
-	ORDER BY ccu.constraint_name, local_table, local_column, foreign_table, foreign_column
+	SELECT fk.name AS constraint_name ,
+		OBJECT_NAME(fkc.parent_object_id) AS local_table ,
+		COL_NAME(fkc.parent_object_id, fkc.parent_column_id) AS local_column ,
+		OBJECT_NAME(fkc.referenced_object_id) AS foreign_table ,
+		COL_NAME(fkc.referenced_object_id, fkc.referenced_column_id) AS foreign_column
+	FROM sys.foreign_keys fk
+	INNER JOIN sys.foreign_key_columns fkc ON fkc.constraint_object_id = fk.object_id
+	WHERE SCHEMA_NAME(fk.schema_id) = ?
+	  AND OBJECT_NAME(fk.parent_object_id) = ?
+	ORDER BY fk.name, fkc.constraint_column_id
 	`
 
 	var rows *sql.Rows
 	var err error
-	if rows, err = m.conn.Query(query, schema, schema, tableName); err != nil {
+	if rows, err = m.conn.Query(query, schema, tableName); err != nil {
 		return nil, err
 	}
 
@@ -373,6 +421,33 @@ func (m *MSSQLDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreig
 	return fkeys, nil
 }
 
+// Sequences retrieves the schema's sequences, SQL Server doesn't tie them to
+// columns.
+func (m *MSSQLDriver) Sequences(schema string) ([]drivers.Sequence, error) {
+	query := `
+	select name, '', ''
+	from sys.sequences
+	where schema_id = schema_id(@p1)
+	order by name;`
+
+	rows, err := m.conn.Query(query, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seqs []drivers.Sequence
+	for rows.Next() {
+		var seq drivers.Sequence
+		if err := rows.Scan(&seq.Name, &seq.Table, &seq.Column); err != nil {
+			return nil, errors.Wrap(err, "unable to scan sequence")
+		}
+		seqs = append(seqs, seq)
+	}
+
+	return seqs, rows.Err()
+}
+
 // TranslateColumnType converts postgres database types to Go types, for example
 // "varchar" to "string" and "bigint" to "int64". It returns this parsed data
 // as a Column object.
@@ -464,7 +539,7 @@ func (MSSQLDriver) Imports() (col importers.Collection, err error) {
 				`"strings"`,
 			},
 			ThirdParty: importers.List{
-				`"github.com/volatiletech/strmangle"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
 				`"github.com/volatiletech/sqlboiler/v4/drivers"`,
 			},
 		},
@@ -480,6 +555,7 @@ func (MSSQLDriver) Imports() (col importers.Collection, err error) {
 				`"bytes"`,
 				`"database/sql"`,
 				`"fmt"`,
+				`"io"`,
 				`"os"`,
 				`"os/exec"`,
 				`"regexp"`,
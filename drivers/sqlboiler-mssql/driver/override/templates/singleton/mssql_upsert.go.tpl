@@ -1,15 +1,15 @@
 // buildUpsertQueryMSSQL builds a SQL statement string using the upsertData provided.
 func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, update, insert []string, output []string) string {
-	insert = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, insert)
+	insert = dia.QuoteIdentSlice(insert)
 
-	buf := strmangle.GetBuffer()
-	defer strmangle.PutBuffer(buf)
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
 
 	startIndex := 1
 
 	fmt.Fprintf(buf, "MERGE INTO %s as [t]\n", tableName)
 	fmt.Fprintf(buf, "USING (SELECT %s) as [s] ([%s])\n",
-		strmangle.Placeholders(dia.UseIndexPlaceholders, len(primary), startIndex, 1),
+		dia.Placeholders(len(primary), startIndex, 1),
 		strings.Join(primary, string(dia.RQ)+","+string(dia.LQ)))
 	fmt.Fprint(buf, "ON (")
 	for i, v := range primary {
@@ -24,7 +24,7 @@ func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, updat
 
 	if len(update) > 0 {
 		fmt.Fprint(buf, "WHEN MATCHED THEN ")
-		fmt.Fprintf(buf, "UPDATE SET %s\n", strmangle.SetParamNames(string(dia.LQ), string(dia.RQ), startIndex, update))
+		fmt.Fprintf(buf, "UPDATE SET %s\n", dia.SetParamNames(startIndex, update))
 
 		startIndex += len(update)
 	}
@@ -32,13 +32,14 @@ func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, updat
 	fmt.Fprint(buf, "WHEN NOT MATCHED THEN ")
 	fmt.Fprintf(buf, "INSERT (%s) VALUES (%s)",
 		strings.Join(insert, ", "),
-		strmangle.Placeholders(dia.UseIndexPlaceholders, len(insert), startIndex, 1))
+		dia.Placeholders(len(insert), startIndex, 1))
 
-	if len(output) > 0 {
-		fmt.Fprintf(buf, "\nOUTPUT INSERTED.[%s];", strings.Join(output, "],INSERTED.["))
-	} else {
-		fmt.Fprint(buf, ";")
+	// $action is output last, it says whether the row was inserted or updated.
+	fmt.Fprint(buf, "\nOUTPUT ")
+	for _, o := range output {
+		fmt.Fprintf(buf, "INSERTED.[%s],", o)
 	}
+	fmt.Fprint(buf, "$action;")
 
 	return buf.String()
 }
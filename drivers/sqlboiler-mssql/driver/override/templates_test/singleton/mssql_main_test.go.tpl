@@ -19,6 +19,10 @@ func init() {
 func (m *mssqlTester) setup() error {
 	var err error
 
+	if len(*flagContainer) != 0 {
+		return errors.New("the -test.container flag is not supported for mssql")
+	}
+
 	viper.SetDefault("mssql.schema", "dbo")
 	viper.SetDefault("mssql.sslmode", "true")
 	viper.SetDefault("mssql.port", 1433)
@@ -59,15 +63,23 @@ func (m *mssqlTester) setup() error {
 
 		createCmd := exec.Command("sqlcmd", "-S", m.host, "-U", m.user, "-P", m.pass, "-d", m.testDBName)
 
-		f, err := os.Open("tables_schema.sql")
-		if err != nil {
-			return errors.Wrap(err, "failed to open tables_schema.sql file")
+		var schema io.Reader
+		if len(*flagSchema) != 0 {
+			if schema, err = readSchemaFiles(*flagSchema); err != nil {
+				return errors.Wrap(err, "failed to read test.schema")
+			}
+		} else {
+			f, err := os.Open("tables_schema.sql")
+			if err != nil {
+				return errors.Wrap(err, "failed to open tables_schema.sql file")
+			}
+
+			defer func() { _ = f.Close() }()
+			schema = f
 		}
 
-		defer func() { _ = f.Close() }()
-
 		stderr := &bytes.Buffer{}
-		createCmd.Stdin = newFKeyDestroyer(rgxMSSQLkey, f)
+		createCmd.Stdin = newFKeyDestroyer(rgxMSSQLkey, schema)
 		createCmd.Stderr = stderr
 
 		if err = createCmd.Start(); err != nil {
@@ -6,7 +6,7 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 		t.Skip("Skipping table with only primary key columns")
 	}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	// Attempt the INSERT side of an UPSERT
 	o := {{$alias.UpSingular}}{}
@@ -17,9 +17,13 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 	{{if not .NoContext}}ctx := context.Background(){{end}}
 	tx := MustTx({{if .NoContext}}{{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}}{{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
-	if err = o.Upsert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer()); err != nil {
+	result, err := o.UpsertWithResult({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer())
+	if err != nil {
 		t.Errorf("Unable to upsert {{$alias.UpSingular}}: %s", err)
 	}
+	if result != boil.UpsertInserted {
+		t.Error("want inserted, got:", result)
+	}
 
 	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
 	if err != nil {
@@ -34,9 +38,13 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	if err = o.Upsert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer()); err != nil {
+	result, err = o.UpsertWithResult({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer())
+	if err != nil {
 		t.Errorf("Unable to upsert {{$alias.UpSingular}}: %s", err)
 	}
+	if result != boil.UpsertUpdated {
+		t.Error("want updated, got:", result)
+	}
 
 	count, err = {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
 	if err != nil {
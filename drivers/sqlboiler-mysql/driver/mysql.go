@@ -2,7 +2,7 @@ package driver
 
 import (
 	"database/sql"
-	"encoding/base64"
+	"embed"
 	"fmt"
 	"strconv"
 	"strings"
@@ -17,7 +17,8 @@ func init() {
 	drivers.RegisterFromInit("mysql", &MySQLDriver{})
 }
 
-//go:generate go-bindata -nometadata -pkg driver -prefix override override/...
+//go:embed override
+var templates embed.FS
 
 // Assemble is more useful for calling into the library so you don't
 // have to instantiate an empty type.
@@ -37,18 +38,7 @@ type MySQLDriver struct {
 
 // Templates that should be added/overridden
 func (MySQLDriver) Templates() (map[string]string, error) {
-	names := AssetNames()
-	tpls := make(map[string]string)
-	for _, n := range names {
-		b, err := Asset(n)
-		if err != nil {
-			return nil, err
-		}
-
-		tpls[n] = base64.StdEncoding.EncodeToString(b)
-	}
-
-	return tpls, nil
+	return drivers.TemplatesFromFS(templates, "override")
 }
 
 // Assemble all the information we need to provide back to the driver
@@ -70,6 +60,7 @@ func (m *MySQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 	schema := dbname
 	whitelist, _ := config.StringSlice(drivers.ConfigWhitelist)
 	blacklist, _ := config.StringSlice(drivers.ConfigBlacklist)
+	concurrency := config.DefaultInt(drivers.ConfigConcurrency, drivers.DefaultConcurrency)
 
 	tinyIntAsIntIntf, ok := config["tinyint_as_int"]
 	if ok {
@@ -93,15 +84,19 @@ func (m *MySQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 
 	dbinfo = &drivers.DBInfo{
 		Dialect: drivers.Dialect{
+			Name: "mysql",
+
 			LQ: '`',
 			RQ: '`',
 
 			UseLastInsertID: true,
 			UseSchema:       false,
+
+			MaxParameters: 65535,
 		},
 	}
 
-	dbinfo.Tables, err = drivers.Tables(m, schema, whitelist, blacklist)
+	dbinfo.Tables, err = drivers.TablesConcurrently(m, schema, whitelist, blacklist, concurrency)
 	if err != nil {
 		return nil, err
 	}
@@ -323,7 +318,7 @@ func (m *MySQLDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreig
 	select constraint_name, table_name, column_name, referenced_table_name, referenced_column_name
 	from information_schema.key_column_usage
 	where table_schema = ? and referenced_table_schema = ? and table_name = ?
-	order by constraint_name, table_name, column_name, referenced_table_name, referenced_column_name
+	order by constraint_name, ordinal_position
 	`
 
 	var rows *sql.Rows
@@ -472,16 +467,31 @@ func (MySQLDriver) Imports() (col importers.Collection, err error) {
 		Standard: importers.List{
 			`"strconv"`,
 		},
+		ThirdParty: importers.List{
+			`"github.com/volatiletech/sqlboiler/v4/drivers"`,
+		},
 	}
 
 	col.Singleton = importers.Map{
+		"mysql_load_data": {
+			Standard: importers.List{
+				`"fmt"`,
+				`"io"`,
+				`"strings"`,
+				`"sync/atomic"`,
+			},
+			ThirdParty: importers.List{
+				`"github.com/go-sql-driver/mysql"`,
+				`"github.com/volatiletech/sqlboiler/v4/drivers"`,
+			},
+		},
 		"mysql_upsert": {
 			Standard: importers.List{
 				`"fmt"`,
 				`"strings"`,
 			},
 			ThirdParty: importers.List{
-				`"github.com/volatiletech/strmangle"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
 				`"github.com/volatiletech/sqlboiler/v4/drivers"`,
 			},
 		},
@@ -503,12 +513,13 @@ func (MySQLDriver) Imports() (col importers.Collection, err error) {
 				`"os"`,
 				`"os/exec"`,
 				`"regexp"`,
-				`"strings"`,
+				`"time"`,
 			},
 			ThirdParty: importers.List{
 				`"github.com/kat-co/vala"`,
 				`"github.com/friendsofgo/errors"`,
 				`"github.com/spf13/viper"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil/testdb"`,
 				`"github.com/volatiletech/sqlboiler/v4/drivers/sqlboiler-mysql/driver"`,
 				`"github.com/volatiletech/randomize"`,
 				`_ "github.com/go-sql-driver/mysql"`,
@@ -40,15 +40,24 @@ var mySQL{{$alias.UpSingular}}UniqueColumns = []string{
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) error {
+	_, err := o.UpsertWithResult({{if not .NoContext}}ctx, {{end -}} exec, updateColumns, insertColumns)
+	return err
+}
+
+// UpsertWithResult is Upsert that also reports whether the row was inserted,
+// updated or left alone on conflict. It's told apart by the affected row count,
+// so it's only accurate when the connection doesn't set clientFoundRows: with
+// it a row the update left unchanged is reported as boil.UpsertInserted.
+func (o *{{$alias.UpSingular}}) UpsertWithResult({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) (boil.UpsertResult, error) {
 	if o == nil {
-		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
+		return boil.UpsertNone, errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
 	}
 
 	{{- template "timestamp_upsert_helper" . }}
 
 	{{if not .NoHooks -}}
 	if err := o.doBeforeUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
-		return err
+		return boil.UpsertNone, err
 	}
 	{{- end}}
 
@@ -56,11 +65,11 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	nzUniques := queries.NonZeroDefaultSet(mySQL{{$alias.UpSingular}}UniqueColumns, o)
 
 	if len(nzUniques) == 0 {
-		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		return boil.UpsertNone, errors.New("cannot upsert with a table that cannot conflict on a unique column")
 	}
 
 	// Build cache key in-line uglily - mysql vs psql problems
-	buf := strmangle.GetBuffer()
+	buf := boil.GetBuffer()
 	buf.WriteString(strconv.Itoa(updateColumns.Kind))
 	for _, c := range updateColumns.Cols {
 		buf.WriteString(c)
@@ -79,7 +88,7 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		buf.WriteString(c)
 	}
 	key := buf.String()
-	strmangle.PutBuffer(buf)
+	boil.PutBuffer(buf)
 
 	{{$alias.DownSingular}}UpsertCacheMut.RLock()
 	cache, cached := {{$alias.DownSingular}}UpsertCache[key]
@@ -100,25 +109,25 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		)
 
 		if !updateColumns.IsNone() && len(update) == 0 {
-			return errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
+			return boil.UpsertNone, errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
 		}
 
 		ret = strmangle.SetComplement(ret, nzUniques)
 		cache.query = buildUpsertQueryMySQL(dialect, "{{$schemaTable}}", update, insert)
 		cache.retQuery = fmt.Sprintf(
 			"SELECT %s FROM {{.LQ}}{{.Table.Name}}{{.RQ}} WHERE %s",
-			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
-			strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", 0, nzUniques),
+			strings.Join(dialect.QuoteIdentSlice(ret), ","),
+			dialect.WhereClause(0, nzUniques),
 		)
 
 		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, insert)
 		if err != nil {
-			return err
+			return boil.UpsertNone, err
 		}
 		if len(ret) != 0 {
 			cache.retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, ret)
 			if err != nil {
-				return err
+				return boil.UpsertNone, err
 			}
 		}
 	}
@@ -135,30 +144,31 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		fmt.Fprintln(boil.DebugWriter, cache.query)
 		fmt.Fprintln(boil.DebugWriter, vals)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
-	}
 	{{end -}}
 
 	{{$canLastInsertID := .Table.CanLastInsertID -}}
-	{{if $canLastInsertID -}}
-		{{if .NoContext -}}
-	result, err := exec.Exec(cache.query, vals...)
-		{{else -}}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
-		{{end -}}
+	{{if .NoContext -}}
+	res, err := exec.Exec(cache.query, vals...)
 	{{else -}}
-		{{if .NoContext -}}
-	_, err = exec.Exec(cache.query, vals...)
-		{{else -}}
-	_, err = exec.ExecContext(ctx, cache.query, vals...)
-		{{end -}}
-	{{- end}}
+	res, err := boil.ExecContext(ctx, exec, cache.query, vals...)
+	{{end -}}
+	if err != nil {
+		return boil.UpsertNone, errors.Wrap(boil.WrapConstraintErr(boil.WrapRetryable(err)), "{{.PkgName}}: unable to upsert for {{.Table.Name}}")
+	}
+
+	// MySQL counts an inserted row once and an updated row twice, a row
+	// that's ignored or updated to the values it already had isn't counted.
+	// With clientFoundRows that row counts once and is reported as inserted.
+	affected, err := res.RowsAffected()
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to upsert for {{.Table.Name}}")
+		return boil.UpsertNone, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by upsert for {{.Table.Name}}")
+	}
+	result := boil.UpsertNone
+	switch affected {
+	case 1:
+		result = boil.UpsertInserted
+	case 2:
+		result = boil.UpsertUpdated
 	}
 
 	{{if $canLastInsertID -}}
@@ -172,9 +182,9 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	}
 
 	{{if $canLastInsertID -}}
-	lastID, err = result.LastInsertId()
+	lastID, err = res.LastInsertId()
 	if err != nil {
-		return ErrSyncFail
+		return boil.UpsertNone, ErrSyncFail
 	}
 
 	{{$colName := index .Table.PKey.Columns 0 -}}
@@ -188,7 +198,7 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 
 	uniqueMap, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, nzUniques)
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to retrieve unique values for {{.Table.Name}}")
+		return boil.UpsertNone, errors.Wrap(err, "{{.PkgName}}: unable to retrieve unique values for {{.Table.Name}}")
  	}
 	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
 
@@ -197,21 +207,15 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
 		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.retQuery)
-		fmt.Fprintln(writer, nzUniqueCols...)
-	}
 	{{end -}}
 
 	{{if .NoContext -}}
 	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
 	{{else -}}
-	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	err = boil.QueryRowContext(ctx, exec, cache.retQuery, nzUniqueCols...).Scan(returns...)
 	{{end -}}
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
+		return boil.UpsertNone, errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
 	}
 
 CacheNoHooks:
@@ -220,10 +224,13 @@ CacheNoHooks:
 		{{$alias.DownSingular}}UpsertCache[key] = cache
 		{{$alias.DownSingular}}UpsertCacheMut.Unlock()
 	}
+	o.ClearChanges()
 
 	{{if not .NoHooks -}}
-	return o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
-	{{- else -}}
-	return nil
-	{{- end}}
+	if err := o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+		return boil.UpsertNone, err
+	}
+
+	{{end -}}
+	return result, nil
 }
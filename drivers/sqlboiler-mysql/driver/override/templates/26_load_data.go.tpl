@@ -0,0 +1,101 @@
+{{- $alias := .Aliases.Table .Table.Name}}
+{{- $schemaTable := .Table.Name | .SchemaTable}}
+{{if .AddGlobal -}}
+// LoadDataG loads the slice into the database with LOAD DATA, using the global executor.
+func (o {{$alias.UpSingular}}Slice) LoadDataG({{if not .NoContext}}ctx context.Context, {{end -}} columns boil.Columns) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	return o.LoadData({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, columns)
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// LoadDataP loads the slice into the database with LOAD DATA, and panics on error.
+func (o {{$alias.UpSingular}}Slice) LoadDataP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) {{if not .NoRowsAffected}}int64{{end -}} {
+	{{if not .NoRowsAffected}}rowsAff, {{end}}err := o.LoadData({{if not .NoContext}}ctx, {{end -}} exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- if not .NoRowsAffected}}
+
+	return rowsAff
+	{{end -}}
+}
+
+{{end -}}
+
+// LoadData inserts the slice with a single LOAD DATA LOCAL INFILE, which is a lot
+// quicker than inserting rows one at a time for big imports. The rows are
+// streamed to the server, which needs local_infile turned on. The columns are
+// chosen as they are for Insert, a column with a default is loaded when any of
+// the rows has a non-zero value for it. Hooks and automatic timestamps aren't
+// applied and generated values aren't read back. As is usual for LOAD DATA
+// LOCAL, rows with duplicate keys are skipped with a warning. Times are
+// written in UTC, which the connection's loc parameter has to match.
+func (o {{$alias.UpSingular}}Slice) LoadData({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	if len(o) == 0 {
+		return {{if not .NoRowsAffected}}0, {{end -}} nil
+	}
+
+	var nzDefaults []string
+	for _, obj := range o {
+		nzDefaults = strmangle.SetMerge(nzDefaults, queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, obj))
+	}
+
+	wl, _ := columns.InsertColumnSet(
+		{{$alias.DownSingular}}AllColumns,
+		{{$alias.DownSingular}}ColumnsWithDefault,
+		{{$alias.DownSingular}}ColumnsWithoutDefault,
+		nzDefaults,
+	)
+	if unknown := strmangle.SetComplement(wl, {{$alias.DownSingular}}AllColumns); len(unknown) != 0 {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Errorf("{{.PkgName}}: unknown columns %s for loading into {{.Table.Name}}", strings.Join(unknown, ", "))
+	}
+
+	mapping, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, wl)
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+
+	query, done := mySQLLoadDataQuery("{{$schemaTable}}", wl, func(w *drivers.LoadDataWriter) error {
+		for _, obj := range o {
+			if err := w.WriteRow(queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mapping)...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	defer done()
+
+	{{if .NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+	}
+	{{end -}}
+
+	{{if .NoRowsAffected -}}
+		{{if .NoContext -}}
+	_, err = exec.Exec(query)
+		{{else -}}
+	_, err = boil.ExecContext(ctx, exec, query)
+		{{end -}}
+	{{else -}}
+		{{if .NoContext -}}
+	result, err := exec.Exec(query)
+		{{else -}}
+	result, err := boil.ExecContext(ctx, exec, query)
+		{{end -}}
+	{{end -}}
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to load data into {{.Table.Name}}")
+	}
+
+	{{if not .NoRowsAffected -}}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by load data for {{.Table.Name}}")
+	}
+
+	{{end -}}
+
+	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
+}
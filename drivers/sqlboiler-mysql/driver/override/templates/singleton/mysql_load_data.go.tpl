@@ -0,0 +1,29 @@
+var mySQLLoadDataCount uint64
+
+// mySQLLoadDataQuery registers a reader with the MySQL driver that streams the
+// rows written by write, and returns the LOAD DATA LOCAL INFILE statement that
+// reads them into table. done has to be called once the statement has run.
+func mySQLLoadDataQuery(table string, columns []string, write func(w *drivers.LoadDataWriter) error) (query string, done func()) {
+	name := fmt.Sprintf("sqlboiler_load_data_%d", atomic.AddUint64(&mySQLLoadDataCount, 1))
+
+	pr, pw := io.Pipe()
+	go func() {
+		w := drivers.NewLoadDataWriter(pw)
+		err := write(w)
+		if err == nil {
+			err = w.Flush()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	mysql.RegisterReaderHandler(name, func() io.Reader { return pr })
+
+	query = fmt.Sprintf("LOAD DATA LOCAL INFILE 'Reader::%s' INTO TABLE %s (%s)",
+		name, table, strings.Join(dialect.QuoteIdentSlice(columns), ","))
+
+	return query, func() {
+		// Stops the writer when the driver didn't read all of it
+		pr.Close()
+		mysql.DeregisterReaderHandler(name)
+	}
+}
@@ -1,10 +1,10 @@
 // buildUpsertQueryMySQL builds a SQL statement string using the upsertData provided.
 func buildUpsertQueryMySQL(dia drivers.Dialect, tableName string, update, whitelist []string) string {
-	whitelist = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, whitelist)
-	tableName = strmangle.IdentQuote(dia.LQ, dia.RQ, tableName)
+	whitelist = dia.QuoteIdentSlice(whitelist)
+	tableName = dia.QuoteIdent(tableName)
 
-	buf := strmangle.GetBuffer()
-	defer strmangle.PutBuffer(buf)
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
 
 	var columns string
 	if len(whitelist) != 0 {
@@ -17,7 +17,7 @@ func buildUpsertQueryMySQL(dia drivers.Dialect, tableName string, update, whitel
 			"INSERT IGNORE INTO %s (%s) VALUES (%s)",
 			tableName,
 			columns,
-			strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), 1, 1),
+			dia.Placeholders(len(whitelist), 1, 1),
 		)
 		return buf.String()
 	}
@@ -27,14 +27,14 @@ func buildUpsertQueryMySQL(dia drivers.Dialect, tableName string, update, whitel
 		"INSERT INTO %s (%s) VALUES (%s) ON DUPLICATE KEY UPDATE ",
 		tableName,
 		columns,
-		strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), 1, 1),
+		dia.Placeholders(len(whitelist), 1, 1),
 	)
 
 	for i, v := range update {
 		if i != 0 {
 			buf.WriteByte(',')
 		}
-		quoted := strmangle.IdentQuote(dia.LQ, dia.RQ, v)
+		quoted := dia.QuoteIdent(v)
 		buf.WriteString(quoted)
 		buf.WriteString(" = VALUES(")
 		buf.WriteString(quoted)
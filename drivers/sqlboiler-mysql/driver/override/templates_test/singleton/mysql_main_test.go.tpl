@@ -14,6 +14,8 @@ type mysqlTester struct {
 
 	testDBName string
 	skipSQLCmd bool
+
+	container *dockerContainer
 }
 
 func init() {
@@ -35,16 +37,20 @@ func (m *mysqlTester) setup() error {
 	m.testDBName = viper.GetString("mysql.testdbname")
 	m.skipSQLCmd = viper.GetBool("mysql.skipsqlcmd")
 
-	err = vala.BeginValidation().Validate(
-		vala.StringNotEmpty(m.user, "mysql.user"),
-		vala.StringNotEmpty(m.host, "mysql.host"),
-		vala.Not(vala.Equals(m.port, 0, "mysql.port")),
-		vala.StringNotEmpty(m.dbName, "mysql.dbname"),
-		vala.StringNotEmpty(m.sslmode, "mysql.sslmode"),
-	).Check()
-
-	if err != nil {
-		return err
+	// A container loading the schema from -test.schema doesn't use the
+	// configured database at all
+	if len(*flagContainer) == 0 || len(*flagSchema) == 0 {
+		err = vala.BeginValidation().Validate(
+			vala.StringNotEmpty(m.user, "mysql.user"),
+			vala.StringNotEmpty(m.host, "mysql.host"),
+			vala.Not(vala.Equals(m.port, 0, "mysql.port")),
+			vala.StringNotEmpty(m.dbName, "mysql.dbname"),
+			vala.StringNotEmpty(m.sslmode, "mysql.sslmode"),
+		).Check()
+
+		if err != nil {
+			return err
+		}
 	}
 
 	// Create a randomized db name.
@@ -56,16 +62,29 @@ func (m *mysqlTester) setup() error {
 		return errors.Wrap(err, "couldn't make option file")
 	}
 
-	if !m.skipSQLCmd {
+	if len(*flagContainer) != 0 {
+		if err = m.startContainer(*flagContainer); err != nil {
+			return err
+		}
+	} else if !m.skipSQLCmd {
 		if err = m.dropTestDB(); err != nil {
 			return err
 		}
 		if err = m.createTestDB(); err != nil {
 			return err
 		}
+	}
 
-		dumpCmd := exec.Command("mysqldump", m.defaultsFile(), "--no-data", m.dbName)
+	if !m.skipSQLCmd || m.container != nil {
 		createCmd := exec.Command("mysql", m.defaultsFile(), "--database", m.testDBName)
+		if m.container != nil {
+			createCmd = m.container.command("mysql", "--host", "127.0.0.1", "--user", "root", "--database", m.testDBName)
+		}
+		if len(*flagSchema) != 0 {
+			return m.loadSchema(createCmd)
+		}
+
+		dumpCmd := exec.Command("mysqldump", m.defaultsFile(), "--no-data", m.dbName)
 
 		r, w := io.Pipe()
 		dumpCmdStderr := &bytes.Buffer{}
@@ -102,6 +121,44 @@ func (m *mysqlTester) setup() error {
 	return nil
 }
 
+// loadSchema runs the sql of -test.schema with createCmd. Foreign keys are
+// removed like they are from a dump, which finds them as CONSTRAINT lines of
+// CREATE TABLE the way mysqldump writes them.
+func (m *mysqlTester) loadSchema(createCmd *exec.Cmd) error {
+	schema, err := readSchemaFiles(*flagSchema)
+	if err != nil {
+		return errors.Wrap(err, "failed to read test.schema")
+	}
+
+	createCmdStderr := &bytes.Buffer{}
+	createCmd.Stdin = newFKeyDestroyer(rgxMySQLkey, schema)
+	createCmd.Stderr = createCmdStderr
+
+	if err = createCmd.Run(); err != nil {
+		fmt.Println(err)
+		fmt.Println(createCmdStderr.String())
+		return errors.Wrap(err, "failed to run mysql command")
+	}
+
+	return nil
+}
+
+// startContainer runs mysql in docker with an empty test database that root
+// can use without a password, the container only lives as long as the tests.
+func (m *mysqlTester) startContainer(image string) error {
+	var err error
+	m.container, err = startContainer(image, 3306,
+		"MYSQL_ALLOW_EMPTY_PASSWORD=yes",
+		"MYSQL_DATABASE="+m.testDBName,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The server only listens on tcp after the image's init scripts are done
+	return m.container.waitFor(2*time.Minute, "mysql", "--host", "127.0.0.1", "--user", "root", "--execute", "select 1")
+}
+
 func (m *mysqlTester) sslMode(mode string) string {
 	switch mode {
 	case "true":
@@ -166,17 +223,43 @@ func (m *mysqlTester) makeOptionFile() error {
 	return tmp.Close()
 }
 
+// adminConn connects to the server without selecting a database, the test
+// database is created and dropped from there.
+func (m *mysqlTester) adminConn() (*sql.DB, error) {
+	return sql.Open("mysql", driver.MySQLBuildQueryString(m.user, m.pass, "", m.host, m.port, m.sslmode))
+}
+
 func (m *mysqlTester) createTestDB() error {
-	sql := fmt.Sprintf("create database %s;", m.testDBName)
-	return m.runCmd(sql, "mysql")
+	admin, err := m.adminConn()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	return testdb.CreateDatabase(admin, m.testDBName)
 }
 
 func (m *mysqlTester) dropTestDB() error {
-	sql := fmt.Sprintf("drop database if exists %s;", m.testDBName)
-	return m.runCmd(sql, "mysql")
+	admin, err := m.adminConn()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	return testdb.DropDatabase(admin, m.testDBName)
 }
 
 func (m *mysqlTester) teardown() error {
+	if m.container != nil {
+		if m.dbConn != nil {
+			_ = m.dbConn.Close()
+		}
+		if err := m.container.remove(); err != nil {
+			return err
+		}
+		return os.Remove(m.optionFile)
+	}
+
 	if m.dbConn != nil {
 		return m.dbConn.Close()
 	}
@@ -190,33 +273,17 @@ func (m *mysqlTester) teardown() error {
 	return os.Remove(m.optionFile)
 }
 
-func (m *mysqlTester) runCmd(stdin, command string, args ...string) error {
-	args = append([]string{m.defaultsFile()}, args...)
-
-	cmd := exec.Command(command, args...)
-	cmd.Stdin = strings.NewReader(stdin)
-
-	stdout := &bytes.Buffer{}
-	stderr := &bytes.Buffer{}
-	cmd.Stdout = stdout
-	cmd.Stderr = stderr
-	if err := cmd.Run(); err != nil {
-	fmt.Println("failed running:", command, args)
-	fmt.Println(stdout.String())
-	fmt.Println(stderr.String())
-	return err
-	}
-
-	return nil
-}
-
 func (m *mysqlTester) conn() (*sql.DB, error) {
 	if m.dbConn != nil {
 	return m.dbConn, nil
 	}
 
 	var err error
-	m.dbConn, err = sql.Open("mysql", driver.MySQLBuildQueryString(m.user, m.pass, m.testDBName, m.host, m.port, m.sslmode))
+	if m.container != nil {
+		m.dbConn, err = sql.Open("mysql", driver.MySQLBuildQueryString("root", "", m.testDBName, "127.0.0.1", m.container.port, "false"))
+	} else {
+		m.dbConn, err = sql.Open("mysql", driver.MySQLBuildQueryString(m.user, m.pass, m.testDBName, m.host, m.port, m.sslmode))
+	}
 	if err != nil {
 	return nil, err
 	}
@@ -32,22 +32,29 @@ func (o *{{$alias.UpSingular}}) UpsertP({{if .NoContext}}exec boil.Executor{{els
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	_, err := o.UpsertWithResult({{if not .NoContext}}ctx, {{end -}} exec, updateOnConflict, conflictColumns, updateColumns, insertColumns)
+	return err
+}
+
+// UpsertWithResult is Upsert that also reports whether the row was inserted,
+// updated or left alone on conflict, it's told apart with the xmax system column.
+func (o *{{$alias.UpSingular}}) UpsertWithResult({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) (boil.UpsertResult, error) {
 	if o == nil {
-		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
+		return boil.UpsertNone, errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
 	}
 
 	{{- template "timestamp_upsert_helper" . }}
 
 	{{if not .NoHooks -}}
 	if err := o.doBeforeUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
-		return err
+		return boil.UpsertNone, err
 	}
 	{{- end}}
 
 	nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
 
 	// Build cache key in-line uglily - mysql vs psql problems
-	buf := strmangle.GetBuffer()
+	buf := boil.GetBuffer()
 	if updateOnConflict {
 		buf.WriteByte('t')
 	} else {
@@ -72,7 +79,7 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		buf.WriteString(c)
 	}
 	key := buf.String()
-	strmangle.PutBuffer(buf)
+	boil.PutBuffer(buf)
 
 	{{$alias.DownSingular}}UpsertCacheMut.RLock()
 	cache, cached := {{$alias.DownSingular}}UpsertCache[key]
@@ -93,7 +100,7 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		)
 
 		if updateOnConflict && len(update) == 0 {
-			return errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
+			return boil.UpsertNone, errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
 		}
 
 		conflict := conflictColumns
@@ -105,12 +112,12 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 
 		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, insert)
 		if err != nil {
-			return err
+			return boil.UpsertNone, err
 		}
 		if len(ret) != 0 {
 			cache.retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, ret)
 			if err != nil {
-				return err
+				return boil.UpsertNone, err
 			}
 		}
 	}
@@ -127,32 +134,25 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		fmt.Fprintln(boil.DebugWriter, cache.query)
 		fmt.Fprintln(boil.DebugWriter, vals)
 	}
+	{{end -}}
+
+	var inserted bool
+	{{if .NoContext -}}
+	err = exec.QueryRow(cache.query, vals...).Scan(append(returns, &inserted)...)
 	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
-	}
+	err = boil.QueryRowContext(ctx, exec, cache.query, vals...).Scan(append(returns, &inserted)...)
 	{{end -}}
 
-	if len(cache.retMapping) != 0 {
-		{{if .NoContext -}}
-		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
-		{{else -}}
-		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
-		{{end -}}
-		if err == sql.ErrNoRows {
-			err = nil // Postgres doesn't return anything when there's no update
-		}
-	} else {
-		{{if .NoContext -}}
-		_, err = exec.Exec(cache.query, vals...)
-		{{else -}}
-		_, err = exec.ExecContext(ctx, cache.query, vals...)
-		{{end -}}
+	result := boil.UpsertUpdated
+	switch {
+	case err == sql.ErrNoRows:
+		err = nil // Postgres doesn't return anything when there's no update
+		result = boil.UpsertNone
+	case inserted:
+		result = boil.UpsertInserted
 	}
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to upsert {{.Table.Name}}")
+		return boil.UpsertNone, errors.Wrap(boil.WrapConstraintErr(boil.WrapRetryable(err)), "{{.PkgName}}: unable to upsert {{.Table.Name}}")
 	}
 
 	if !cached {
@@ -160,10 +160,13 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		{{$alias.DownSingular}}UpsertCache[key] = cache
 		{{$alias.DownSingular}}UpsertCacheMut.Unlock()
 	}
+	o.ClearChanges()
 
 	{{if not .NoHooks -}}
-	return o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
-	{{- else -}}
-	return nil
-	{{- end}}
+	if err := o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+		return boil.UpsertNone, err
+	}
+
+	{{end -}}
+	return result, nil
 }
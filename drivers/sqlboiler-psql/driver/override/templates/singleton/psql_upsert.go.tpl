@@ -1,17 +1,17 @@
 // buildUpsertQueryPostgres builds a SQL statement string using the upsertData provided.
 func buildUpsertQueryPostgres(dia drivers.Dialect, tableName string, updateOnConflict bool, ret, update, conflict, whitelist []string) string {
-	conflict = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, conflict)
-	whitelist = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, whitelist)
-	ret = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, ret)
+	conflict = dia.QuoteIdentSlice(conflict)
+	whitelist = dia.QuoteIdentSlice(whitelist)
+	ret = dia.QuoteIdentSlice(ret)
 
-	buf := strmangle.GetBuffer()
-	defer strmangle.PutBuffer(buf)
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
 
 	columns := "DEFAULT VALUES"
 	if len(whitelist) != 0 {
 		columns = fmt.Sprintf("(%s) VALUES (%s)",
 			strings.Join(whitelist, ", "),
-			strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), 1, 1))
+			dia.Placeholders(len(whitelist), 1, 1))
 	}
 
 	fmt.Fprintf(
@@ -32,17 +32,21 @@ func buildUpsertQueryPostgres(dia drivers.Dialect, tableName string, updateOnCon
 			if i != 0 {
 				buf.WriteByte(',')
 			}
-			quoted := strmangle.IdentQuote(dia.LQ, dia.RQ, v)
+			quoted := dia.QuoteIdent(v)
 			buf.WriteString(quoted)
 			buf.WriteString(" = EXCLUDED.")
 			buf.WriteString(quoted)
 		}
 	}
 
-	if len(ret) != 0 {
-		buf.WriteString(" RETURNING ")
-		buf.WriteString(strings.Join(ret, ", "))
+	// xmax is only zero for a row version that was freshly inserted, which
+	// tells an insert apart from the update of a conflicting row.
+	buf.WriteString(" RETURNING ")
+	for _, r := range ret {
+		buf.WriteString(r)
+		buf.WriteString(", ")
 	}
+	buf.WriteString("(xmax = 0)")
 
 	return buf.String()
 }
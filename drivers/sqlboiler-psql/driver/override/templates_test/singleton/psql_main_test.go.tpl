@@ -14,6 +14,8 @@ type pgTester struct {
 
 	testDBName string
 	skipSQLCmd bool
+
+	container *dockerContainer
 }
 
 func init() {
@@ -39,16 +41,20 @@ func (p *pgTester) setup() error {
 	p.testDBName = viper.GetString("psql.testdbname")
 	p.skipSQLCmd = viper.GetBool("psql.skipsqlcmd")
 
-	err = vala.BeginValidation().Validate(
-		vala.StringNotEmpty(p.user, "psql.user"),
-		vala.StringNotEmpty(p.host, "psql.host"),
-		vala.Not(vala.Equals(p.port, 0, "psql.port")),
-		vala.StringNotEmpty(p.dbName, "psql.dbname"),
-		vala.StringNotEmpty(p.sslmode, "psql.sslmode"),
-	).Check()
-
-	if err != nil {
-		return err
+	// A container loading the schema from -test.schema doesn't use the
+	// configured database at all
+	if len(*flagContainer) == 0 || len(*flagSchema) == 0 {
+		err = vala.BeginValidation().Validate(
+			vala.StringNotEmpty(p.user, "psql.user"),
+			vala.StringNotEmpty(p.host, "psql.host"),
+			vala.Not(vala.Equals(p.port, 0, "psql.port")),
+			vala.StringNotEmpty(p.dbName, "psql.dbname"),
+			vala.StringNotEmpty(p.sslmode, "psql.sslmode"),
+		).Check()
+
+		if err != nil {
+			return err
+		}
 	}
 
 	// if no testing DB passed
@@ -61,18 +67,31 @@ func (p *pgTester) setup() error {
 		return err
 	}
 
-	if !p.skipSQLCmd {
+	if len(*flagContainer) != 0 {
+		if err = p.startContainer(*flagContainer); err != nil {
+			return err
+		}
+	} else if !p.skipSQLCmd {
 		if err = p.dropTestDB(); err != nil {
 			return err
 		}
 		if err = p.createTestDB(); err != nil {
 			return err
 		}
+	}
 
-		dumpCmd := exec.Command("pg_dump", "--schema-only", p.dbName)
-		dumpCmd.Env = append(os.Environ(), p.pgEnv()...)
+	if !p.skipSQLCmd || p.container != nil {
 		createCmd := exec.Command("psql", p.testDBName)
 		createCmd.Env = append(os.Environ(), p.pgEnv()...)
+		if p.container != nil {
+			createCmd = p.container.command("psql", "--host", "127.0.0.1", "--username", "postgres", p.testDBName)
+		}
+		if len(*flagSchema) != 0 {
+			return p.loadSchema(createCmd)
+		}
+
+		dumpCmd := exec.Command("pg_dump", "--schema-only", p.dbName)
+		dumpCmd.Env = append(os.Environ(), p.pgEnv()...)
 
 		r, w := io.Pipe()
 		dumpCmdStderr := &bytes.Buffer{}
@@ -109,26 +128,43 @@ func (p *pgTester) setup() error {
 	return nil
 }
 
-func (p *pgTester) runCmd(stdin, command string, args ...string) error {
-	cmd := exec.Command(command, args...)
-	cmd.Env = append(os.Environ(), p.pgEnv()...)
+// loadSchema runs the sql of -test.schema with createCmd. Foreign keys are
+// removed like they are from a dump, which only finds them when they're
+// added with ALTER TABLE the way pg_dump writes them.
+func (p *pgTester) loadSchema(createCmd *exec.Cmd) error {
+	schema, err := readSchemaFiles(*flagSchema)
+	if err != nil {
+		return errors.Wrap(err, "failed to read test.schema")
+	}
+
+	createCmdStderr := &bytes.Buffer{}
+	createCmd.Stdin = newFKeyDestroyer(rgxPGFkey, schema)
+	createCmd.Stderr = createCmdStderr
 
-	if len(stdin) != 0 {
-		cmd.Stdin = strings.NewReader(stdin)
+	if err = createCmd.Run(); err != nil {
+		fmt.Println(err)
+		fmt.Println(createCmdStderr.String())
+		return errors.Wrap(err, "failed to run psql command")
 	}
 
-	stdout := &bytes.Buffer{}
-	stderr := &bytes.Buffer{}
-	cmd.Stdout = stdout
-	cmd.Stderr = stderr
-	if err := cmd.Run(); err != nil {
-		fmt.Println("failed running:", command, args)
-		fmt.Println(stdout.String())
-		fmt.Println(stderr.String())
+	return nil
+}
+
+// startContainer runs postgres in docker with an empty test database, it
+// trusts all connections since the container only lives as long as the
+// tests.
+func (p *pgTester) startContainer(image string) error {
+	var err error
+	p.container, err = startContainer(image, 5432,
+		"POSTGRES_HOST_AUTH_METHOD=trust",
+		"POSTGRES_DB="+p.testDBName,
+	)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	// The server only listens on tcp after the image's init scripts are done
+	return p.container.waitFor(time.Minute, "psql", "--host", "127.0.0.1", "--username", "postgres", "--command", "select 1", p.testDBName)
 }
 
 func (p *pgTester) pgEnv() []string {
@@ -168,12 +204,30 @@ func (p *pgTester) makePGPassFile() error {
 	return tmp.Close()
 }
 
+// adminConn connects to the postgres database, the test database is
+// created and dropped from there.
+func (p *pgTester) adminConn() (*sql.DB, error) {
+	return sql.Open("postgres", driver.PSQLBuildQueryString(p.user, p.pass, "postgres", p.host, p.port, p.sslmode))
+}
+
 func (p *pgTester) createTestDB() error {
-	return p.runCmd("", "createdb", p.testDBName)
+	admin, err := p.adminConn()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	return testdb.CreateDatabase(admin, p.testDBName)
 }
 
 func (p *pgTester) dropTestDB() error {
-	return p.runCmd("", "dropdb", "--if-exists", p.testDBName)
+	admin, err := p.adminConn()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	return testdb.DropDatabase(admin, p.testDBName)
 }
 
 // teardown executes cleanup tasks when the tests finish running
@@ -184,7 +238,11 @@ func (p *pgTester) teardown() error {
 	}
 	p.dbConn = nil
 
-	if !p.skipSQLCmd {
+	if p.container != nil {
+		if err = p.container.remove(); err != nil {
+			return err
+		}
+	} else if !p.skipSQLCmd {
 		if err = p.dropTestDB(); err != nil {
 			return err
 		}
@@ -199,7 +257,11 @@ func (p *pgTester) conn() (*sql.DB, error) {
 	}
 
 	var err error
-	p.dbConn, err = sql.Open("postgres", driver.PSQLBuildQueryString(p.user, p.pass, p.testDBName, p.host, p.port, p.sslmode))
+	if p.container != nil {
+		p.dbConn, err = sql.Open("postgres", driver.PSQLBuildQueryString("postgres", "", p.testDBName, "127.0.0.1", p.container.port, "disable"))
+	} else {
+		p.dbConn, err = sql.Open("postgres", driver.PSQLBuildQueryString(p.user, p.pass, p.testDBName, p.host, p.port, p.sslmode))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -5,11 +5,12 @@ package driver
 
 import (
 	"database/sql"
-	"encoding/base64"
+	"embed"
 	"fmt"
 	"os"
 	"strings"
 
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/importers"
 
 	"github.com/friendsofgo/errors"
@@ -20,7 +21,8 @@ import (
 	_ "github.com/lib/pq"
 )
 
-//go:generate go-bindata -nometadata -pkg driver -prefix override override/...
+//go:embed override
+var templates embed.FS
 
 func init() {
 	drivers.RegisterFromInit("psql", &PostgresDriver{})
@@ -42,18 +44,7 @@ type PostgresDriver struct {
 
 // Templates that should be added/overridden
 func (p PostgresDriver) Templates() (map[string]string, error) {
-	names := AssetNames()
-	tpls := make(map[string]string)
-	for _, n := range names {
-		b, err := Asset(n)
-		if err != nil {
-			return nil, err
-		}
-
-		tpls[n] = base64.StdEncoding.EncodeToString(b)
-	}
-
-	return tpls, nil
+	return drivers.TemplatesFromFS(templates, "override")
 }
 
 // Assemble all the information we need to provide back to the driver
@@ -74,10 +65,16 @@ func (p *PostgresDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo
 	schema := config.DefaultString(drivers.ConfigSchema, "public")
 	whitelist, _ := config.StringSlice(drivers.ConfigWhitelist)
 	blacklist, _ := config.StringSlice(drivers.ConfigBlacklist)
+	concurrency := config.DefaultInt(drivers.ConfigConcurrency, drivers.DefaultConcurrency)
 
 	useSchema := schema != "public"
 
 	p.connStr = PSQLBuildQueryString(user, pass, dbname, host, port, sslmode)
+	// Name the session so it can be told apart from the application's ones
+	p.connStr, err = boil.ApplicationNameDSN("postgres", p.connStr, "sqlboiler")
+	if err != nil {
+		return nil, err
+	}
 	p.conn, err = sql.Open("postgres", p.connStr)
 	if err != nil {
 		return nil, errors.Wrap(err, "sqlboiler-psql failed to connect to database")
@@ -93,15 +90,25 @@ func (p *PostgresDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo
 	dbinfo = &drivers.DBInfo{
 		Schema: schema,
 		Dialect: drivers.Dialect{
+			Name: "psql",
+
 			LQ: '"',
 			RQ: '"',
 
 			UseIndexPlaceholders: true,
 			UseSchema:            useSchema,
 			UseDefaultKeyword:    true,
+			UseLateralJoin:       true,
+
+			MaxParameters: 65535,
 		},
 	}
-	dbinfo.Tables, err = drivers.Tables(p, schema, whitelist, blacklist)
+	dbinfo.Tables, err = drivers.TablesConcurrently(p, schema, whitelist, blacklist, concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	dbinfo.Sequences, err = drivers.Sequences(p, schema, dbinfo.Tables)
 	if err != nil {
 		return nil, err
 	}
@@ -323,9 +330,54 @@ func (p *PostgresDriver) Columns(schema, tableName string, whitelist, blacklist
 		columns = append(columns, column)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	checks, err := p.checkValues(schema, tableName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read check constraints of table %s", tableName)
+	}
+	for i := range columns {
+		columns[i].CheckValues = checks[columns[i].Name]
+	}
+
 	return columns, nil
 }
 
+// checkValues finds the values the check constraints on a single column of
+// the table allow, see drivers.CheckValues.
+func (p *PostgresDriver) checkValues(schema, tableName string) (map[string][]string, error) {
+	query := `
+	select a.attname, pg_get_constraintdef(con.oid)
+	from pg_constraint con
+	inner join pg_class cl on cl.oid = con.conrelid
+	inner join pg_namespace n on n.oid = cl.relnamespace
+	inner join pg_attribute a on a.attrelid = con.conrelid and a.attnum = con.conkey[1]
+	where con.contype = 'c' and array_length(con.conkey, 1) = 1 and n.nspname = $1 and cl.relname = $2
+	order by con.conname;`
+
+	rows, err := p.conn.Query(query, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	checks := make(map[string][]string)
+	for rows.Next() {
+		var column, definition string
+		if err := rows.Scan(&column, &definition); err != nil {
+			return nil, err
+		}
+
+		if values := drivers.CheckValues(column, definition); values != nil {
+			checks[column] = values
+		}
+	}
+
+	return checks, rows.Err()
+}
+
 // PrimaryKeyInfo looks up the primary key for a table.
 func (p *PostgresDriver) PrimaryKeyInfo(schema, tableName string) (*drivers.PrimaryKey, error) {
 	pkey := &drivers.PrimaryKey{}
@@ -392,10 +444,11 @@ func (p *PostgresDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.For
 		inner join pg_class pgc on pgn.oid = pgc.relnamespace and pgc.relkind = 'r'
 		inner join pg_constraint pgcon on pgn.oid = pgcon.connamespace and pgc.oid = pgcon.conrelid
 		inner join pg_class dstlookupname on pgcon.confrelid = dstlookupname.oid
-		inner join pg_attribute pgasrc on pgc.oid = pgasrc.attrelid and pgasrc.attnum = ANY(pgcon.conkey)
-		inner join pg_attribute pgadst on pgcon.confrelid = pgadst.attrelid and pgadst.attnum = ANY(pgcon.confkey)
+		cross join lateral unnest(pgcon.conkey, pgcon.confkey) with ordinality as cols(src, dst, pos)
+		inner join pg_attribute pgasrc on pgc.oid = pgasrc.attrelid and pgasrc.attnum = cols.src
+		inner join pg_attribute pgadst on pgcon.confrelid = pgadst.attrelid and pgadst.attnum = cols.dst
 	where pgn.nspname = $2 and pgc.relname = $1 and pgcon.contype = 'f'
-	order by pgcon.conname, source_table, source_column, dest_table, dest_column`
+	order by pgcon.conname, cols.pos`
 
 	var rows *sql.Rows
 	var err error
@@ -423,6 +476,38 @@ func (p *PostgresDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.For
 	return fkeys, nil
 }
 
+// Sequences retrieves the schema's sequences along with the columns that own
+// them, which are the serial and identity columns.
+func (p *PostgresDriver) Sequences(schema string) ([]drivers.Sequence, error) {
+	query := `
+	select s.relname, coalesce(t.relname, ''), coalesce(a.attname, '')
+	from pg_class s
+	inner join pg_namespace n on n.oid = s.relnamespace
+	left join pg_depend d on d.objid = s.oid and d.classid = 'pg_class'::regclass
+		and d.refclassid = 'pg_class'::regclass and d.deptype in ('a', 'i')
+	left join pg_class t on t.oid = d.refobjid
+	left join pg_attribute a on a.attrelid = d.refobjid and a.attnum = d.refobjsubid
+	where s.relkind = 'S' and n.nspname = $1
+	order by s.relname;`
+
+	rows, err := p.conn.Query(query, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seqs []drivers.Sequence
+	for rows.Next() {
+		var seq drivers.Sequence
+		if err := rows.Scan(&seq.Name, &seq.Table, &seq.Column); err != nil {
+			return nil, errors.Wrap(err, "unable to scan sequence")
+		}
+		seqs = append(seqs, seq)
+	}
+
+	return seqs, rows.Err()
+}
+
 // TranslateColumnType converts postgres database types to Go types, for example
 // "varchar" to "string" and "bigint" to "int64". It returns this parsed data
 // as a Column object.
@@ -616,7 +701,7 @@ func (p PostgresDriver) Imports() (importers.Collection, error) {
 				`"strings"`,
 			},
 			ThirdParty: importers.List{
-				`"github.com/volatiletech/strmangle"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
 				`"github.com/volatiletech/sqlboiler/v4/drivers"`,
 			},
 		},
@@ -637,12 +722,13 @@ func (p PostgresDriver) Imports() (importers.Collection, error) {
 				`"os"`,
 				`"os/exec"`,
 				`"regexp"`,
-				`"strings"`,
+				`"time"`,
 			},
 			ThirdParty: importers.List{
 				`"github.com/kat-co/vala"`,
 				`"github.com/friendsofgo/errors"`,
 				`"github.com/spf13/viper"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil/testdb"`,
 				`"github.com/volatiletech/sqlboiler/v4/drivers/sqlboiler-psql/driver"`,
 				`"github.com/volatiletech/randomize"`,
 				`_ "github.com/lib/pq"`,
@@ -1,6 +1,11 @@
 package drivers
 
-import "fmt"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
 
 // Table metadata from the database schema.
 type Table struct {
@@ -12,6 +17,9 @@ type Table struct {
 
 	PKey  *PrimaryKey  `json:"p_key"`
 	FKeys []ForeignKey `json:"f_keys"`
+	// CompositeFKeys are the foreign keys over more than one column, they
+	// are not in FKeys.
+	CompositeFKeys []CompositeForeignKey `json:"composite_f_keys,omitempty"`
 
 	IsJoinTable bool `json:"is_join_table"`
 
@@ -72,4 +80,75 @@ func (t Table) CanSoftDelete() bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
+// TablesByDependency orders tables so that every table comes after the
+// tables its foreign keys reference, the order rows can be inserted in.
+// Rows can be deleted in the reverse order. References of a table to itself
+// and to tables that aren't in the list are ignored, when tables reference
+// each other in a cycle the first of them in the list goes first.
+func TablesByDependency(tables []Table) []Table {
+	known := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		known[t.Name] = true
+	}
+
+	ordered := make([]Table, 0, len(tables))
+	placed := make(map[string]bool, len(tables))
+	remaining := append([]Table(nil), tables...)
+
+	for len(remaining) != 0 {
+		var next []Table
+		for _, t := range remaining {
+			if !dependsOnUnplaced(t, known, placed) {
+				ordered = append(ordered, t)
+				placed[t.Name] = true
+			} else {
+				next = append(next, t)
+			}
+		}
+
+		if len(next) == len(remaining) {
+			// Nothing could be placed so there's a cycle, break it
+			ordered = append(ordered, next[0])
+			placed[next[0].Name] = true
+			next = next[1:]
+		}
+		remaining = next
+	}
+
+	return ordered
+}
+
+func dependsOnUnplaced(t Table, known, placed map[string]bool) bool {
+	for _, fk := range t.FKeys {
+		if fk.ForeignTable != t.Name && known[fk.ForeignTable] && !placed[fk.ForeignTable] {
+			return true
+		}
+	}
+	for _, fk := range t.CompositeFKeys {
+		if fk.ForeignTable != t.Name && known[fk.ForeignTable] && !placed[fk.ForeignTable] {
+			return true
+		}
+	}
+	return false
+}
+
+// SchemaHash identifies the tables and their columns' names, database types
+// and nullability, it changes when any of them do. The order of tables and
+// columns doesn't matter.
+func SchemaHash(tables []Table) string {
+	var lines []string
+	for _, t := range tables {
+		for _, c := range t.Columns {
+			lines = append(lines, fmt.Sprintf("%s\t%s\t%s\t%t\n", t.Name, c.Name, c.DBType, c.Nullable))
+		}
+	}
+	sort.Strings(lines)
+
+	h := sha256.New()
+	for _, line := range lines {
+		h.Write([]byte(line))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
@@ -1,6 +1,9 @@
 package drivers
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestGetTable(t *testing.T) {
 	t.Parallel()
@@ -148,3 +151,56 @@ func TestCanSoftDelete(t *testing.T) {
 		}
 	}
 }
+
+func TestTablesByDependency(t *testing.T) {
+	t.Parallel()
+
+	fk := func(table string) ForeignKey { return ForeignKey{ForeignTable: table} }
+	tables := []Table{
+		{Name: "pilot_languages", FKeys: []ForeignKey{fk("pilots"), fk("languages")}},
+		{Name: "jets", FKeys: []ForeignKey{fk("pilots"), fk("airports")}},
+		{Name: "pilots", FKeys: []ForeignKey{fk("pilots"), fk("hangars")}},
+		{Name: "airports"},
+		{Name: "languages"},
+		{Name: "a", FKeys: []ForeignKey{fk("b")}},
+		{Name: "b", FKeys: []ForeignKey{fk("a")}},
+		{Name: "tickets", CompositeFKeys: []CompositeForeignKey{{ForeignTable: "seats"}}},
+		{Name: "seats"},
+	}
+
+	var got []string
+	for _, t := range TablesByDependency(tables) {
+		got = append(got, t.Name)
+	}
+
+	want := []string{"pilots", "airports", "languages", "seats", "pilot_languages", "jets", "tickets", "a", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestSchemaHash(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{
+		{Name: "pilots", Columns: []Column{{Name: "id", DBType: "integer"}, {Name: "name", DBType: "text"}}},
+		{Name: "jets", Columns: []Column{{Name: "id", DBType: "integer"}}},
+	}
+	hash := SchemaHash(tables)
+	if len(hash) != 16 {
+		t.Errorf("want a 16 character hash, got %q", hash)
+	}
+
+	reordered := []Table{
+		{Name: "jets", Columns: []Column{{Name: "id", DBType: "integer"}}},
+		{Name: "pilots", Columns: []Column{{Name: "name", DBType: "text"}, {Name: "id", DBType: "integer"}}},
+	}
+	if got := SchemaHash(reordered); got != hash {
+		t.Errorf("want the same hash regardless of order, got %s and %s", hash, got)
+	}
+
+	tables[0].Columns[1].Nullable = true
+	if got := SchemaHash(tables); got == hash {
+		t.Error("want a different hash when a column changes")
+	}
+}
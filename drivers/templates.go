@@ -0,0 +1,39 @@
+package drivers
+
+import (
+	"encoding/base64"
+	"io/fs"
+	"path"
+
+	"github.com/friendsofgo/errors"
+)
+
+// TemplatesFromFS reads the templates (.tpl files) under root in fsys into
+// the form Interface.Templates returns: base64 encoded and keyed by their
+// path relative to root, eg. templates/17_upsert.go.tpl. It's meant for
+// drivers that embed their templates with go:embed.
+func TemplatesFromFS(fsys fs.FS, root string) (map[string]string, error) {
+	tpls := make(map[string]string)
+	err := fs.WalkDir(fsys, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != ".tpl" {
+			return err
+		}
+
+		b, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		rel := name
+		if root != "." {
+			rel = name[len(root)+1:]
+		}
+		tpls[rel] = base64.StdEncoding.EncodeToString(b)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read templates")
+	}
+
+	return tpls, nil
+}
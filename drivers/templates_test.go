@@ -0,0 +1,38 @@
+package drivers
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestTemplatesFromFS(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"override/templates/17_upsert.go.tpl":        {Data: []byte("upsert")},
+		"override/templates/singleton/upsert.go.tpl": {Data: []byte("singleton")},
+		"override/templates_test/upsert.go.tpl":      {Data: []byte("test")},
+		"override/templates/README.md":               {Data: []byte("ignored")},
+	}
+
+	tpls, err := TemplatesFromFS(fsys, "override")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enc := base64.StdEncoding.EncodeToString
+	want := map[string]string{
+		"templates/17_upsert.go.tpl":        enc([]byte("upsert")),
+		"templates/singleton/upsert.go.tpl": enc([]byte("singleton")),
+		"templates_test/upsert.go.tpl":      enc([]byte("test")),
+	}
+	if !reflect.DeepEqual(tpls, want) {
+		t.Errorf("want %v, got %v", want, tpls)
+	}
+
+	if _, err := TemplatesFromFS(fsys, "missing"); err == nil {
+		t.Error("want an error for a missing root")
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/volatiletech/sqlboiler/v4
 
-go 1.14
+go 1.16
 
 require (
 	github.com/DATA-DOG/go-sqlmock v1.4.1
@@ -18,4 +18,5 @@ require (
 	github.com/volatiletech/null/v8 v8.1.0
 	github.com/volatiletech/randomize v0.0.1
 	github.com/volatiletech/strmangle v0.0.1
+	gopkg.in/yaml.v2 v2.2.4
 )
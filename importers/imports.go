@@ -194,7 +194,9 @@ func NewDefaultImports() Collection {
 	col.All = Set{
 		Standard: List{
 			`"database/sql"`,
+			`"database/sql/driver"`,
 			`"fmt"`,
+			`"io"`,
 			`"reflect"`,
 			`"strings"`,
 			`"sync"`,
@@ -211,13 +213,92 @@ func NewDefaultImports() Collection {
 	}
 
 	col.Singleton = Map{
+		"adminctl": {
+			Standard: List{
+				`"bufio"`,
+				`"context"`,
+				`"database/sql"`,
+				`"encoding/json"`,
+				`"fmt"`,
+				`"io"`,
+				`"io/ioutil"`,
+				`"strings"`,
+			},
+			ThirdParty: List{
+				`"github.com/friendsofgo/errors"`,
+				`"github.com/spf13/cobra"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
+				`"github.com/volatiletech/sqlboiler/v4/queries/qm"`,
+				`"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"`,
+			},
+		},
+		"boil_audit": {
+			Standard: List{
+				`"context"`,
+				`"encoding/json"`,
+				`"time"`,
+			},
+			ThirdParty: List{
+				`"github.com/friendsofgo/errors"`,
+				`"github.com/volatiletech/null/v8"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
+				`"github.com/volatiletech/sqlboiler/v4/queries"`,
+			},
+		},
+		"boil_outbox": {
+			Standard: List{
+				`"context"`,
+				`"encoding/json"`,
+				`"time"`,
+			},
+			ThirdParty: List{
+				`"github.com/friendsofgo/errors"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
+				`"github.com/volatiletech/sqlboiler/v4/queries/qm"`,
+				`"github.com/volatiletech/sqlboiler/v4/types"`,
+			},
+		},
+		"boil_proto": {
+			ThirdParty: List{
+				`"github.com/volatiletech/null/v8"`,
+				`"google.golang.org/protobuf/types/known/timestamppb"`,
+				`"google.golang.org/protobuf/types/known/wrapperspb"`,
+			},
+		},
 		"boil_queries": {
 			ThirdParty: List{
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
 				`"github.com/volatiletech/sqlboiler/v4/drivers"`,
 				`"github.com/volatiletech/sqlboiler/v4/queries"`,
 				`"github.com/volatiletech/sqlboiler/v4/queries/qm"`,
 			},
 		},
+		"boil_schema": {
+			Standard: List{
+				`"fmt"`,
+				`"strings"`,
+			},
+			ThirdParty: List{
+				`"github.com/friendsofgo/errors"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
+			},
+		},
+		"boil_sequences": {
+			ThirdParty: List{
+				`"github.com/friendsofgo/errors"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
+			},
+		},
+		"boil_snapshot": {
+			Standard: List{
+				`"fmt"`,
+				`"strings"`,
+			},
+			ThirdParty: List{
+				`"github.com/friendsofgo/errors"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
+			},
+		},
 		"boil_types": {
 			Standard: List{
 				`"strconv"`,
@@ -225,7 +306,6 @@ func NewDefaultImports() Collection {
 			ThirdParty: List{
 				`"github.com/friendsofgo/errors"`,
 				`"github.com/volatiletech/sqlboiler/v4/boil"`,
-				`"github.com/volatiletech/strmangle"`,
 			},
 		},
 	}
@@ -239,7 +319,7 @@ func NewDefaultImports() Collection {
 		ThirdParty: List{
 			`"github.com/volatiletech/sqlboiler/v4/boil"`,
 			`"github.com/volatiletech/sqlboiler/v4/queries"`,
-			`"github.com/volatiletech/randomize"`,
+			`"github.com/volatiletech/sqlboiler/v4/randomize"`,
 			`"github.com/volatiletech/strmangle"`,
 		},
 	}
@@ -247,12 +327,18 @@ func NewDefaultImports() Collection {
 	col.TestSingleton = Map{
 		"boil_main_test": {
 			Standard: List{
+				`"bytes"`,
 				`"database/sql"`,
 				`"flag"`,
 				`"fmt"`,
+				`"io"`,
+				`"io/ioutil"`,
 				`"math/rand"`,
 				`"os"`,
+				`"os/exec"`,
 				`"path/filepath"`,
+				`"sort"`,
+				`"strconv"`,
 				`"strings"`,
 				`"testing"`,
 				`"time"`,
@@ -260,6 +346,7 @@ func NewDefaultImports() Collection {
 			ThirdParty: List{
 				`"github.com/spf13/viper"`,
 				`"github.com/volatiletech/sqlboiler/v4/boil"`,
+				`"github.com/volatiletech/sqlboiler/v4/randomize"`,
 			},
 		},
 		"boil_queries_test": {
@@ -2,11 +2,13 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/friendsofgo/errors"
 	"github.com/spf13/cobra"
@@ -14,14 +16,14 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/boilingcore"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
 	"github.com/volatiletech/sqlboiler/v4/importers"
+	"github.com/volatiletech/sqlboiler/v4/randomize"
 )
 
-//go:generate go-bindata -nometadata -pkg templatebin -o templatebin/bindata.go templates templates/singleton templates_test templates_test/singleton
-
 const sqlBoilerVersion = "4.4.0"
 
 var (
 	flagConfigFile string
+	flagCommand    string
 	cmdState       *boilingcore.State
 	cmdConfig      *boilingcore.Config
 )
@@ -74,11 +76,11 @@ func main() {
 
 	// Set up the cobra root command
 	var rootCmd = &cobra.Command{
-		Use:   "sqlboiler [flags] <driver>",
+		Use:   "sqlboiler [flags] <driver> [verify | migration [name] | ddl | graph | metadata | openapi | seed]",
 		Short: "SQL Boiler generates an ORM tailored to your database schema.",
 		Long: "SQL Boiler generates a Go ORM from template files, tailored to your database schema.\n" +
 			`Complete documentation is available at http://github.com/volatiletech/sqlboiler`,
-		Example:       `sqlboiler psql`,
+		Example:       "sqlboiler psql\nsqlboiler psql verify\nsqlboiler psql migration add_callsign\nsqlboiler psql ddl > schema.sql\nsqlboiler psql graph | dot -Tsvg > schema.svg\nsqlboiler psql metadata > metadata.json\nsqlboiler psql openapi > models.yaml\nsqlboiler psql seed --rows users=1000,orders=5000 | psql mydb",
 		PreRunE:       preRun,
 		RunE:          run,
 		PostRunE:      postRun,
@@ -92,7 +94,8 @@ func main() {
 	rootCmd.PersistentFlags().StringVarP(&flagConfigFile, "config", "c", "", "Filename of config file to override default lookup")
 	rootCmd.PersistentFlags().StringP("output", "o", "models", "The name of the folder to output to")
 	rootCmd.PersistentFlags().StringP("pkgname", "p", "models", "The name you wish to assign to your generated package")
-	rootCmd.PersistentFlags().StringSliceP("templates", "", nil, "A templates directory, overrides the bindata'd template folders in sqlboiler")
+	rootCmd.PersistentFlags().StringP("pkg-import-path", "", "", "The import path of the generated package, found from go.mod by default")
+	rootCmd.PersistentFlags().StringSliceP("templates", "", nil, "A templates directory, overrides the template folders embedded in sqlboiler")
 	rootCmd.PersistentFlags().StringSliceP("tag", "t", nil, "Struct tags to be included on your models in addition to json, yaml, toml")
 	rootCmd.PersistentFlags().StringSliceP("replace", "", nil, "Replace templates by directory: relpath/to_file.tpl:relpath/to_replacement.tpl")
 	rootCmd.PersistentFlags().BoolP("debug", "d", false, "Debug mode prints stack traces on error")
@@ -103,14 +106,41 @@ func main() {
 	rootCmd.PersistentFlags().BoolP("no-auto-timestamps", "", false, "Disable automatic timestamps for created_at/updated_at")
 	rootCmd.PersistentFlags().BoolP("no-driver-templates", "", false, "Disable parsing of templates defined by the database driver")
 	rootCmd.PersistentFlags().BoolP("no-back-referencing", "", false, "Disable back referencing in the loaded relationship structs")
+	rootCmd.PersistentFlags().BoolP("nullable-pointers", "", false, "Use pointer types instead of the null package types for nullable columns")
 	rootCmd.PersistentFlags().BoolP("add-global-variants", "", false, "Enable generation for global variants")
 	rootCmd.PersistentFlags().BoolP("add-panic-variants", "", false, "Enable generation for panic variants")
 	rootCmd.PersistentFlags().BoolP("add-soft-deletes", "", false, "Enable soft deletion by updating deleted_at timestamp")
+	rootCmd.PersistentFlags().BoolP("add-docs", "", false, "Generate markdown documentation of the tables in a docs folder")
 	rootCmd.PersistentFlags().BoolP("version", "", false, "Print the version")
 	rootCmd.PersistentFlags().BoolP("wipe", "", false, "Delete the output folder (rm -rf) before generation to ensure sanity")
-	rootCmd.PersistentFlags().StringP("struct-tag-casing", "", "snake", "Decides the casing for go structure tag names. camel, title or snake (default snake)")
+	rootCmd.PersistentFlags().StringP("struct-tag-casing", "", "snake", "Decides the casing for go structure tag names. camel, title, alias or snake")
 	rootCmd.PersistentFlags().StringP("relation-tag", "r", "-", "Relationship struct tag name")
 	rootCmd.PersistentFlags().StringSliceP("tag-ignore", "", nil, "List of column names that should have tags values set to '-' (ignored during parsing)")
+	rootCmd.PersistentFlags().StringP("json-tag-casing", "", "", "Casing for json tag names, camel, title, alias or snake (default struct-tag-casing)")
+	rootCmd.PersistentFlags().BoolP("no-json-omitempty", "", false, "Disable omitempty on the json tags of nullable columns")
+	rootCmd.PersistentFlags().BoolP("add-admin-cli", "", false, "Generate a cobra based admin CLI for the tables in an adminctl package")
+	rootCmd.PersistentFlags().BoolP("add-binary-marshal", "", false, "Generate gob based MarshalBinary and UnmarshalBinary methods for the models")
+	rootCmd.PersistentFlags().BoolP("add-dataloaders", "", false, "Generate batch functions loading the models by their keys for dataloaders")
+	rootCmd.PersistentFlags().BoolP("add-parquet", "", false, "Generate parquet schemas and WriteParquet methods for the model slices")
+	rootCmd.PersistentFlags().BoolP("add-proto-helpers", "", false, "Generate converters between the null types and protobuf wrapper types and optional fields")
+	rootCmd.PersistentFlags().BoolP("add-query-filters", "", false, "Generate parsers turning query parameters into query mods for list endpoints")
+	rootCmd.PersistentFlags().BoolP("add-repositories", "", false, "Generate repository interfaces for the tables with implementations using the models")
+	rootCmd.PersistentFlags().BoolP("add-typescript", "", false, "Generate typescript definitions of the json of the models in a typescript folder")
+	rootCmd.PersistentFlags().BoolP("add-validate-tags", "", false, "Add go-playground/validator tags derived from the column constraints")
+	rootCmd.PersistentFlags().BoolP("add-xml-tags", "", false, "Add xml tags to the generated structs")
+	rootCmd.PersistentFlags().StringSliceP("xml-attributes", "", nil, "List of column names that are xml attributes instead of elements, eg. id")
+	rootCmd.PersistentFlags().StringSliceP("json-ignore", "", nil, "List of column names that should have their json tag set to '-', eg. password_hash")
+	rootCmd.PersistentFlags().StringSliceP("audit-tables", "", nil, "Tables whose changes the generated hooks record in a <table>_audit table, eg. users")
+	rootCmd.PersistentFlags().StringSliceP("outbox-tables", "", nil, "Tables whose changes the generated hooks publish as change events to an outbox table, eg. users")
+	rootCmd.PersistentFlags().StringSliceP("uppercase-words", "", nil, "Additional words to fully uppercase in generated names, eg. sku,http")
+	rootCmd.PersistentFlags().StringSliceP("pre-generate", "", nil, "Command run before the database is read, as comma separated arguments, eg. migrate,-path,migrations,up")
+	rootCmd.PersistentFlags().StringP("schema-out", "", "", "Write the schema read from the database to this file, for use with --schema-in")
+	rootCmd.PersistentFlags().StringP("schema-in", "", "", "Generate from a file written by --schema-out instead of connecting to the database")
+	rootCmd.PersistentFlags().StringP("migration-dir", "", "migrations", "The folder the migration command writes to")
+	rootCmd.PersistentFlags().StringP("migration-to", "", "", "Schema snapshot the migration command migrates to instead of the database")
+	rootCmd.PersistentFlags().StringP("graph-format", "", "dot", "Output format of the graph command, dot or json")
+	rootCmd.PersistentFlags().StringP("openapi-format", "", "yaml", "Output format of the openapi command, yaml or json")
+	rootCmd.PersistentFlags().StringSliceP("rows", "", nil, "Rows the seed command adds to each table, eg. users=1000,orders=5000")
 
 	// hide flags not recommended for use
 	rootCmd.PersistentFlags().MarkHidden("replace")
@@ -145,6 +175,15 @@ func preRun(cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
 		return commandFailure("must provide a driver name")
 	}
+	if len(args) > 1 {
+		flagCommand = args[1]
+		switch {
+		case (flagCommand == "verify" || flagCommand == "ddl" || flagCommand == "graph" || flagCommand == "metadata" || flagCommand == "openapi" || flagCommand == "seed") && len(args) == 2:
+		case flagCommand == "migration" && len(args) <= 3:
+		default:
+			return commandFailure("unknown command: " + strings.Join(args[1:], " "))
+		}
+	}
 
 	driverName := args[0]
 	driverPath := args[0]
@@ -169,10 +208,12 @@ func preRun(cmd *cobra.Command, args []string) error {
 		DriverName:        driverName,
 		OutFolder:         viper.GetString("output"),
 		PkgName:           viper.GetString("pkgname"),
+		PkgImportPath:     viper.GetString("pkg-import-path"),
 		Debug:             viper.GetBool("debug"),
 		AddGlobal:         viper.GetBool("add-global-variants"),
 		AddPanic:          viper.GetBool("add-panic-variants"),
 		AddSoftDeletes:    viper.GetBool("add-soft-deletes"),
+		AddDocs:           viper.GetBool("add-docs"),
 		NoContext:         viper.GetBool("no-context"),
 		NoTests:           viper.GetBool("no-tests"),
 		NoHooks:           viper.GetBool("no-hooks"),
@@ -180,15 +221,39 @@ func preRun(cmd *cobra.Command, args []string) error {
 		NoAutoTimestamps:  viper.GetBool("no-auto-timestamps"),
 		NoDriverTemplates: viper.GetBool("no-driver-templates"),
 		NoBackReferencing: viper.GetBool("no-back-referencing"),
+		NullablePointers:  viper.GetBool("nullable-pointers"),
 		Wipe:              viper.GetBool("wipe"),
 		StructTagCasing:   strings.ToLower(viper.GetString("struct-tag-casing")), // camel | snake | title
 		TagIgnore:         viper.GetStringSlice("tag-ignore"),
+		JSONTagCasing:     strings.ToLower(viper.GetString("json-tag-casing")),
+		NoJSONOmitEmpty:   viper.GetBool("no-json-omitempty"),
+		JSONIgnore:        viper.GetStringSlice("json-ignore"),
+		AddXMLTags:        viper.GetBool("add-xml-tags"),
+		AddBinaryMarshal:  viper.GetBool("add-binary-marshal"),
+		AddValidateTags:   viper.GetBool("add-validate-tags"),
+		AddProtoHelpers:   viper.GetBool("add-proto-helpers"),
+		AddParquet:        viper.GetBool("add-parquet"),
+		AddDataloaders:    viper.GetBool("add-dataloaders"),
+		AddQueryFilters:   viper.GetBool("add-query-filters"),
+		AddAdminCLI:       viper.GetBool("add-admin-cli"),
+		AddRepositories:   viper.GetBool("add-repositories"),
+		AddTypeScript:     viper.GetBool("add-typescript"),
+		AuditTables:       viper.GetStringSlice("audit-tables"),
+		OutboxTables:      viper.GetStringSlice("outbox-tables"),
+		XMLAttributes:     viper.GetStringSlice("xml-attributes"),
+		UppercaseWords:    viper.GetStringSlice("uppercase-words"),
+		SchemaOut:         viper.GetString("schema-out"),
+		SchemaIn:          viper.GetString("schema-in"),
+		PreGenerate:       viper.GetStringSlice("pre-generate"),
+		Abbreviations:     viper.GetStringMapString("abbreviations"),
 		RelationTag:       viper.GetString("relation-tag"),
 		TemplateDirs:      viper.GetStringSlice("templates"),
 		Tags:              viper.GetStringSlice("tag"),
 		Replacements:      viper.GetStringSlice("replace"),
 		Aliases:           boilingcore.ConvertAliases(viper.Get("aliases")),
 		TypeReplaces:      boilingcore.ConvertTypeReplace(viper.Get("types")),
+		Polymorphic:       boilingcore.ConvertPolymorphic(viper.Get("polymorphic")),
+		ExtraOutputs:      boilingcore.ConvertExtraOutputs(viper.Get("outputs")),
 		Version:           sqlBoilerVersion,
 	}
 
@@ -212,6 +277,10 @@ func preRun(cmd *cobra.Command, args []string) error {
 
 	cmdConfig.Imports = configureImports()
 
+	if len(flagCommand) != 0 {
+		return nil
+	}
+
 	cmdState, err = boilingcore.New(cmdConfig)
 	return err
 }
@@ -253,13 +322,216 @@ func configureImports() importers.Collection {
 }
 
 func run(cmd *cobra.Command, args []string) error {
+	switch flagCommand {
+	case "verify":
+		return verify()
+	case "migration":
+		name := "schema_changes"
+		if len(args) > 2 {
+			name = args[2]
+		}
+		return migration(name)
+	case "ddl":
+		return ddl()
+	case "graph":
+		return graph()
+	case "metadata":
+		return metadata()
+	case "openapi":
+		return openapi()
+	case "seed":
+		return seed()
+	}
+
 	return cmdState.Run()
 }
 
 func postRun(cmd *cobra.Command, args []string) error {
+	if len(flagCommand) != 0 {
+		return nil
+	}
+
 	return cmdState.Cleanup()
 }
 
+// verify compares the database to the schema snapshot the models were
+// generated from and fails when they differ.
+func verify() error {
+	snapshot, err := snapshotPath("verify")
+	if err != nil {
+		return err
+	}
+
+	diff, err := boilingcore.VerifySchema(cmdConfig, snapshot)
+	if err != nil {
+		return err
+	}
+
+	if len(diff) == 0 {
+		fmt.Println("database matches", snapshot)
+		return nil
+	}
+
+	fmt.Printf("database differs from %s, regenerate the models:\n", snapshot)
+	for _, line := range diff {
+		fmt.Println(line)
+	}
+
+	return errors.Errorf("schema has drifted: %d differences", len(diff))
+}
+
+// migration writes up and down migrations from the schema snapshot the models
+// were generated from to the database, or another snapshot.
+func migration(name string) error {
+	snapshot, err := snapshotPath("migration")
+	if err != nil {
+		return err
+	}
+
+	up, down, err := boilingcore.Migration(cmdConfig, snapshot, viper.GetString("migration-to"))
+	if err != nil {
+		return err
+	}
+
+	if len(up) == 0 {
+		fmt.Println("no schema changes since", snapshot)
+		return nil
+	}
+
+	version := time.Now().UTC().Format("20060102150405")
+	upPath, downPath, err := boilingcore.WriteMigration(viper.GetString("migration-dir"), version, name, up, down)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("wrote", upPath)
+	fmt.Println("wrote", downPath)
+	return nil
+}
+
+// ddl prints the statements that create the schema in the database, or the
+// --schema-in snapshot.
+func ddl() error {
+	stmts, err := boilingcore.SchemaDDL(cmdConfig, cmdConfig.SchemaIn)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(boilingcore.SQLScript("-- Generated by sqlboiler from the introspected schema.\n", stmts))
+	return nil
+}
+
+// graph prints the tables and foreign keys of the database, or the
+// --schema-in snapshot, as a graph.
+func graph() error {
+	format := viper.GetString("graph-format")
+	if format != "dot" && format != "json" {
+		return commandFailure("unknown graph format: " + format)
+	}
+
+	g, err := boilingcore.SchemaGraph(cmdConfig, cmdConfig.SchemaIn)
+	if err != nil {
+		return err
+	}
+
+	if format == "dot" {
+		fmt.Print(g.DOT())
+		return nil
+	}
+
+	b, err := g.JSON()
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+// metadata prints the tables, columns and keys of the database, or the
+// --schema-in snapshot, in the stable drivers.Metadata format.
+func metadata() error {
+	meta, err := boilingcore.SchemaMetadata(cmdConfig, cmdConfig.SchemaIn)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+// openapi prints the OpenAPI component schemas of the models generated from
+// the database, or the --schema-in snapshot.
+func openapi() error {
+	format := viper.GetString("openapi-format")
+	if format != "yaml" && format != "json" {
+		return commandFailure("unknown openapi format: " + format)
+	}
+
+	doc, err := boilingcore.SchemaOpenAPI(cmdConfig, cmdConfig.SchemaIn)
+	if err != nil {
+		return err
+	}
+
+	if format == "yaml" {
+		b, err := doc.YAML()
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(b))
+		return nil
+	}
+
+	b, err := doc.JSON()
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+// seed prints statements that fill the tables given with --rows with fake
+// data, in the database or the --schema-in snapshot.
+func seed() error {
+	rows, err := boilingcore.ParseSeedRows(viper.GetStringSlice("rows"))
+	if err != nil {
+		return commandFailure(err.Error())
+	}
+	if len(rows) == 0 {
+		return commandFailure("seed needs the rows to add with --rows, eg. --rows users=1000,orders=5000")
+	}
+
+	start, err := randomize.InitialSeed()
+	if err != nil {
+		return err
+	}
+
+	stmts, err := boilingcore.SchemaSeed(cmdConfig, cmdConfig.SchemaIn, rows, start)
+	if err != nil {
+		return err
+	}
+
+	header := fmt.Sprintf("-- Generated by sqlboiler with %s=%d, run it again with the same value for the same data.\n", randomize.SeedEnv, start)
+	fmt.Print(boilingcore.SQLScript(header, stmts))
+	return nil
+}
+
+// snapshotPath is the schema snapshot for commands that compare against it,
+// it's the one generation reads or writes.
+func snapshotPath(command string) (string, error) {
+	if len(cmdConfig.SchemaIn) != 0 {
+		return cmdConfig.SchemaIn, nil
+	}
+	if len(cmdConfig.SchemaOut) != 0 {
+		return cmdConfig.SchemaOut, nil
+	}
+
+	return "", commandFailure(command + " needs the schema snapshot written by --schema-out, pass it with --schema-in or --schema-out")
+}
+
 func allKeys(prefix string) []string {
 	keys := make(map[string]bool)
 
@@ -0,0 +1 @@
+UPDATE "t" SET "a" = $1, "b" = b + $2, "c" = CASE WHEN a > $3 THEN $4 ELSE c END WHERE (id=$5);
\ No newline at end of file
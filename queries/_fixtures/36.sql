@@ -0,0 +1 @@
+UPDATE "t" SET "b" = $1 WHERE (a=$2) RETURNING "id", "b";
\ No newline at end of file
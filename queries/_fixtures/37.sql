@@ -0,0 +1 @@
+INSERT INTO "t" ("a", "b") SELECT "a", "b" FROM "s" WHERE (c=$1);
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- copy
+INSERT INTO "t" ("a") SELECT "x" FROM "s" LIMIT 5 RETURNING "id";
\ No newline at end of file
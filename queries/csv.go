@@ -0,0 +1,270 @@
+package queries
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/friendsofgo/errors"
+)
+
+// CSVTimeFormat is the layout times are written to csv in. Reading accepts
+// it as well as the layouts in CSVTimeParseFormats.
+var CSVTimeFormat = time.RFC3339Nano
+
+// CSVTimeParseFormats are the layouts tried after CSVTimeFormat when reading
+// a time from csv, so files that went through a spreadsheet can be read back.
+var CSVTimeParseFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+	"15:04:05.999999999",
+}
+
+// WriteCSV writes the columns of a slice of structs, eg. a slice of models,
+// to w as csv with a header row of the column names. The columns are looked
+// up in mapping, see MakeStructMapping. Null values are written as empty
+// cells and times are written in CSVTimeFormat.
+func WriteCSV(w io.Writer, mapping map[string]uint64, slice interface{}, columns []string) error {
+	fields, err := csvMapping(mapping, columns)
+	if err != nil {
+		return err
+	}
+
+	sliceVal := reflect.Indirect(reflect.ValueOf(slice))
+	if sliceVal.Kind() != reflect.Slice {
+		return errors.Errorf("csv can only be written from a slice, got %T", slice)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+
+	record := make([]string, len(columns))
+	for i := 0; i < sliceVal.Len(); i++ {
+		obj := reflect.Indirect(sliceVal.Index(i))
+		for j, field := range fields {
+			if record[j], err = csvFormat(csvField(obj, field).Interface()); err != nil {
+				return errors.Wrapf(err, "unable to write column %s of row %d", columns[j], i+1)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadCSV reads csv written by WriteCSV into obj, a pointer to a slice of
+// pointers to structs. The header row decides which column each cell goes
+// into, every header has to be in mapping. Empty cells are read as null for
+// nullable types and as the zero value otherwise.
+func ReadCSV(r io.Reader, mapping map[string]uint64, obj interface{}) error {
+	ptrVal := reflect.ValueOf(obj)
+	if ptrVal.Kind() != reflect.Ptr || ptrVal.Elem().Kind() != reflect.Slice ||
+		ptrVal.Elem().Type().Elem().Kind() != reflect.Ptr {
+		return errors.Errorf("csv can only be read into a pointer to a slice of pointers, got %T", obj)
+	}
+	sliceVal := ptrVal.Elem()
+	structType := sliceVal.Type().Elem().Elem()
+
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	columns := append([]string(nil), header...)
+
+	fields, err := csvMapping(mapping, columns)
+	if err != nil {
+		return err
+	}
+
+	for row := 1; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		val := reflect.New(structType)
+		for i, field := range fields {
+			if err := csvParse(csvField(val.Elem(), field).Addr().Interface(), record[i]); err != nil {
+				return errors.Wrapf(err, "unable to read column %s of row %d", columns[i], row)
+			}
+		}
+		sliceVal.Set(reflect.Append(sliceVal, val))
+	}
+}
+
+// csvMapping looks up columns in mapping, every one of them has to be there.
+func csvMapping(mapping map[string]uint64, columns []string) ([]uint64, error) {
+	fields := make([]uint64, len(columns))
+	for i, c := range columns {
+		field, ok := mapping[c]
+		if !ok {
+			return nil, errors.Errorf("unknown column %s", c)
+		}
+		fields[i] = field
+	}
+
+	return fields, nil
+}
+
+// csvField returns the field the mapping points to. Unlike ptrFromMapping
+// it doesn't dereference the field itself, so pointer fields can be nil.
+func csvField(val reflect.Value, mapping uint64) reflect.Value {
+	for i := 0; i < 8; i++ {
+		if (mapping>>uint((i+1)*8))&sentinel == sentinel {
+			return val.Field(int((mapping >> uint(i*8)) & sentinel))
+		}
+
+		val = reflect.Indirect(val.Field(int((mapping >> uint(i*8)) & sentinel)))
+	}
+
+	panic("could not find field from mapping")
+}
+
+// csvFormat formats a field as a csv cell.
+func csvFormat(v interface{}) (string, error) {
+	v = derefPtr(v)
+	if valuer, ok := v.(driver.Valuer); ok {
+		var err error
+		if v, err = valuer.Value(); err != nil {
+			return "", err
+		}
+	}
+
+	switch t := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return t, nil
+	case []byte:
+		return string(t), nil
+	case time.Time:
+		return t.Format(CSVTimeFormat), nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case float32:
+		return strconv.FormatFloat(float64(t), 'g', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(t, 'g', -1, 64), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// csvParse parses a csv cell into the field ptr points to.
+func csvParse(ptr interface{}, s string) error {
+	val := reflect.ValueOf(ptr).Elem()
+	if val.Kind() == reflect.Ptr {
+		if len(s) == 0 {
+			val.Set(reflect.Zero(val.Type()))
+			return nil
+		}
+
+		elem := reflect.New(val.Type().Elem())
+		if err := csvParse(elem.Interface(), s); err != nil {
+			return err
+		}
+		val.Set(elem)
+		return nil
+	}
+
+	if scanner, ok := ptr.(sql.Scanner); ok {
+		if len(s) == 0 {
+			return scanner.Scan(nil)
+		}
+		if err := scanner.Scan(s); err != nil {
+			// Scanners of times usually won't take a string
+			t, terr := csvParseTime(s)
+			if terr != nil {
+				return err
+			}
+			return scanner.Scan(t)
+		}
+		return nil
+	}
+
+	if len(s) == 0 {
+		val.Set(reflect.Zero(val.Type()))
+		return nil
+	}
+
+	switch val.Kind() {
+	case reflect.String:
+		val.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		val.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+		val.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+		val.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+		val.SetFloat(f)
+	case reflect.Slice:
+		if val.Type().Elem().Kind() != reflect.Uint8 {
+			return errors.Errorf("unable to read csv into %s", val.Type())
+		}
+		val.SetBytes([]byte(s))
+	default:
+		if val.Type() != reflect.TypeOf(time.Time{}) {
+			return errors.Errorf("unable to read csv into %s", val.Type())
+		}
+		t, err := csvParseTime(s)
+		if err != nil {
+			return err
+		}
+		val.Set(reflect.ValueOf(t))
+	}
+
+	return nil
+}
+
+// csvParseTime parses s with CSVTimeFormat or one of CSVTimeParseFormats.
+func csvParseTime(s string) (time.Time, error) {
+	t, err := time.Parse(CSVTimeFormat, s)
+	if err == nil {
+		return t, nil
+	}
+
+	for _, layout := range CSVTimeParseFormats {
+		if t, perr := time.Parse(layout, s); perr == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
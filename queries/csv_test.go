@@ -0,0 +1,83 @@
+package queries
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+type csvTestRow struct {
+	ID      int       `boil:"id"`
+	Name    string    `boil:"name"`
+	Score   *float64  `boil:"score"`
+	Created time.Time `boil:"created_at"`
+	Deleted null.Time `boil:"deleted_at"`
+	Note    null.String
+
+	R *struct{} `boil:"-"`
+}
+
+func TestCSV(t *testing.T) {
+	t.Parallel()
+
+	mapping := MakeStructMapping(reflect.TypeOf(csvTestRow{}))
+	created := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	score := 1.5
+
+	rows := []*csvTestRow{
+		{ID: 1, Name: "a, \"b\"", Score: &score, Created: created, Deleted: null.TimeFrom(created), Note: null.StringFrom("")},
+		{ID: 2, Created: created},
+	}
+
+	var buf bytes.Buffer
+	columns := []string{"id", "name", "score", "created_at", "deleted_at", "note"}
+	if err := WriteCSV(&buf, mapping, rows, columns); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "id,name,score,created_at,deleted_at,note\n" +
+		"1,\"a, \"\"b\"\"\",1.5,2020-01-02T03:04:05.000000006Z,2020-01-02T03:04:05.000000006Z,\n" +
+		"2,,,2020-01-02T03:04:05.000000006Z,,\n"
+	if got := buf.String(); got != want {
+		t.Errorf("wrong csv:\nwant: %q\ngot:  %q", want, got)
+	}
+
+	var read []*csvTestRow
+	if err := ReadCSV(&buf, mapping, &read); err != nil {
+		t.Fatal(err)
+	}
+
+	// An empty string can't be told apart from null
+	rows[0].Note = null.String{}
+	if !reflect.DeepEqual(read, rows) {
+		t.Errorf("wrong rows:\nwant: %#v\ngot:  %#v", rows, read)
+	}
+}
+
+func TestCSVErrors(t *testing.T) {
+	t.Parallel()
+
+	mapping := MakeStructMapping(reflect.TypeOf(csvTestRow{}))
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, mapping, []*csvTestRow{}, []string{"id", "nope"}); err == nil {
+		t.Error("want an error for an unknown column")
+	}
+
+	var read []*csvTestRow
+	if err := ReadCSV(strings.NewReader("id,name\nx,a\n"), mapping, &read); err == nil || !strings.Contains(err.Error(), "column id of row 1") {
+		t.Errorf("want a parse error for id of row 1, got %v", err)
+	}
+
+	read = nil
+	if err := ReadCSV(strings.NewReader("name,created_at\nb,2020-01-02 03:04:05\n"), mapping, &read); err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != 1 || read[0].Name != "b" || !read[0].Created.Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("wrong rows: %#v", read)
+	}
+}
@@ -0,0 +1,61 @@
+package queries
+
+import (
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// DialectExecutor is an executor that knows the dialect of the database it
+// runs queries on. Queries built from query mods that are run on one are
+// built in its dialect, instead of the one they were made with, so the
+// placeholders, quoting and limit syntax suit the database.
+type DialectExecutor interface {
+	Dialect() *drivers.Dialect
+}
+
+// WithDialect wraps exec so that the queries built from query mods that are
+// run on it use dialect. This lets a binary that talks to several kinds of
+// databases share code building queries from mods:
+//
+//	pg := queries.WithDialect(pgDB, &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true})
+//	my := queries.WithDialect(myDB, &drivers.Dialect{LQ: '`', RQ: '`'})
+//
+// Only the SQL the query builder writes follows the dialect, so this is for
+// queries built from hand written mods like qm.From and qm.Where. Clauses
+// passed to query mods are used as they are, apart from their ? placeholders,
+// which includes the ones the generated helpers pass: models.Pilots(), the
+// where helpers, relationships and eager loading quote identifiers for the
+// package's dialect. The statements the generated models write themselves,
+// like inserts and upserts, are generated for a single dialect too.
+// Transactions can't be begun on the wrapped executor, wrap the *sql.Tx
+// instead.
+func WithDialect(exec boil.ContextExecutor, dialect *drivers.Dialect) boil.ContextExecutor {
+	return dialectExecutor{ContextExecutor: exec, dialect: dialect}
+}
+
+type dialectExecutor struct {
+	boil.ContextExecutor
+	dialect *drivers.Dialect
+}
+
+func (e dialectExecutor) Dialect() *drivers.Dialect {
+	return e.dialect
+}
+
+// useExecDialect switches q to the dialect of exec, if it has one, throwing
+// away SQL built for another dialect.
+func useExecDialect(q *Query, exec boil.Executor) {
+	d, ok := exec.(DialectExecutor)
+	if !ok {
+		return
+	}
+
+	dialect := d.Dialect()
+	if dialect == nil || (q.dialect != nil && *q.dialect == *dialect) {
+		return
+	}
+	q.dialect = dialect
+	if q.rawSQL.built {
+		q.rawSQL = rawSQL{}
+	}
+}
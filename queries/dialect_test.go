@@ -0,0 +1,46 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestWithDialect(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	psql := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	mysql := &drivers.Dialect{LQ: '`', RQ: '`'}
+
+	mock.ExpectExec(`DELETE FROM "pilots" WHERE (id = $1);`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM `pilots` WHERE (id = ?);").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	q := &Query{dialect: psql, delete: true, from: []string{"pilots"}}
+	AppendWhere(q, "id = ?", 1)
+
+	ctx := context.Background()
+	if _, err := q.ExecContext(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.ExecContext(ctx, WithDialect(db, mysql)); err != nil {
+		t.Fatal(err)
+	}
+
+	raw := Raw("select 1")
+	useExecDialect(raw, WithDialect(db, mysql))
+	if sql, _ := BuildQuery(raw); sql != "select 1" {
+		t.Errorf("raw queries should be left alone, got %s", sql)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,6 +1,7 @@
 package queries
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"reflect"
 )
@@ -41,3 +42,13 @@ func NonZeroDefaultSet(defaults []string, obj interface{}) []string {
 
 	return c
 }
+
+// DriverValues converts query arguments to the driver.Value slice that
+// mocking libraries like go-sqlmock take for their expected arguments.
+func DriverValues(args []interface{}) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, a := range args {
+		values[i] = a
+	}
+	return values
+}
@@ -65,3 +65,18 @@ func TestNonZeroDefaultSet(t *testing.T) {
 		}
 	}
 }
+
+func TestDriverValues(t *testing.T) {
+	t.Parallel()
+
+	args := []interface{}{1, "a", null.IntFrom(5), nil}
+	values := DriverValues(args)
+	if len(values) != len(args) {
+		t.Fatalf("want %d values, got %d", len(args), len(values))
+	}
+	for i := range args {
+		if !reflect.DeepEqual(values[i], args[i]) {
+			t.Errorf("%d) want: %#v, got: %#v", i, args[i], values[i])
+		}
+	}
+}
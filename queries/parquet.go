@@ -0,0 +1,523 @@
+package queries
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/friendsofgo/errors"
+)
+
+// ParquetType is the physical type a column is stored as in parquet, the
+// values are the ones of the parquet format.
+type ParquetType int32
+
+// Physical types of parquet columns
+const (
+	ParquetBoolean   ParquetType = 0
+	ParquetInt32     ParquetType = 1
+	ParquetInt64     ParquetType = 2
+	ParquetFloat     ParquetType = 4
+	ParquetDouble    ParquetType = 5
+	ParquetByteArray ParquetType = 6
+)
+
+// ParquetLogical tells readers how to interpret the physical values of a
+// column, eg. an INT64 column with ParquetTimestamp holds times.
+type ParquetLogical int
+
+// Logical types of parquet columns
+const (
+	ParquetNone ParquetLogical = iota
+	ParquetString
+	ParquetJSON
+	ParquetDate
+	ParquetTimestamp
+	ParquetDecimal
+	ParquetInt8
+	ParquetInt16
+	ParquetUint8
+	ParquetUint16
+	ParquetUint32
+	ParquetUint64
+)
+
+// parquetConvertedTypes are the ConvertedType values of the parquet format
+// that are written for the logical types.
+var parquetConvertedTypes = map[ParquetLogical]int32{
+	ParquetString:    0,
+	ParquetDecimal:   5,
+	ParquetDate:      6,
+	ParquetTimestamp: 10, // TIMESTAMP_MICROS
+	ParquetUint8:     11,
+	ParquetUint16:    12,
+	ParquetUint32:    13,
+	ParquetUint64:    14,
+	ParquetInt8:      15,
+	ParquetInt16:     16,
+	ParquetJSON:      19,
+}
+
+// ParquetColumn describes how a column is written to parquet. Precision and
+// Scale are only used by ParquetDecimal.
+type ParquetColumn struct {
+	Name      string
+	Type      ParquetType
+	Logical   ParquetLogical
+	Nullable  bool
+	Precision int
+	Scale     int
+}
+
+// WriteParquet writes the columns of a slice of structs, eg. a slice of
+// models, to w as an uncompressed parquet file with a single row group. The
+// columns are looked up in mapping, see MakeStructMapping.
+//
+// Values are converted to the types of the columns: times are written in
+// microseconds since the epoch in UTC, dates as days since the epoch and
+// decimals as unscaled integers, so they have to fit the precision and scale
+// of the column. Other values of byte array columns are written as text.
+func WriteParquet(w io.Writer, mapping map[string]uint64, slice interface{}, columns []ParquetColumn) error {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	fields, err := csvMapping(mapping, names)
+	if err != nil {
+		return err
+	}
+
+	sliceVal := reflect.Indirect(reflect.ValueOf(slice))
+	if sliceVal.Kind() != reflect.Slice {
+		return errors.Errorf("parquet can only be written from a slice, got %T", slice)
+	}
+	numRows := sliceVal.Len()
+
+	var file bytes.Buffer
+	file.WriteString("PAR1")
+
+	chunks := make([]parquetChunk, len(columns))
+	for i, c := range columns {
+		chunk, err := parquetColumnChunk(c, sliceVal, fields[i])
+		if err != nil {
+			return errors.Wrapf(err, "unable to write column %s", c.Name)
+		}
+
+		chunks[i] = parquetChunk{offset: int64(file.Len()), size: int64(len(chunk))}
+		file.Write(chunk)
+	}
+
+	footer := parquetFileMetaData(columns, chunks, int64(numRows))
+	file.Write(footer)
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(footer)))
+	file.Write(length[:])
+	file.WriteString("PAR1")
+
+	_, err = w.Write(file.Bytes())
+	return err
+}
+
+// parquetChunk is where a column chunk was written in the file.
+type parquetChunk struct {
+	offset int64
+	size   int64
+}
+
+// parquetColumnChunk encodes a column of the slice as a single PLAIN data
+// page. Nullable columns are preceded by their definition levels.
+func parquetColumnChunk(c ParquetColumn, sliceVal reflect.Value, field uint64) ([]byte, error) {
+	numRows := sliceVal.Len()
+	present := make([]bool, numRows)
+	var bools []bool
+	var values bytes.Buffer
+
+	for i := 0; i < numRows; i++ {
+		v, err := parquetValue(csvField(reflect.Indirect(sliceVal.Index(i)), field))
+		if err != nil {
+			return nil, errors.Wrapf(err, "row %d", i+1)
+		}
+		if v == nil {
+			if !c.Nullable {
+				return nil, errors.Errorf("row %d is null but the column isn't nullable", i+1)
+			}
+			continue
+		}
+		present[i] = true
+
+		if c.Type == ParquetBoolean {
+			b, ok := v.(bool)
+			if !ok {
+				return nil, errors.Errorf("row %d: unable to write %T as a boolean", i+1, v)
+			}
+			bools = append(bools, b)
+			continue
+		}
+		if err := parquetEncode(&values, c, v); err != nil {
+			return nil, errors.Wrapf(err, "row %d", i+1)
+		}
+	}
+
+	var page bytes.Buffer
+	if c.Nullable {
+		levels := parquetBitPacked(present)
+		var length [4]byte
+		binary.LittleEndian.PutUint32(length[:], uint32(len(levels)))
+		page.Write(length[:])
+		page.Write(levels)
+	}
+	if c.Type == ParquetBoolean {
+		page.Write(parquetBits(bools))
+	} else {
+		page.Write(values.Bytes())
+	}
+
+	var header thriftWriter
+	header.i32(1, 0) // DATA_PAGE
+	header.i32(2, int32(page.Len()))
+	header.i32(3, int32(page.Len()))
+	header.structBegin(5)
+	header.i32(1, int32(numRows))
+	header.i32(2, 0) // PLAIN
+	header.i32(3, 3) // RLE
+	header.i32(4, 3) // RLE
+	header.structEnd()
+	header.stop()
+
+	return append(header.Bytes(), page.Bytes()...), nil
+}
+
+// parquetFileMetaData encodes the footer of the file.
+func parquetFileMetaData(columns []ParquetColumn, chunks []parquetChunk, numRows int64) []byte {
+	var t thriftWriter
+	t.i32(1, 1)
+
+	t.listBegin(2, thriftStruct, len(columns)+1)
+	t.elemBegin()
+	t.binary(4, []byte("schema"))
+	t.i32(5, int32(len(columns)))
+	t.elemEnd()
+	for _, c := range columns {
+		t.elemBegin()
+		t.i32(1, int32(c.Type))
+		if c.Nullable {
+			t.i32(3, 1) // OPTIONAL
+		} else {
+			t.i32(3, 0) // REQUIRED
+		}
+		t.binary(4, []byte(c.Name))
+		if converted, ok := parquetConvertedTypes[c.Logical]; ok {
+			t.i32(6, converted)
+		}
+		if c.Logical == ParquetDecimal {
+			t.i32(7, int32(c.Scale))
+			t.i32(8, int32(c.Precision))
+		}
+		t.elemEnd()
+	}
+
+	t.i64(3, numRows)
+
+	var size int64
+	for _, chunk := range chunks {
+		size += chunk.size
+	}
+	t.listBegin(4, thriftStruct, 1)
+	t.elemBegin()
+	t.listBegin(1, thriftStruct, len(columns))
+	for i, c := range columns {
+		t.elemBegin()
+		t.i64(2, chunks[i].offset)
+		t.structBegin(3)
+		t.i32(1, int32(c.Type))
+		if c.Nullable {
+			t.listBegin(2, thriftI32, 2)
+			t.i32Elem(0) // PLAIN
+			t.i32Elem(3) // RLE
+		} else {
+			t.listBegin(2, thriftI32, 1)
+			t.i32Elem(0) // PLAIN
+		}
+		t.listBegin(3, thriftBinary, 1)
+		t.binaryElem([]byte(c.Name))
+		t.i32(4, 0) // UNCOMPRESSED
+		t.i64(5, numRows)
+		t.i64(6, chunks[i].size)
+		t.i64(7, chunks[i].size)
+		t.i64(9, chunks[i].offset)
+		t.structEnd()
+		t.elemEnd()
+	}
+	t.i64(2, size)
+	t.i64(3, numRows)
+	t.elemEnd()
+
+	t.binary(6, []byte("sqlboiler"))
+	t.stop()
+
+	return t.Bytes()
+}
+
+// parquetValue returns the value of a field as one of the types
+// parquetEncode writes, or nil for null. Integers are returned as int64.
+func parquetValue(field reflect.Value) (interface{}, error) {
+	for field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return nil, nil
+		}
+		field = field.Elem()
+	}
+
+	if valuer, ok := field.Interface().(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil || v == nil {
+			return nil, err
+		}
+		field = reflect.ValueOf(v)
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(field.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return field.Float(), nil
+	case reflect.Bool:
+		return field.Bool(), nil
+	case reflect.String:
+		return field.String(), nil
+	case reflect.Slice:
+		if field.Type().Elem().Kind() == reflect.Uint8 {
+			return field.Bytes(), nil
+		}
+	}
+
+	return field.Interface(), nil
+}
+
+// parquetEncode appends the PLAIN encoding of v in the type of c to buf.
+func parquetEncode(buf *bytes.Buffer, c ParquetColumn, v interface{}) error {
+	var scratch [8]byte
+
+	switch c.Type {
+	case ParquetInt32:
+		var i int64
+		switch t := v.(type) {
+		case int64:
+			i = t
+		case time.Time:
+			if c.Logical != ParquetDate {
+				return errors.New("times can only be written to INT32 columns as dates")
+			}
+			y, m, d := t.Date()
+			i = time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+		default:
+			return errors.Errorf("unable to write %T as an INT32", v)
+		}
+		binary.LittleEndian.PutUint32(scratch[:4], uint32(i))
+		buf.Write(scratch[:4])
+	case ParquetInt64:
+		var i int64
+		switch t := v.(type) {
+		case int64:
+			i = t
+		case time.Time:
+			i = t.Unix()*1e6 + int64(t.Nanosecond()/1e3)
+		default:
+			return errors.Errorf("unable to write %T as an INT64", v)
+		}
+		binary.LittleEndian.PutUint64(scratch[:], uint64(i))
+		buf.Write(scratch[:])
+	case ParquetFloat, ParquetDouble:
+		f, ok := v.(float64)
+		if !ok {
+			return errors.Errorf("unable to write %T as a floating point number", v)
+		}
+		if c.Type == ParquetFloat {
+			binary.LittleEndian.PutUint32(scratch[:4], math.Float32bits(float32(f)))
+			buf.Write(scratch[:4])
+		} else {
+			binary.LittleEndian.PutUint64(scratch[:], math.Float64bits(f))
+			buf.Write(scratch[:])
+		}
+	case ParquetByteArray:
+		var b []byte
+		switch t := v.(type) {
+		case []byte:
+			b = t
+		case string:
+			b = []byte(t)
+		case time.Time:
+			b = []byte(t.Format(time.RFC3339Nano))
+		default:
+			b = []byte(fmt.Sprint(t))
+		}
+		if c.Logical == ParquetDecimal {
+			var err error
+			if b, err = parquetDecimal(string(b), c.Precision, c.Scale); err != nil {
+				return err
+			}
+		}
+		binary.LittleEndian.PutUint32(scratch[:4], uint32(len(b)))
+		buf.Write(scratch[:4])
+		buf.Write(b)
+	default:
+		return errors.Errorf("unknown parquet type %d", c.Type)
+	}
+
+	return nil
+}
+
+// parquetDecimal converts a decimal in text to the big endian two's
+// complement of its unscaled value.
+func parquetDecimal(s string, precision, scale int) ([]byte, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, errors.Errorf("invalid decimal %q", s)
+	}
+
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)))
+	if !r.IsInt() {
+		return nil, errors.Errorf("decimal %s has more than %d decimal places", s, scale)
+	}
+	unscaled := r.Num()
+
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	if new(big.Int).Abs(unscaled).Cmp(limit) >= 0 {
+		return nil, errors.Errorf("decimal %s has more than %d digits", s, precision)
+	}
+
+	n := unscaled.BitLen()/8 + 1
+	if unscaled.Sign() < 0 {
+		unscaled = new(big.Int).Add(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(n*8)))
+	}
+
+	return unscaled.FillBytes(make([]byte, n)), nil
+}
+
+// parquetBitPacked encodes bits in the RLE/bit-packing hybrid encoding with a
+// bit width of one, as a single bit-packed run.
+func parquetBitPacked(bits []bool) []byte {
+	groups := (len(bits) + 7) / 8
+
+	var header [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(header[:], uint64(groups)<<1|1)
+
+	return append(header[:n], parquetBits(bits)...)
+}
+
+// parquetBits packs bits into bytes, least significant bit first.
+func parquetBits(bits []bool) []byte {
+	packed := make([]byte, (len(bits)+7)/8)
+	for i, b := range bits {
+		if b {
+			packed[i/8] |= 1 << uint(i%8)
+		}
+	}
+
+	return packed
+}
+
+// Types of the thrift compact protocol
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter writes the thrift compact protocol the parquet metadata is
+// encoded in. Only the parts parquet needs are implemented.
+type thriftWriter struct {
+	bytes.Buffer
+	lastID  int16
+	parents []int16
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	if delta := id - t.lastID; delta > 0 && delta <= 15 {
+		t.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		t.WriteByte(typ)
+		t.varint(int64(id))
+	}
+	t.lastID = id
+}
+
+func (t *thriftWriter) uvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	t.Write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+func (t *thriftWriter) varint(v int64) {
+	t.uvarint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.varint(int64(v))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.varint(v)
+}
+
+func (t *thriftWriter) binary(id int16, b []byte) {
+	t.field(id, thriftBinary)
+	t.binaryElem(b)
+}
+
+func (t *thriftWriter) structBegin(id int16) {
+	t.field(id, thriftStruct)
+	t.elemBegin()
+}
+
+func (t *thriftWriter) structEnd() {
+	t.elemEnd()
+}
+
+func (t *thriftWriter) listBegin(id int16, elem byte, size int) {
+	t.field(id, thriftList)
+	if size < 15 {
+		t.WriteByte(byte(size)<<4 | elem)
+	} else {
+		t.WriteByte(0xf0 | elem)
+		t.uvarint(uint64(size))
+	}
+}
+
+// elemBegin starts a struct that's an element of a list.
+func (t *thriftWriter) elemBegin() {
+	t.parents = append(t.parents, t.lastID)
+	t.lastID = 0
+}
+
+// elemEnd ends a struct started by elemBegin or structBegin.
+func (t *thriftWriter) elemEnd() {
+	t.stop()
+	t.lastID = t.parents[len(t.parents)-1]
+	t.parents = t.parents[:len(t.parents)-1]
+}
+
+func (t *thriftWriter) i32Elem(v int32) {
+	t.varint(int64(v))
+}
+
+func (t *thriftWriter) binaryElem(b []byte) {
+	t.uvarint(uint64(len(b)))
+	t.Write(b)
+}
+
+// stop ends the top level struct.
+func (t *thriftWriter) stop() {
+	t.WriteByte(0)
+}
@@ -0,0 +1,218 @@
+package queries
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+type parquetTestRow struct {
+	ID      int64       `boil:"id"`
+	Name    null.String `boil:"name"`
+	Active  bool        `boil:"active"`
+	Score   *float64    `boil:"score"`
+	Price   string      `boil:"price"`
+	Created time.Time   `boil:"created_at"`
+}
+
+func TestWriteParquet(t *testing.T) {
+	t.Parallel()
+
+	mapping := MakeStructMapping(reflect.TypeOf(parquetTestRow{}))
+	created := time.Date(2020, 1, 2, 3, 4, 5, 6000, time.UTC)
+	score := 1.5
+
+	rows := []*parquetTestRow{
+		{ID: 1, Name: null.StringFrom("a"), Active: true, Score: &score, Price: "1.25", Created: created},
+		{ID: 2, Price: "-3", Created: created},
+		{ID: 3, Name: null.StringFrom("c"), Active: true, Price: "0.1", Created: created},
+	}
+	columns := []ParquetColumn{
+		{Name: "id", Type: ParquetInt64},
+		{Name: "name", Type: ParquetByteArray, Logical: ParquetString, Nullable: true},
+		{Name: "active", Type: ParquetBoolean},
+		{Name: "score", Type: ParquetDouble, Nullable: true},
+		{Name: "price", Type: ParquetByteArray, Logical: ParquetDecimal, Precision: 5, Scale: 2},
+		{Name: "created_at", Type: ParquetInt64, Logical: ParquetTimestamp},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteParquet(&buf, mapping, rows, columns); err != nil {
+		t.Fatal(err)
+	}
+	file := buf.Bytes()
+
+	if !bytes.HasPrefix(file, []byte("PAR1")) || !bytes.HasSuffix(file, []byte("PAR1")) {
+		t.Fatal("missing magic bytes")
+	}
+	footerLen := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	footer := file[len(file)-8-footerLen : len(file)-8]
+
+	meta, n := readThriftStruct(t, footer)
+	if n != len(footer) {
+		t.Errorf("footer is %d bytes, read %d", len(footer), n)
+	}
+	if meta[3] != int64(3) {
+		t.Errorf("want 3 rows, got %v", meta[3])
+	}
+
+	schema := meta[2].([]interface{})
+	if len(schema) != len(columns)+1 {
+		t.Fatalf("want %d schema elements, got %d", len(columns)+1, len(schema))
+	}
+	price := schema[5].(map[int16]interface{})
+	if string(price[4].([]byte)) != "price" || price[6] != int64(5) || price[7] != int64(2) || price[8] != int64(5) {
+		t.Errorf("wrong decimal schema: %v", price)
+	}
+	if name := schema[2].(map[int16]interface{}); name[3] != int64(1) || name[6] != int64(0) {
+		t.Errorf("wrong string schema: %v", name)
+	}
+
+	chunks := meta[4].([]interface{})[0].(map[int16]interface{})[1].([]interface{})
+	pages := make([][]byte, len(chunks))
+	for i, c := range chunks {
+		offset := int(c.(map[int16]interface{})[3].(map[int16]interface{})[9].(int64))
+		header, n := readThriftStruct(t, file[offset:])
+		size := int(header[3].(int64))
+		if numValues := header[5].(map[int16]interface{})[1]; numValues != int64(3) {
+			t.Errorf("column %d: want 3 values, got %v", i, numValues)
+		}
+		pages[i] = file[offset+n : offset+n+size]
+	}
+
+	le := binary.LittleEndian
+	if got := []uint64{le.Uint64(pages[0]), le.Uint64(pages[0][8:]), le.Uint64(pages[0][16:])}; !reflect.DeepEqual(got, []uint64{1, 2, 3}) {
+		t.Errorf("wrong ids: %v", got)
+	}
+	// Definition levels: length, bit-packed run of one group, 0b101
+	if want := []byte{2, 0, 0, 0, 3, 5, 1, 0, 0, 0, 'a', 1, 0, 0, 0, 'c'}; !bytes.Equal(pages[1], want) {
+		t.Errorf("wrong names: %v", pages[1])
+	}
+	if want := []byte{5}; !bytes.Equal(pages[2], want) {
+		t.Errorf("wrong actives: %v", pages[2])
+	}
+	if got := math.Float64frombits(le.Uint64(pages[3][6:])); len(pages[3]) != 14 || got != 1.5 {
+		t.Errorf("wrong scores: %v", pages[3])
+	}
+	if want := []byte{1, 0, 0, 0, 125, 2, 0, 0, 0, 0xfe, 0xd4, 1, 0, 0, 0, 10}; !bytes.Equal(pages[4], want) {
+		t.Errorf("wrong prices: %v", pages[4])
+	}
+	if got := int64(le.Uint64(pages[5])); got != created.UnixNano()/1000 {
+		t.Errorf("wrong created_at: %d", got)
+	}
+}
+
+func TestWriteParquetErrors(t *testing.T) {
+	t.Parallel()
+
+	mapping := MakeStructMapping(reflect.TypeOf(parquetTestRow{}))
+	rows := []parquetTestRow{{Price: "1.234"}}
+
+	tests := []ParquetColumn{
+		{Name: "name", Type: ParquetByteArray},
+		{Name: "price", Type: ParquetByteArray, Logical: ParquetDecimal, Precision: 5, Scale: 2},
+		{Name: "price", Type: ParquetByteArray, Logical: ParquetDecimal, Precision: 2, Scale: 3},
+		{Name: "created_at", Type: ParquetInt32},
+		{Name: "unknown", Type: ParquetInt32},
+	}
+
+	for _, c := range tests {
+		if err := WriteParquet(&bytes.Buffer{}, mapping, rows, []ParquetColumn{c}); err == nil {
+			t.Errorf("%#v: want an error", c)
+		}
+	}
+}
+
+func TestParquetDecimal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In    string
+		Scale int
+		Want  []byte
+	}{
+		{"0", 0, []byte{0}},
+		{"127", 0, []byte{127}},
+		{"128", 0, []byte{0, 128}},
+		{"-1", 0, []byte{0xff}},
+		{"-128", 0, []byte{0xff, 0x80}},
+		{"1.5", 2, []byte{0, 150}},
+		{"1E+3", 1, []byte{0x27, 0x10}},
+	}
+
+	for _, test := range tests {
+		got, err := parquetDecimal(test.In, 10, test.Scale)
+		if err != nil {
+			t.Errorf("%s: %v", test.In, err)
+			continue
+		}
+		if !bytes.Equal(got, test.Want) {
+			t.Errorf("%s: want %v, got %v", test.In, test.Want, got)
+		}
+	}
+}
+
+// readThriftStruct decodes a struct in the thrift compact protocol into a map
+// of field ids to values, it returns the number of bytes read.
+func readThriftStruct(t *testing.T, b []byte) (map[int16]interface{}, int) {
+	t.Helper()
+
+	fields := make(map[int16]interface{})
+	var id int16
+	i := 0
+	for {
+		header := b[i]
+		i++
+		if header == 0 {
+			return fields, i
+		}
+
+		if delta := int16(header >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, n := binary.Varint(b[i:])
+			id = int16(v)
+			i += n
+		}
+
+		v, n := readThriftValue(t, header&0x0f, b[i:])
+		fields[id] = v
+		i += n
+	}
+}
+
+func readThriftValue(t *testing.T, typ byte, b []byte) (interface{}, int) {
+	t.Helper()
+
+	switch typ {
+	case thriftI32, thriftI64:
+		return binary.Varint(b)
+	case thriftBinary:
+		size, n := binary.Uvarint(b)
+		return b[n : n+int(size)], n + int(size)
+	case thriftStruct:
+		return readThriftStruct(t, b)
+	case thriftList:
+		size, elem, i := int(b[0]>>4), b[0]&0x0f, 1
+		if size == 15 {
+			s, n := binary.Uvarint(b[1:])
+			size = int(s)
+			i += n
+		}
+		list := make([]interface{}, size)
+		for j := range list {
+			v, n := readThriftValue(t, elem, b[i:])
+			list[j] = v
+			i += n
+		}
+		return list, i
+	default:
+		t.Fatalf("unexpected thrift type %d", typ)
+		return nil, 0
+	}
+}
@@ -449,29 +449,66 @@ func (qm groupByQueryMod) Apply(q *queries.Query) {
 	queries.AppendGroupBy(q, qm.clause)
 }
 
-// GroupBy allows you to specify a group by clause for your statement
+// GroupBy allows you to specify a group by clause for your statement.
+// The clause isn't validated, use ValidGroupBy for clauses built from user
+// input.
 func GroupBy(clause string) QueryMod {
 	return groupByQueryMod{
 		clause: clause,
 	}
 }
 
+// ValidGroupBy is GroupBy for clauses built from user input, which may only
+// name columns with an optional direction, see queries.ValidateClause. It
+// returns the validation error instead of a query mod for other clauses.
+func ValidGroupBy(clause string) (QueryMod, error) {
+	if err := queries.ValidateClause(clause); err != nil {
+		return nil, err
+	}
+
+	return GroupBy(clause), nil
+}
+
 type orderByQueryMod struct {
 	clause string
+	args   []interface{}
 }
 
 // Apply implements QueryMod.Apply.
 func (qm orderByQueryMod) Apply(q *queries.Query) {
-	queries.AppendOrderBy(q, qm.clause)
+	queries.AppendOrderBy(q, qm.clause, qm.args...)
 }
 
-// OrderBy allows you to specify a order by clause for your statement
+// OrderBy allows you to specify a order by clause for your statement.
+// The clause isn't validated, use ValidOrderBy for clauses built from user
+// input.
 func OrderBy(clause string) QueryMod {
 	return orderByQueryMod{
 		clause: clause,
 	}
 }
 
+// ValidOrderBy is OrderBy for clauses built from user input, which may only
+// name columns with an optional direction, see queries.ValidateClause. It
+// returns the validation error instead of a query mod for other clauses.
+func ValidOrderBy(clause string) (QueryMod, error) {
+	if err := queries.ValidateClause(clause); err != nil {
+		return nil, err
+	}
+
+	return OrderBy(clause), nil
+}
+
+// OrderByRaw allows you to specify a order by clause for your statement
+// with placeholders (?) in the clause bound to args. Never build the clause
+// from user input.
+func OrderByRaw(clause string, args ...interface{}) QueryMod {
+	return orderByQueryMod{
+		clause: clause,
+		args:   args,
+	}
+}
+
 type havingQueryMod struct {
 	clause string
 	args   []interface{}
@@ -522,6 +559,27 @@ func Limit(limit int) QueryMod {
 	}
 }
 
+type limitPerParentQueryMod struct {
+	limit int
+}
+
+// Apply implements QueryMod.Apply.
+func (qm limitPerParentQueryMod) Apply(q *queries.Query) {
+	queries.SetPartitionLimit(q, qm.limit)
+}
+
+// LimitPerParent limits the number of eager loaded rows for every parent,
+// combined with OrderBy it loads eg. the latest 3 comments of every post:
+//
+//	Load("Comments", OrderBy("created_at desc"), LimitPerParent(3))
+//
+// It only has an effect on queries passed to Load.
+func LimitPerParent(limit int) QueryMod {
+	return limitPerParentQueryMod{
+		limit: limit,
+	}
+}
+
 type offsetQueryMod struct {
 	offset int
 }
@@ -570,6 +628,25 @@ func Comment(comment string) QueryMod {
 	}
 }
 
+type sqlCommentQueryMod struct {
+	tags map[string]string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm sqlCommentQueryMod) Apply(q *queries.Query) {
+	queries.AppendSQLComment(q, qm.tags)
+}
+
+// SQLComment adds tags to the trailing comment of your query in the
+// sqlcommenter format, eg. /*route='%2Fpilots'*/, see boil.SQLCommentTags.
+// Unlike Comment it's added when the query runs, so it doesn't change the
+// query's cached statement.
+func SQLComment(tags map[string]string) QueryMod {
+	return sqlCommentQueryMod{
+		tags: tags,
+	}
+}
+
 // Rels is an alias for strings.Join to make it easier to use relationship name
 // constants in Load.
 func Rels(r ...string) string {
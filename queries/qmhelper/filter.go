@@ -0,0 +1,224 @@
+package qmhelper
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilterKind is the kind of values a column is filtered with, it decides how
+// the values of the query parameters are parsed and which operators work.
+type FilterKind int
+
+// Kinds of filterable columns
+const (
+	FilterString FilterKind = iota
+	FilterInt
+	FilterUint
+	FilterFloat
+	FilterDecimal
+	FilterBool
+	FilterTime
+)
+
+// FilterColumn is a column that can be filtered on
+type FilterColumn struct {
+	// Name is the quoted name of the column, as used in the where clause
+	Name string
+	Kind FilterKind
+}
+
+// FilterError is returned by ParseFilter for query parameters that aren't
+// valid filters, it's meant to be shown to the client.
+type FilterError struct {
+	Param  string
+	Reason string
+}
+
+// Error implements error.
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("invalid filter %s: %s", e.Param, e.Reason)
+}
+
+var rgxFilterDecimal = regexp.MustCompile(`^[-+]?(?:\d+\.?\d*|\.\d+)$`)
+
+var filterComparisons = map[string]string{
+	"eq":  "=",
+	"ne":  "!=",
+	"lt":  "<",
+	"lte": "<=",
+	"gt":  ">",
+	"gte": ">=",
+}
+
+// ParseFilter converts query parameters into where clauses for a list
+// endpoint. A parameter is a field, optionally followed by two underscores
+// and an operator, eg. ?email__ilike=%25@example.com&created_at__gte=2021-01-01:
+//
+//	eq (the default), ne, lt, lte, gt, gte  compare the column with the value
+//	like, ilike  match text columns with a pattern, ilike ignoring case
+//	in  matches a comma separated list of values
+//	isnull  takes true or false
+//
+// The fields are the keys of columns, only those in allowed can be filtered
+// on. Parameters that don't name a field are ignored, so the filter can share
+// the query string with other parameters like paging. Repeating a parameter
+// adds a clause for every value, except for in, which matches the values of
+// all of them. Times are RFC 3339 or dates.
+//
+// The clauses are sorted by parameter, invalid parameters return a
+// *FilterError.
+func ParseFilter(values map[string][]string, columns map[string]FilterColumn, allowed []string) ([]WhereQueryMod, error) {
+	params := make([]string, 0, len(values))
+	for param := range values {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+
+	var wheres []WhereQueryMod
+	for _, param := range params {
+		field, op := param, "eq"
+		if i := strings.LastIndex(param, "__"); i > 0 {
+			if _, ok := columns[param[:i]]; ok {
+				field, op = param[:i], param[i+2:]
+			}
+		}
+
+		column, ok := columns[field]
+		if !ok {
+			continue
+		}
+		if !filterAllowed(field, allowed) {
+			return nil, &FilterError{Param: param, Reason: "filtering on " + field + " isn't allowed"}
+		}
+
+		paramWheres, err := parseFilterParam(column, op, values[param])
+		if err != nil {
+			return nil, &FilterError{Param: param, Reason: err.Error()}
+		}
+		wheres = append(wheres, paramWheres...)
+	}
+
+	return wheres, nil
+}
+
+func filterAllowed(field string, allowed []string) bool {
+	for _, a := range allowed {
+		if a == field {
+			return true
+		}
+	}
+	return false
+}
+
+func parseFilterParam(column FilterColumn, op string, values []string) ([]WhereQueryMod, error) {
+	switch op {
+	case "in":
+		var args []interface{}
+		for _, value := range values {
+			for _, v := range strings.Split(value, ",") {
+				arg, err := column.Parse(v)
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+			}
+		}
+		if len(args) == 0 {
+			return nil, errors.New("no values")
+		}
+		return []WhereQueryMod{{
+			Clause: column.Name + " in (" + strings.Repeat(",?", len(args))[1:] + ")",
+			Args:   args,
+		}}, nil
+	case "isnull":
+		wheres := make([]WhereQueryMod, len(values))
+		for i, value := range values {
+			isNull, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("%q isn't true or false", value)
+			}
+			if isNull {
+				wheres[i] = WhereIsNull(column.Name)
+			} else {
+				wheres[i] = WhereIsNotNull(column.Name)
+			}
+		}
+		return wheres, nil
+	}
+
+	var clause string
+	switch op {
+	case "eq", "ne", "lt", "lte", "gt", "gte":
+		if column.Kind == FilterBool && op != "eq" && op != "ne" {
+			return nil, fmt.Errorf("%s doesn't work on booleans", op)
+		}
+		clause = column.Name + " " + filterComparisons[op] + " ?"
+	case "like", "ilike":
+		if column.Kind != FilterString {
+			return nil, fmt.Errorf("%s only works on text", op)
+		}
+		if op == "like" {
+			clause = column.Name + " like ?"
+		} else {
+			clause = "lower(" + column.Name + ") like lower(?)"
+		}
+	default:
+		return nil, fmt.Errorf("unknown operator %q", op)
+	}
+
+	wheres := make([]WhereQueryMod, len(values))
+	for i, value := range values {
+		arg, err := column.Parse(value)
+		if err != nil {
+			return nil, err
+		}
+		wheres[i] = WhereQueryMod{Clause: clause, Args: []interface{}{arg}}
+	}
+	return wheres, nil
+}
+
+// Parse converts a value given for the column to the type it's compared with.
+func (c FilterColumn) Parse(value string) (interface{}, error) {
+	switch c.Kind {
+	case FilterInt:
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i, nil
+		}
+		return nil, fmt.Errorf("%q isn't an integer", value)
+	case FilterUint:
+		if u, err := strconv.ParseUint(value, 10, 64); err == nil {
+			return u, nil
+		}
+		return nil, fmt.Errorf("%q isn't a positive integer", value)
+	case FilterFloat:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f, nil
+		}
+		return nil, fmt.Errorf("%q isn't a number", value)
+	case FilterDecimal:
+		if rgxFilterDecimal.MatchString(value) {
+			return value, nil
+		}
+		return nil, fmt.Errorf("%q isn't a number", value)
+	case FilterBool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b, nil
+		}
+		return nil, fmt.Errorf("%q isn't true or false", value)
+	case FilterTime:
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return t, nil
+		}
+		if t, err := time.Parse("2006-01-02", value); err == nil {
+			return t, nil
+		}
+		return nil, fmt.Errorf("%q isn't a time", value)
+	default:
+		return value, nil
+	}
+}
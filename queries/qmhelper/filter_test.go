@@ -0,0 +1,77 @@
+package qmhelper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testFilterColumns = map[string]FilterColumn{
+	"id":         {Name: `"id"`, Kind: FilterInt},
+	"name":       {Name: `"name"`, Kind: FilterString},
+	"active":     {Name: `"active"`, Kind: FilterBool},
+	"created_at": {Name: `"created_at"`, Kind: FilterTime},
+	"price":      {Name: `"price"`, Kind: FilterDecimal},
+}
+
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+
+	values := map[string][]string{
+		"id__in":          {"1,2", "3"},
+		"name__ilike":     {"jo%"},
+		"created_at__gte": {"2021-01-02"},
+		"active":          {"true"},
+		"price__isnull":   {"false"},
+		"page":            {"2"},
+	}
+	wheres, err := ParseFilter(values, testFilterColumns, []string{"id", "name", "created_at", "active", "price"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []WhereQueryMod{
+		{Clause: `"active" = ?`, Args: []interface{}{true}},
+		{Clause: `"created_at" >= ?`, Args: []interface{}{time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)}},
+		{Clause: `"id" in (?,?,?)`, Args: []interface{}{int64(1), int64(2), int64(3)}},
+		{Clause: `lower("name") like lower(?)`, Args: []interface{}{"jo%"}},
+		{Clause: `"price" is not null`},
+	}
+	if !reflect.DeepEqual(wheres, want) {
+		t.Errorf("want: %#v\ngot:  %#v", want, wheres)
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Param string
+		Value string
+	}{
+		{"id", "one"},
+		{"id__like", "1%"},
+		{"active__gt", "true"},
+		{"name__between", "a"},
+		{"price", "1e3"},
+		{"created_at__lt", "yesterday"},
+		{"secret", "x"},
+	}
+
+	columns := map[string]FilterColumn{"secret": {Name: `"secret"`, Kind: FilterString}}
+	for k, v := range testFilterColumns {
+		columns[k] = v
+	}
+
+	for _, test := range tests {
+		_, err := ParseFilter(map[string][]string{test.Param: {test.Value}}, columns, []string{"id", "name", "created_at", "active", "price"})
+		filterErr, ok := err.(*FilterError)
+		if !ok {
+			t.Errorf("%s=%s: want a *FilterError, got: %v", test.Param, test.Value, err)
+			continue
+		}
+		if filterErr.Param != test.Param {
+			t.Errorf("%s=%s: wrong param in error: %s", test.Param, test.Value, filterErr.Param)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package qmhelper
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/volatiletech/sqlboiler/v4/queries"
 )
@@ -82,3 +83,32 @@ func Where(name string, operator operator, value interface{}) WhereQueryMod {
 		Args:   []interface{}{value},
 	}
 }
+
+// WhereTuplesIn matches rows whose names hold one of the tuples of values,
+// each tuple has a value for every name in order. It's used for foreign keys
+// over several columns. The clause is spelled out as
+// (a = ? AND b = ?) OR (a = ? AND b = ?) since not every database supports
+// (a, b) IN ((?, ?), (?, ?)).
+func WhereTuplesIn(names []string, tuples [][]interface{}) WhereQueryMod {
+	if len(tuples) == 0 {
+		return WhereQueryMod{Clause: "1=0"}
+	}
+
+	eq := make([]string, len(names))
+	for i, name := range names {
+		eq[i] = name + " = ?"
+	}
+	tuple := "(" + strings.Join(eq, " AND ") + ")"
+
+	clauses := make([]string, len(tuples))
+	args := make([]interface{}, 0, len(tuples)*len(names))
+	for i, t := range tuples {
+		clauses[i] = tuple
+		args = append(args, t...)
+	}
+
+	return WhereQueryMod{
+		Clause: strings.Join(clauses, " OR "),
+		Args:   args,
+	}
+}
@@ -0,0 +1,23 @@
+package qmhelper
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWhereTuplesIn(t *testing.T) {
+	t.Parallel()
+
+	mod := WhereTuplesIn([]string{"a", "b"}, [][]interface{}{{1, 2}, {3, 4}})
+	if want := "(a = ? AND b = ?) OR (a = ? AND b = ?)"; mod.Clause != want {
+		t.Errorf("want: %s, got: %s", want, mod.Clause)
+	}
+	if want := []interface{}{1, 2, 3, 4}; !reflect.DeepEqual(mod.Args, want) {
+		t.Errorf("want: %v, got: %v", want, mod.Args)
+	}
+
+	mod = WhereTuplesIn([]string{"a", "b"}, nil)
+	if mod.Clause != "1=0" || len(mod.Args) != 0 {
+		t.Errorf("no tuples should match nothing: %#v", mod)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
 )
@@ -31,9 +32,12 @@ type Query struct {
 
 	delete     bool
 	update     map[string]interface{}
+	insertInto string
+	insertCols []string
 	withs      []argClause
 	selectCols []string
 	count      bool
+	exists     bool
 	from       []string
 	joins      []join
 	where      []where
@@ -45,6 +49,12 @@ type Query struct {
 	forlock    string
 	distinct   string
 	comment    string
+	sqlComment map[string]string
+
+	partitionBy    string
+	partitionLimit int
+
+	returning []string
 }
 
 // Applicator exists only to allow
@@ -86,6 +96,10 @@ type argClause struct {
 type rawSQL struct {
 	sql  string
 	args []interface{}
+
+	// built is set when sql was built from the query's mods by BuildQuery
+	// rather than given, it's built again if the dialect changes.
+	built bool
 }
 
 type join struct {
@@ -111,7 +125,9 @@ func RawG(query string, args ...interface{}) *Query {
 
 // Exec executes a query that does not need a row returned
 func (q *Query) Exec(exec boil.Executor) (sql.Result, error) {
+	useExecDialect(q, exec)
 	qs, args := BuildQuery(q)
+	qs = boil.AppendSQLComment(boil.WithSQLComment(context.Background(), q.sqlComment), qs)
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
 		fmt.Fprintln(boil.DebugWriter, args)
@@ -121,7 +137,9 @@ func (q *Query) Exec(exec boil.Executor) (sql.Result, error) {
 
 // QueryRow executes the query for the One finisher and returns a row
 func (q *Query) QueryRow(exec boil.Executor) *sql.Row {
+	useExecDialect(q, exec)
 	qs, args := BuildQuery(q)
+	qs = boil.AppendSQLComment(boil.WithSQLComment(context.Background(), q.sqlComment), qs)
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
 		fmt.Fprintln(boil.DebugWriter, args)
@@ -131,7 +149,12 @@ func (q *Query) QueryRow(exec boil.Executor) *sql.Row {
 
 // Query executes the query for the All finisher and returns multiple rows
 func (q *Query) Query(exec boil.Executor) (*sql.Rows, error) {
+	useExecDialect(q, exec)
+	if err := checkOrder(q); err != nil {
+		return nil, err
+	}
 	qs, args := BuildQuery(q)
+	qs = boil.AppendSQLComment(boil.WithSQLComment(context.Background(), q.sqlComment), qs)
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
 		fmt.Fprintln(boil.DebugWriter, args)
@@ -141,35 +164,26 @@ func (q *Query) Query(exec boil.Executor) (*sql.Rows, error) {
 
 // ExecContext executes a query that does not need a row returned
 func (q *Query) ExecContext(ctx context.Context, exec boil.ContextExecutor) (sql.Result, error) {
+	useExecDialect(q, exec)
 	qs, args := BuildQuery(q)
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, qs)
-		fmt.Fprintln(writer, args)
-	}
-	return exec.ExecContext(ctx, qs, args...)
+	return boil.ExecContext(boil.WithSQLComment(ctx, q.sqlComment), exec, qs, args...)
 }
 
 // QueryRowContext executes the query for the One finisher and returns a row
 func (q *Query) QueryRowContext(ctx context.Context, exec boil.ContextExecutor) *sql.Row {
+	useExecDialect(q, exec)
 	qs, args := BuildQuery(q)
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, qs)
-		fmt.Fprintln(writer, args)
-	}
-	return exec.QueryRowContext(ctx, qs, args...)
+	return boil.QueryRowContext(boil.WithSQLComment(ctx, q.sqlComment), exec, qs, args...)
 }
 
 // QueryContext executes the query for the All finisher and returns multiple rows
 func (q *Query) QueryContext(ctx context.Context, exec boil.ContextExecutor) (*sql.Rows, error) {
-	qs, args := BuildQuery(q)
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, qs)
-		fmt.Fprintln(writer, args)
+	useExecDialect(q, exec)
+	if err := checkOrder(q); err != nil {
+		return nil, err
 	}
-	return exec.QueryContext(ctx, qs, args...)
+	qs, args := BuildQuery(q)
+	return boil.QueryContext(boil.WithSQLComment(ctx, q.sqlComment), exec, qs, args...)
 }
 
 // ExecP executes a query that does not need a row returned
@@ -251,16 +265,72 @@ func SetCount(q *Query) {
 	q.count = true
 }
 
+// SetExists on the query. The query is wrapped in SELECT EXISTS(...) so it
+// returns a single boolean instead of rows.
+func SetExists(q *Query) {
+	q.exists = true
+}
+
 // SetDelete on the query.
 func SetDelete(q *Query) {
 	q.delete = true
 }
 
+// SetReturning on the query. Deletes and updates get a RETURNING clause
+// with these columns so they return the rows they changed, it's up to the
+// caller to only use it with databases that support it.
+func SetReturning(q *Query, cols ...string) {
+	q.returning = cols
+}
+
+// InsertFrom returns a copy of q that inserts the rows q selects into the
+// columns of table: INSERT INTO table (columns) SELECT .... When q doesn't
+// select anything it selects the columns by name, otherwise it has to select
+// one value for each of the columns. SetReturning on the copy gets back the
+// inserted rows, as an OUTPUT clause when the dialect uses those.
+func InsertFrom(q *Query, table string, columns ...string) (*Query, error) {
+	if len(columns) == 0 {
+		return nil, errors.New("no columns to insert into")
+	}
+	if len(q.from) == 0 || q.delete || len(q.update) != 0 || q.count || q.exists {
+		return nil, errors.New("can only insert the rows of a select query")
+	}
+
+	ins := *q
+	ins.rawSQL = rawSQL{}
+	ins.load = nil
+	ins.loadMods = nil
+	ins.insertInto = table
+	ins.insertCols = columns
+
+	switch len(q.selectCols) {
+	case 0:
+		ins.selectCols = columns
+	case len(columns):
+	default:
+		return nil, errors.Errorf("query selects %d columns but %d are inserted into", len(q.selectCols), len(columns))
+	}
+
+	return &ins, nil
+}
+
 // SetLimit on the query.
 func SetLimit(q *Query, limit int) {
 	q.limit = limit
 }
 
+// SetPartitionBy sets the column that a per partition limit applies to.
+// Eager loading sets it to the column that refers to the parent.
+func SetPartitionBy(q *Query, column string) {
+	q.partitionBy = column
+}
+
+// SetPartitionLimit limits the number of rows returned for each value of
+// the partition column. It has no effect until a partition column is set.
+func SetPartitionLimit(q *Query, limit int) {
+	q.partitionLimit = limit
+}
+
 // SetOffset on the query.
 func SetOffset(q *Query, offset int) {
 	q.offset = offset
@@ -271,11 +341,29 @@ func SetFor(q *Query, clause string) {
 	q.forlock = clause
 }
 
+// GetFor from the query
+func GetFor(q *Query) string {
+	return q.forlock
+}
+
 // SetComment on the query.
 func SetComment(q *Query, comment string) {
 	q.comment = comment
 }
 
+// AppendSQLComment adds tags to the trailing comment of the query, see
+// boil.SQLCommentTags.
+func AppendSQLComment(q *Query, tags map[string]string) {
+	merged := make(map[string]string, len(q.sqlComment)+len(tags))
+	for k, v := range q.sqlComment {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	q.sqlComment = merged
+}
+
 // SetUpdate on the query.
 func SetUpdate(q *Query, cols map[string]interface{}) {
 	q.update = cols
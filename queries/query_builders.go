@@ -7,7 +7,17 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/volatiletech/strmangle"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// Aliases used by the queries that limit the rows per partition
+const (
+	partitionParentAlias    = "__boil_parent"
+	partitionKeyAlias       = "__boil_key"
+	partitionLateralAlias   = "__boil_lateral"
+	partitionRankedAlias    = "__boil_ranked"
+	partitionRowNumberAlias = "__boil_row_number"
 )
 
 var (
@@ -19,38 +29,98 @@ var (
 // BuildQuery builds a query object into the query string
 // and it's accompanying arguments. Using this method
 // allows query building without immediate execution.
+//
+// Statements are cached by the shape of the query, so building another query
+// from the same mods only has to collect its arguments. See SetSQLCacheSize.
 func BuildQuery(q *Query) (string, []interface{}) {
+	if len(q.rawSQL.sql) != 0 {
+		return q.rawSQL.sql, q.rawSQL.args
+	}
+
+	key, cacheable := newSQLCacheKey(q)
+	if cacheable {
+		if sql, ok := sqlCache.get(key); ok {
+			args := sqlCacheArgs(q)
+			q.rawSQL = rawSQL{sql: sql, args: args, built: true}
+			return sql, args
+		}
+	}
+
+	sql, args := buildQuery(q)
+
+	// Cache the generated query for query object re-use
+	q.rawSQL = rawSQL{sql: sql, args: args, built: true}
+
+	if cacheable {
+		sqlCache.put(key, sql)
+	}
+
+	return sql, args
+}
+
+// buildQuery builds the statement of a query that isn't raw.
+func buildQuery(q *Query) (string, []interface{}) {
 	var buf *bytes.Buffer
 	var args []interface{}
 
 	switch {
-	case len(q.rawSQL.sql) != 0:
-		return q.rawSQL.sql, q.rawSQL.args
 	case q.delete:
 		buf, args = buildDeleteQuery(q)
 	case len(q.update) > 0:
 		buf, args = buildUpdateQuery(q)
+	case len(q.insertInto) != 0:
+		buf, args = buildInsertQuery(q)
 	default:
 		buf, args = buildSelectQuery(q)
 	}
 
-	defer strmangle.PutBuffer(buf)
-
-	// Cache the generated query for query object re-use
-	bufStr := buf.String()
-	q.rawSQL.sql = bufStr
-	q.rawSQL.args = args
-
-	return bufStr, args
+	defer boil.PutBuffer(buf)
+	return buf.String(), args
 }
 
 func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
-	buf := strmangle.GetBuffer()
+	buf := boil.GetBuffer()
 	var args []interface{}
 
 	writeComment(q, buf)
 	writeCTEs(q, buf, &args)
 
+	if q.partitionLimit != 0 && len(q.partitionBy) != 0 {
+		if q.dialect.UseLateralJoin {
+			writeLateralPartition(q, buf, &args)
+		} else {
+			writeWindowPartition(q, buf, &args)
+		}
+
+		buf.WriteByte(';')
+		return buf, args
+	}
+
+	if q.exists {
+		if q.dialect.UseCaseWhenExistsClause {
+			buf.WriteString("SELECT CASE WHEN EXISTS(")
+		} else {
+			buf.WriteString("SELECT EXISTS(")
+		}
+	}
+
+	writeSelect(q, buf)
+	writeFrom(q, buf, &args, "")
+	writeModifiers(q, buf, &args)
+
+	if q.exists {
+		if q.dialect.UseCaseWhenExistsClause {
+			buf.WriteString(") THEN 1 ELSE 0 END")
+		} else {
+			buf.WriteByte(')')
+		}
+	}
+
+	buf.WriteByte(';')
+	return buf, args
+}
+
+func writeSelect(q *Query, buf *bytes.Buffer) {
 	buf.WriteString("SELECT ")
 
 	if q.dialect.UseTopClause {
@@ -66,7 +136,9 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	hasSelectCols := len(q.selectCols) != 0
 	hasJoins := len(q.joins) != 0
 	hasDistinct := q.distinct != ""
-	if hasDistinct {
+	if q.exists {
+		buf.WriteByte('1')
+	} else if hasDistinct {
 		buf.WriteString("DISTINCT ")
 		if q.count {
 			buf.WriteString("(")
@@ -80,7 +152,7 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 		// Don't identQuoteSlice - writeAsStatements does this
 		buf.WriteString(strings.Join(selectColsWithAs, ", "))
 	} else if hasSelectCols {
-		buf.WriteString(strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.selectCols), ", "))
+		buf.WriteString(strings.Join(q.dialect.QuoteIdentSlice(q.selectCols), ", "))
 	} else if hasJoins && !q.count {
 		selectColsWithStars := writeStars(q)
 		buf.WriteString(strings.Join(selectColsWithStars, ", "))
@@ -92,12 +164,16 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	if q.count {
 		buf.WriteByte(')')
 	}
+}
 
-	fmt.Fprintf(buf, " FROM %s", strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
+// writeFrom writes the from, join and where clauses. When cond isn't empty
+// it's added to the where clause with an AND.
+func writeFrom(q *Query, buf *bytes.Buffer, args *[]interface{}, cond string) {
+	fmt.Fprintf(buf, " FROM %s", strings.Join(q.dialect.QuoteIdentSlice(q.from), ", "))
 
 	if len(q.joins) > 0 {
-		argsLen := len(args)
-		joinBuf := strmangle.GetBuffer()
+		argsLen := len(*args)
+		joinBuf := boil.GetBuffer()
 		for _, j := range q.joins {
 			switch j.kind {
 			case JoinInner:
@@ -111,39 +187,98 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 			default:
 				panic(fmt.Sprintf("Unsupported join of kind %v", j.kind))
 			}
-			args = append(args, j.args...)
+			*args = append(*args, j.args...)
 		}
 		var resp string
 		if q.dialect.UseIndexPlaceholders {
-			resp, _ = convertQuestionMarks(joinBuf.String(), argsLen+1)
+			resp, _ = convertQuestionMarks(q.dialect, joinBuf.String(), argsLen+1)
 		} else {
 			resp = joinBuf.String()
 		}
 		fmt.Fprintf(buf, resp)
-		strmangle.PutBuffer(joinBuf)
+		boil.PutBuffer(joinBuf)
 	}
 
-	where, whereArgs := whereClause(q, len(args)+1)
-	buf.WriteString(where)
+	where, whereArgs := whereClause(q, len(*args)+1)
+	switch {
+	case len(cond) == 0:
+		buf.WriteString(where)
+	case len(where) == 0:
+		fmt.Fprintf(buf, " WHERE %s", cond)
+	default:
+		fmt.Fprintf(buf, " WHERE (%s) AND %s", strings.TrimPrefix(where, " WHERE "), cond)
+	}
 	if len(whereArgs) != 0 {
-		args = append(args, whereArgs...)
+		*args = append(*args, whereArgs...)
 	}
+}
 
-	writeModifiers(q, buf, &args)
+// writeLateralPartition limits the rows per value of the partition column by
+// running the query as a lateral subquery once for every value:
+//
+//	SELECT "l".* FROM (SELECT DISTINCT <column> AS "k" FROM ...) AS "p"
+//	CROSS JOIN LATERAL (SELECT ... AND <column> = "p"."k" ORDER BY ... LIMIT n) AS "l"
+func writeLateralPartition(q *Query, buf *bytes.Buffer, args *[]interface{}) {
+	parent := q.dialect.QuoteIdent(partitionParentAlias)
+	key := q.dialect.QuoteIdent(partitionKeyAlias)
+	lateral := q.dialect.QuoteIdent(partitionLateralAlias)
+
+	fmt.Fprintf(buf, "SELECT %s.* FROM (SELECT DISTINCT %s AS %s", lateral, q.partitionBy, key)
+	writeFrom(q, buf, args, "")
+	fmt.Fprintf(buf, ") AS %s CROSS JOIN LATERAL (", parent)
+
+	inner := *q
+	inner.limit = q.partitionLimit
+	inner.offset = 0
+	writeSelect(&inner, buf)
+	writeFrom(&inner, buf, args, fmt.Sprintf("%s = %s.%s", q.partitionBy, parent, key))
+	writeModifiers(&inner, buf, args)
+
+	fmt.Fprintf(buf, ") AS %s", lateral)
+}
 
-	buf.WriteByte(';')
-	return buf, args
+// writeWindowPartition limits the rows per value of the partition column by
+// numbering them with a window function and filtering on the number:
+//
+//	SELECT * FROM (SELECT ..., ROW_NUMBER() OVER (PARTITION BY <column> ORDER BY ...) AS "rn" FROM ...) AS "r"
+//	WHERE "rn" <= n ORDER BY "rn"
+//
+// The row number is returned as an extra column.
+func writeWindowPartition(q *Query, buf *bytes.Buffer, args *[]interface{}) {
+	rowNumber := q.dialect.QuoteIdent(partitionRowNumberAlias)
+
+	buf.WriteString("SELECT * FROM (")
+
+	inner := *q
+	inner.orderBy = nil
+	inner.limit = 0
+	inner.offset = 0
+	writeSelect(&inner, buf)
+
+	fmt.Fprintf(buf, ", ROW_NUMBER() OVER (PARTITION BY %s", q.partitionBy)
+	if len(q.orderBy) != 0 {
+		writeParameterizedModifiers(q, buf, args, " ORDER BY ", ", ", q.orderBy)
+	} else if q.dialect.UseTopClause {
+		buf.WriteString(" ORDER BY (SELECT NULL)")
+	}
+	fmt.Fprintf(buf, ") AS %s", rowNumber)
+
+	writeFrom(&inner, buf, args, "")
+	writeModifiers(&inner, buf, args)
+
+	fmt.Fprintf(buf, ") AS %s WHERE %s <= %d ORDER BY %s",
+		q.dialect.QuoteIdent(partitionRankedAlias), rowNumber, q.partitionLimit, rowNumber)
 }
 
 func buildDeleteQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	var args []interface{}
-	buf := strmangle.GetBuffer()
+	buf := boil.GetBuffer()
 
 	writeComment(q, buf)
 	writeCTEs(q, buf, &args)
 
 	buf.WriteString("DELETE FROM ")
-	buf.WriteString(strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
+	buf.WriteString(strings.Join(q.dialect.QuoteIdentSlice(q.from), ", "))
 
 	where, whereArgs := whereClause(q, 1)
 	if len(whereArgs) != 0 {
@@ -152,42 +287,65 @@ func buildDeleteQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	buf.WriteString(where)
 
 	writeModifiers(q, buf, &args)
+	writeReturning(q, buf)
 
 	buf.WriteByte(';')
 
 	return buf, args
 }
 
-func buildUpdateQuery(q *Query) (*bytes.Buffer, []interface{}) {
-	buf := strmangle.GetBuffer()
-	var args []interface{}
+func buildInsertQuery(q *Query) (*bytes.Buffer, []interface{}) {
+	buf := boil.GetBuffer()
 
 	writeComment(q, buf)
-	writeCTEs(q, buf, &args)
-
-	buf.WriteString("UPDATE ")
-	buf.WriteString(strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
 
-	cols := make(sort.StringSlice, len(q.update))
+	fmt.Fprintf(buf, "INSERT INTO %s (%s) ",
+		q.dialect.QuoteIdent(q.insertInto),
+		strings.Join(q.dialect.QuoteIdentSlice(q.insertCols), ", "),
+	)
 
-	count := 0
-	for name := range q.update {
-		cols[count] = name
-		count++
+	if len(q.returning) != 0 && q.dialect.UseOutputClause {
+		buf.WriteString("OUTPUT ")
+		for i, r := range q.returning {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString("INSERTED.")
+			buf.WriteString(q.dialect.QuoteIdent(r))
+		}
+		buf.WriteByte(' ')
 	}
 
-	cols.Sort()
+	sel := *q
+	sel.comment = ""
+	sel.insertInto = ""
+	sel.returning = nil
+	selBuf, args := buildSelectQuery(&sel)
+	buf.Write(bytes.TrimSuffix(selBuf.Bytes(), []byte{';'}))
+	boil.PutBuffer(selBuf)
 
-	for i := 0; i < len(cols); i++ {
-		args = append(args, q.update[cols[i]])
-		cols[i] = strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, cols[i])
+	if !q.dialect.UseOutputClause {
+		writeReturning(q, buf)
 	}
 
-	setSlice := make([]string, len(cols))
-	for index, col := range cols {
-		setSlice[index] = fmt.Sprintf("%s = %s", col, strmangle.Placeholders(q.dialect.UseIndexPlaceholders, 1, index+1, 1))
-	}
-	fmt.Fprintf(buf, " SET %s", strings.Join(setSlice, ", "))
+	buf.WriteByte(';')
+
+	return buf, args
+}
+
+func buildUpdateQuery(q *Query) (*bytes.Buffer, []interface{}) {
+	buf := boil.GetBuffer()
+	var args []interface{}
+
+	writeComment(q, buf)
+	writeCTEs(q, buf, &args)
+
+	buf.WriteString("UPDATE ")
+	buf.WriteString(strings.Join(q.dialect.QuoteIdentSlice(q.from), ", "))
+
+	set, setArgs := UpdateSet(q.dialect, q.update, 1)
+	args = append(args, setArgs...)
+	fmt.Fprintf(buf, " SET %s", set)
 
 	where, whereArgs := whereClause(q, len(args)+1)
 	if len(whereArgs) != 0 {
@@ -196,15 +354,70 @@ func buildUpdateQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	buf.WriteString(where)
 
 	writeModifiers(q, buf, &args)
+	writeReturning(q, buf)
 
 	buf.WriteByte(';')
 
 	return buf, args
 }
 
+// UpdateSet builds the assignments of an UPDATE's SET clause for cols,
+// sorted by column name and with placeholders numbered from startAt. A value
+// that's a boil.Expression is written as its SQL with its own arguments.
+func UpdateSet(dialect *drivers.Dialect, cols map[string]interface{}, startAt int) (string, []interface{}) {
+	names := make(sort.StringSlice, 0, len(cols))
+	for name := range cols {
+		names = append(names, name)
+	}
+	names.Sort()
+
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	args := make([]interface{}, 0, len(cols))
+	for i, name := range names {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(dialect.QuoteIdent(name))
+		buf.WriteString(" = ")
+
+		value := cols[name]
+		expr, ok := value.(boil.Expression)
+		if !ok {
+			dialect.WritePlaceholder(buf, startAt)
+			args = append(args, value)
+			startAt++
+			continue
+		}
+
+		clause := expr.SQL
+		if dialect.UseIndexPlaceholders {
+			var n int
+			clause, n = convertQuestionMarks(dialect, clause, startAt)
+			startAt += n
+		} else {
+			startAt += len(expr.Args)
+		}
+		buf.WriteString(clause)
+		args = append(args, expr.Args...)
+	}
+
+	return buf.String(), args
+}
+
+func writeReturning(q *Query, buf *bytes.Buffer) {
+	if len(q.returning) == 0 {
+		return
+	}
+
+	buf.WriteString(" RETURNING ")
+	buf.WriteString(strings.Join(q.dialect.QuoteIdentSlice(q.returning), ", "))
+}
+
 func writeParameterizedModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}, keyword, delim string, clauses []argClause) {
 	argsLen := len(*args)
-	modBuf := strmangle.GetBuffer()
+	modBuf := boil.GetBuffer()
 	fmt.Fprintf(modBuf, keyword)
 
 	for i, j := range clauses {
@@ -217,13 +430,13 @@ func writeParameterizedModifiers(q *Query, buf *bytes.Buffer, args *[]interface{
 
 	var resp string
 	if q.dialect.UseIndexPlaceholders {
-		resp, _ = convertQuestionMarks(modBuf.String(), argsLen+1)
+		resp, _ = convertQuestionMarks(q.dialect, modBuf.String(), argsLen+1)
 	} else {
 		resp = modBuf.String()
 	}
 
 	buf.WriteString(resp)
-	strmangle.PutBuffer(modBuf)
+	boil.PutBuffer(modBuf)
 }
 
 func writeModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}) {
@@ -286,7 +499,7 @@ func writeStars(q *Query) []string {
 	for i, f := range q.from {
 		toks := strings.Split(f, " ")
 		if len(toks) == 1 {
-			cols[i] = fmt.Sprintf(`%s.*`, strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, toks[0]))
+			cols[i] = fmt.Sprintf(`%s.*`, q.dialect.QuoteIdent(toks[0]))
 			continue
 		}
 
@@ -298,7 +511,7 @@ func writeStars(q *Query) []string {
 		if len(alias) != 0 {
 			name = alias
 		}
-		cols[i] = fmt.Sprintf(`%s.*`, strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, name))
+		cols[i] = fmt.Sprintf(`%s.*`, q.dialect.QuoteIdent(name))
 	}
 
 	return cols
@@ -307,23 +520,18 @@ func writeStars(q *Query) []string {
 func writeAsStatements(q *Query) []string {
 	cols := make([]string, len(q.selectCols))
 	for i, col := range q.selectCols {
-		if !rgxIdentifier.MatchString(col) {
+		unquoted := q.dialect.Unquote(col)
+		if !rgxIdentifier.MatchString(unquoted) {
 			cols[i] = col
 			continue
 		}
 
-		toks := strings.Split(col, ".")
-		if len(toks) == 1 {
-			cols[i] = strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, col)
+		if !strings.ContainsRune(unquoted, '.') {
+			cols[i] = q.dialect.QuoteIdent(col)
 			continue
 		}
 
-		asParts := make([]string, len(toks))
-		for j, tok := range toks {
-			asParts[j] = strings.Trim(tok, `"`)
-		}
-
-		cols[i] = fmt.Sprintf(`%s as "%s"`, strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, col), strings.Join(asParts, "."))
+		cols[i] = fmt.Sprintf(`%s as %c%s%c`, q.dialect.QuoteIdent(col), q.dialect.LQ, unquoted, q.dialect.RQ)
 	}
 
 	return cols
@@ -349,8 +557,8 @@ ManualParen:
 		}
 	}
 
-	buf := strmangle.GetBuffer()
-	defer strmangle.PutBuffer(buf)
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
 	var args []interface{}
 
 	notFirstExpression := false
@@ -372,7 +580,7 @@ ManualParen:
 				buf.WriteByte('(')
 			}
 			if q.dialect.UseIndexPlaceholders {
-				replaced, n := convertQuestionMarks(where.clause, startAt)
+				replaced, n := convertQuestionMarks(q.dialect, where.clause, startAt)
 				buf.WriteString(replaced)
 				startAt += n
 			} else {
@@ -416,7 +624,7 @@ ManualParen:
 			// column name side, however if this case is being hit then the regexp
 			// probably needs adjustment, or the user is passing in invalid clauses.
 			if matches == nil {
-				clause, count := convertInQuestionMarks(q.dialect.UseIndexPlaceholders, where.clause, startAt, 1, ln)
+				clause, count := convertInQuestionMarks(q.dialect, where.clause, startAt, 1, ln)
 				if !manualParens {
 					buf.WriteByte('(')
 				}
@@ -435,13 +643,13 @@ ManualParen:
 			// of the clause to determine how many columns they are using.
 			// This number determines the groupAt for the convert function.
 			cols := strings.Split(leftSide, ",")
-			cols = strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, cols)
+			cols = q.dialect.QuoteIdentSlice(cols)
 			groupAt := len(cols)
 
 			var leftClause string
 			var leftCount int
 			if q.dialect.UseIndexPlaceholders {
-				leftClause, leftCount = convertQuestionMarks(strings.Join(cols, ","), startAt)
+				leftClause, leftCount = convertQuestionMarks(q.dialect, strings.Join(cols, ","), startAt)
 			} else {
 				// Count the number of cols that are question marks, so we know
 				// how much to offset convertInQuestionMarks by
@@ -452,7 +660,7 @@ ManualParen:
 				}
 				leftClause = strings.Join(cols, ",")
 			}
-			rightClause, rightCount := convertInQuestionMarks(q.dialect.UseIndexPlaceholders, rightSide, startAt+leftCount, groupAt, ln-leftCount)
+			rightClause, rightCount := convertInQuestionMarks(q.dialect, rightSide, startAt+leftCount, groupAt, ln-leftCount)
 			if !manualParens {
 				buf.WriteByte('(')
 			}
@@ -481,13 +689,13 @@ ManualParen:
 // It uses groupAt to determine how many placeholders should be in each group,
 // for example, groupAt 2 would result in: (($1,$2),($3,$4))
 // and groupAt 1 would result in ($1,$2,$3,$4)
-func convertInQuestionMarks(UseIndexPlaceholders bool, clause string, startAt, groupAt, total int) (string, int) {
+func convertInQuestionMarks(dialect *drivers.Dialect, clause string, startAt, groupAt, total int) (string, int) {
 	if startAt == 0 || len(clause) == 0 {
 		panic("Not a valid start number.")
 	}
 
-	paramBuf := strmangle.GetBuffer()
-	defer strmangle.PutBuffer(paramBuf)
+	paramBuf := boil.GetBuffer()
+	defer boil.PutBuffer(paramBuf)
 
 	foundAt := -1
 	for i := 0; i < len(clause); i++ {
@@ -503,7 +711,7 @@ func convertInQuestionMarks(UseIndexPlaceholders bool, clause string, startAt, g
 
 	paramBuf.WriteString(clause[:foundAt])
 	paramBuf.WriteByte('(')
-	paramBuf.WriteString(strmangle.Placeholders(UseIndexPlaceholders, total, startAt, groupAt))
+	paramBuf.WriteString(dialect.Placeholders(total, startAt, groupAt))
 	paramBuf.WriteByte(')')
 	paramBuf.WriteString(clause[foundAt+1:])
 
@@ -512,16 +720,17 @@ func convertInQuestionMarks(UseIndexPlaceholders bool, clause string, startAt, g
 	return ret, total
 }
 
-// convertQuestionMarks converts each occurrence of ? with $<number>
-// where <number> is an incrementing digit starting at startAt.
+// convertQuestionMarks converts each occurrence of ? with the dialect's
+// numbered placeholder, eg. $<number> where <number> is an incrementing digit
+// starting at startAt.
 // If question-mark (?) is escaped using back-slash (\), it will be ignored.
-func convertQuestionMarks(clause string, startAt int) (string, int) {
+func convertQuestionMarks(dialect *drivers.Dialect, clause string, startAt int) (string, int) {
 	if startAt == 0 {
 		panic("Not a valid start number.")
 	}
 
-	paramBuf := strmangle.GetBuffer()
-	defer strmangle.PutBuffer(paramBuf)
+	paramBuf := boil.GetBuffer()
+	defer boil.PutBuffer(paramBuf)
 	paramIndex := 0
 	total := 0
 
@@ -540,12 +749,14 @@ func convertQuestionMarks(clause string, startAt int) (string, int) {
 
 		escapeIndex := strings.Index(clause, `\?`)
 		if escapeIndex != -1 && paramIndex > escapeIndex {
-			paramBuf.WriteString(clause[:escapeIndex] + "?")
+			paramBuf.WriteString(clause[:escapeIndex])
+			paramBuf.WriteByte('?')
 			paramIndex++
 			continue
 		}
 
-		paramBuf.WriteString(clause[:paramIndex] + fmt.Sprintf("$%d", startAt))
+		paramBuf.WriteString(clause[:paramIndex])
+		dialect.WritePlaceholder(paramBuf, startAt)
 		total++
 		startAt++
 		paramIndex++
@@ -609,7 +820,7 @@ func writeCTEs(q *Query, buf *bytes.Buffer, args *[]interface{}) {
 
 	buf.WriteString("WITH")
 	argsLen := len(*args)
-	withBuf := strmangle.GetBuffer()
+	withBuf := boil.GetBuffer()
 	lastPos := len(q.withs) - 1
 	for i, w := range q.withs {
 		fmt.Fprintf(withBuf, " %s", w.clause)
@@ -621,10 +832,10 @@ func writeCTEs(q *Query, buf *bytes.Buffer, args *[]interface{}) {
 	withBuf.WriteByte(' ')
 	var resp string
 	if q.dialect.UseIndexPlaceholders {
-		resp, _ = convertQuestionMarks(withBuf.String(), argsLen+1)
+		resp, _ = convertQuestionMarks(q.dialect, withBuf.String(), argsLen+1)
 	} else {
 		resp = withBuf.String()
 	}
 	fmt.Fprintf(buf, resp)
-	strmangle.PutBuffer(withBuf)
+	boil.PutBuffer(withBuf)
 }
@@ -0,0 +1,52 @@
+//go:build go1.18
+// +build go1.18
+
+package queries
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func FuzzConvertQuestionMarks(f *testing.F) {
+	for _, seed := range []string{"", "?", `\?`, "a=? and b=?", `a=\? and b=?`, `??`, `\\?`, `?\`} {
+		f.Add(seed, 1)
+	}
+
+	dialect := &drivers.Dialect{UseIndexPlaceholders: true}
+
+	f.Fuzz(func(t *testing.T, clause string, start int) {
+		if start <= 0 || start > 1<<20 {
+			t.Skip()
+		}
+
+		out, total := convertQuestionMarks(dialect, clause, start)
+		if want := strings.Count(clause, "?") - strings.Count(clause, `\?`); total != want {
+			t.Errorf("convertQuestionMarks(%q) replaced %d placeholders, want %d", clause, total, want)
+		}
+		if strings.Count(out, "?") != strings.Count(clause, `\?`) {
+			t.Errorf("convertQuestionMarks(%q) = %q left the wrong question marks", clause, out)
+		}
+	})
+}
+
+func FuzzConvertInQuestionMarks(f *testing.F) {
+	for _, seed := range []string{"?", "a IN ?", `a=\? and b IN ?`, `\?`, "(a,b) IN ?"} {
+		f.Add(seed, 1, 1, 3)
+	}
+
+	dialect := &drivers.Dialect{UseIndexPlaceholders: true}
+
+	f.Fuzz(func(t *testing.T, clause string, start, group, total int) {
+		if len(clause) == 0 || start <= 0 || group <= 0 || total < 0 || start > 1<<20 || group > 64 || total > 1024 {
+			t.Skip()
+		}
+
+		_, n := convertInQuestionMarks(dialect, clause, start, group, total)
+		if n != 0 && n != total {
+			t.Errorf("convertInQuestionMarks(%q) reported %d args, want 0 or %d", clause, n, total)
+		}
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
 )
 
@@ -124,6 +125,20 @@ func TestBuildQuery(t *testing.T) {
 		{&Query{from: []string{"t"}, distinct: "id", count: true}, nil},
 		{&Query{from: []string{"t"}, distinct: "id, t.*", joins: []join{{JoinInner, "dogs d on d.cat_id = t.id", nil}}}, nil},
 		{&Query{from: []string{"t"}, distinct: "id, t.*", count: true, joins: []join{{JoinInner, "dogs d on d.cat_id = t.id", nil}}}, nil},
+		{&Query{from: []string{"t"}, exists: true, where: []where{{clause: "a=?", args: []interface{}{1}}}}, []interface{}{1}},
+		{&Query{
+			from: []string{"t"},
+			update: map[string]interface{}{
+				"a": 1,
+				"b": boil.Expr("b + ?", 2),
+				"c": boil.Expr("CASE WHEN a > ? THEN ? ELSE c END", 3, 4),
+			},
+			where: []where{{clause: "id=?", args: []interface{}{5}}},
+		}, []interface{}{1, 2, 3, 4, 5}},
+		{&Query{from: []string{"t"}, delete: true, returning: []string{"*"}, where: []where{{clause: "a=?", args: []interface{}{1}}}}, []interface{}{1}},
+		{&Query{from: []string{"t"}, update: map[string]interface{}{"b": 2}, returning: []string{"id", "b"}, where: []where{{clause: "a=?", args: []interface{}{1}}}}, []interface{}{2, 1}},
+		{&Query{from: []string{"s"}, insertInto: "t", insertCols: []string{"a", "b"}, selectCols: []string{"a", "b"}, where: []where{{clause: "c=?", args: []interface{}{1}}}}, []interface{}{1}},
+		{&Query{from: []string{"s"}, insertInto: "t", insertCols: []string{"a"}, selectCols: []string{"x"}, returning: []string{"id"}, comment: "copy", limit: 5}, nil},
 	}
 
 	for i, test := range tests {
@@ -539,8 +554,9 @@ func TestConvertQuestionMarks(t *testing.T) {
 		{clause: `?\??\??\?`, start: 1, expect: `$1?$2?$3?`, count: 3},
 	}
 
+	dialect := &drivers.Dialect{UseIndexPlaceholders: true}
 	for i, test := range tests {
-		res, count := convertQuestionMarks(test.clause, test.start)
+		res, count := convertQuestionMarks(dialect, test.clause, test.start)
 		if res != test.expect {
 			t.Errorf("%d) Mismatch between expect and result:\n%s\n%s\n", i, test.expect, res)
 		}
@@ -548,6 +564,15 @@ func TestConvertQuestionMarks(t *testing.T) {
 			t.Errorf("%d) Expected count %d, got %d", i, test.count, count)
 		}
 	}
+
+	dialect.IndexPlaceholderPrefix = "@p"
+	res, count := convertQuestionMarks(dialect, `thing=? and stuff \? and happy=?`, 3)
+	if res != `thing=@p3 and stuff ? and happy=@p4` {
+		t.Errorf("Mismatch between expected and result: %s", res)
+	}
+	if count != 2 {
+		t.Errorf("Expected count 2, got %d", count)
+	}
 }
 
 func TestConvertInQuestionMarks(t *testing.T) {
@@ -576,8 +601,9 @@ func TestConvertInQuestionMarks(t *testing.T) {
 		{clause: `?\??\??\?`, start: 1, expect: `($1,$2,$3)?????`, total: 3, group: 1},
 	}
 
+	dialect := &drivers.Dialect{UseIndexPlaceholders: true}
 	for i, test := range tests {
-		res, count := convertInQuestionMarks(true, test.clause, test.start, test.group, test.total)
+		res, count := convertInQuestionMarks(dialect, test.clause, test.start, test.group, test.total)
 		if res != test.expect {
 			t.Errorf("%d) Mismatch between expect and result:\n%s\n%s\n", i, test.expect, res)
 		}
@@ -586,7 +612,7 @@ func TestConvertInQuestionMarks(t *testing.T) {
 		}
 	}
 
-	res, count := convertInQuestionMarks(false, "?", 1, 3, 9)
+	res, count := convertInQuestionMarks(&drivers.Dialect{}, "?", 1, 3, 9)
 	if res != "((?,?,?),(?,?,?),(?,?,?))" {
 		t.Errorf("Mismatch between expected and result: %s", res)
 	}
@@ -628,6 +654,33 @@ func TestWriteAsStatements(t *testing.T) {
 			t.Errorf(`%d) want: %s, got: %s`, i, expect[i], got)
 		}
 	}
+
+	query = Query{
+		selectCols: []string{
+			"a",
+			"a.fun",
+			"`b`.`fun`",
+			"`b`.fun",
+			"COUNT(a)",
+		},
+		dialect: &drivers.Dialect{LQ: '`', RQ: '`'},
+	}
+
+	expect = []string{
+		"`a`",
+		"`a`.`fun` as `a.fun`",
+		"`b`.`fun` as `b.fun`",
+		"`b`.`fun` as `b.fun`",
+		"COUNT(a)",
+	}
+
+	gots = writeAsStatements(&query)
+
+	for i, got := range gots {
+		if expect[i] != got {
+			t.Errorf(`%d) want: %s, got: %s`, i, expect[i], got)
+		}
+	}
 }
 
 func TestWriteComment(t *testing.T) {
@@ -662,3 +715,49 @@ func TestWriteComment(t *testing.T) {
 		t.Errorf(`bad two lines comment, got: %s`, got)
 	}
 }
+
+func TestBuildPartitionedQuery(t *testing.T) {
+	t.Parallel()
+
+	newQuery := func() *Query {
+		return &Query{
+			from:           []string{"comments"},
+			where:          []where{{kind: whereKindIn, clause: "comments.post_id in ?", args: []interface{}{1, 2}}},
+			orderBy:        []argClause{{clause: "created_at desc"}},
+			partitionBy:    `"comments"."post_id"`,
+			partitionLimit: 3,
+		}
+	}
+
+	tests := []struct {
+		dialect drivers.Dialect
+		want    string
+		args    []interface{}
+	}{
+		{
+			dialect: drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseLateralJoin: true},
+			want: `SELECT "__boil_lateral".* FROM (SELECT DISTINCT "comments"."post_id" AS "__boil_key" FROM "comments" WHERE ("comments"."post_id" IN ($1,$2))) AS "__boil_parent" ` +
+				`CROSS JOIN LATERAL (SELECT * FROM "comments" WHERE (("comments"."post_id" IN ($3,$4))) AND "comments"."post_id" = "__boil_parent"."__boil_key" ORDER BY created_at desc LIMIT 3) AS "__boil_lateral";`,
+			args: []interface{}{1, 2, 1, 2},
+		},
+		{
+			dialect: drivers.Dialect{LQ: '`', RQ: '`'},
+			want: "SELECT * FROM (SELECT *, ROW_NUMBER() OVER (PARTITION BY \"comments\".\"post_id\" ORDER BY created_at desc) AS `__boil_row_number` FROM `comments` WHERE (`comments`.`post_id` IN (?,?))) AS `__boil_ranked` " +
+				"WHERE `__boil_row_number` <= 3 ORDER BY `__boil_row_number`;",
+			args: []interface{}{1, 2},
+		},
+	}
+
+	for i, test := range tests {
+		q := newQuery()
+		q.dialect = &test.dialect
+
+		out, args := BuildQuery(q)
+		if out != test.want {
+			t.Errorf("%d) wrong query:\nwant: %s\ngot:  %s", i, test.want, out)
+		}
+		if !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%d) wrong args:\nwant: %v\ngot:  %v", i, test.args, args)
+		}
+	}
+}
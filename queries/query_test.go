@@ -1,8 +1,13 @@
 package queries
 
 import (
+	"context"
 	"reflect"
 	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
 )
 
 func TestSetLimit(t *testing.T) {
@@ -91,7 +96,7 @@ func TestAppendWhere(t *testing.T) {
 		t.Errorf("Expected %s, got %#v", expect, q.where)
 	}
 
-	if len(q.where[0].args) != 2 || len(q.where[0].args) != 2 {
+	if len(q.where[0].args) != 2 || len(q.where[1].args) != 2 {
 		t.Errorf("arg length wrong: %#v", q.where)
 	}
 
@@ -166,7 +171,7 @@ func TestAppendIn(t *testing.T) {
 		t.Errorf("Expected %s, got %#v", expect, q.where)
 	}
 
-	if len(q.where[0].args) != 2 || len(q.where[0].args) != 2 {
+	if len(q.where[0].args) != 2 || len(q.where[1].args) != 2 {
 		t.Errorf("arg length wrong: %#v", q.where)
 	}
 
@@ -366,6 +371,47 @@ func TestSetDelete(t *testing.T) {
 	}
 }
 
+func TestInsertFrom(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, IndexPlaceholderPrefix: "@p", UseOutputClause: true}
+	q := &Query{dialect: dialect, from: []string{"s"}, load: []string{"Rel"}}
+	AppendWhere(q, "c=?", 1)
+
+	ins, err := InsertFrom(q, "t", "a", "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetReturning(ins, "*")
+
+	if len(q.selectCols) != 0 || len(q.insertInto) != 0 {
+		t.Error("original query was modified")
+	}
+	if len(ins.load) != 0 {
+		t.Error("loads should be cleared")
+	}
+
+	sql, args := BuildQuery(ins)
+	expect := "INSERT INTO [t] ([a], [b]) OUTPUT INSERTED.* SELECT [a], [b] FROM [s] WHERE (c=@p1);"
+	if sql != expect {
+		t.Errorf("want:\n%s\ngot:\n%s", expect, sql)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1}) {
+		t.Error("args wrong:", args)
+	}
+
+	SetSelect(q, []string{"x"})
+	if _, err = InsertFrom(q, "t", "a", "b"); err == nil {
+		t.Error("expected an error for misaligned columns")
+	}
+	if _, err = InsertFrom(q, "t"); err == nil {
+		t.Error("expected an error for no columns")
+	}
+	if _, err = InsertFrom(Raw("select 1"), "t", "a"); err == nil {
+		t.Error("expected an error for a raw query")
+	}
+}
+
 func TestSetArgs(t *testing.T) {
 	t.Parallel()
 
@@ -651,3 +697,26 @@ func TestSetComment(t *testing.T) {
 		t.Errorf("Got invalid comment: %s", q.comment)
 	}
 }
+
+func TestAppendSQLComment(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("DELETE FROM t /*route='jobs',trace='abc'*/;").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	q := Raw("DELETE FROM t;")
+	AppendSQLComment(q, map[string]string{"route": "jobs"})
+
+	ctx := boil.WithSQLComment(context.Background(), map[string]string{"route": "api", "trace": "abc"})
+	if _, err := q.ExecContext(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
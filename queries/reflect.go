@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -14,7 +15,6 @@ import (
 
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"github.com/volatiletech/strmangle"
 )
 
 // Identifies what kind of object we're binding to
@@ -109,7 +109,19 @@ func Bind(rows *sql.Rows, obj interface{}) error {
 		return err
 	}
 
-	return bind(rows, obj, structType, sliceType, singular)
+	return bind(rows, obj, structType, sliceType, singular, false)
+}
+
+// BindStrict is like Bind but fails if any of the columns returned has no
+// matching struct field, or if any of the struct fields is not populated by
+// one of the columns. The error lists the offending columns and fields.
+func BindStrict(rows *sql.Rows, obj interface{}) error {
+	structType, sliceType, singular, err := bindChecks(obj)
+	if err != nil {
+		return err
+	}
+
+	return bind(rows, obj, structType, sliceType, singular, true)
 }
 
 // Bind executes the query and inserts the
@@ -119,6 +131,7 @@ func Bind(rows *sql.Rows, obj interface{}) error {
 // Executor to a ContextExecutor and query with the passed context.
 // If Context is non-nil, any eager loading that's done must also
 // be using load* methods that support context as the first parameter.
+// A context from boil.WithStrictBind binds as BindStrict does.
 //
 // Also see documentation for Bind()
 func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) error {
@@ -127,7 +140,29 @@ func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) e
 		return err
 	}
 
+	// A single struct is never split, binding appends to slices so the
+	// chunks of a split query land one after the other.
+	split := []*Query{q}
+	if bkind != kindStruct {
+		split = SplitIn(q)
+	}
+	for _, chunk := range split {
+		if err = chunk.bindRows(ctx, exec, obj, structType, sliceType, bkind); err != nil {
+			return err
+		}
+	}
+
+	if len(q.load) != 0 {
+		return eagerLoad(ctx, exec, q.load, q.loadMods, obj, bkind)
+	}
+
+	return nil
+}
+
+// bindRows executes the query and binds the rows it returns into obj.
+func (q *Query) bindRows(ctx context.Context, exec boil.Executor, obj interface{}, structType, sliceType reflect.Type, bkind bindKind) error {
 	var rows *sql.Rows
+	var err error
 	if ctx != nil {
 		rows, err = q.QueryContext(ctx, exec.(boil.ContextExecutor))
 	} else {
@@ -136,7 +171,8 @@ func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) e
 	if err != nil {
 		return errors.Wrap(err, "bind failed to execute query")
 	}
-	if err = bind(rows, obj, structType, sliceType, bkind); err != nil {
+	strict := ctx != nil && boil.IsStrictBind(ctx)
+	if err = bind(rows, obj, structType, sliceType, bkind, strict); err != nil {
 		if innerErr := rows.Close(); innerErr != nil {
 			return errors.Wrapf(err, "error on rows.Close after bind error: %+v", innerErr)
 		}
@@ -150,10 +186,6 @@ func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) e
 		return errors.Wrap(err, "error from rows in bind")
 	}
 
-	if len(q.load) != 0 {
-		return eagerLoad(ctx, exec, q.load, q.loadMods, obj, bkind)
-	}
-
 	return nil
 }
 
@@ -212,7 +244,7 @@ func bindChecks(obj interface{}) (structType reflect.Type, sliceType reflect.Typ
 	}
 }
 
-func bind(rows *sql.Rows, obj interface{}, structType, sliceType reflect.Type, bkind bindKind) error {
+func bind(rows *sql.Rows, obj interface{}, structType, sliceType reflect.Type, bkind bindKind, strict bool) error {
 	cols, err := rows.Columns()
 	if err != nil {
 		return errors.Wrap(err, "bind failed to get column names")
@@ -224,11 +256,18 @@ func bind(rows *sql.Rows, obj interface{}, structType, sliceType reflect.Type, b
 		ptrSlice = reflect.Indirect(reflect.ValueOf(obj))
 	}
 
-	mapping, err := getMappingCache(structType).mapping(cols)
+	cache := getMappingCache(structType)
+	mapping, err := cache.mapping(cols)
 	if err != nil {
 		return err
 	}
 
+	if strict {
+		if err := cache.checkStrict(cols, mapping); err != nil {
+			return err
+		}
+	}
+
 	var oneStruct reflect.Value
 	if bkind == kindSliceStruct {
 		oneStruct = reflect.Indirect(reflect.New(structType))
@@ -442,8 +481,8 @@ func newMappingCache(typ reflect.Type) *mappingCache {
 }
 
 func (b *mappingCache) mapping(cols []string) ([]uint64, error) {
-	buf := strmangle.GetBuffer()
-	defer strmangle.PutBuffer(buf)
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
 
 	for _, s := range cols {
 		buf.WriteString(s)
@@ -470,6 +509,35 @@ func (b *mappingCache) mapping(cols []string) ([]uint64, error) {
 	return mapping, nil
 }
 
+// checkStrict returns an error listing the columns that have no struct field
+// to bind to and the struct fields that none of the columns bind to.
+func (b *mappingCache) checkStrict(cols []string, mapping []uint64) error {
+	var unmapped []string
+	bound := make(map[uint64]struct{}, len(mapping))
+	for i, m := range mapping {
+		if m == 0 {
+			unmapped = append(unmapped, cols[i])
+			continue
+		}
+		bound[m] = struct{}{}
+	}
+
+	var unpopulated []string
+	for name, m := range b.structMap {
+		if _, ok := bound[m]; !ok {
+			unpopulated = append(unpopulated, name)
+		}
+	}
+
+	if len(unmapped) == 0 && len(unpopulated) == 0 {
+		return nil
+	}
+
+	sort.Strings(unpopulated)
+	return errors.Errorf("strict bind failed for %s, columns without a field: %q, fields not populated: %q",
+		b.typ.String(), unmapped, unpopulated)
+}
+
 // Equal is different to reflect.DeepEqual in that it's both less efficient
 // less magical, and dosen't concern itself with a wide variety of types that could
 // be present but it does use the driver.Valuer interface since many types that will
@@ -478,9 +546,10 @@ func (b *mappingCache) mapping(cols []string) ([]uint64, error) {
 // We're focused on basic types + []byte. Since we're really only interested in things
 // that are typically used for primary keys in a database.
 //
-// Choosing not to use the DefaultParameterConverter here because sqlboiler doesn't generate
-// pointer columns.
+// Pointers, which are generated for nullable columns when the nullable
+// pointers option is used, are compared by the values they point to.
 func Equal(a, b interface{}) bool {
+	a, b = derefPtr(a), derefPtr(b)
 	if (a == nil && b != nil) || (a != nil && b == nil) {
 		return false
 	}
@@ -536,6 +605,12 @@ func Equal(a, b interface{}) bool {
 // Assign assigns a value to another using reflection.
 // Dst must be a pointer.
 func Assign(dst, src interface{}) {
+	srcIsPtr := src != nil && reflect.TypeOf(src).Kind() == reflect.Ptr
+	src = derefPtr(src)
+	if assignPtr(dst, src) {
+		return
+	}
+
 	// Fast path for []byte since it's one of the
 	// most frequent other "ids" we'll be assigning.
 	if db, ok := dst.(*[]byte); ok {
@@ -577,6 +652,10 @@ func Assign(dst, src interface{}) {
 
 		assignValue(dst, val)
 
+	case srcIsPtr:
+		// A pointer column's value being assigned to a primitive one
+		assignValue(dst, upgradeNumericTypes(src))
+
 	default:
 		// We should always be comparing primitives with each other with == in templates
 		// so this method should never be called for say: string, string, or int, int
@@ -647,9 +726,17 @@ func assignValue(dst interface{}, val driver.Value) {
 	}
 }
 
-// MustTime retrieves a time value from a valuer.
-func MustTime(val driver.Valuer) time.Time {
-	v, err := val.Value()
+// MustTime retrieves a time value from a valuer or a *time.Time.
+func MustTime(val interface{}) time.Time {
+	if t, ok := val.(*time.Time); ok {
+		if t == nil {
+			return time.Time{}
+		}
+		return *t
+	}
+
+	valuer := val.(driver.Valuer)
+	v, err := valuer.Value()
 	if err != nil {
 		panic(fmt.Sprintf("attempted to call value on %T to get time but got an error: %+v", val, err))
 	}
@@ -686,13 +773,84 @@ func IsNil(val interface{}) bool {
 	return reflect.ValueOf(val).IsNil()
 }
 
-// SetScanner attempts to set a scannable value on a scanner.
-func SetScanner(scanner sql.Scanner, val driver.Value) {
-	if err := scanner.Scan(val); err != nil {
+// SetScanner attempts to set a scannable value on a scanner. The scanner may
+// also be a pointer to a pointer field, which is set to nil or to a new
+// pointer to val.
+func SetScanner(scanner interface{}, val driver.Value) {
+	if assignPtr(scanner, val) {
+		return
+	}
+
+	if err := scanner.(sql.Scanner).Scan(val); err != nil {
 		panic(fmt.Sprintf("attempted to call Scan on %T with %#v but got an error: %+v", scanner, val, err))
 	}
 }
 
+// derefPtr returns what a non-nil pointer points to, or nil for a nil
+// pointer. Anything that isn't a pointer is returned unchanged. Valuers
+// are left alone since they know how to produce their own value.
+func derefPtr(val interface{}) interface{} {
+	if val == nil {
+		return nil
+	}
+	if _, ok := val.(driver.Valuer); ok {
+		return val
+	}
+
+	v := reflect.ValueOf(val)
+	if v.Kind() != reflect.Ptr {
+		return val
+	}
+	if v.IsNil() {
+		return nil
+	}
+
+	return v.Elem().Interface()
+}
+
+// assignPtr handles dst being a pointer to a pointer field, which is set to
+// nil when val is nil and to a pointer to a copy of val otherwise. It returns
+// false if dst is not a pointer to a pointer.
+func assignPtr(dst interface{}, val interface{}) bool {
+	dstVal := reflect.ValueOf(dst)
+	if dstVal.Kind() != reflect.Ptr || dstVal.Elem().Kind() != reflect.Ptr {
+		return false
+	}
+
+	field := dstVal.Elem()
+	if val == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return true
+	}
+
+	elemType := field.Type().Elem()
+	newVal := reflect.New(elemType)
+	if valuer, ok := val.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			panic(fmt.Sprintf("tried to call value on %T but got err: %+v", val, err))
+		}
+		if v == nil {
+			field.Set(reflect.Zero(field.Type()))
+			return true
+		}
+		val = v
+	}
+
+	if scanner, ok := newVal.Interface().(sql.Scanner); ok {
+		if err := scanner.Scan(val); err != nil {
+			panic(fmt.Sprintf("tried to call Scan on %T with %#v but got err: %+v", scanner, val, err))
+		}
+	} else if src := reflect.ValueOf(val); src.Type().ConvertibleTo(elemType) {
+		newVal.Elem().Set(src.Convert(elemType))
+	} else {
+		assignValue(newVal.Interface(), val)
+	}
+	field.Set(newVal)
+
+	return true
+}
+
 // These are sorted by size so that the biggest thing
 // gets replaced first (think guid/id). This list is copied
 // from strmangle.uppercaseWords and should hopefully be kept
@@ -737,7 +895,7 @@ func unTitleCase(n string) string {
 	// Make our words no longer special case
 	n = specialWordReplacer.Replace(n)
 
-	buf := strmangle.GetBuffer()
+	buf := boil.GetBuffer()
 
 	first := true
 
@@ -777,6 +935,6 @@ func unTitleCase(n string) string {
 	}
 
 	ret := buf.String()
-	strmangle.PutBuffer(buf)
+	boil.PutBuffer(buf)
 	return ret
 }
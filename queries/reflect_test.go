@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -477,6 +478,54 @@ func TestBindSingular(t *testing.T) {
 	}
 }
 
+func TestBindStrict(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		ID   int
+		Name string `boil:"test"`
+		Age  int
+	}
+
+	query := &Query{
+		from:    []string{"fun"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Error(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id", "test", "age"})
+	ret.AddRow(driver.Value(int64(35)), driver.Value("pat"), driver.Value(int64(20)))
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+
+	ret = sqlmock.NewRows([]string{"id", "tset"})
+	ret.AddRow(driver.Value(int64(35)), driver.Value("pat"))
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+
+	ctx := boil.WithStrictBind(context.Background())
+
+	var ok testStruct
+	if err = query.Bind(ctx, db, &ok); err != nil {
+		t.Error(err)
+	}
+
+	var bad testStruct
+	err = query.Bind(ctx, db, &bad)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if want := `columns without a field: ["tset"], fields not populated: ["age" "test"]`; !strings.Contains(err.Error(), want) {
+		t.Errorf("error should contain %s, got: %v", want, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestBind_InnerJoin(t *testing.T) {
 	t.Parallel()
 
@@ -607,6 +656,10 @@ func TestEqual(t *testing.T) {
 		{A: "hello", B: sql.NullString{Valid: false}, Want: false},
 		{A: now, B: now, Want: true},
 		{A: now, B: now.Add(time.Hour), Want: false},
+		{A: int(5), B: intPtr(5), Want: true},
+		{A: intPtr(5), B: intPtr(6), Want: false},
+		{A: int(5), B: (*int)(nil), Want: false},
+		{A: &now, B: sql.NullTime{Time: now, Valid: true}, Want: true},
 	}
 
 	for i, test := range tests {
@@ -741,6 +794,43 @@ func TestAssignPanic(t *testing.T) {
 	Assign(&aint, bint)
 }
 
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestAssignPtr(t *testing.T) {
+	t.Parallel()
+
+	var ptr *int
+	Assign(&ptr, 5)
+	if ptr == nil || *ptr != 5 {
+		t.Errorf("assignment did not occur: %v", ptr)
+	}
+
+	Assign(&ptr, sql.NullInt64{})
+	if ptr != nil {
+		t.Error("should have been set to nil")
+	}
+
+	Assign(&ptr, sql.NullInt64{Int64: 6, Valid: true})
+	if ptr == nil || *ptr != 6 {
+		t.Errorf("assignment did not occur: %v", ptr)
+	}
+
+	var i int
+	Assign(&i, intPtr(7))
+	if i != 7 {
+		t.Errorf("assignment did not occur: %d", i)
+	}
+
+	var ns sql.NullString
+	str := "hello"
+	Assign(&ns, &str)
+	if !ns.Valid || ns.String != "hello" {
+		t.Error("assignment did not occur")
+	}
+}
+
 type nullTime struct {
 	Time  time.Time
 	Valid bool
@@ -788,6 +878,20 @@ func TestMustTime(t *testing.T) {
 	}
 }
 
+func TestMustTimePtr(t *testing.T) {
+	t.Parallel()
+
+	var nt *time.Time
+	if !MustTime(nt).IsZero() {
+		t.Error("should be zero")
+	}
+
+	now := time.Now()
+	if !MustTime(&now).Equal(now) {
+		t.Error("time was wrong")
+	}
+}
+
 func TestMustTimePanic(t *testing.T) {
 	t.Parallel()
 
@@ -831,6 +935,22 @@ func TestSetScanner(t *testing.T) {
 	}
 }
 
+func TestSetScannerPtr(t *testing.T) {
+	t.Parallel()
+
+	var ptr *time.Time
+	now := time.Now()
+	SetScanner(&ptr, now)
+	if ptr == nil || !ptr.Equal(now) {
+		t.Errorf("it's value should have been %v", now)
+	}
+
+	SetScanner(&ptr, nil)
+	if ptr != nil {
+		t.Error("it should be nil")
+	}
+}
+
 func TestSetScannerPanic(t *testing.T) {
 	t.Parallel()
 
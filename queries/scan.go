@@ -0,0 +1,129 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"sort"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/strmangle"
+)
+
+// ScanFunc scans the current row of rows, which has the columns cols.
+type ScanFunc func(rows *sql.Rows, cols []string) error
+
+// BindScan executes the query like Bind, but instead of binding the rows to
+// obj with reflection it calls scan for every row, for callers that know
+// where each column goes like the generated models. When obj is a pointer to
+// a struct scan is only called for the first row, and sql.ErrNoRows is
+// returned if there isn't one. obj is what Bind would bind to and is used for
+// eager loading after scanning. columns are the ones obj has fields for, a
+// context from boil.WithStrictBind checks the query's columns against them.
+func (q *Query) BindScan(ctx context.Context, exec boil.Executor, obj interface{}, columns []string, scan ScanFunc) error {
+	structType, _, bkind, err := bindChecks(obj)
+	if err != nil {
+		return err
+	}
+
+	split := []*Query{q}
+	if bkind != kindStruct {
+		split = SplitIn(q)
+	}
+	for _, chunk := range split {
+		if err = chunk.scanRows(ctx, exec, structType, columns, bkind == kindStruct, scan); err != nil {
+			return err
+		}
+	}
+
+	if len(q.load) != 0 {
+		return eagerLoad(ctx, exec, q.load, q.loadMods, obj, bkind)
+	}
+
+	return nil
+}
+
+// scanRows executes the query and calls scan for the rows it returns, only
+// for the first one if one is set.
+func (q *Query) scanRows(ctx context.Context, exec boil.Executor, structType reflect.Type, columns []string, one bool, scan ScanFunc) error {
+	var rows *sql.Rows
+	var err error
+	if ctx != nil {
+		rows, err = q.QueryContext(ctx, exec.(boil.ContextExecutor))
+	} else {
+		rows, err = q.Query(exec)
+	}
+	if err != nil {
+		return errors.Wrap(err, "bind failed to execute query")
+	}
+
+	foundOne := false
+	err = func() error {
+		cols, err := rows.Columns()
+		if err != nil {
+			return errors.Wrap(err, "bind failed to get column names")
+		}
+
+		if ctx != nil && boil.IsStrictBind(ctx) {
+			if err := checkStrictColumns(structType, cols, columns); err != nil {
+				return err
+			}
+		}
+
+		for rows.Next() {
+			foundOne = true
+			if err := scan(rows, cols); err != nil {
+				return errors.Wrap(err, "failed to bind pointers to obj")
+			}
+			if one {
+				break
+			}
+		}
+
+		return nil
+	}()
+	if err != nil {
+		if innerErr := rows.Close(); innerErr != nil {
+			return errors.Wrapf(err, "error on rows.Close after bind error: %+v", innerErr)
+		}
+
+		return err
+	}
+	if err = rows.Close(); err != nil {
+		return errors.Wrap(err, "failed to clean up rows in bind")
+	}
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "error from rows in bind")
+	}
+
+	if one && !foundOne {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// checkStrictColumns is checkStrict for BindScan, cols are the query's
+// columns and columns the ones typ has fields for.
+func checkStrictColumns(typ reflect.Type, cols, columns []string) error {
+	var unmapped, unpopulated []string
+	for _, c := range cols {
+		if !strmangle.SetInclude(c, columns) {
+			unmapped = append(unmapped, c)
+		}
+	}
+	for _, c := range columns {
+		if !strmangle.SetInclude(c, cols) {
+			unpopulated = append(unpopulated, c)
+		}
+	}
+
+	if len(unmapped) == 0 && len(unpopulated) == 0 {
+		return nil
+	}
+
+	sort.Strings(unpopulated)
+	return errors.Errorf("strict bind failed for %s, columns without a field: %q, fields not populated: %q",
+		typ.String(), unmapped, unpopulated)
+}
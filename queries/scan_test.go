@@ -0,0 +1,153 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+type scanTestStruct struct {
+	ID   int
+	Name string
+}
+
+func scanTestRow(rows *sql.Rows, cols []string, o *scanTestStruct) error {
+	dest := make([]interface{}, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &o.ID
+		case "name":
+			dest[i] = &o.Name
+		default:
+			dest[i] = new(interface{})
+		}
+	}
+
+	return rows.Scan(dest...)
+}
+
+func TestBindScanSlice(t *testing.T) {
+	t.Parallel()
+
+	query := &Query{
+		from:    []string{"fun"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"name", "extra", "id"})
+	ret.AddRow(driver.Value("pat"), driver.Value("ignored"), driver.Value(int64(35)))
+	ret.AddRow(driver.Value("cat"), driver.Value("ignored"), driver.Value(int64(12)))
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+
+	var results []*scanTestStruct
+	err = query.BindScan(nil, db, &results, []string{"id", "name"}, func(rows *sql.Rows, cols []string) error {
+		o := &scanTestStruct{}
+		if err := scanTestRow(rows, cols, o); err != nil {
+			return err
+		}
+		results = append(results, o)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 2 {
+		t.Fatal("wrong number of results:", len(results))
+	}
+	if *results[0] != (scanTestStruct{ID: 35, Name: "pat"}) || *results[1] != (scanTestStruct{ID: 12, Name: "cat"}) {
+		t.Errorf("wrong results: %v, %v", results[0], results[1])
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBindScanStruct(t *testing.T) {
+	t.Parallel()
+
+	query := &Query{
+		from:    []string{"fun"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id", "name"})
+	ret.AddRow(driver.Value(int64(35)), driver.Value("pat"))
+	ret.AddRow(driver.Value(int64(12)), driver.Value("cat"))
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+	calls := 0
+	var result scanTestStruct
+	scan := func(rows *sql.Rows, cols []string) error {
+		calls++
+		return scanTestRow(rows, cols, &result)
+	}
+
+	if err = query.BindScan(nil, db, &result, []string{"id", "name"}, scan); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 || result != (scanTestStruct{ID: 35, Name: "pat"}) {
+		t.Errorf("want only the first row scanned, got %d calls and %v", calls, result)
+	}
+
+	if err = query.BindScan(nil, db, &result, []string{"id", "name"}, scan); err != sql.ErrNoRows {
+		t.Error("want sql.ErrNoRows without rows, got:", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBindScanStrict(t *testing.T) {
+	t.Parallel()
+
+	query := &Query{
+		from:    []string{"fun"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id", "nmae"})
+	ret.AddRow(driver.Value(int64(35)), driver.Value("pat"))
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+
+	var result scanTestStruct
+	err = query.BindScan(boil.WithStrictBind(context.Background()), db, &result, []string{"id", "name"}, func(rows *sql.Rows, cols []string) error {
+		return scanTestRow(rows, cols, &result)
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if want := `columns without a field: ["nmae"], fields not populated: ["name"]`; !strings.Contains(err.Error(), want) {
+		t.Errorf("error should contain %s, got: %v", want, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
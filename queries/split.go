@@ -0,0 +1,125 @@
+package queries
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// rgxSplitNot matches the left side of a negated IN, rows of a NOT IN can't
+// be found one chunk at a time
+var rgxSplitNot = regexp.MustCompile(`(?i)(^|[\s)])not$`)
+
+// SplitIn breaks a select query whose arguments don't fit in a single
+// statement (see drivers.Dialect.MaxParameters) into several queries that
+// each take a chunk of its largest WHERE IN list. Running all of them and
+// concatenating the rows gives the same result as the original query would.
+//
+// Only queries where that holds are split: the IN must be one of the ANDed
+// conditions and not negated, and the query can't have a limit, offset, group
+// by, having, distinct, selected expressions such as aggregates or be a count
+// or an insert. An order by is only kept per chunk, so it's allowed only when
+// the query is partitioned per parent. Anything else is returned as the
+// single element of the slice, unchanged.
+func SplitIn(q *Query) []*Query {
+	if q.dialect == nil || q.dialect.MaxParameters <= 0 {
+		return []*Query{q}
+	}
+	if len(q.rawSQL.sql) != 0 || q.delete || len(q.update) != 0 || len(q.insertInto) != 0 ||
+		q.count || q.exists || len(q.distinct) != 0 ||
+		q.limit != 0 || q.offset != 0 || len(q.groupBy) != 0 || len(q.having) != 0 ||
+		(len(q.orderBy) != 0 && len(q.partitionBy) == 0) {
+		return []*Query{q}
+	}
+	// Aggregates and other expressions are computed per chunk, so only plain
+	// columns like the eager loaders select can be concatenated
+	for _, col := range q.selectCols {
+		if strings.ContainsAny(col, "()") {
+			return []*Query{q}
+		}
+	}
+
+	// BuildQuery caches what it builds on the query, so measure a copy
+	probe := *q
+	_, args := BuildQuery(&probe)
+	if len(args) <= q.dialect.MaxParameters {
+		return []*Query{q}
+	}
+
+	index := -1
+	for i, w := range q.where {
+		if w.orSeparator {
+			return []*Query{q}
+		}
+		if w.kind == whereKindIn && (index < 0 || len(w.args) > len(q.where[index].args)) {
+			index = i
+		}
+	}
+	if index < 0 || len(q.where[index].args) == 0 {
+		return []*Query{q}
+	}
+
+	in := q.where[index]
+	groupAt := 1
+	if matches := rgxInClause.FindStringSubmatch(in.clause); matches != nil {
+		leftSide := strings.TrimSpace(matches[1])
+		if strings.Contains(leftSide, "?") || rgxSplitNot.MatchString(leftSide) {
+			return []*Query{q}
+		}
+		groupAt = len(strings.Split(leftSide, ","))
+	}
+
+	// The IN arguments can show up more than once in the built query, eg.
+	// a lateral join repeats the where clause, so measure what's left over
+	// without them instead of assuming.
+	without := *q
+	without.where = make([]where, len(q.where))
+	copy(without.where, q.where)
+	without.where[index].args = nil
+	_, rest := BuildQuery(&without)
+
+	repeat := (len(args) - len(rest)) / len(in.args)
+	if repeat < 1 {
+		return []*Query{q}
+	}
+	size := (q.dialect.MaxParameters - len(rest)) / repeat
+	size -= size % groupAt
+	if size < groupAt {
+		return []*Query{q}
+	}
+
+	var split []*Query
+	for start := 0; start < len(in.args); start += size {
+		end := start + size
+		if end > len(in.args) {
+			end = len(in.args)
+		}
+
+		chunk := *q
+		chunk.load = nil
+		chunk.loadMods = nil
+		chunk.where = make([]where, len(q.where))
+		copy(chunk.where, q.where)
+		chunk.where[index].args = in.args[start:end]
+		split = append(split, &chunk)
+	}
+
+	return split
+}
+
+// RowsPerStatement returns how many rows, that take argsPerRow arguments
+// each, fit in a single statement next to fixedArgs other arguments, see
+// drivers.Dialect.MaxParameters. It's at least one, and zero means there's no
+// limit.
+func RowsPerStatement(dialect *drivers.Dialect, fixedArgs, argsPerRow int) int {
+	if dialect == nil || dialect.MaxParameters <= 0 || argsPerRow <= 0 {
+		return 0
+	}
+
+	rows := (dialect.MaxParameters - fixedArgs) / argsPerRow
+	if rows < 1 {
+		return 1
+	}
+	return rows
+}
@@ -0,0 +1,232 @@
+package queries
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func splitTestArgs(n int) []interface{} {
+	args := make([]interface{}, n)
+	for i := range args {
+		args[i] = i
+	}
+	return args
+}
+
+func TestSplitIn(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, MaxParameters: 10}
+
+	tests := []struct {
+		name  string
+		q     *Query
+		sizes []int
+	}{
+		{
+			name:  "no limit",
+			q:     &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"'}, from: []string{"a"}, where: []where{{kind: whereKindIn, clause: "id in ?", args: splitTestArgs(25)}}},
+			sizes: []int{25},
+		},
+		{
+			name:  "fits",
+			q:     &Query{dialect: dialect, from: []string{"a"}, where: []where{{kind: whereKindIn, clause: "id in ?", args: splitTestArgs(10)}}},
+			sizes: []int{10},
+		},
+		{
+			name:  "split",
+			q:     &Query{dialect: dialect, from: []string{"a"}, where: []where{{kind: whereKindIn, clause: "id in ?", args: splitTestArgs(25)}}},
+			sizes: []int{10, 10, 5},
+		},
+		{
+			name: "other args",
+			q: &Query{dialect: dialect, from: []string{"a"}, where: []where{
+				{kind: whereKindNormal, clause: "b=?", args: []interface{}{1}},
+				{kind: whereKindIn, clause: "id in ?", args: splitTestArgs(25)},
+			}},
+			sizes: []int{9, 9, 7},
+		},
+		{
+			name:  "composite",
+			q:     &Query{dialect: dialect, from: []string{"a"}, where: []where{{kind: whereKindIn, clause: "(a, b, c) in ?", args: splitTestArgs(24)}}},
+			sizes: []int{9, 9, 6},
+		},
+		{
+			name: "or",
+			q: &Query{dialect: dialect, from: []string{"a"}, where: []where{
+				{kind: whereKindNormal, clause: "b=?", args: []interface{}{1}},
+				{kind: whereKindIn, clause: "id in ?", args: splitTestArgs(25), orSeparator: true},
+			}},
+			sizes: []int{25},
+		},
+		{
+			name:  "limit",
+			q:     &Query{dialect: dialect, from: []string{"a"}, limit: 5, where: []where{{kind: whereKindIn, clause: "id in ?", args: splitTestArgs(25)}}},
+			sizes: []int{25},
+		},
+		{
+			name:  "order",
+			q:     &Query{dialect: dialect, from: []string{"a"}, orderBy: []argClause{{clause: "id"}}, where: []where{{kind: whereKindIn, clause: "id in ?", args: splitTestArgs(25)}}},
+			sizes: []int{25},
+		},
+		{
+			name: "per parent",
+			q: &Query{
+				dialect:        &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseLateralJoin: true, MaxParameters: 10},
+				from:           []string{"a"},
+				orderBy:        []argClause{{clause: "id"}},
+				partitionBy:    "a.parent_id",
+				partitionLimit: 2,
+				where:          []where{{kind: whereKindIn, clause: "a.parent_id in ?", args: splitTestArgs(25)}},
+			},
+			// The lateral join has the IN list twice
+			sizes: []int{5, 5, 5, 5, 5},
+		},
+		{
+			name:  "not in",
+			q:     &Query{dialect: dialect, from: []string{"a"}, where: []where{{kind: whereKindNotIn, clause: "id not in ?", args: splitTestArgs(25)}}},
+			sizes: []int{25},
+		},
+		{
+			name:  "not in clause",
+			q:     &Query{dialect: dialect, from: []string{"a"}, where: []where{{kind: whereKindIn, clause: "id NOT IN ?", args: splitTestArgs(25)}}},
+			sizes: []int{25},
+		},
+		{
+			name:  "not in composite",
+			q:     &Query{dialect: dialect, from: []string{"a"}, where: []where{{kind: whereKindIn, clause: "(a, b) not in ?", args: splitTestArgs(24)}}},
+			sizes: []int{24},
+		},
+		{
+			name:  "aggregate",
+			q:     &Query{dialect: dialect, from: []string{"a"}, selectCols: []string{"count(*)"}, where: []where{{kind: whereKindIn, clause: "id in ?", args: splitTestArgs(25)}}},
+			sizes: []int{25},
+		},
+		{
+			name:  "columns",
+			q:     &Query{dialect: dialect, from: []string{"a"}, selectCols: []string{`"a".*`, `"b"."a_id"`}, where: []where{{kind: whereKindIn, clause: "a_id in ?", args: splitTestArgs(25)}}},
+			sizes: []int{10, 10, 5},
+		},
+		{
+			name:  "column named not",
+			q:     &Query{dialect: dialect, from: []string{"a"}, where: []where{{kind: whereKindIn, clause: "knot in ?", args: splitTestArgs(25)}}},
+			sizes: []int{10, 10, 5},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			split := SplitIn(test.q)
+			if len(split) != len(test.sizes) {
+				t.Fatalf("want %d queries, got %d", len(test.sizes), len(split))
+			}
+
+			for i, q := range split {
+				var inArgs []interface{}
+				for _, w := range q.where {
+					if w.kind == whereKindIn || w.kind == whereKindNotIn {
+						inArgs = w.args
+					}
+				}
+				if len(inArgs) != test.sizes[i] {
+					t.Errorf("query %d: want %d arguments, got %d", i, test.sizes[i], len(inArgs))
+				}
+				if test.q.dialect.MaxParameters > 0 && len(split) > 1 {
+					if _, args := BuildQuery(q); len(args) > test.q.dialect.MaxParameters {
+						t.Errorf("query %d: has %d arguments", i, len(args))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSplitInLeavesOriginal(t *testing.T) {
+	t.Parallel()
+
+	args := splitTestArgs(25)
+	q := &Query{
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, MaxParameters: 10},
+		from:    []string{"a"},
+		where:   []where{{kind: whereKindIn, clause: "id in ?", args: args}},
+	}
+
+	if split := SplitIn(q); len(split) != 3 {
+		t.Fatal("want 3 queries, got", len(split))
+	}
+	if len(q.where[0].args) != len(args) {
+		t.Error("the original query was changed")
+	}
+}
+
+func TestBindSplit(t *testing.T) {
+	t.Parallel()
+
+	var testResults []struct {
+		ID int
+	}
+
+	query := &Query{
+		from:    []string{"fun"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, MaxParameters: 2},
+		where:   []where{{kind: whereKindIn, clause: "id in ?", args: []interface{}{1, 2, 3}}},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Error(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id"})
+	ret.AddRow(driver.Value(int64(1)))
+	ret.AddRow(driver.Value(int64(2)))
+	mock.ExpectQuery(`SELECT \* FROM "fun" WHERE \("id" IN \(\$1,\$2\)\);`).WithArgs(1, 2).WillReturnRows(ret)
+	ret = sqlmock.NewRows([]string{"id"})
+	ret.AddRow(driver.Value(int64(3)))
+	mock.ExpectQuery(`SELECT \* FROM "fun" WHERE \("id" IN \(\$1\)\);`).WithArgs(3).WillReturnRows(ret)
+
+	if err = query.Bind(nil, db, &testResults); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(testResults) != 3 {
+		t.Fatal("wrong number of results:", len(testResults))
+	}
+	for i, r := range testResults {
+		if r.ID != i+1 {
+			t.Errorf("%d) wrong ID: %d", i, r.ID)
+		}
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRowsPerStatement(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{MaxParameters: 10}
+	tests := []struct {
+		dialect             *drivers.Dialect
+		fixed, perRow, want int
+	}{
+		{nil, 0, 1, 0},
+		{&drivers.Dialect{}, 0, 1, 0},
+		{dialect, 0, 1, 10},
+		{dialect, 1, 2, 4},
+		{dialect, 0, 3, 3},
+		{dialect, 10, 1, 1},
+		{dialect, 0, 0, 0},
+	}
+
+	for i, test := range tests {
+		if got := RowsPerStatement(test.dialect, test.fixed, test.perRow); got != test.want {
+			t.Errorf("%d) want %d, got %d", i, test.want, got)
+		}
+	}
+}
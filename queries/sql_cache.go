@@ -0,0 +1,206 @@
+package queries
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// DefaultSQLCacheSize is how many built statements BuildQuery keeps by
+// default, see SetSQLCacheSize.
+const DefaultSQLCacheSize = 1000
+
+// sqlCacheKey identifies the SQL of a query, shape describes everything
+// about the query the SQL depends on except for the dialect.
+type sqlCacheKey struct {
+	dialect drivers.Dialect
+	shape   string
+}
+
+type sqlCacheMap struct {
+	mu   sync.RWMutex
+	size int
+	sql  map[sqlCacheKey]string
+}
+
+var sqlCache = &sqlCacheMap{
+	size: DefaultSQLCacheSize,
+	sql:  make(map[sqlCacheKey]string),
+}
+
+// SetSQLCacheSize sets how many built statements BuildQuery keeps to reuse
+// for queries of the same shape, ie. built from the same mods with the same
+// number of arguments in IN clauses. Once it's full other statements are
+// built every time. Zero turns the cache off. The cache is emptied.
+func SetSQLCacheSize(size int) {
+	sqlCache.mu.Lock()
+	sqlCache.size = size
+	sqlCache.sql = make(map[sqlCacheKey]string)
+	sqlCache.mu.Unlock()
+}
+
+func (c *sqlCacheMap) get(key sqlCacheKey) (string, bool) {
+	c.mu.RLock()
+	sql, ok := c.sql[key]
+	c.mu.RUnlock()
+	return sql, ok
+}
+
+func (c *sqlCacheMap) put(key sqlCacheKey, sql string) {
+	c.mu.Lock()
+	if len(c.sql) < c.size {
+		c.sql[key] = sql
+	}
+	c.mu.Unlock()
+}
+
+// newSQLCacheKey returns the key of the query's SQL, and false if the query
+// can't be cached.
+func newSQLCacheKey(q *Query) (sqlCacheKey, bool) {
+	if q.dialect == nil || q.partitionLimit != 0 {
+		return sqlCacheKey{}, false
+	}
+
+	sqlCache.mu.RLock()
+	size := sqlCache.size
+	sqlCache.mu.RUnlock()
+	if size == 0 {
+		return sqlCacheKey{}, false
+	}
+
+	buf := boil.GetBuffer()
+	defer boil.PutBuffer(buf)
+
+	writeShapeBool(buf, q.delete)
+	writeShapeBool(buf, q.count)
+	writeShapeBool(buf, q.exists)
+	writeShapeStrings(buf, q.from)
+	writeShapeStrings(buf, q.selectCols)
+	writeShapeStrings(buf, q.groupBy)
+	writeShapeStrings(buf, q.returning)
+	writeShapeStrings(buf, q.insertCols)
+	writeShapeString(buf, q.insertInto)
+	writeShapeString(buf, q.forlock)
+	writeShapeString(buf, q.distinct)
+	writeShapeString(buf, q.comment)
+	writeShapeInt(buf, q.limit)
+	writeShapeInt(buf, q.offset)
+	writeShapeClauses(buf, q.withs)
+	writeShapeClauses(buf, q.having)
+	writeShapeClauses(buf, q.orderBy)
+
+	for _, j := range q.joins {
+		writeShapeInt(buf, int(j.kind))
+		writeShapeString(buf, j.clause)
+	}
+	buf.WriteByte(1)
+
+	for _, w := range q.where {
+		writeShapeInt(buf, int(w.kind))
+		writeShapeBool(buf, w.orSeparator)
+		writeShapeString(buf, w.clause)
+		// The placeholders of IN clauses are expanded to the arguments
+		if w.kind == whereKindIn || w.kind == whereKindNotIn {
+			writeShapeInt(buf, len(w.args))
+		}
+	}
+	buf.WriteByte(1)
+
+	for _, name := range sortedUpdateColumns(q.update) {
+		writeShapeString(buf, name)
+		if expr, ok := q.update[name].(boil.Expression); ok {
+			writeShapeString(buf, expr.SQL)
+		} else {
+			buf.WriteByte(1)
+		}
+	}
+
+	return sqlCacheKey{dialect: *q.dialect, shape: buf.String()}, true
+}
+
+// sqlCacheArgs collects the query's arguments in the order the statement
+// BuildQuery builds for it takes them.
+func sqlCacheArgs(q *Query) []interface{} {
+	var args []interface{}
+	for _, w := range q.withs {
+		args = append(args, w.args...)
+	}
+
+	switch {
+	case q.delete:
+	case len(q.update) > 0:
+		for _, name := range sortedUpdateColumns(q.update) {
+			value := q.update[name]
+			if expr, ok := value.(boil.Expression); ok {
+				args = append(args, expr.Args...)
+			} else {
+				args = append(args, value)
+			}
+		}
+	default:
+		for _, j := range q.joins {
+			args = append(args, j.args...)
+		}
+	}
+
+	for _, w := range q.where {
+		args = append(args, w.args...)
+	}
+	for _, h := range q.having {
+		args = append(args, h.args...)
+	}
+	for _, o := range q.orderBy {
+		args = append(args, o.args...)
+	}
+
+	return args
+}
+
+func sortedUpdateColumns(update map[string]interface{}) []string {
+	if len(update) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(update))
+	for name := range update {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func writeShapeBool(buf *bytes.Buffer, b bool) {
+	if b {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+}
+
+func writeShapeInt(buf *bytes.Buffer, i int) {
+	buf.WriteString(strconv.Itoa(i))
+	buf.WriteByte(0)
+}
+
+func writeShapeString(buf *bytes.Buffer, s string) {
+	buf.WriteString(s)
+	buf.WriteByte(0)
+}
+
+func writeShapeStrings(buf *bytes.Buffer, strs []string) {
+	for _, s := range strs {
+		writeShapeString(buf, s)
+	}
+	buf.WriteByte(1)
+}
+
+func writeShapeClauses(buf *bytes.Buffer, clauses []argClause) {
+	for _, c := range clauses {
+		writeShapeString(buf, c.clause)
+	}
+	buf.WriteByte(1)
+}
@@ -0,0 +1,117 @@
+package queries
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestSQLCacheArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []*Query{
+		{from: []string{"t"}},
+		{
+			withs:   []argClause{{"cte AS (SELECT * FROM x WHERE y = ?)", []interface{}{"w"}}},
+			from:    []string{"happiness as a"},
+			joins:   []join{{kind: JoinInner, clause: "rainbows r on a.id = r.happy_id and r.color = ?", args: []interface{}{"j"}}},
+			where:   []where{{clause: "a=?", args: []interface{}{1}}, {kind: whereKindIn, clause: "b IN ?", args: []interface{}{2, 3}}},
+			groupBy: []string{"a"},
+			having:  []argClause{{"count(*) > ?", []interface{}{4}}},
+			orderBy: []argClause{{"b like ? DESC", []interface{}{"o"}}},
+		},
+		{
+			from:  []string{"videos"},
+			joins: []join{{clause: "ignored j on ?", args: []interface{}{"skipped"}}},
+			update: map[string]interface{}{
+				"c": boil.Expr("CASE WHEN a > ? THEN ? ELSE c END", 3, 4),
+				"a": 1,
+				"b": boil.Expr("b + ?", 2),
+			},
+			where: []where{{clause: "id=?", args: []interface{}{5}}},
+		},
+		{from: []string{"t"}, delete: true, joins: []join{{clause: "ignored j on ?", args: []interface{}{"skipped"}}}, where: []where{{clause: "a=?", args: []interface{}{1}}}},
+		{from: []string{"s"}, insertInto: "t", insertCols: []string{"a"}, selectCols: []string{"a"}, where: []where{{clause: "c=?", args: []interface{}{1}}}},
+	}
+
+	for i, q := range tests {
+		q.dialect = &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+		_, want := buildQuery(q)
+		if got := sqlCacheArgs(q); !reflect.DeepEqual(got, want) {
+			t.Errorf("%d) want args %v, got %v", i, want, got)
+		}
+	}
+}
+
+func TestSQLCacheKey(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	newQuery := func(in ...interface{}) *Query {
+		return &Query{
+			dialect: dialect,
+			from:    []string{"t"},
+			where:   []where{{clause: "a=?", args: []interface{}{in[0]}}, {kind: whereKindIn, clause: "b IN ?", args: in}},
+		}
+	}
+
+	a, ok := newSQLCacheKey(newQuery(1, 2))
+	if !ok {
+		t.Fatal("want the query to be cacheable")
+	}
+	if b, _ := newSQLCacheKey(newQuery(3, 4)); a != b {
+		t.Error("want the same key for different arguments")
+	}
+	if b, _ := newSQLCacheKey(newQuery(1, 2, 3)); a == b {
+		t.Error("want a different key for a different number of IN arguments")
+	}
+
+	q := newQuery(1, 2)
+	q.dialect = &drivers.Dialect{LQ: '`', RQ: '`'}
+	if b, _ := newSQLCacheKey(q); a == b {
+		t.Error("want a different key for a different dialect")
+	}
+
+	q = newQuery(1, 2)
+	q.partitionBy, q.partitionLimit = "a", 2
+	if _, ok := newSQLCacheKey(q); ok {
+		t.Error("want partitioned queries not to be cached")
+	}
+}
+
+func TestBuildQueryCached(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	newQuery := func(a, b interface{}) *Query {
+		return &Query{
+			dialect: dialect,
+			from:    []string{"sql_cache_test"},
+			where:   []where{{clause: "a=?", args: []interface{}{a}}, {kind: whereKindIn, clause: "b IN ?", args: []interface{}{b, b}}},
+			limit:   3,
+		}
+	}
+
+	sql, args := BuildQuery(newQuery(1, 2))
+	if want := `SELECT * FROM "sql_cache_test" WHERE (a=$1) AND ("b" IN ($2,$3)) LIMIT 3;`; sql != want {
+		t.Errorf("want %s, got %s", want, sql)
+	}
+	if want := []interface{}{1, 2, 2}; !reflect.DeepEqual(args, want) {
+		t.Errorf("want args %v, got %v", want, args)
+	}
+
+	key, _ := newSQLCacheKey(newQuery(1, 2))
+	if cached, ok := sqlCache.get(key); !ok || cached != sql {
+		t.Errorf("want the statement cached, got %q", cached)
+	}
+
+	again, args := BuildQuery(newQuery(5, 6))
+	if again != sql {
+		t.Errorf("want %s, got %s", sql, again)
+	}
+	if want := []interface{}{5, 6, 6}; !reflect.DeepEqual(args, want) {
+		t.Errorf("want args %v, got %v", want, args)
+	}
+}
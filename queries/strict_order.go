@@ -0,0 +1,44 @@
+package queries
+
+import (
+	"sync/atomic"
+
+	"github.com/friendsofgo/errors"
+)
+
+// ErrUnorderedLimit is returned for queries that use a limit or an offset
+// without an order by when strict ordering is on, see SetStrictOrder.
+var ErrUnorderedLimit = errors.New("queries: limit or offset used without an order by")
+
+var strictOrder int32
+
+// SetStrictOrder turns the check that queries using a limit or an offset
+// also have an order by on or off, it's off by default. Without an order by
+// the database may return rows in any order, so paging through them can
+// repeat or skip rows. Selects failing the check return ErrUnorderedLimit
+// instead of running, this includes the All and One finishers.
+//
+// Queries for a single row without an offset, as made by One and Exists,
+// don't need an order by. Neither do raw queries, deletes and updates.
+func SetStrictOrder(strict bool) {
+	var v int32
+	if strict {
+		v = 1
+	}
+	atomic.StoreInt32(&strictOrder, v)
+}
+
+// checkOrder returns ErrUnorderedLimit if strict ordering is on and q pages
+// through rows without an order by.
+func checkOrder(q *Query) error {
+	if atomic.LoadInt32(&strictOrder) == 0 {
+		return nil
+	}
+	if q.delete || q.update != nil || len(q.orderBy) != 0 {
+		return nil
+	}
+	if q.offset != 0 || q.limit > 1 {
+		return ErrUnorderedLimit
+	}
+	return nil
+}
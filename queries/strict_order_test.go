@@ -0,0 +1,51 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/friendsofgo/errors"
+)
+
+// TestStrictOrder isn't parallel as it changes global state, the parallel
+// tests only run once it's done.
+func TestStrictOrder(t *testing.T) {
+	SetStrictOrder(true)
+	defer SetStrictOrder(false)
+
+	tests := []struct {
+		Query *Query
+		Err   bool
+	}{
+		{Query: &Query{limit: 10}, Err: true},
+		{Query: &Query{offset: 10}, Err: true},
+		{Query: &Query{limit: 10, orderBy: []argClause{{clause: "id"}}}},
+		{Query: &Query{limit: 1}},
+		{Query: &Query{limit: 10, delete: true}},
+		{Query: &Query{}},
+	}
+
+	for i, test := range tests {
+		if err := checkOrder(test.Query); test.Err != (err != nil) {
+			t.Errorf("%d: want error %t, got %v", i, test.Err, err)
+		}
+	}
+
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	q := &Query{from: []string{"pilots"}, limit: 10}
+	var pilots []struct{ ID int }
+	if err := q.Bind(context.Background(), db, &pilots); errors.Cause(err) != ErrUnorderedLimit {
+		t.Errorf("want ErrUnorderedLimit, got %v", err)
+	}
+
+	SetStrictOrder(false)
+	if err := checkOrder(&Query{limit: 10}); err != nil {
+		t.Error("the check should be off", err)
+	}
+}
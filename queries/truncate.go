@@ -0,0 +1,127 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// connector hands out a single connection from a pool, like *sql.DB.
+type connector interface {
+	Conn(ctx context.Context) (*sql.Conn, error)
+}
+
+// TruncateStatements returns the statements that empty table with the given
+// options. The reset statements undo session settings the others changed,
+// they have to run on the same connection even when truncating fails.
+func TruncateStatements(dialect *drivers.Dialect, table string, opts boil.TruncateOptions) (stmts, reset []string, err error) {
+	isPsql := dialect.Name == "psql"
+	switch {
+	case opts.RestartIdentity && !isPsql:
+		return nil, nil, errors.Errorf("restart identity is not supported by %s, identities are always reset", dialect.Name)
+	case opts.Cascade && !isPsql:
+		return nil, nil, errors.Errorf("cascade is not supported by %s", dialect.Name)
+	case opts.DisableForeignKeyChecks && dialect.Name != "mysql":
+		return nil, nil, errors.Errorf("disabling foreign key checks is not supported by %s", dialect.Name)
+	}
+
+	truncate := "TRUNCATE TABLE " + dialect.QuoteIdent(table)
+	if opts.RestartIdentity {
+		truncate += " RESTART IDENTITY"
+	}
+	if opts.Cascade {
+		truncate += " CASCADE"
+	}
+
+	if opts.DisableForeignKeyChecks {
+		return []string{"SET FOREIGN_KEY_CHECKS = 0", truncate}, []string{"SET FOREIGN_KEY_CHECKS = 1"}, nil
+	}
+
+	return []string{truncate}, nil, nil
+}
+
+// Truncate empties table, see TruncateStatements. When that takes more than
+// one statement and exec is a pool they're run on a single connection.
+func Truncate(exec boil.Executor, dialect *drivers.Dialect, table string, opts boil.TruncateOptions) error {
+	stmts, reset, err := TruncateStatements(dialect, table, opts)
+	if err != nil {
+		return err
+	}
+
+	run := func(query string) error {
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, query)
+		}
+		_, err := exec.Exec(query)
+		return err
+	}
+
+	if db, ok := exec.(connector); ok && len(reset) != 0 {
+		ctx := context.Background()
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		run = func(query string) error {
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, query)
+			}
+			_, err := conn.ExecContext(ctx, query)
+			return err
+		}
+	}
+
+	return runTruncate(run, stmts, reset)
+}
+
+// TruncateContext empties table, see TruncateStatements. When that takes more
+// than one statement and exec is a pool they're run on a single connection.
+func TruncateContext(ctx context.Context, exec boil.ContextExecutor, dialect *drivers.Dialect, table string, opts boil.TruncateOptions) error {
+	stmts, reset, err := TruncateStatements(dialect, table, opts)
+	if err != nil {
+		return err
+	}
+
+	var execer interface {
+		ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	} = exec
+	if db, ok := exec.(connector); ok && len(reset) != 0 {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		execer = conn
+	}
+
+	return runTruncate(func(query string) error {
+		if boil.IsDebug(ctx) {
+			fmt.Fprintln(boil.DebugWriterFrom(ctx), query)
+		}
+		_, err := execer.ExecContext(ctx, query)
+		return err
+	}, stmts, reset)
+}
+
+func runTruncate(run func(string) error, stmts, reset []string) error {
+	var err error
+	for _, stmt := range stmts {
+		if err = run(stmt); err != nil {
+			break
+		}
+	}
+
+	for _, stmt := range reset {
+		if resetErr := run(stmt); resetErr != nil && err == nil {
+			err = resetErr
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,81 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestTruncateStatements(t *testing.T) {
+	t.Parallel()
+
+	psql := &drivers.Dialect{Name: "psql", LQ: '"', RQ: '"'}
+	mysql := &drivers.Dialect{Name: "mysql", LQ: '`', RQ: '`'}
+	mssql := &drivers.Dialect{Name: "mssql", LQ: '[', RQ: ']'}
+
+	tests := []struct {
+		Dialect *drivers.Dialect
+		Opts    boil.TruncateOptions
+		Stmts   []string
+		Reset   []string
+		Err     bool
+	}{
+		{Dialect: psql, Stmts: []string{`TRUNCATE TABLE "t"`}},
+		{Dialect: psql, Opts: boil.TruncateOptions{RestartIdentity: true, Cascade: true}, Stmts: []string{`TRUNCATE TABLE "t" RESTART IDENTITY CASCADE`}},
+		{Dialect: psql, Opts: boil.TruncateOptions{DisableForeignKeyChecks: true}, Err: true},
+		{Dialect: mysql, Stmts: []string{"TRUNCATE TABLE `t`"}},
+		{Dialect: mysql, Opts: boil.TruncateOptions{DisableForeignKeyChecks: true}, Stmts: []string{"SET FOREIGN_KEY_CHECKS = 0", "TRUNCATE TABLE `t`"}, Reset: []string{"SET FOREIGN_KEY_CHECKS = 1"}},
+		{Dialect: mysql, Opts: boil.TruncateOptions{Cascade: true}, Err: true},
+		{Dialect: mssql, Stmts: []string{"TRUNCATE TABLE [t]"}},
+		{Dialect: mssql, Opts: boil.TruncateOptions{RestartIdentity: true}, Err: true},
+	}
+
+	for i, test := range tests {
+		stmts, reset, err := TruncateStatements(test.Dialect, "t", test.Opts)
+		if test.Err {
+			if err == nil {
+				t.Errorf("%d) want an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d) %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(stmts, test.Stmts) {
+			t.Errorf("%d) want statements %q, got %q", i, test.Stmts, stmts)
+		}
+		if !reflect.DeepEqual(reset, test.Reset) {
+			t.Errorf("%d) want reset %q, got %q", i, test.Reset, reset)
+		}
+	}
+}
+
+func TestTruncateResetsOnFailure(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fail := errors.New("referenced by a foreign key")
+	mock.ExpectExec(`SET FOREIGN_KEY_CHECKS = 0`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("TRUNCATE TABLE `t`").WillReturnError(fail)
+	mock.ExpectExec(`SET FOREIGN_KEY_CHECKS = 1`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	dialect := &drivers.Dialect{Name: "mysql", LQ: '`', RQ: '`'}
+	err = TruncateContext(context.Background(), db, dialect, "t", boil.TruncateOptions{DisableForeignKeyChecks: true})
+	if err != fail {
+		t.Error("want the truncate error, got:", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
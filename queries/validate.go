@@ -0,0 +1,135 @@
+package queries
+
+import (
+	"strings"
+
+	"github.com/friendsofgo/errors"
+)
+
+// ValidateClause checks that an ORDER BY or GROUP BY clause is a comma
+// separated list of columns, each optionally followed by ASC or DESC and
+// NULLS FIRST or NULLS LAST. A column is an identifier or a quoted
+// identifier ("name", `name` or [name]), optionally qualified with dots like
+// "pilots"."name".
+//
+// Anything else is rejected, including function calls, parentheses,
+// operators, numbers and literals, since those are what time and error based
+// injections are built from. It's used by qm.ValidOrderBy and qm.ValidGroupBy
+// for clauses built from user input, clauses that need more should be
+// written by hand with qm.OrderBy or qm.GroupBy.
+func ValidateClause(clause string) error {
+	items := strings.Split(clause, ",")
+	for _, item := range items {
+		if err := validateClauseItem(item); err != nil {
+			return errors.Wrapf(err, "invalid clause %q", clause)
+		}
+	}
+
+	return nil
+}
+
+// validateClauseItem checks a single column of a clause and its ordering.
+func validateClauseItem(item string) error {
+	i := skipSpace(item, 0)
+	if i == len(item) {
+		return errors.New("empty column")
+	}
+
+	// The column, one or more identifiers separated by dots
+	for {
+		end, err := scanIdentifier(item, i)
+		if err != nil {
+			return err
+		}
+		i = end
+		if i < len(item) && item[i] == '.' {
+			i++
+			continue
+		}
+		break
+	}
+
+	// The ordering, as words after the column
+	var words []string
+	for {
+		i = skipSpace(item, i)
+		if i == len(item) {
+			break
+		}
+		if !isWordStart(item[i]) {
+			return errors.Errorf("unexpected %q", item[i:])
+		}
+		j := i + 1
+		for j < len(item) && isWordPart(item[j]) {
+			j++
+		}
+		words = append(words, strings.ToUpper(item[i:j]))
+		i = j
+	}
+
+	if len(words) != 0 && (words[0] == "ASC" || words[0] == "DESC") {
+		words = words[1:]
+	}
+	if len(words) == 2 && words[0] == "NULLS" && (words[1] == "FIRST" || words[1] == "LAST") {
+		words = nil
+	}
+	if len(words) != 0 {
+		return errors.Errorf("unexpected %s", strings.Join(words, " "))
+	}
+
+	return nil
+}
+
+// scanIdentifier returns the end of the bare or quoted identifier starting
+// at i. Quotes inside quoted identifiers are escaped by doubling them.
+func scanIdentifier(s string, i int) (int, error) {
+	if i == len(s) {
+		return 0, errors.New("missing identifier")
+	}
+
+	c := s[i]
+	switch {
+	case c == '"' || c == '`' || c == '[':
+		end := c
+		if c == '[' {
+			end = ']'
+		}
+		for j := i + 1; j < len(s); j++ {
+			if s[j] != end {
+				continue
+			}
+			if j+1 < len(s) && s[j+1] == end {
+				j++
+				continue
+			}
+			if j == i+1 {
+				return 0, errors.New("empty quoted identifier")
+			}
+			return j + 1, nil
+		}
+		return 0, errors.New("unterminated quoted identifier")
+	case isWordStart(c):
+		j := i + 1
+		for j < len(s) && isWordPart(s[j]) {
+			j++
+		}
+		return j, nil
+	}
+
+	return 0, errors.Errorf("unexpected %q", s[i:])
+}
+
+func skipSpace(s string, i int) int {
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t' || s[i] == '\n' || s[i] == '\r') {
+		i++
+	}
+	return i
+}
+
+func isWordStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isWordPart(c byte) bool {
+	return isWordStart(c) || c == '$' || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,61 @@
+package queries
+
+import "testing"
+
+func TestValidateClause(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{
+		"name",
+		"name desc",
+		"name asc, age desc",
+		`"pilots"."name" DESC NULLS LAST`,
+		"`pilots`.`name`",
+		"[dbo].[pilots].[name]",
+		`"odd""name" asc nulls first`,
+		"updated_at",
+		"selected_at, union_id",
+		" name ,age\tDESC ",
+	}
+
+	for _, clause := range valid {
+		if err := ValidateClause(clause); err != nil {
+			t.Errorf("%s: unexpected error: %v", clause, err)
+		}
+	}
+
+	invalid := []string{
+		"name; drop table pilots",
+		"name -- comment",
+		"name /* comment */",
+		"name # comment",
+		"name = 'x'",
+		"(select password from users limit 1)",
+		"name union select 1",
+		"lower(name",
+		"name)",
+		`"unterminated`,
+		"[unterminated",
+		"",
+		"name,",
+		"name desc asc",
+		"name nulls",
+		"pilots.",
+		`""`,
+		"lower(name) asc",
+		"count(*) desc",
+		"age * -1",
+		"? desc",
+		"1",
+		"id, pg_sleep(10)",
+		"sleep(5)",
+		"extractvalue(1,concat(0x7e,version()))",
+		"(case when ascii(substr(current_user,1,1))>100 then id else name end)",
+	}
+
+	for _, clause := range invalid {
+		if err := ValidateClause(clause); err == nil {
+			t.Errorf("%s: expected an error", clause)
+		}
+	}
+}
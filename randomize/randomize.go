@@ -0,0 +1,154 @@
+// Package randomize wraps github.com/volatiletech/randomize so that the
+// generated test suite can fill in columns whose types come from the type
+// replacement config. Types that can't implement randomize.Randomizer
+// themselves, because they're defined in another package, can register a
+// function that produces random values for them instead.
+package randomize
+
+import (
+	"os"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/strmangle"
+)
+
+// Func returns a random value for a field of a registered type. The
+// arguments are the same as for randomize.Randomizer: nextInt produces
+// sequential integers to avoid collisions in unique columns, fieldType is
+// the database type of the column and shouldBeNull suggests a null value
+// should be returned if the type has one.
+type Func func(nextInt func() int64, fieldType string, shouldBeNull bool) interface{}
+
+// Seed is the counter values are derived from, see randomize.Seed
+type Seed = randomize.Seed
+
+var (
+	registryMut sync.RWMutex
+	registry    = map[reflect.Type]Func{}
+)
+
+// SeedEnv is the environment variable InitialSeed reads the starting value
+// of seeds from. Set it to the value printed by a failed test run to get
+// the same values again.
+const SeedEnv = "SQLBOILER_SEED"
+
+// NewSeed creates a new seed for pseudo-randomization
+func NewSeed() *Seed {
+	return randomize.NewSeed()
+}
+
+// NewSeedFrom creates a seed that starts at start, two seeds with the same
+// start produce the same values in the same order.
+func NewSeedFrom(start int64) *Seed {
+	s := Seed(start)
+	return &s
+}
+
+// InitialSeed returns the value a seed should start at: the value of SeedEnv
+// if it's set, otherwise the current time like NewSeed.
+func InitialSeed() (int64, error) {
+	env, ok := os.LookupEnv(SeedEnv)
+	if !ok || len(env) == 0 {
+		return time.Now().Unix(), nil
+	}
+
+	start, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s", SeedEnv)
+	}
+	return start, nil
+}
+
+// Register makes Struct use fn for every field of the same type as value,
+// typically from an init function in a _test.go file next to the generated
+// models:
+//
+//	randomize.Register(decimal.Decimal{}, func(nextInt func() int64, _ string, _ bool) interface{} {
+//	  return decimal.New(nextInt(), -2)
+//	})
+//
+// The value fn returns must be assignable or convertible to the field.
+// Registering a type again replaces its function.
+func Register(value interface{}, fn Func) {
+	if value == nil || fn == nil {
+		panic("randomize: Register needs a value of the type and a function")
+	}
+
+	registryMut.Lock()
+	registry[reflect.TypeOf(value)] = fn
+	registryMut.Unlock()
+}
+
+func lookup(typ reflect.Type) (Func, bool) {
+	registryMut.RLock()
+	fn, ok := registry[typ]
+	registryMut.RUnlock()
+	return fn, ok
+}
+
+// Struct fills the fields of str with random data the same way as
+// randomize.Struct, fields whose type was registered get their value from
+// the registered function.
+func Struct(s *Seed, str interface{}, colTypes map[string]string, canBeNull bool, blacklist ...string) error {
+	value := reflect.ValueOf(str)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		// Let randomize produce the error
+		return randomize.Struct(s, str, colTypes, canBeNull, blacklist...)
+	}
+
+	value = value.Elem()
+	typ := value.Type()
+
+	skip := make([]string, len(blacklist), len(blacklist)+typ.NumField())
+	copy(skip, blacklist)
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fn, ok := lookup(field.Type)
+		if !ok {
+			continue
+		}
+
+		tag := field.Tag.Get("boil")
+		if tag == "-" || isBlacklisted(field, append(blacklist, "deleted_at")) {
+			continue
+		}
+
+		shouldBeNull := canBeNull && s.NextInt()%3 == 0
+		random := reflect.ValueOf(fn(s.NextInt, colTypes[field.Name], shouldBeNull))
+		switch {
+		case !random.IsValid():
+			return errors.Errorf("randomizer for %s returned nil for field %s", field.Type, field.Name)
+		case random.Type().AssignableTo(field.Type):
+		case random.Type().ConvertibleTo(field.Type):
+			random = random.Convert(field.Type)
+		default:
+			return errors.Errorf("randomizer for %s returned a %s for field %s", field.Type, random.Type(), field.Name)
+		}
+		value.Field(i).Set(random)
+
+		// randomize matches the blacklist against the boil tag as well as
+		// the field name, the tag is the column name
+		if len(tag) != 0 {
+			skip = append(skip, tag)
+		} else {
+			skip = append(skip, field.Name)
+		}
+	}
+
+	return randomize.Struct(s, str, colTypes, canBeNull, skip...)
+}
+
+func isBlacklisted(field reflect.StructField, blacklist []string) bool {
+	for _, b := range blacklist {
+		if strmangle.TitleCase(b) == field.Name || b == field.Tag.Get("boil") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package randomize
+
+import (
+	"os"
+	"testing"
+)
+
+type customType struct {
+	val int64
+}
+
+type customStruct struct {
+	Name   string
+	Custom customType `boil:"custom"`
+	Skip   customType `boil:"skip"`
+	R      *struct{}  `boil:"-"`
+}
+
+func TestStructRegistered(t *testing.T) {
+	Register(customType{}, func(nextInt func() int64, fieldType string, shouldBeNull bool) interface{} {
+		if fieldType != "custom_type" {
+			t.Errorf("wrong field type: %s", fieldType)
+		}
+		return customType{val: nextInt()}
+	})
+
+	var s customStruct
+	colTypes := map[string]string{"Name": "text", "Custom": "custom_type"}
+	if err := Struct(NewSeed(), &s, colTypes, false, "skip"); err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Custom.val == 0 {
+		t.Error("custom field was not randomized")
+	}
+	if s.Skip.val != 0 {
+		t.Error("blacklisted field was randomized")
+	}
+	if len(s.Name) == 0 {
+		t.Error("name was not randomized")
+	}
+}
+
+func TestStructUnregistered(t *testing.T) {
+	t.Parallel()
+
+	var s struct {
+		Other struct{ x int }
+	}
+	if err := Struct(NewSeed(), &s, nil, false); err == nil {
+		t.Error("expected an error for a type that can't be randomized")
+	}
+}
+
+func TestNewSeedFrom(t *testing.T) {
+	t.Parallel()
+
+	a, b := NewSeedFrom(42), NewSeedFrom(42)
+	for i := 0; i < 3; i++ {
+		if x, y := a.NextInt(), b.NextInt(); x != y {
+			t.Errorf("%d) seeds diverged: %d != %d", i, x, y)
+		}
+	}
+}
+
+func TestInitialSeed(t *testing.T) {
+	old, ok := os.LookupEnv(SeedEnv)
+	defer func() {
+		if ok {
+			os.Setenv(SeedEnv, old)
+		} else {
+			os.Unsetenv(SeedEnv)
+		}
+	}()
+
+	os.Setenv(SeedEnv, "1234")
+	if start, err := InitialSeed(); err != nil || start != 1234 {
+		t.Errorf("want 1234, got: %d %v", start, err)
+	}
+
+	os.Setenv(SeedEnv, "abc")
+	if _, err := InitialSeed(); err == nil {
+		t.Error("expected an error for an invalid seed")
+	}
+
+	os.Unsetenv(SeedEnv)
+	if start, err := InitialSeed(); err != nil || start == 0 {
+		t.Errorf("expected a time based seed, got: %d %v", start, err)
+	}
+}
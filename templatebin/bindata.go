@@ -1,1291 +0,0 @@
-// Code generated by go-bindata. DO NOT EDIT.
-// sources:
-// templates/00_struct.go.tpl (7.222kB)
-// templates/01_types.go.tpl (2.472kB)
-// templates/02_hooks.go.tpl (6.687kB)
-// templates/03_finishers.go.tpl (7.298kB)
-// templates/04_relationship_to_one.go.tpl (884B)
-// templates/05_relationship_one_to_one.go.tpl (919B)
-// templates/06_relationship_to_many.go.tpl (1.878kB)
-// templates/07_relationship_to_one_eager.go.tpl (4.398kB)
-// templates/08_relationship_one_to_one_eager.go.tpl (3.903kB)
-// templates/09_relationship_to_many_eager.go.tpl (6.494kB)
-// templates/10_relationship_to_one_setops.go.tpl (7.568kB)
-// templates/11_relationship_one_to_one_setops.go.tpl (7.106kB)
-// templates/12_relationship_to_many_setops.go.tpl (15.771kB)
-// templates/13_all.go.tpl (588B)
-// templates/14_find.go.tpl (2.985kB)
-// templates/15_insert.go.tpl (7.18kB)
-// templates/16_update.go.tpl (10.916kB)
-// templates/18_delete.go.tpl (12.968kB)
-// templates/19_reload.go.tpl (4.306kB)
-// templates/20_exists.go.tpl (3.177kB)
-// templates/21_auto_timestamps.go.tpl (2.845kB)
-// templates/singleton/boil_queries.go.tpl (769B)
-// templates/singleton/boil_table_names.go.tpl (196B)
-// templates/singleton/boil_types.go.tpl (3.028kB)
-// templates_test/00_types.go.tpl (173B)
-// templates_test/all.go.tpl (211B)
-// templates_test/delete.go.tpl (7.608kB)
-// templates_test/exists.go.tpl (1.08kB)
-// templates_test/find.go.tpl (1.005kB)
-// templates_test/finishers.go.tpl (4.239kB)
-// templates_test/hooks.go.tpl (6.346kB)
-// templates_test/insert.go.tpl (1.692kB)
-// templates_test/relationship_one_to_one.go.tpl (2.676kB)
-// templates_test/relationship_one_to_one_setops.go.tpl (5.365kB)
-// templates_test/relationship_to_many.go.tpl (4.014kB)
-// templates_test/relationship_to_many_setops.go.tpl (10.967kB)
-// templates_test/relationship_to_one.go.tpl (2.739kB)
-// templates_test/relationship_to_one_setops.go.tpl (5.221kB)
-// templates_test/reload.go.tpl (1.561kB)
-// templates_test/select.go.tpl (868B)
-// templates_test/types.go.tpl (253B)
-// templates_test/update.go.tpl (4.117kB)
-// templates_test/singleton/boil_main_test.go.tpl (2.078kB)
-// templates_test/singleton/boil_queries_test.go.tpl (975B)
-// templates_test/singleton/boil_suites_test.go.tpl (12.439kB)
-
-package templatebin
-
-import (
-	"bytes"
-	"compress/gzip"
-	"crypto/sha256"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes  []byte
-	info   os.FileInfo
-	digest [sha256.Size]byte
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _templates00_structGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\xdd\x6f\xdb\x36\x10\x7f\xb6\xfe\x8a\x83\x91\x0e\x76\xe0\x28\x7b\x0e\x50\x0c\x5d\x9a\x66\xd9\x5c\xb7\x49\xbc\xed\xa1\x28\x1a\x46\x3e\x2b\xec\x24\xd2\x25\xe9\xa6\x86\xca\xff\x7d\xe0\x87\x3e\x2d\x39\x76\x92\x36\xed\x93\x65\xf1\x3e\x7e\xf7\xe3\xf1\x78\xba\x2c\x3b\x80\x3d\x92\x50\x22\xe1\xe8\x39\x84\x2f\xcc\x13\xca\x70\x4a\xae\x13\x04\xf7\x13\x4e\x48\x8a\x70\xa0\x75\x60\x85\xb9\xa0\xf1\x07\x75\x9d\x7c\x60\xe6\xb5\x51\x6a\x48\x05\x87\x87\x90\x65\xce\x68\xf8\xf7\xe2\x92\xb2\x78\x99\x10\xa1\x35\x50\x09\x84\x01\xbf\xfe\x88\x91\x02\x81\x0b\x81\x12\x99\xa2\x2c\x06\x75\x83\x30\x23\x8a\x5c\x13\x89\xa0\xac\xbd\x40\xad\x16\xd8\x61\x48\x2a\xb1\x8c\x14\x64\x41\xcf\x40\x12\x84\xc5\x08\x7b\x11\x4f\x96\x29\xab\x20\x3a\xb6\x2f\xa4\x05\x65\x05\x8d\xc8\x8b\x3c\x56\x6f\xd7\x09\xe5\xda\x65\x14\xbd\x32\xd8\x88\x97\xc1\xb6\xcb\xd5\x10\x84\xc7\x3c\x4d\x91\x29\xf8\x0a\x72\x91\x50\x35\xa6\x0c\x2d\x08\xb0\xc4\x40\x08\x4e\x0d\xd9\x2c\xb7\x40\xe7\x40\x63\xc6\x05\x36\xe9\x6d\x00\xd8\x0b\xa7\x24\x3e\x73\x92\x5e\xb5\x88\x49\x6b\x70\xff\x0c\x84\xe9\x6a\x81\x5a\xc3\x55\x96\xc5\xc8\x50\x10\x85\x4e\x6b\x4a\x62\xe9\xac\x48\xad\xaf\x39\x4d\x8e\xfa\xa5\x92\x89\x49\xeb\x3e\x7c\x94\x9c\x1d\xf5\x0f\xfa\xa0\x78\x9a\xd8\x87\x15\x71\x0f\x57\x16\x77\x22\x11\xe8\x1c\xf0\x13\xec\x85\x97\x76\x27\xa6\x24\x3e\x26\xd2\x6c\x64\x5f\x51\x95\x60\x7f\x57\x74\x15\x5c\x50\x87\xb3\x19\x64\xfd\x3d\x7c\x05\xeb\xfe\x98\x48\xd4\xda\xd2\x5a\x2c\x2f\x93\xc4\x24\x85\xd6\x23\x9e\x52\x85\xe9\x42\xad\xec\x16\x18\x5b\x2e\xce\x4d\xb6\x72\x0a\x1e\xc5\xdf\x16\x2c\x46\x24\xc5\xe4\xe9\x58\xb4\xee\x1f\x89\xc5\x8a\xad\x4e\x16\xef\xe3\x6f\x0b\x16\xed\x09\x7f\x30\x8b\x5e\x67\x1b\x0a\xbd\xe8\xfd\x38\xf3\xca\x75\x92\x76\xb5\x58\xb2\xf2\x24\xb9\xf3\xd0\x7c\xe9\xca\x91\x9d\x19\x28\x6b\x6b\xe5\xf1\xc0\xa4\x8a\xbf\x1c\xce\xe4\x9f\x9c\x32\x77\xcf\x15\xcb\x86\x38\xf3\x7c\x01\xfb\xc5\xc5\xf3\x92\xdf\xb2\xf2\xea\xb9\xe8\xe4\x2c\xbc\xc0\x84\x28\xca\xd9\x94\xc4\x15\xd2\xea\xaf\x2b\xac\x35\x17\x0a\x3a\x9a\x0b\x05\x1f\x8d\x85\xab\xa0\x37\x86\x0e\x98\xe3\xad\x4a\xff\xc1\xdd\xb5\xde\x93\xa7\x83\xe0\x33\x11\xed\xb7\x71\x7e\xcd\x3e\xaf\x5d\xcb\xdf\xea\x52\xae\xe6\xb3\x54\x82\xb2\xb8\x86\xf3\x7b\xf9\x3e\x82\xf5\xcc\x1d\x35\x18\xcb\xb2\xc3\x7d\x38\xf5\x9b\x30\x83\xdb\x1b\x14\x08\x37\x98\x2c\x50\x48\x98\x73\x01\x24\x49\xc0\x74\x39\x12\x28\xab\xb7\x40\xfb\x87\x5a\x9b\x3e\xaa\xa1\x1d\x94\xcd\x46\x57\x48\x74\x0e\x03\xce\x22\x7c\xbb\x54\xb0\x17\xbe\xfc\x7d\x6a\xed\xdb\x03\x3f\xf4\x51\xe4\xbd\xcc\x42\x50\xa6\xe6\xd0\xb7\xa6\xff\xb0\xb8\x9e\xc9\x3e\x0c\x62\xfe\x0f\x11\x56\xa8\x50\xcb\x7b\x31\x66\x23\x2d\x36\x1a\xe6\x14\x93\x99\xdf\x07\xd0\xc1\x7c\xc9\x22\x18\xdc\x96\x92\x43\x38\x39\x1f\x7c\x81\x2c\xf3\x15\x67\x08\x9f\xd2\xf0\x7c\x89\x62\xf5\x9a\xcf\x20\x03\x81\x6a\x29\x18\x7c\x4a\x1d\x2d\xe1\xbf\x06\x8a\x3d\xea\x95\x33\x6e\x9e\x4e\xce\x07\xb7\xa1\xf5\x36\x82\x39\x49\x24\x8e\xe0\xcb\xd0\x55\x3a\xad\xcb\xa5\xc2\xd0\xc9\xb9\x17\x30\x35\xa1\x1d\xd9\xe4\x1b\x40\x53\x62\x79\x17\xb2\x49\x13\x5a\xdd\xa6\xdd\xc9\x16\xb4\x67\xd2\x48\x0c\xb6\x42\xe9\x65\xbd\xef\x61\x7b\xf8\x67\x72\xc2\xd5\x4e\x36\xbd\x78\xc5\x6c\x99\xee\x2d\x0e\xc6\xd3\x9d\xe9\x6d\xa1\x6b\x3c\x35\x6c\xb5\x87\x30\x9e\x9e\x3c\x8e\x8b\x93\x6e\x1f\xa7\x8f\x12\xc5\xe9\x86\x28\x4e\x1f\x27\x8a\xd3\x22\x0a\xf7\x3d\x21\xdf\x0a\x9a\x52\x45\x3f\xfb\x63\xdc\x99\x58\x93\x81\x4c\x68\x84\xf0\xee\x7d\x17\x86\x00\xe0\x33\x49\x96\x68\xcb\x64\x4a\xfe\xc3\xc1\xbb\xf7\x94\x29\x14\x73\x12\x61\xa6\x47\xf0\xeb\x08\x12\x64\xce\xce\x70\x18\x80\xad\x6e\x1f\x46\x4e\xcb\x28\xb9\x9a\xe5\xfc\x18\x73\x85\xc1\xe7\x40\x16\x0b\x64\xb3\x81\xfb\xef\x55\x8c\x09\x1d\x40\x19\xbb\xcf\x41\x36\x98\xa7\x2a\xbc\x74\x85\x6b\xd0\x7f\x26\xe1\x6c\x02\xbf\xf5\x47\x90\xe7\xa4\xd7\x97\x61\x18\x0e\x83\xf6\x53\xbf\x4d\xbc\xbd\x9d\xc2\xed\x6d\x8e\xb6\x77\x67\xb0\x3d\x1d\xf4\x1a\xa1\x4e\xb8\x6a\x89\x76\xf2\x66\xba\x31\x62\xa8\x9d\x49\xd7\xd2\xf8\x3f\xfe\x59\x6f\xba\xc9\xad\xe7\x27\xb8\xc7\x2b\x17\x50\x96\x95\xb7\x4f\xbd\x59\x7d\xa2\x6b\x7e\x2b\x6c\x99\xdd\x0b\xd7\x13\x54\xc7\x21\x5f\xcd\x57\x49\x74\x83\x29\x99\xba\xfb\x22\x5c\xfb\xf4\xd9\x0b\xcf\x97\x5c\xa1\x69\xfc\xf5\x7a\x03\xb1\xa9\x63\xad\x34\xac\x5d\x13\x97\x0b\x4c\x24\x50\xe9\x5b\x0f\xe1\xdb\x47\x79\x43\x17\x60\xa2\x90\x40\x04\x82\x54\x5c\xe0\x2c\xec\x4e\x0b\x6b\xa5\x2d\x2b\x3c\xb0\x57\x7f\xe1\xaa\xca\xb6\xc0\x35\xb6\x2f\xaa\xae\xeb\x64\xe7\xd2\xe1\x2b\x2e\x90\xc6\xac\xb5\xaf\x5b\xf3\x39\xe5\x6f\x18\x56\xad\x56\x01\xcc\xed\x04\xc9\xba\x6f\x4e\xb4\xbc\x93\x46\xdf\x5f\x87\xec\xd4\xb7\xc2\x3c\xe6\x11\x49\xb6\x45\xfc\x9a\xb0\x55\x17\xe4\x1a\x80\x02\x74\x53\xa3\x81\xdf\x81\x0a\xcb\xb4\xb0\x8f\x16\x93\xd9\x93\x1d\x21\xdb\x76\xd5\x41\x56\x3c\x25\x6c\xe5\xba\x50\xfd\xed\x37\xdc\x1d\x9c\xf5\xf7\xf5\x8e\xfa\x07\xcf\x81\x46\x10\xfe\xed\x9d\x21\xfc\x50\x49\xb1\x45\x0c\x5d\x59\x52\x1f\xfb\x36\x3f\x9a\x5b\x6b\x50\xbd\xfc\xd4\xc7\xbd\x4d\x03\x5b\x17\x9f\x07\xee\xfb\x7d\xca\xd5\x7e\x96\xe5\xf9\x52\x9b\x52\x77\x4d\x0a\xd6\x4d\x14\xd3\x82\x96\x33\x50\x4e\x0c\xda\x16\x8b\xa9\x41\xdb\x62\x31\x39\x68\x59\xbc\xfa\x89\x8e\xd6\xfd\x19\xf6\x06\xd6\xf9\xcd\x53\xbb\x85\xdd\x62\x69\x9d\xdb\x62\x69\x9d\xd9\x7c\xe9\x2e\x5e\x37\x9d\xf7\x07\x12\xfb\x5d\xae\x8d\x2c\xcb\xc7\x06\xcf\xe4\xa5\xe9\x70\xfb\xf0\x33\x6e\xcd\xc6\x32\x36\xc1\x5b\x37\x4b\x86\x48\x20\x51\xa6\x4f\x02\x86\xb7\xf5\x06\xca\x55\x24\xff\x89\xd1\x39\x2e\x1c\x96\xc6\x06\xc3\x0d\x53\xc5\xac\xf8\x02\xf8\xa5\x4b\x26\xbb\xa3\xca\x8e\xcb\x2a\x3b\xe6\x64\x06\x29\xaa\x1b\x3e\x73\x93\x26\x24\xd1\x4d\x1d\xfe\xb6\xa5\x77\xec\x03\xcd\xaa\x5f\x16\xff\x07\x00\x00\xff\xff\x87\xa0\x58\x05\x36\x1c\x00\x00")
-
-func templates00_structGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates00_structGoTpl,
-		"templates/00_struct.go.tpl",
-	)
-}
-
-func templates00_structGoTpl() (*asset, error) {
-	bytes, err := templates00_structGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/00_struct.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x73, 0x2b, 0xdc, 0x47, 0x2d, 0x77, 0x3d, 0xe9, 0xea, 0x49, 0x65, 0xcc, 0x5, 0x13, 0xaa, 0x1b, 0x7f, 0x6c, 0xe5, 0x8a, 0x4, 0x17, 0x81, 0x97, 0x28, 0x2d, 0x2a, 0x64, 0x87, 0x10, 0xac, 0x85}}
-	return a, nil
-}
-
-var _templates01_typesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x51\x6f\xdb\x36\x10\x7e\xb6\x7e\xc5\xc1\x18\x36\xbb\x70\xe4\xf7\x01\x7e\x48\x93\x15\xeb\x0a\x67\xdd\x9c\x20\x0f\x41\x30\x30\xd4\xc9\xe2\x42\x91\x0c\x79\x5c\x22\xa8\xfc\xef\x03\x29\x69\x76\x37\xcb\x71\xbb\xe6\x25\x47\xdd\x7d\xdf\x7d\x9f\x74\x3c\xb7\xad\x28\x21\xbf\x66\x0f\x12\xf3\xf7\xee\x17\x2d\x54\x8a\xe1\x2c\x84\xac\x6d\x51\xba\x21\x3c\x83\xef\x98\x14\xcc\xc1\x8f\x2b\xc8\xcf\x63\x84\xae\xc3\x0d\xf0\x2b\x56\x77\xc5\x7f\x31\x0b\xb3\x6c\xd2\xb6\x1d\x22\xbf\xd4\xcf\x6a\x23\xd4\xd6\x4b\x66\x43\x38\x97\xf2\x42\x4b\x5f\x2b\x07\x9f\xff\xad\xe0\xee\xde\x91\x15\x6a\xdb\xb6\xd3\x76\x1a\x42\xdb\xf6\xcc\x43\xfd\x27\xe0\x29\x8a\x9d\xe2\xa9\xab\x5e\x33\x03\xf9\x26\x85\xef\xbc\xe2\x2e\x7f\xf2\x9a\xf0\xd6\x32\x03\x9f\xe0\x4f\x2d\x14\x4c\x17\x90\xe8\xa6\x61\x1a\x42\x14\x16\x3d\x5f\x0a\x26\x91\x53\x7e\xe3\xf0\xdc\x93\x1e\x7a\x9c\x75\x15\x07\xa5\xf7\x35\xb7\x82\xaa\x08\x39\x49\x71\x29\x24\xa1\xed\xcf\x6f\x9b\x84\x23\xeb\xf1\x7f\x98\xf9\xdc\x0b\xaa\xe2\x54\xd1\xda\xd3\x25\x96\xcc\x4b\xfa\x1a\xe9\x03\xb4\x64\x71\x2a\xbe\x95\xfc\xd7\x34\x0f\x5d\x4f\x1c\x90\x11\xcd\xdf\xf4\x8d\x1f\x94\xfc\xd1\x8a\x9a\xd9\xe6\x03\x36\xfb\xd3\x3d\x2e\xf9\xe3\x07\x6c\xf6\x74\x7f\xdd\x28\xcf\xb3\x8c\x1a\x83\xf1\xb6\x2d\x97\xf0\x8f\xb2\x1b\xb3\xd3\xb5\x91\x82\x23\x08\x07\x4c\x41\x77\x81\x4b\x6d\x81\x81\x4b\xcf\x75\x09\x46\x0b\x45\x68\x1d\x90\x3e\xcc\x90\x27\xf2\xeb\x4a\x38\x70\x95\xf6\xb2\x80\x2d\x2a\xb4\x4c\xca\x06\x1e\x10\xbc\xc3\x02\xb4\x31\x3a\xfe\x27\x0d\x77\xf7\x63\x2c\x47\xf4\xdd\xdd\xbf\x39\x98\xed\x2f\xab\xd2\x04\xf9\x95\xfe\x59\xeb\xc7\xfe\x86\x8e\xd9\x8d\x25\xd1\x2d\x55\x08\x4e\x6c\x15\x23\x6f\x31\x59\xe6\xde\x91\xae\x0f\xa3\xa0\x8a\xb0\x1a\xa9\xd2\x85\x1b\x11\x9a\x98\x4b\xaf\xf8\xac\xdb\x1f\x57\xfa\x42\x2b\xc2\x17\x0a\xe1\x41\x0b\x99\xff\xf4\x82\xdc\x93\xb6\xdd\xd6\x0c\x81\x77\xd9\xbc\xaf\x5a\x40\xaa\xea\x4f\x7b\xc5\xaa\x08\x61\x01\x87\xed\xcf\x01\xad\xd5\x36\x2a\x3a\x83\x54\x99\x8d\x0e\xe0\x6f\x1e\x6d\x13\xe7\xc8\x73\x82\x36\x9b\x4c\xde\x3c\x79\xb4\x02\x5d\x9e\x32\xd9\x24\x8d\xcb\x72\x09\x17\x8c\x57\xdd\x2b\x11\xca\xa1\xa5\x05\x78\x53\x30\x42\x60\xaa\x00\x6f\xe2\xa3\x57\x56\xf8\x75\x9c\xb9\x15\x58\x2c\xd3\x06\x8d\xc7\x5f\xcb\xd9\xf7\x07\x2d\xb4\x61\x3e\xca\xb3\x66\xc6\x08\xb5\x85\x15\x0c\x52\xd7\xec\x11\x37\xc9\x42\x9f\x9b\x1d\x91\x30\x4e\xbc\xbb\x8c\x3d\xcd\x02\xfe\xd8\xeb\xf2\x56\xa8\xe2\x04\xfe\x05\x1c\xd7\x3d\x9a\xff\xcf\x2e\x18\x57\xfa\x3e\x7d\x82\xf4\x49\xd6\x9e\xc0\x35\x8a\xe7\xbf\xdf\xae\x3d\xe1\xcb\x29\x18\x58\x41\xcd\x1e\x71\x56\x33\x73\xd7\xad\x90\x7b\xb1\xcb\x8e\xb7\xbd\x49\x5f\xfc\xcb\xda\xee\x61\x0e\xb4\xf5\xbb\xec\xb1\xb6\x5f\xee\x76\x0f\xf3\x9a\xdb\x79\x36\x0c\xee\x72\x09\xef\xb4\xe5\x08\x24\x6a\x04\xc3\xf8\x23\xdb\x22\x14\x68\x50\x15\xa8\x78\xd3\x2d\x41\x4f\xba\x66\x84\x05\x74\xd6\x8a\x73\x5a\x5e\x58\xec\xa2\x3c\x9b\xc4\x91\x89\xf8\x7c\x83\x5c\xab\x62\x8f\xf5\xa9\xae\x50\x1a\xb4\xff\x66\x7c\xae\xd0\x22\x70\xc9\xbc\xc3\x7e\x4b\x92\xd0\x0a\x66\xcf\x95\xe0\x15\x14\x1a\x9d\xfa\x81\x12\x11\x93\xcf\xac\x71\x50\x31\x63\x50\xcd\xbb\x66\x03\x6d\x7e\x1b\x79\xb2\x79\xb6\xfb\x75\xff\x3b\x00\x00\xff\xff\x79\x00\x66\xe8\xa8\x09\x00\x00")
-
-func templates01_typesGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates01_typesGoTpl,
-		"templates/01_types.go.tpl",
-	)
-}
-
-func templates01_typesGoTpl() (*asset, error) {
-	bytes, err := templates01_typesGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/01_types.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xae, 0xf7, 0x2b, 0xbf, 0x37, 0x35, 0x4b, 0xfe, 0xf8, 0xa3, 0xc7, 0x2c, 0xa5, 0x9f, 0xff, 0xa5, 0x5d, 0xae, 0xef, 0x71, 0xaa, 0x51, 0x8c, 0x4e, 0x2f, 0x56, 0xfc, 0xe7, 0xc5, 0xa6, 0xd2, 0xa7}}
-	return a, nil
-}
-
-var _templates02_hooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xc1\x4e\xdc\x30\x10\x3d\x6f\xbe\x62\x8a\x7a\xc8\x56\x4b\xb8\x53\x71\xd8\x96\x4a\xe5\x82\x2a\x51\x4e\x55\x55\x99\x64\x02\x16\xa9\x1d\x39\x4e\xd9\x2a\xf2\xbf\x57\xb6\x17\x62\x92\xec\xc6\x5e\xad\x14\xa4\xf4\x04\xac\x67\x9e\x9f\xdf\x9b\x71\x66\x43\xd3\x9c\x02\xcd\x81\x71\x09\xc9\x35\xff\xca\xf9\x63\x05\xa7\x4a\x45\xfa\xf3\xf7\xa4\xa0\xa4\x82\xf3\x0b\x48\xd6\xfa\x37\xac\x92\xef\xe4\xae\x40\xb0\x3f\x92\x6b\xf2\x1b\x95\x8a\xa2\x3f\x44\x40\xd3\xd8\xe8\xe4\x92\x3f\xb1\x1b\xca\xee\xeb\x82\x08\xa5\x3e\x61\xce\x05\x5e\xb1\x0a\x85\xb4\xe0\x3f\x7e\xbe\x84\xde\x96\x6d\xa0\x5e\x1c\x07\xba\x2d\x33\x22\xf1\x08\x40\x97\x58\xe0\x51\x80\x6e\x4b\xbf\xa3\xed\x43\x5a\xe7\x12\xc5\x11\x34\x32\x38\x37\x58\x60\x7a\x04\x9c\x23\x48\x6d\x70\x8e\xa0\xf4\x96\x8f\xa7\xd0\x67\x67\x90\xf1\x7e\xdd\xe1\x06\xd3\x5a\x62\x05\xa4\x28\xe0\xe4\xce\xac\x03\x35\x01\x27\xf0\xa0\x43\x92\x28\xaf\x59\x0a\x31\x87\x0f\x83\xf0\xcb\x21\xdc\xb8\x69\x68\xae\x5b\xe7\x33\x67\x12\x37\x52\x29\xbd\x11\xdc\x71\x5a\x24\x5f\xcc\x96\x5c\x34\x0d\x16\x15\x2a\x95\xca\x0d\xa4\x36\x2c\xd9\x86\xaf\xa0\x0d\xdf\x7e\xe4\x64\xb1\x4c\xef\x1a\xa3\x10\x80\x42\x70\xb1\x84\x26\x5a\x98\x0d\xb7\xfd\xba\x4d\x31\x1d\xbb\xa0\xb9\xc5\x31\xbc\xd6\x02\x6f\x1e\x69\x59\x62\x16\xa7\x72\x63\x12\x17\x02\x65\x2d\x18\x30\x5a\x44\x0b\x15\x69\x24\x64\x99\xcd\xcd\xb9\x80\x5f\x2b\xa3\x83\xee\x77\x41\xd8\x3d\xfa\xf7\xb4\x06\xa7\xb9\xe6\xa8\x93\x35\x48\x3c\xc0\xd2\x08\xb0\x82\x97\x5d\xcd\xd1\x57\xc0\x97\x1f\x4d\xe6\xbb\x0b\xcd\xcc\x60\x3d\x33\x45\x21\xa2\xc5\x42\x59\xb6\x0e\x7b\xf5\xca\x65\xb7\x52\x07\x5d\xb6\x01\xc1\x2e\x3b\xb8\xb3\x75\xd9\xd5\x76\x5a\x97\xdd\x7b\x64\xd0\x65\x1b\x10\xec\xb2\x83\x3b\x5b\x97\x5d\x6d\xa7\xee\xe5\x91\x1b\xdb\x06\x1c\xd0\xcb\xff\x6f\x6c\x57\xdb\xa9\x5c\xee\x8d\x3a\xaf\x4d\x26\x7a\x19\xae\x42\x9f\xca\x5d\xd4\x19\x5a\xdc\x13\x76\x52\x87\xdd\x21\x74\xc8\x61\xbb\x1e\xea\xb0\x83\x3a\x57\x87\x5d\x61\x27\x75\x78\xf7\xd0\x65\x1d\x0e\x9e\xb9\xba\xa8\x73\x75\xf8\x2d\x4c\x5c\xbd\x2f\x6e\x43\x0e\x07\xcf\x5b\x5d\xd4\xb9\x3a\xfc\x16\xa6\xad\xde\x57\xea\xe1\x1e\x3e\xe4\x39\x3c\xef\x51\xab\x27\xec\x34\x0e\xaf\xb3\x6c\xe7\x2b\x12\x10\x78\x4f\x2b\x89\xa2\x82\xbf\xbc\x16\xf6\x5c\xda\x5c\x49\x39\x03\x7d\x58\x5d\x03\x79\x2d\x6b\x81\xc0\x4b\x14\x44\x2f\x3c\x17\xc0\x3e\xe4\x58\x43\x7d\xe3\x94\xc9\x56\x6c\xf3\xe7\x6a\x97\x5c\x86\xcf\x4e\x3c\xe3\x4c\xf5\x44\x65\xfa\x00\x2d\xb4\x56\x21\x25\x15\xda\x3d\xba\x6f\x23\xce\xb5\x46\xde\x6f\x2e\x2e\x80\x94\x25\xb2\x2c\xf6\xcd\xd8\x7b\x92\xe5\x00\xb3\xf6\x3e\x1f\x67\xe6\xde\xfd\x7e\xcc\x9c\x8c\x60\x66\xed\x3d\x34\xce\xcc\xbd\xb3\xfc\x98\x39\x19\x07\x68\xe6\xef\xa6\xdb\x6b\xbe\x9a\x1d\xe2\x66\x67\xc0\xde\x47\xac\x37\x8b\x8f\xf1\xea\x26\x84\xd2\x6a\xa7\xc2\x51\x5a\xee\x00\xe9\x45\xcb\x49\x08\xa5\xe5\x57\xfa\xbd\xa9\xc7\x8b\xd6\x61\x85\xdf\x79\xfe\x8e\xd2\x0a\x29\xfb\x6e\x42\xb8\x5a\xde\xb5\x15\x52\xf3\xdd\x84\x31\x5a\x2a\xb2\xff\xfc\x31\xcf\xd7\xe8\x5f\x00\x00\x00\xff\xff\x7d\x83\x65\x8e\x1f\x1a\x00\x00")
-
-func templates02_hooksGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates02_hooksGoTpl,
-		"templates/02_hooks.go.tpl",
-	)
-}
-
-func templates02_hooksGoTpl() (*asset, error) {
-	bytes, err := templates02_hooksGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/02_hooks.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf5, 0xad, 0x10, 0xfe, 0x4f, 0xf6, 0xe9, 0x46, 0xc6, 0xae, 0xd1, 0x39, 0x3, 0xed, 0x27, 0xfb, 0x84, 0x7d, 0x9c, 0xe6, 0x5, 0x26, 0xdb, 0x92, 0x8a, 0x1a, 0xfc, 0x97, 0x2, 0xd6, 0xdd, 0x32}}
-	return a, nil
-}
-
-var _templates03_finishersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x98\x51\x6f\xdb\x36\x10\xc7\x9f\xad\x4f\x71\x0b\x86\x40\x1a\x54\xb5\x03\x86\x3d\x64\xf0\x00\x37\x0d\xb2\x87\x21\x75\xeb\x0d\x7b\x18\x86\x41\x96\x4f\x2e\x1b\x86\x8c\x49\x7a\xce\x20\xe8\xbb\x0f\x47\x4a\xb6\xac\xc8\xb1\x25\xcb\x0e\xf6\xd2\xda\x22\x75\xbc\xfb\xff\x4f\xf7\xb3\x92\x65\x6f\xe0\xdb\x98\xb3\x58\xc3\xd5\x10\xa2\x11\x7d\x42\x1d\xfd\x16\x4f\x39\x82\xfb\x2f\xba\x8b\x1f\x30\xcf\x3d\x2f\xcb\x58\x0a\xd1\x68\x36\xbb\xe5\x72\x1a\x73\x78\x93\xe7\xde\xdb\xb7\xf0\x51\xe0\x2d\x28\x34\x4b\x25\x34\xc4\xa0\x99\x98\x73\x84\x2c\x73\x61\xa3\x0f\x72\x25\x26\x4c\xcc\x97\x3c\x56\x79\x0e\x0a\x13\xa9\x66\x90\x2a\xf9\x00\xe6\x0b\xc2\x62\x89\xea\x5f\x58\xd2\x5d\xf6\xfb\xdc\xc5\xc6\x27\x4c\x96\x46\xaa\xc8\x4b\x97\x22\x01\x7f\xb1\x2b\xe0\x27\xba\x3f\xb0\x49\xf8\x36\x41\x21\x0d\x44\x77\xf2\x5a\x0a\x83\x4f\x26\xcf\x13\xf3\x04\x89\xfb\x12\x15\x17\xb3\x0c\xc5\x2c\xcf\x03\xf0\xbf\x5b\x47\xfd\xfd\x71\x13\x33\x04\x54\x4a\xaa\x00\x32\x6f\xe0\x0a\x83\x45\xf4\x51\xa0\x3b\xa0\x1a\x7c\x2a\x19\x8f\x6e\xd1\x7c\x78\xef\x07\x59\x86\x5c\xa3\x3d\x30\x84\x72\xa1\xd8\x59\xac\x8b\x19\x89\x16\x78\x56\xcc\xe2\x5b\xa1\x6b\x2c\x66\x55\x6d\xe9\xe3\x38\x16\x2c\xa9\xaa\x3c\x3e\x99\xcc\xa1\x3d\xff\x91\x0e\xd4\x20\x85\xab\xbf\x8d\xf6\xe3\xf6\xe2\x37\x6b\x4f\x9a\x4b\x6b\x00\x35\x64\xbf\xb2\x0f\x58\x6a\x03\x7f\x33\x04\xc1\x38\x9d\x34\xb0\x25\xfb\xf6\xb6\x3f\x54\xfc\x78\xa3\x94\x8f\x4a\x05\x81\x37\xc8\xbd\xb5\xf9\xb2\xc9\xb0\x26\x87\x8e\x35\xe8\x58\x1b\xc6\xcf\xa5\x22\x87\x9d\x2c\x37\x85\xd7\x15\xc1\xea\xde\x84\xb0\xd9\x5e\x5c\xaa\xdc\xf5\xe2\x33\x13\xec\x34\xae\xa1\x27\x42\x58\xab\x69\x4f\xec\xcf\x1a\xe7\xc3\x91\x36\xb4\x50\xfc\xf5\x04\xaf\x0e\x29\x49\x92\x5f\x36\x6e\xcb\x48\x2c\xaa\x8a\xa1\x8e\x26\x68\x7e\x65\x0f\xcc\xf8\x8b\xe8\x93\xeb\xb7\xef\x03\xcf\x1b\xac\x3d\x7b\xcf\xc4\xec\x79\x45\x82\xf1\x4a\x09\x45\x5e\x2e\xf3\x10\x64\xa3\x77\xee\x8a\x54\x3a\xba\x8e\x97\x1a\xad\x71\x30\x1c\x82\x5e\xf0\xe8\x46\xa9\x3b\xf9\x59\xae\xb4\xdd\x59\x1a\x29\x18\x0f\xb7\x97\xbd\xc1\x20\xf7\xb6\xd7\x8b\x98\xd4\x0e\x14\x32\x84\x8b\x2c\x8b\xc6\xf7\x73\x47\xa8\x2b\x48\x63\xc6\x71\x06\x46\x16\x83\x0d\x21\x06\x29\xca\xe9\x97\x4a\x05\x59\xb6\x05\xb5\x8b\xa2\x9b\xaa\x8d\xfa\x8b\x94\xf7\xda\x76\x53\x59\xd8\xd5\x10\x64\x34\x93\xa3\xd4\xa0\x9a\x20\xc7\xc4\xd8\x3d\x87\xb7\xf7\x4f\x75\x7d\xca\xee\xb5\x25\x51\x0a\x03\x02\xb1\x15\xb6\xd2\xdb\x21\xed\xf7\x76\x93\x77\xc4\x79\x85\xbc\x9c\x43\xf3\x44\x75\x3d\xae\x7b\x67\x2e\x1d\xdf\x81\xb9\x8d\x49\x4e\x38\x4b\xb0\x91\xbb\x23\xce\x5f\x93\xbb\x54\xe4\xf8\x04\x22\x1f\x35\xea\x6d\x52\xed\xa5\xdf\xad\x7c\x7d\x78\xf7\x29\xfa\x69\xa9\x3b\xe2\xbc\xbb\x3d\xc7\x9a\x70\x0e\xde\x76\x30\xed\xdc\xc4\x1d\x71\xde\xd9\x82\x16\x6a\x9f\x83\xb5\x87\x0d\xa7\x7f\x62\x05\x12\xfe\xfc\xab\x99\xcc\x47\x12\xf5\xb2\x19\xa9\xdd\x38\x18\x6b\xcd\xe6\xc2\xba\xe2\x06\x92\x42\xbd\xe4\x46\xd3\x5a\xb3\x4d\x9a\x8a\xdd\xcf\x45\x8e\xc2\xdf\xe1\x58\x9d\x93\x01\x95\xf1\xce\x16\x41\x08\xfe\x3b\x04\x39\xfd\x4a\xf2\xa8\x58\xcc\x11\xa4\xfb\x1d\x50\x61\xed\xf4\x6b\xbf\xb4\x7d\xc6\x5b\xf7\xcb\x22\xef\x0c\xde\x6b\xb9\x14\x66\x83\x5e\x6a\xe6\x84\x2e\x81\x4c\x0f\x78\x00\x98\xe8\x69\x02\xb9\x34\x3a\x20\x98\x09\xf3\xe3\x0f\x8d\xb4\xb5\x21\x5f\x93\xb7\xae\xa6\x71\x1f\xda\x9e\x08\xbe\x45\x86\xed\x65\xb7\xaa\x93\xda\x49\x65\x68\xf7\x2b\x78\xd7\xa1\x9e\x1c\xc4\x5a\x9b\x6b\x2f\xd6\x1c\x6f\xc0\x39\xd0\xbb\xcf\xb0\x93\x72\xb6\x6e\x49\xa9\x7f\x1f\xf2\xb7\x52\xfa\x1c\xd8\x7d\x36\x90\x88\xb0\xae\x38\xbb\xb4\xfd\x0e\xeb\xa8\xb0\x79\x89\x15\x8c\x07\x5b\x1b\x5c\xde\xc5\x7a\x50\x72\x6c\x53\x82\xa3\xd8\xda\x52\xbb\xcd\xfd\xfb\x59\xae\x7c\xeb\x5b\x34\x49\x62\xe1\x5f\xda\x1c\x02\x0a\x40\x05\xbe\x78\x5f\x11\xdb\xb7\x5d\xb0\x23\x46\x61\xe6\x6e\xbc\xbf\x6b\x03\x77\x27\x50\xed\x95\x16\x94\x5c\xe9\x8b\x5a\x2f\xd1\xc6\x7d\x50\xbb\x79\x62\xda\xe8\x5b\x48\xbe\x60\x72\xaf\x81\xa5\xb6\x5f\x94\x5c\x01\xda\x95\xb2\x83\x0c\x1d\x76\xd4\x03\x5c\x9c\xd4\x01\x5c\x53\x29\x79\x23\xb7\x5c\xc8\xd7\x04\x57\x51\xd4\xf8\x30\xfd\x4e\xc4\xa6\x32\x89\xf6\xd2\x92\xb2\xa4\x28\x56\x46\x5d\xcf\xa2\x76\x9d\x84\x78\x10\x9c\x5c\xb2\x07\xca\xdf\x83\xc8\xe7\x00\xd0\x3e\x53\x4e\x0a\xa0\xba\xec\x6b\x8d\x0f\x93\xb8\x9d\x9a\xe7\x80\x4c\x7d\x78\xf4\xca\x98\x7d\x7f\x63\xfd\xdf\x10\x28\x8d\xb9\xc6\x56\x14\xa2\x6e\xa0\x66\xa8\x83\xc8\x35\x45\x13\x8a\xe0\x67\x02\x9d\xc3\xd1\x7f\x01\x00\x00\xff\xff\xb2\x2e\xc3\xee\x82\x1c\x00\x00")
-
-func templates03_finishersGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates03_finishersGoTpl,
-		"templates/03_finishers.go.tpl",
-	)
-}
-
-func templates03_finishersGoTpl() (*asset, error) {
-	bytes, err := templates03_finishersGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/03_finishers.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x23, 0x2f, 0x8d, 0x1f, 0x86, 0xfe, 0x9b, 0x99, 0x63, 0x53, 0x25, 0xe3, 0x1f, 0xc2, 0xe6, 0x1, 0x63, 0x2e, 0xba, 0x2e, 0x55, 0xf8, 0x6b, 0xeb, 0xe7, 0x7b, 0xbd, 0x46, 0x2b, 0x58, 0xd4, 0xf6}}
-	return a, nil
-}
-
-var _templates04_relationship_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x93\x4f\x8b\xdb\x3c\x10\xc6\xcf\xf1\xa7\x18\x4c\x0e\xf6\x4b\x32\x7b\x5f\x08\x2f\x65\x97\xc0\xb6\x74\xe9\x36\x5d\x7a\x28\xa5\x68\xa3\x71\x2c\x2a\x4b\x8e\x24\x53\x82\xaa\xef\x5e\xf4\x27\x75\xd2\x2d\xf4\xe6\x89\x9e\xdf\x3c\x33\x8f\x22\xef\xd7\x20\x3a\xc0\x4f\xec\x45\x12\x3e\xd8\xb7\x5a\xa8\xf4\x0d\xeb\x10\xaa\x78\x4a\xd2\xe6\x62\x11\x2b\xc3\xd4\x81\x60\xd9\x7d\xa7\x13\xdc\x6e\xce\xdc\xf6\x1d\x9d\x6c\x16\x25\xd5\x52\xba\xd4\xe3\x76\x03\x4b\x7c\x23\x05\xb3\x64\xb3\x34\xa3\x38\x5b\x14\xa0\xfb\x07\xb0\xd5\x86\xc4\x41\xbd\xe2\x0c\xc9\x04\x65\x43\xfc\x48\x92\x39\xa1\x95\xed\xc5\x58\xc8\x47\x36\x5c\x11\x7b\xa6\x76\xba\x73\xf7\x24\xc9\x25\xc3\xe6\x40\xae\x58\x65\x4b\xfb\x17\xcf\x16\xef\xae\xb8\x10\xaa\x9b\x1b\xf0\x3e\x0e\x70\x16\x86\x00\xa3\x16\xca\x11\x07\xa7\xe1\xe5\x04\xae\x27\xe8\xf2\x19\xc4\x86\x55\x37\xa9\x3d\x34\x1a\xfe\xf3\xfe\x3c\xf1\xf3\xb8\x13\xea\x30\x49\x66\x42\x68\x5f\x35\x6c\x06\xcd\x2d\x20\xe2\x71\xc0\xa7\x89\xcc\xe9\xbd\xe6\x2d\x34\xde\x97\xc0\xf0\x5e\xff\x50\x73\x83\x24\x69\xc1\x57\x8b\x63\x11\xdb\xb8\xe1\x97\xaf\x17\xb8\xaf\x16\x8b\xe3\x80\x9f\x7b\x32\xd4\xd4\xb1\xd3\xc5\xae\x77\x5a\x4e\x83\x82\x9f\xb0\xc4\xa7\x49\x3b\xb2\x21\xc0\x06\xfe\xaf\x57\xa0\x71\x9e\xb9\xa8\x32\x99\x8b\x10\xda\x55\x4a\x58\x74\xc0\x14\x8f\xb7\xc8\xf9\x9c\x97\xfd\x33\xf7\x7c\x21\xc7\xa1\x27\x39\x92\xc9\xd3\x3c\xd8\xc7\x49\xca\xa6\xe6\x49\xc2\xbf\x31\x57\x97\xa6\x6b\x20\xc5\x23\x11\xaa\xcb\xd5\x36\xc0\xc6\x91\x14\x6f\x7e\xff\xb4\x82\x18\x18\x22\xb6\x67\x61\xdc\x7f\x8e\xeb\x79\xfc\x20\x27\xc3\x64\x08\x33\x93\xd4\x49\x2c\xc8\xe2\x8e\xdc\xd6\xe8\x21\x1f\xe7\xd0\x56\x50\x7b\x7f\xfd\x0f\x8c\x01\xed\xf6\x3d\x0d\x2c\xd5\x21\xd4\xd1\xd0\x90\x9b\x8c\x82\x84\x56\xe5\xf5\x28\x3e\xbf\xa4\xf2\xfd\x2b\x00\x00\xff\xff\xe8\x8f\x0c\x7b\x74\x03\x00\x00")
-
-func templates04_relationship_to_oneGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates04_relationship_to_oneGoTpl,
-		"templates/04_relationship_to_one.go.tpl",
-	)
-}
-
-func templates04_relationship_to_oneGoTpl() (*asset, error) {
-	bytes, err := templates04_relationship_to_oneGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/04_relationship_to_one.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2e, 0xb4, 0xd5, 0x6a, 0xe5, 0x1f, 0x32, 0x58, 0xf5, 0x93, 0xc7, 0x8a, 0x70, 0xed, 0xdc, 0xee, 0xc4, 0xc1, 0x1c, 0xc, 0xf2, 0x71, 0x21, 0xf2, 0x6c, 0xb3, 0xa4, 0x19, 0x46, 0xd9, 0x99, 0x82}}
-	return a, nil
-}
-
-var _templates05_relationship_one_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x93\xd1\x6b\xdb\x30\x10\xc6\x9f\xe3\xbf\xe2\x30\x79\xb0\x47\x72\x7d\x2f\x84\x31\x5a\x0a\x1d\x5b\xb7\x2e\x2d\x7b\x18\x63\xa8\xd1\x39\x16\x93\x25\x47\x92\x19\x41\xd3\xff\x3e\x24\xd9\x71\xb2\x8e\xe5\x49\x8a\xbe\xdf\x7d\xa7\xef\x2c\xef\xd7\x20\x1a\xc0\x27\xf6\x22\x09\xef\xed\x7b\x2d\x54\x5a\xc3\x3a\x84\x22\x9e\x92\xb4\x79\xb3\x88\x3b\xc3\xd4\x9e\x60\x69\x48\xc2\xf5\x66\xc2\x9e\xf4\x27\x45\x5f\x48\x32\x27\xb4\xb2\xad\xe8\x6d\x06\x12\xb1\x94\x2e\xd5\xbb\xde\xc0\x12\xdf\x49\xc1\x2c\xd9\xcc\xa5\x32\x38\xbb\x8d\xfa\xe6\xff\xfa\x3b\x6d\x48\xec\xd5\x2b\xcc\x90\x4c\xea\x04\xe6\x1a\x78\xde\x53\xa6\x1f\x58\x77\x41\xed\x98\xda\xea\xc6\xdd\x92\x24\x97\x3c\xab\x3d\xb9\xd1\x2d\xbb\xda\xd7\xb6\x35\xde\x5c\x60\x21\x14\x57\x57\xe0\xfd\xa9\x07\xfc\xa0\x77\x4c\x86\x00\xbd\x16\xca\x11\x07\xa7\xe1\xe5\x08\xae\x25\x68\x72\x1d\xf8\x49\x47\x2c\x9a\x41\xed\xa0\xd2\xf0\xc6\xfb\x31\x26\x7c\xee\xb7\x42\xed\x07\xc9\x4c\x08\xf5\xbf\x6a\x56\x9d\xe6\x16\x10\xf1\xd0\xe1\xe3\x40\xe6\xf8\x51\xf3\x1a\x2a\xef\xa7\x4b\xdf\xea\x5f\x6a\xae\x91\x24\x35\xf8\x62\x71\x18\xc5\x29\xa1\x6f\xdf\xcf\x70\x5f\x2c\x16\x87\x0e\xbf\xb6\x64\xa8\x2a\xb3\xe7\x74\xe1\x1b\x2d\x87\x4e\xc1\x6f\x58\xe2\xe3\xa0\x1d\xd9\x10\x60\x03\x6f\xcb\x15\x68\x9c\xbb\x1e\x55\x09\xcc\xeb\x10\xea\x55\x01\xe3\xcf\x7b\xd1\x00\x53\x3c\x4e\x94\xf3\x39\x39\xfb\xf7\x00\xe2\x64\x26\xe8\xd0\xb5\x24\x7b\x32\xb9\xaf\x7b\xfb\x30\x48\x59\x95\x3c\x09\xf9\x0f\xe6\xca\x0b\x83\x35\x90\xe2\x71\xae\xa1\x38\xbf\xea\x06\x58\xdf\x93\xe2\xd5\xe9\xaf\x15\xc4\x00\x11\xb1\x9e\x84\x31\x8f\x39\xbe\xe7\xfe\xb3\x1c\x4c\x4a\xfa\xc4\x24\x75\x12\x0b\xb2\xb8\x25\x77\x67\x74\x97\x8f\x73\x88\x2b\x28\xbd\xbf\xfc\x34\x63\x62\xdb\x5d\x4b\x1d\x4b\xfb\x10\xca\x68\x68\xc8\x0d\x46\x41\x42\x8b\xf1\x81\x29\x3e\x3f\xb6\x71\xfd\x27\x00\x00\xff\xff\x9e\xcf\x1d\x38\x97\x03\x00\x00")
-
-func templates05_relationship_one_to_oneGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates05_relationship_one_to_oneGoTpl,
-		"templates/05_relationship_one_to_one.go.tpl",
-	)
-}
-
-func templates05_relationship_one_to_oneGoTpl() (*asset, error) {
-	bytes, err := templates05_relationship_one_to_oneGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/05_relationship_one_to_one.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5a, 0x4f, 0x4a, 0xdc, 0x18, 0xd9, 0xbb, 0xcf, 0xb9, 0xb8, 0xb6, 0xab, 0x45, 0xe, 0xef, 0xc5, 0x6e, 0xb6, 0xc5, 0xd5, 0xf9, 0xd2, 0x9e, 0x2f, 0xbb, 0x4c, 0xf5, 0xc3, 0x74, 0x5e, 0x5e, 0xe3}}
-	return a, nil
-}
-
-var _templates06_relationship_to_manyGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x5d\x6f\xd3\x30\x14\x7d\x6e\x7e\xc5\x25\xaa\x44\x53\x75\x2e\xcf\x93\x22\x84\x36\x0d\x0d\xd8\xc4\xe8\x26\x1e\xa6\x09\x99\xe4\xa6\xb5\xe4\xd8\xad\xed\x6c\x4c\xc1\xff\x1d\xd9\x71\xf3\xd1\x66\x03\xde\x62\xe7\x9c\x73\x8f\xaf\xcf\x4d\xea\xfa\x04\x58\x01\xe4\x96\xfe\xe4\x48\x2e\xf5\x27\xc9\x84\x7f\x86\x13\x6b\x23\xf7\x16\xb9\x6e\x16\x13\xb7\x52\x54\xac\x11\xa6\x0a\x39\x9c\xa6\x7b\xda\xad\xbc\xa2\xe2\xf9\x1b\x72\x6a\x98\x14\x7a\xc3\xb6\xba\x61\x78\xca\x94\x1b\x2f\x78\x9a\xc2\x94\x7c\xe0\x8c\x6a\xd4\x0d\xd1\xeb\x90\xae\x5c\xc0\x17\xaf\xe3\x2f\xa4\x42\xb6\x16\x47\x34\x85\xdc\xa3\x87\xc4\x43\x67\x23\x1a\x7e\xe7\x9a\x96\xe1\xa9\x6b\x41\xbb\xfc\x22\x33\xca\x2f\x3e\xe3\xb3\x47\xf5\x6a\xea\x6c\x83\x25\x1d\xa8\xb9\xb6\x0c\x36\x7e\xc3\x94\xac\x3c\xee\xc8\x72\x46\xc5\x4a\x16\xe6\x1c\x39\x1a\xcf\x9c\xad\xd1\x84\xda\xcd\x91\xf5\x50\x2c\x21\x67\x03\x8a\xb5\xd1\x72\x09\x75\xdd\x1e\x9e\x78\xab\xd6\x82\x42\xa3\x18\x3e\xa2\x06\xca\x39\x98\x0d\x42\x5d\x1f\xfa\xd2\x4c\xac\x2b\x4e\x95\xb5\x6f\xb5\x13\x69\x1a\x4f\xee\xb6\x5f\x79\xa5\xbc\xca\x13\x33\x1b\xa0\x02\xf0\x17\x66\x95\x91\x2a\x0a\x79\x11\xd2\xc0\x0c\x77\x70\x50\x17\x0e\x25\x12\x6b\xe1\x91\xd1\xe0\x70\x5f\xff\x4c\xf2\xaa\x14\xd6\x42\xe6\x1f\x7c\xca\x44\x6e\x2d\x89\x8a\x4a\x64\x30\x93\x30\xaf\xeb\x10\x1b\x72\xb7\x5d\xb5\x36\x93\xb1\xa3\xce\x4a\x99\x6b\x20\x84\xec\x4a\x72\x53\xa1\x7a\xbe\x92\x79\xd2\x3b\xce\xb9\x7c\x12\x9d\x84\x47\x40\x1d\x4d\x1e\xa9\x82\x5d\x80\x6b\xb8\x7f\xe8\xb1\xa3\x09\x2b\x80\xa3\xf0\xca\x09\xbc\x49\xe1\x9d\x63\x4c\x3a\x78\x0a\x74\xbb\x45\x91\xcf\xda\xad\x05\x38\x30\x21\x24\x89\x26\x36\xf2\x17\xcc\x8a\x10\x70\x39\x9c\xaa\xd7\x75\x3c\x35\x04\xab\xe3\xb9\x4c\x0f\xc3\x39\x1e\xab\x5d\x49\x2e\x85\x40\xe5\x70\xb3\xf8\x58\xc8\x5a\x90\x02\xda\xfd\x7e\x20\xac\x25\x63\xd7\xe4\x0b\xdd\x54\xd2\xa0\xb6\x16\x52\x18\xd3\xdc\x13\xdd\xd6\xcb\xe4\x38\x59\x34\x0e\xbf\x6f\x50\xe1\xb8\xbb\xbe\x92\xbf\xdf\x11\x9d\xf4\x7d\xbc\x00\x49\xba\x88\x04\x8c\x27\xee\xb3\xe5\x6a\x25\xbe\x97\xdd\x07\xec\x6f\x7d\x1f\xb1\xf6\xbf\x0d\xfa\x67\x6f\x4d\x3e\xa8\xc8\xdd\xb7\x2a\xcf\xbb\x99\xd6\x87\x9f\x85\xfd\xc5\x6e\x90\x6f\x51\x35\x0e\x2f\xf5\x75\xc5\xf9\x6b\x3e\xe3\xdc\xb3\xf3\x1f\xd4\xc4\x23\xb7\xd0\xce\x5c\xdb\x25\xbf\x08\x3d\x72\x71\x1b\xfb\x1e\x74\xed\x6a\x82\xee\x96\x0c\x35\x59\xa1\xb9\x50\xb2\x6c\x5e\x37\x63\xb4\x80\x97\xcc\xc5\x49\xd4\x0e\xd8\x5e\xe0\x23\x9a\x15\x72\xcc\x4c\x5f\x22\x49\x20\xed\x8f\x5e\xa8\x74\x0c\x5c\xc0\xfd\x83\x36\x8a\x89\x75\xfd\x62\x47\xe6\xb1\x0d\x93\xa9\xd0\x54\x4a\x34\xb3\x1f\xd9\x28\xf2\x67\x77\x5d\x76\x3d\x59\xce\xc3\x2f\x4e\x0d\xfe\x66\xf3\x65\xf7\x3f\x1c\x80\x59\x01\xac\xf7\xd3\x0c\xb8\x3f\x01\x00\x00\xff\xff\xd0\x14\xc8\x5e\x56\x07\x00\x00")
-
-func templates06_relationship_to_manyGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates06_relationship_to_manyGoTpl,
-		"templates/06_relationship_to_many.go.tpl",
-	)
-}
-
-func templates06_relationship_to_manyGoTpl() (*asset, error) {
-	bytes, err := templates06_relationship_to_manyGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/06_relationship_to_many.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7b, 0x5, 0x47, 0x5b, 0x4a, 0xef, 0x67, 0xe7, 0xc9, 0xa5, 0x6c, 0x57, 0x7d, 0xd6, 0x74, 0x67, 0x81, 0xa6, 0x4, 0x5e, 0x6b, 0xb8, 0xd0, 0x85, 0x62, 0x6c, 0xb1, 0x20, 0x59, 0x3b, 0x55, 0xdf}}
-	return a, nil
-}
-
-var _templates07_relationship_to_one_eagerGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\xdf\x6f\xd4\x38\x10\x7e\x4e\xfe\x8a\xa1\xea\xa1\x04\xa5\x29\xbc\x72\x5a\x9d\xa0\x80\x8e\x3b\xd4\xbb\x6b\x41\x3c\x20\x44\xbd\xc9\x64\xd7\xd4\x6b\x6f\x6d\x07\x5a\x45\xf9\xdf\x4f\x63\x3b\xbf\xf6\x57\x81\xbe\x35\xde\xf9\x66\x3e\xcf\x7c\x9e\x19\xb5\x69\x4e\x80\x57\x90\xbf\x67\x73\x81\xf9\x5b\xf3\x97\xe2\xd2\xfd\x0d\x27\x6d\x1b\xd3\xaf\x28\x8c\xff\x88\xe8\x4b\x33\xb9\x40\x38\xae\xae\xf1\x0e\x9e\xcf\x3a\xdc\x9b\xbf\xf1\xce\x78\x23\x67\x75\x2c\xac\xf3\xf1\x7c\x06\xc7\xf9\x0b\xc1\x99\x41\xe3\x4d\x3d\x34\x1f\x42\x04\x40\x75\x0f\xe0\x8d\xd2\xc8\x17\x72\x0b\xa7\x51\x38\x90\x0f\x98\x5f\xa0\x60\x96\x2b\x69\x96\x7c\x1d\x90\xe7\x6c\x35\x41\x30\xbd\x20\xc4\x5a\x73\x69\x2b\x38\x5a\xb1\xbb\x39\xfe\x66\x8e\x7a\x17\x1f\xd6\x97\x5c\x2e\x6a\xc1\xf4\x18\x55\xa8\x49\x9c\x33\x25\xea\x95\x0c\x11\xc2\xc7\xf8\x36\x9d\x79\xb5\xc3\x3c\x5c\x65\x1b\x55\x1b\x34\xff\x6a\xbe\xe2\x96\x7f\x43\x43\xf8\x8d\x93\x63\x9f\x12\x33\x49\xe2\x84\xc3\x8e\x64\xdd\x13\xb4\x60\xf2\x52\x55\xf6\x15\x0a\xb4\x2e\xff\xc9\x02\x6d\x40\x4e\xc3\x8d\xbd\xa6\xf9\xd9\x04\xd7\xb6\xf1\xe9\x29\xbc\x53\xac\x6c\x1a\xaa\x49\x67\xdc\xb6\xc0\x84\x50\xdf\x0d\x30\x09\xc8\x16\xa8\x41\x28\x75\x5d\xaf\x41\x55\xf0\x8d\x89\x1a\x4d\x06\x05\x2b\x96\x58\x02\x97\x56\x81\x5d\x22\x79\x12\x8a\x95\x58\x82\xb1\xba\x2e\xac\x21\x63\xbb\x44\x50\xf3\xaf\x58\x58\x93\xc3\xfb\x25\x37\xc0\x0d\x54\x4a\x93\xe3\xf3\x93\x67\xa0\x47\x95\xcf\xe3\xaa\x96\x05\x24\x4d\xd3\xd5\xeb\x95\xfa\x2e\xbb\xb2\xb6\xed\xbb\x74\x27\xd5\xa4\x69\x78\x05\xc7\xf9\xb9\x3a\x53\xd2\xe2\xad\x6d\x5b\x84\xb9\xe2\x22\x7f\x7d\x8b\x45\x6d\x95\x6e\x1a\x7a\x0d\x6d\x5b\xd8\x5b\x28\xbc\x4d\x1e\x6c\x33\x08\xb6\xe1\x7b\x04\x91\x65\xdb\x66\x60\x3a\x55\xcd\x95\x12\x19\x34\x0d\x29\xb1\x6d\xe9\xda\xa8\x2b\x56\x60\xd3\x66\xb0\x52\xa5\x81\x9b\x1a\x35\x47\x93\xbf\x58\xaf\x05\x2f\x98\x55\x3a\x05\xd4\x5a\x69\x68\xe2\xe8\x1b\xd3\x60\x04\x2f\x10\x3e\x7d\x7e\x32\x5c\x70\x50\x2d\x95\x96\x8c\x7c\xb2\x60\x9f\x4d\x1c\xf1\x6a\xe0\xd4\xc4\x51\x14\x00\xb3\x9e\x5a\x9e\xec\x01\xa7\x71\xd4\xfa\xbe\x40\x38\xcf\x66\xe6\x22\x75\xb8\xbd\xdc\x08\x1a\xc7\x11\xd3\x0b\x27\xf0\x15\xbb\xc6\xe4\xd3\xe7\x49\x0e\x9e\x66\xf0\x2c\xdd\xa6\xc7\xab\x70\xa5\xfc\x02\x66\x33\x90\x5c\xb8\xe3\x68\x38\x84\xc7\xfb\x0a\x7e\xd1\x90\xde\xbd\xe6\xa9\xc4\x1b\xef\xca\x3f\x07\xc7\x69\x06\x6c\xbd\x46\x59\x26\xf4\x95\x75\x11\x9b\x86\x1a\x80\x63\x1f\x79\x0d\x04\x0c\xaf\xe0\x51\x57\xae\xb7\xe6\x9c\x8b\x64\x13\xe1\x49\xfe\xa0\x6f\xcf\xd0\x09\x66\x92\xe2\x7f\x6a\x8b\xfa\x79\x1c\x45\x24\xf8\x2f\x0e\x4a\xd9\xf3\xcd\xd8\xe7\xdf\x85\xf1\x39\xda\x48\x50\x14\x8e\xee\x4b\x8f\x2b\x4c\x1f\x82\x0d\x01\x1c\x79\xef\xea\x40\xfa\x5c\x74\x46\x91\x29\x5e\x77\xab\x1e\x37\x4a\x9a\xb3\xec\xb2\xf6\xfa\xa6\x66\x22\x61\xd9\x04\x95\x0e\x30\x59\xf6\xa8\x88\x9e\x1c\x97\x35\x82\xcb\x87\x3b\x1b\x11\x3f\xc4\x6d\x4f\xfe\x27\xc9\xdf\x20\xb9\xb3\xb4\x5b\x0c\x7f\xc8\x71\x1b\x47\xa3\xba\xd2\x67\x78\x7f\x02\xa5\xc3\xa4\x94\xb6\xa7\xce\xa5\x46\x5b\x6b\x49\xd5\xf3\x56\x44\xc1\x8d\xda\x73\xfc\xfe\x1f\xfd\x9d\xc4\x11\x00\xc0\xcd\x2a\x7f\xa3\xd5\x2a\xb9\x0a\x4d\xeb\x15\x67\x82\xe4\xf4\xc1\xe0\x65\xb1\xc4\x15\x6b\xdb\xa6\x39\xce\xbb\xbf\xf3\x10\xbe\x69\x26\x7d\xbc\x6d\xaf\xd2\xac\x77\xf8\x71\x89\x1a\xdf\xca\x07\xfb\xcc\x87\x13\x3f\x70\x5c\x9b\x83\x3f\xae\x32\xa7\xa5\x3c\xcf\xbb\xa0\x2e\x10\x93\x25\x4d\xfd\xb2\x1c\x06\x8a\xd9\x1c\x4c\xae\x28\x9e\xe6\x12\xc5\x1a\x75\x20\x6b\xce\x6b\x21\x1e\x4e\xb8\x74\x51\xca\x2f\xcc\x5e\x0d\xd4\x4e\xc0\x57\x8c\xbe\x7c\x43\x72\xed\xf9\xd1\xf0\xb6\xe8\xdb\xb5\xe9\xbb\xc4\xd5\x29\x74\xb7\xcd\x39\xe2\xd9\x6b\x34\xb5\xb0\x26\xa3\x5e\x4e\x05\x75\x88\xdc\xd7\x14\xd3\x78\x22\xbf\x03\xb6\xc1\x67\x52\xd8\xdb\x0c\x02\xae\x7b\x24\xbc\x72\x80\x11\xc3\x20\x27\x37\x3e\x4c\xfe\x51\xb3\x75\x82\x5a\x67\x70\x54\x31\x2e\xb0\x04\xab\xfa\xb1\xcc\x4a\xea\xfc\xd5\x76\xcf\x3e\x0a\xd7\xa2\xa9\xe2\x89\x5d\x8e\x06\xd0\x0e\x40\x4f\x64\xd6\x3f\xf3\x97\x5c\x96\x49\x7f\xab\xc7\x23\x37\xe9\xef\xbf\xc0\x79\xce\x65\x39\x22\x4e\xab\x82\xef\x82\x07\x2f\xd0\xb3\x0a\x44\xf2\x33\xa1\x0c\x26\xbf\xc4\xa0\x20\x68\xe7\x88\x16\x94\x51\x1a\xa9\x85\x6e\x69\xcc\x93\xd8\xe6\xf0\x5a\xeb\x9f\x61\xe0\x17\x01\x55\x14\xb5\xd6\x58\x42\x59\x6b\x2e\x17\xc0\x2d\x6a\xb7\xfe\x4c\x99\x60\x39\xec\x45\x87\x58\x05\xc9\x4a\x65\x9d\x6c\xff\x54\xea\xda\xf4\x8a\xa2\x26\xb5\x6f\x72\xbc\xa8\x2c\xea\x4b\xa4\x47\xe7\x40\x29\xdd\xc1\x37\xb2\x5d\xa3\x6a\xac\x9e\x6e\x60\x05\x85\x53\xd3\x2c\xd5\xa6\xbf\x5d\x2b\xd9\x68\x09\xcb\x00\xc3\x93\xde\xce\xe0\x38\x87\x5d\x13\x76\x9d\x37\x1a\x5e\x76\x7f\xbf\xb1\x1e\xf7\xf7\xe2\xcd\x95\xa4\xf2\xa9\x74\xf7\x1b\x1c\x7c\x7a\xfa\x39\x1e\xd6\x92\x7c\x6b\x21\x9e\x41\xc0\x75\xeb\x48\x97\xf6\x97\xac\xb8\xbe\xc0\x0a\x35\xca\x82\x8a\xda\xaf\x18\xc1\x7e\x63\xae\x8f\x4e\xdd\x68\xaf\x0e\x6f\x3e\xa1\x2b\xb9\x55\xfe\x83\xe4\x37\x75\x37\xe9\x7a\x3f\x1d\xd5\x77\xaa\x60\xc2\x11\xf5\x97\xd8\x9a\x8d\x07\x10\x61\x10\xee\xb3\xe8\xb6\x9e\x34\xde\x98\xee\xe3\xbf\x37\xd3\x1e\x94\x24\xc8\xc5\xae\xb5\x27\xfc\x3e\xae\xc6\x6e\xb5\x1d\xda\x10\x48\x08\x14\x60\xd8\x5c\x66\x7d\xa1\xe8\xac\x1a\xad\x33\x5b\x8b\xc2\x74\x99\x99\xfa\xc9\xb6\xbd\xa4\xbd\x9b\xd1\x76\xe3\x51\xf7\xe8\xe5\x87\x14\x73\x40\x33\x3f\xa5\x9a\xa0\x9b\xfd\xca\x39\xa8\x04\x77\x9f\x0e\x3f\xd9\xfe\x1e\x22\x1f\xe7\x35\xed\xdd\x8e\xf2\x37\xfd\x9a\x6b\x64\xd7\x93\x67\x1f\x8f\x75\xd5\xc6\xbd\x79\xd3\x9c\x3e\x09\x82\x79\x72\xda\x86\x1f\xc2\xf1\x57\xc5\x25\xf8\xff\x4c\xb8\xdf\xfe\x0f\x00\x00\xff\xff\x09\x42\x75\xe0\x2e\x11\x00\x00")
-
-func templates07_relationship_to_one_eagerGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates07_relationship_to_one_eagerGoTpl,
-		"templates/07_relationship_to_one_eager.go.tpl",
-	)
-}
-
-func templates07_relationship_to_one_eagerGoTpl() (*asset, error) {
-	bytes, err := templates07_relationship_to_one_eagerGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/07_relationship_to_one_eager.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2a, 0x53, 0xe0, 0xf0, 0x74, 0x43, 0xf4, 0x73, 0x9d, 0x23, 0x81, 0x73, 0x66, 0x2e, 0xb5, 0x45, 0xea, 0x31, 0x3d, 0xe3, 0x1a, 0x77, 0x80, 0x7d, 0x60, 0x23, 0xa, 0xd4, 0x14, 0x6c, 0x62, 0xd2}}
-	return a, nil
-}
-
-var _templates08_relationship_one_to_one_eagerGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x6b\x6f\xd4\x3a\x13\xfe\x9c\xfc\x8a\x79\xab\xbe\x28\x8b\x52\x17\xbe\x72\xb4\x3a\x82\x02\x3a\x1c\xa1\x72\x4e\x0b\xe2\x03\x42\xd4\x9b\x4c\x76\x4d\xbd\xf6\xd6\x76\xa0\x28\xca\x7f\x3f\x1a\xdb\xb9\xed\x8d\xab\x84\xb4\x71\xe6\x99\x79\xe6\xe2\x67\xd2\xa6\x39\x03\x51\x01\x7b\xcb\x17\x12\xd9\x2b\xfb\xb7\x16\xca\xff\x86\xb3\xb6\x4d\xe9\x2d\x4a\x1b\x1e\x12\x7a\x32\x5c\x2d\x11\x4e\x0d\x4a\x78\x32\xef\x60\x6f\xf5\x1b\x85\x57\x28\xb9\x13\x5a\xd9\x95\xd8\xd8\x00\xf0\x88\x53\xe9\xbc\xbf\x27\x73\x38\x65\x4f\xa5\xe0\x16\x6d\xc0\x79\x37\x6c\x88\x16\xed\xab\xe3\xf6\x2f\xb5\x41\xb1\x54\x3b\x30\x83\xd2\x5b\x7b\x60\xf0\xc1\xc6\x9c\x02\xfa\x92\xaf\x27\xa8\x42\xfb\x44\x22\x49\x76\xa1\x65\xbd\x56\xc1\x34\xfe\x1e\x33\xeb\xac\xab\x5d\xeb\x48\x6b\x17\x54\x5b\xb4\xff\x18\xb1\x16\x4e\x7c\x41\xcf\x6e\xeb\xe4\x34\x64\x67\xc7\xe5\x18\x13\xd8\xcd\xfa\x78\x40\x6e\x96\x14\x65\x63\x84\x72\x15\x9c\xac\xf9\xb7\x05\xfe\xdf\x9e\xf4\x39\xbe\xdb\x5c\x0b\xb5\xac\x25\x37\x93\x42\x70\x75\xad\x2b\xf7\x1c\x25\x3a\x5f\xfc\x6c\x89\x2e\x86\x9b\x10\x1c\x33\x99\xb1\x8b\x09\xac\x6d\xd3\xf3\x73\x78\xad\x79\xd9\x34\x7d\x43\xd8\x6b\x5d\x70\xd9\xb6\xc0\xa5\xd4\x5f\x2d\x70\x05\xc8\x97\x68\x40\x6a\x7d\x5b\x6f\x40\x57\xf0\x85\xcb\x1a\x6d\x0e\x05\x2f\x56\x58\x82\x50\x4e\x83\x5b\x21\x39\x93\x9a\x97\x58\x82\x75\xa6\x2e\x9c\x25\x63\xb7\x42\xd0\x8b\xcf\x58\x38\xcb\xe0\xed\x4a\x58\x10\x16\x2a\x6d\x80\xc3\xe3\xb3\xc7\x60\x46\x3d\x67\x69\x55\xab\x02\xb2\xa6\xe9\x92\x7f\xae\xbf\xaa\x2e\xfd\xb6\x7d\x3d\x3b\x44\x36\x6b\x1a\x51\xc1\x29\xbb\xd4\x17\x5a\x39\xbc\x77\x6d\x8b\xb0\xd0\x42\xb2\x17\xf7\x58\xd4\x4e\x9b\xa6\xa1\x9b\xd1\xb6\x85\xbb\x87\x22\xd8\xb0\x68\x9b\x43\xb4\x8d\xcf\x23\x88\x2a\xdb\x36\x07\xdb\x35\x60\xa1\xb5\xcc\xa1\x69\xa8\x69\x6d\x4b\x89\xa3\xa9\x78\x81\x4d\x9b\xc3\x5a\x97\x16\xee\x6a\x34\x02\x2d\x7b\xba\xd9\x48\x51\x70\xa7\xcd\x0c\xd0\x18\x6d\xa0\x49\x93\x2f\xdc\x80\x95\xa2\x40\xf8\xf0\xf1\xe1\x90\xe3\xd0\x60\x6a\x2f\x19\x85\x72\xc1\x21\x9b\x34\x11\xd5\xc0\xa9\x49\x93\x24\x02\xe6\x3d\x35\x96\x1d\x00\xcf\xd2\xa4\x0d\x1a\x41\xb8\xc0\x66\xee\x23\x75\xb8\x83\xdc\x08\x9a\xa6\x09\x37\x4b\x7f\x2d\xd6\xfc\x16\xb3\x0f\x1f\x27\x35\x78\x94\xc3\xe3\xd9\x2e\x3d\x51\xc5\x94\xd8\x15\xcc\xe7\xa0\x84\xf4\xc7\xc9\x70\x08\x0f\x0e\xf5\xfc\xaa\xa1\x99\xa7\xff\x3e\xf0\x1c\xf8\x66\x83\xaa\xcc\xe8\x29\xef\xdc\x36\x0d\x29\xc3\x76\x76\x6f\x6a\x87\xe6\x49\x9a\x24\x34\x6d\x9f\xbc\x31\x11\x0f\x9a\x18\x52\xf7\x34\x02\xbd\x2d\x6e\x49\x3c\xfa\x1e\x33\x5f\x93\x3e\x04\x1f\x02\x78\xba\xc1\x55\x18\xce\x2d\x1d\x09\x97\xd9\x47\xe7\x14\x99\xe2\x75\x79\xf4\xb8\x41\xcd\x83\x65\x37\x5f\x2f\xee\x6a\x2e\x33\x9e\x4f\x50\xb3\x01\xa6\xca\x1e\x95\xd0\xb4\x0b\x55\x23\xf8\x7a\xf8\xb3\x11\xf1\x03\x55\x1d\x97\x94\xcc\xe3\xd4\x49\x54\xde\x66\x46\x8c\x1f\xf9\x78\x06\x5d\x6d\x14\x15\x2e\x58\x11\xc5\x6f\x54\x86\x4b\xfc\xfa\x2f\xfd\xce\xd2\x04\x00\xe0\x6e\xcd\x5e\x1a\xbd\xce\x6e\xe2\x55\x7d\x2e\xb8\xa4\xde\xbd\xb3\x78\x5d\xac\x70\xcd\xdb\xb6\x69\x4e\x59\xf7\x9b\xc5\xdb\xd7\x34\x13\x09\x6b\xdb\x9b\x59\x9e\x42\xfc\x77\xb7\x66\xef\x57\x68\xf0\x95\xfa\x6d\xb7\x6c\x38\x09\x1a\xed\xef\x37\xfc\x79\x93\xfb\x4e\x32\xc6\x66\x79\x48\xc4\x07\xe2\xaa\xa4\x7d\x57\x96\x83\x9c\xda\x6d\x55\xf6\x1d\x08\x34\x57\x28\x37\x68\x22\x59\x7b\x59\x4b\xf9\xfb\x84\x4b\x1f\xa5\xfc\xc4\xdd\xcd\x40\xed\x0c\xbc\xb5\xaf\x50\xb8\x89\x5e\x97\xfe\x37\x4c\x36\x3d\x7b\x7d\xfa\x96\xf9\x56\xc5\x6b\xbd\x2d\xa0\x81\xbd\x41\x5b\x4b\x67\x73\x12\x31\xea\xa9\x47\xb0\xd0\x56\x9c\xa5\x93\x09\x3d\x62\x1b\x7d\x66\x85\xbb\xcf\x21\xe2\xba\x11\x15\x95\x07\x8c\x18\xc6\x89\xf2\xba\x69\xd9\x7b\xc3\x37\x19\x1a\x93\xc3\x49\xc5\x85\xc4\x12\x9c\xee\x37\x12\x2f\x49\xf2\xaa\x5d\xb1\x3a\x89\x69\x91\x9c\x06\x62\xd7\x23\xe5\xdd\x03\xe8\x89\xcc\xfb\x4b\xf6\x4c\xa8\x32\xeb\xb3\x7a\x30\x72\x33\xfb\xe3\x17\x38\x2f\x84\x2a\x47\xc4\x69\x4b\x06\x0d\x3a\x9a\x40\xcf\x2a\x12\x61\x17\x52\x5b\xcc\x7e\x89\x41\x41\xd0\xce\x11\xed\xe6\x51\x19\x49\xc0\x76\x66\x2c\x90\xd8\xe5\xf0\xc2\x98\x9f\x61\x10\x36\xa0\x2e\x8a\xda\x18\x2c\xa1\xac\x8d\x50\x4b\x10\x0e\x8d\x5f\xfd\x53\x26\x58\x0e\xdf\x04\xc7\x58\xc5\x91\x55\xda\xf9\xb1\xfd\x4b\xeb\x5b\xdb\x4f\x14\xe9\xd4\x21\xdd\x7e\x5a\x39\x34\xd7\x48\x97\xce\x83\x66\x94\x43\xd0\xb2\x7d\x8b\x62\x3c\x3d\xdd\xba\x88\x13\x4e\x3a\x59\xea\x6d\x7f\xfb\xbe\x45\x46\x5f\x1f\x39\x60\xbc\xd2\xbb\x15\x1c\xd7\x30\x8d\x3a\xed\xc5\x37\x19\x6e\x76\x9f\xdf\x78\x1e\x0f\xcb\xf1\xf6\x2e\xae\x42\x29\x7d\x7e\x83\x83\x0f\x8f\x3e\xa6\xc3\x3e\x66\xfb\x3e\x07\xe7\x10\xa1\x69\x32\xad\xfc\x33\x5e\xdc\x5e\x61\x85\x06\x55\x41\x7d\x0d\x8b\x47\x54\x9d\xfd\xd6\x62\x1d\x9d\xfa\xdd\x5a\x1d\xdf\xfa\xbd\xf9\x84\x54\x1c\x08\x4f\x2b\xb0\x4e\x27\x7b\x8f\x32\x8f\xcd\x94\xc4\x7f\xdf\xde\x8f\xef\xc7\x05\xd9\xdf\xf0\x63\xeb\x9b\x7a\x41\x01\x86\xd5\x3d\xef\x0b\x45\x67\xd5\x68\x9f\x4f\xd7\xf9\xce\x36\x9f\xfa\xc9\x77\xbd\xcc\x7a\x37\xa3\xf5\x1e\x50\xdf\x6f\xd9\x0f\x35\xed\x48\xdb\x7e\xaa\x71\xf1\x0b\xe3\x07\x9a\xe7\xe9\xef\xf9\x6a\x59\x18\xe4\xb7\x93\x2b\x90\x8e\x47\x9b\xfe\xd6\x8d\xe6\x4d\x73\xfe\x30\x76\xee\xe1\x79\x1b\x5f\xc4\xe3\xcf\x5a\x28\x08\x7f\x9f\xfa\x77\xff\x05\x00\x00\xff\xff\xb2\xd4\x14\x18\x3f\x0f\x00\x00")
-
-func templates08_relationship_one_to_one_eagerGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates08_relationship_one_to_one_eagerGoTpl,
-		"templates/08_relationship_one_to_one_eager.go.tpl",
-	)
-}
-
-func templates08_relationship_one_to_one_eagerGoTpl() (*asset, error) {
-	bytes, err := templates08_relationship_one_to_one_eagerGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/08_relationship_one_to_one_eager.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x28, 0x94, 0xae, 0xda, 0x7c, 0x7, 0x5d, 0x7a, 0xfa, 0xb0, 0x54, 0x8d, 0xb0, 0x3a, 0x4, 0x2d, 0x55, 0x33, 0x65, 0xcf, 0xbe, 0xec, 0x66, 0xde, 0x16, 0xd2, 0xee, 0x3b, 0xe7, 0xf2, 0x28, 0x75}}
-	return a, nil
-}
-
-var _templates09_relationship_to_many_eagerGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x5f\x6f\xdc\xb8\x11\x7f\x96\x3e\xc5\x74\xe1\x06\xab\x40\x56\x72\xaf\x29\x16\x45\xce\x49\xda\xb4\x89\xdb\xb3\x7d\xb8\x87\x20\xb8\xd0\xd2\xc8\x66\xcc\x25\x37\x24\x95\xd8\x90\xf5\xdd\x8b\x21\xa9\xbf\x2b\x6d\xec\xbb\xbc\xf5\xc1\xc0\x4a\x3b\xc3\xf9\xcd\x6f\x86\xf3\x67\x5d\xd7\xc7\xc0\x4b\xc8\x2e\xd8\xa5\xc0\xec\xad\xf9\x97\xe2\xd2\x7d\x86\xe3\xa6\x89\xe9\x5b\x14\xc6\x3f\x44\xf4\xa4\x99\xbc\x42\x38\xd2\x28\xe0\xc5\xa6\x55\xbb\x50\xef\x99\xbc\x3b\x43\xc1\x2c\x57\xd2\x5c\xf3\x9d\xf1\x1a\x4e\xe5\x48\x58\x77\xe0\x8b\x0d\x1c\x65\x2f\x05\x67\x06\x8d\x57\x74\xe7\x64\xbd\xb9\x20\x5f\x1e\x96\x7f\xa3\x34\xf2\x2b\xb9\xa7\xa6\x51\x38\xe9\xb1\xe2\x14\xd9\xcc\x19\xee\xcd\x29\xdb\x86\x4f\x3d\x05\xdd\xe3\x3b\x95\x33\xf1\xe6\xdf\x78\xe7\xa4\x06\x36\x73\xe5\x78\x08\x2e\x66\x27\x4a\x54\x5b\xe9\xf5\xc2\xe7\xa1\x5f\xad\x74\xb9\x2f\x1d\x00\xed\x2b\x55\x06\xcd\x7f\x35\xdf\x72\xcb\xbf\xa2\xf3\x6d\xf2\xe6\xc8\x73\x63\x86\x64\x0e\x01\x2c\xf8\xbb\x68\x90\xe9\x2b\xb2\xb2\xd3\x5c\xda\x12\x56\x5b\x76\x77\x89\x7f\x35\xab\xce\xc7\x5f\x77\xe7\x5c\x5e\x55\x82\xe9\xa1\x96\xc9\xaf\x71\xcb\x46\x66\xc8\xd3\x3d\xdb\xf7\x70\x94\x9d\x3b\xd9\xbd\xf8\xe5\x4c\x9e\xab\xd2\xbe\x42\x81\xd6\x69\xaf\xaf\xd0\x06\xc4\x23\x1f\x87\x07\x26\xd9\xc9\x48\xad\x69\xe2\x67\xcf\xe0\x9d\x62\x45\x5d\x77\x19\x91\xb9\xf8\x35\x0d\x30\x21\xd4\x37\x03\x4c\x02\xb2\x2b\xd4\x20\x94\xba\xa9\x76\xa0\x4a\xf8\xca\x44\x85\x26\x85\x9c\xe5\xd7\x58\x00\x97\x56\x81\xbd\x46\x3a\x4c\x28\x56\x60\x01\xc6\xea\x2a\xb7\x86\x84\xed\x35\x82\xba\xfc\x8c\xb9\x35\x19\x5c\x5c\x73\x03\xdc\x40\xa9\x34\x30\xf8\xe9\xf8\x3d\x28\x0d\xa7\xc7\xef\x41\x0f\xb2\x2e\x8b\xcb\x4a\xe6\xb0\xae\xeb\x96\xc6\x57\xea\x9b\x6c\x89\x6c\x9a\x77\xc9\x12\xe6\x75\x5d\xf3\x12\x8e\xb2\x53\x75\xa2\xa4\xc5\x5b\xdb\x34\x08\x97\x8a\x8b\xec\xf5\x2d\xe6\x95\x55\xba\xae\xe9\x8a\x36\x4d\x6e\x6f\x21\xf7\x32\x59\x90\x4d\x21\xc8\x86\xe7\x81\x8a\x2c\x9a\x26\x05\xd3\x86\xf2\x52\x29\x91\x42\x5d\x53\xf8\x9b\x86\xfc\x47\x5d\xb2\x1c\xeb\x26\x85\xad\x2a\x0c\x7c\xa9\x50\x73\x34\xd9\xcb\xdd\x4e\xf0\x9c\x59\xa5\x13\x40\xad\x95\x86\x3a\x8e\xbe\x32\x0d\x46\xf0\x1c\xe1\xc3\xc7\xa7\xbd\x8f\x7d\xaa\x50\x94\x49\xc8\xb3\x06\x4b\x32\x71\xc4\xcb\x1e\x53\x1d\x47\x51\x50\xd8\x74\xd0\xb2\xf5\x82\x72\x12\x47\x8d\x2f\x56\xa4\xe7\xd1\x6c\x9c\xa5\x56\x6f\x11\x1b\xa9\xc6\x71\xc4\xf4\x95\xbb\x60\x5b\x76\x83\xeb\x0f\x1f\x47\x1c\x3c\x4f\xe1\xa7\x64\x1f\x1e\x2f\x83\x4b\xd9\x19\x6c\x36\x20\xb9\x70\xaf\xa3\xfe\x25\x3c\x59\x8a\xf9\x59\x4d\xa9\x4f\x7f\xce\xf0\x06\xd8\x6e\x87\xb2\x58\xd3\x53\xda\x1e\x5b\xd7\xed\x3d\xbe\x07\xcb\xad\xc0\x13\x46\xb1\x1e\x3b\xfb\x9f\xca\xa2\x7e\x11\x47\x11\xe5\xe0\xef\x4e\x97\xfc\xf0\xb5\xda\x33\xe1\x50\x79\xb4\x13\xa8\x51\x78\xf5\x3d\xa0\x8e\xa2\xce\x04\xeb\x0d\x38\xf4\xfe\x28\x9f\xab\x93\x02\xe5\xaf\xb8\xb3\xce\xc8\x32\xd9\xab\x6b\x2a\x9d\x4d\xd3\xe9\xf5\x5d\xc6\x4b\xb6\xe9\xf6\xfa\x4b\xc5\xc4\x9a\xa5\x23\xad\xa4\x57\x93\x45\xa7\x15\x51\xf2\x73\x59\x21\x38\x3e\xdc\xbb\x01\xf0\x05\x92\x0f\x30\x4c\xda\x21\x27\x05\x4a\xa7\x92\x90\x03\xcf\x9d\x79\x8d\xb6\xd2\x92\x78\xf4\x52\xde\xf9\xec\x42\x8d\x5b\x68\x34\x2a\x90\xfd\x77\xd4\x3d\xfb\xa7\xf9\xb2\x18\xda\xc6\xa4\xa8\xee\x57\xc5\x99\x96\x48\xfc\xdd\x91\xf4\x29\x7e\xfb\x85\x3e\xaf\xe3\x28\xfa\xb2\xcd\xce\x51\x60\x6e\xd7\xab\xba\x1e\x9d\x1b\x28\x30\x70\x0f\xb9\xfb\x44\x8d\x8e\x9e\x76\x1a\x4b\x7e\x7b\x6e\x35\x97\x57\xe7\x2e\x93\xd6\xae\x33\xcc\x56\xfc\x55\xb6\x4a\xe0\x1e\x3e\x2b\x2e\x61\x95\xc2\x8a\x2a\x4c\x5d\xf3\x02\x9e\x3b\x07\x7f\xa9\x94\x45\xd3\x34\x44\x79\xd7\x55\x3b\xee\xfb\xef\x57\x49\xea\xc1\xbe\xd1\x6a\xeb\xa0\xee\xdb\x1a\x48\xbd\x95\x12\x35\x9d\x37\x10\xed\x98\xa5\xa2\x6f\xe6\x40\x80\x92\xb0\x70\x32\xe1\x1b\xb7\xc7\x91\xe2\xe6\x90\x4f\xcb\x7a\x1d\xde\xdf\xae\x51\xe3\x5b\x87\xf6\xd1\xd4\x00\x97\xf0\xf7\x55\xea\xee\x5c\x96\x65\xee\x48\x97\x77\x4c\x16\x34\xf4\x14\x45\xdf\x0b\xcd\xb4\xa5\xfa\xa4\xfa\xb2\xbd\x46\xb1\x43\x1d\x70\x98\xd3\x4a\x88\x45\x92\xb3\xba\x5e\x15\x4e\xbb\xf8\x9d\xd9\xd5\x8c\x43\x6e\x44\xa4\x66\x12\x47\x49\x3c\xbe\xc9\x73\x39\x08\x00\xd0\x46\xf6\x53\x68\x6d\xaf\x38\xa3\xa4\xcc\x7e\x35\xe8\xef\x40\xd3\xd4\x75\x7b\x1f\x1c\x06\x67\xa0\x8f\x4a\x00\xf7\x89\x00\x84\x03\x5b\x52\xff\xec\x99\x7b\xb1\x0f\x9c\x7f\x1a\x71\x4e\x46\x1f\x47\xbb\x87\x39\xc3\xfc\x9f\x06\xdc\x87\xe7\x53\x0f\xad\x8d\x09\x3d\x85\xb0\xb4\x95\x92\x97\xbe\xa1\xff\xa5\xef\x01\xf4\xec\x1a\xfb\xdd\xda\xc5\x2c\xf4\xc3\xe9\xe4\xe1\xf5\x35\x9a\x4a\x58\x93\x52\xf7\xa7\xe0\x3a\x8d\xcc\xc7\x17\x93\x78\x94\x01\x07\x64\xc3\x99\xeb\xdc\xde\xa6\x10\xf4\x06\x10\x49\x61\x80\x30\x14\x5b\x37\x70\x98\xec\x37\xcd\x76\x6b\xd4\x3a\x85\x55\xc9\xb8\xc0\x02\xac\xea\x26\x3a\x56\xc0\x1e\x49\xab\xe0\x11\x8d\x20\x1e\xd3\xf9\x60\x5a\x29\xe7\xa6\x95\x85\x6a\xfe\xc7\x2b\xb2\xd3\xfc\x3c\xac\xff\x33\x6a\x73\xd6\x44\xa8\x05\x6e\xa0\xee\x0e\xc8\xfe\x81\x36\x14\x88\x69\xc5\x68\x87\x2d\xa7\x48\xdf\x9d\x28\x61\xe0\xc3\x47\xea\xef\x41\x2a\xbb\xb8\xdb\x21\xc9\x51\x3b\x0f\x51\xca\x4e\x29\x1e\xbe\xb7\x2a\xe9\x10\x4a\xfc\xb6\x9e\x27\x88\x92\x6a\x6a\x03\x66\x0c\xc4\x51\x44\xa1\xdc\x74\x46\xce\x73\x26\xd7\x0f\xed\x3e\xc6\xf5\x9d\xf7\x6c\x07\x6b\x46\x43\xb1\x73\x24\xc0\x49\x66\xbb\xd3\xea\x89\x92\x98\xad\xa6\x5d\xe8\xc9\x10\x67\xe2\x07\xb7\x49\x8a\x3d\x24\xc7\x4c\x3e\x58\x1d\xdc\x56\x10\xdc\x72\xd3\xff\x6c\xda\xb9\x11\x24\x58\xeb\x59\x78\xad\xf5\x3a\xf9\xdb\x1f\x81\xb0\x13\x78\xc9\x99\x3c\xbe\xe4\xb2\x18\x43\x09\x83\xde\x02\x08\x77\x89\xfa\xcc\xef\x06\xa1\xc1\xcb\x14\x94\xa4\x9b\x18\x8d\x13\xa7\x13\x1d\xbd\x4e\x61\x42\x69\x48\xd7\xfe\xf2\x77\x4e\xb7\xb3\xdc\xcf\xbc\xb3\x67\x52\x78\x32\xb0\xbc\x4f\xc5\x03\x98\x78\x14\x03\x2d\x3a\x57\x15\xe3\xfd\x80\x9c\x08\x65\x70\x26\x24\x0f\xc0\x91\x93\x6a\x97\x08\x7c\x98\x20\x7e\xc8\x98\x87\xf3\xc0\x9c\x58\x04\xe0\x97\x2f\x95\xe7\x95\xd6\x58\x40\x51\xd1\x25\x00\x6e\x51\xbb\xad\x93\xf6\xd4\x49\xa6\x86\x75\x74\x39\x57\xbb\xa2\x2f\x95\x75\x85\xff\x9f\x4a\xdd\x98\x2e\x9a\x34\x04\xf7\xd5\x60\xbc\x23\xbc\x2c\x2d\x6a\x3f\x5c\x3a\xa5\x84\x7c\xf0\x83\xf2\xdc\x52\x32\x4c\xc5\x76\x35\x09\x3d\x82\x66\xf2\x42\x4d\xcf\x9b\x5b\x83\x07\x8b\x6f\x0a\xd8\xf5\x8f\x99\x7b\x35\x60\x31\x0e\x5b\x41\xd3\xba\xdb\x8d\x2f\xf3\xab\x67\x76\x96\xcd\xfd\x92\xb0\x19\xba\xd0\x0e\x61\x2d\x6d\x3f\xb3\xfc\xe6\x0c\x4b\xd4\x28\x73\x0a\x8a\x1f\xbc\x02\x0f\xa1\xf0\x1d\xe6\x22\x08\x4d\x57\xb5\xc1\x6b\xb7\xae\xcd\x86\xa2\x5b\xd7\xa2\x03\x3b\x49\x77\xd2\xc8\xbb\x90\x12\xce\xbf\x70\xe9\xbf\x23\xd8\x2e\xaa\x49\xbc\xb7\xc6\x7d\xdf\x84\x57\x8d\x27\x9b\x5c\x33\x9e\x57\xf6\x16\xad\x05\x9f\x88\x5e\xfe\x00\x7a\x47\x1d\xeb\xc5\x66\xdc\x25\x3f\xf0\x8f\x7d\xa4\xdc\x37\xb3\x8b\xf4\xa1\x3d\x97\x2e\x0a\x29\xf6\x3b\xee\x66\x33\x69\x93\xfb\x5c\xed\x6d\xbc\xe3\x23\x26\xc5\xb6\x3b\x61\xb0\xfd\x7a\x85\xa5\x64\x1d\x56\xf0\x79\xa1\x8e\xb9\xa4\xdf\xe4\x0f\xe7\xf3\xa1\x44\x7d\x54\xa6\xfa\x54\xfd\x81\x29\xe9\xdc\x4c\x66\x7e\x22\xb8\xd4\xc8\x6e\x46\x15\x60\x14\x87\x87\xde\xd0\x1f\x9f\x1f\xad\x4b\xc4\xd4\xe0\x87\x91\x47\x26\xc9\xde\x29\xff\xb7\x99\xe2\xe0\x3f\x38\x01\xc2\x50\x30\x28\x34\x4d\x1c\x77\x8a\x75\xfd\xec\x69\x08\xb1\x55\x5b\x26\xef\xe0\xe9\xb3\xf6\x7f\x23\x03\x09\x5e\xc2\xf0\xdf\x27\x4e\xe6\x7f\x01\x00\x00\xff\xff\x50\x60\x52\xab\x5e\x19\x00\x00")
-
-func templates09_relationship_to_many_eagerGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates09_relationship_to_many_eagerGoTpl,
-		"templates/09_relationship_to_many_eager.go.tpl",
-	)
-}
-
-func templates09_relationship_to_many_eagerGoTpl() (*asset, error) {
-	bytes, err := templates09_relationship_to_many_eagerGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/09_relationship_to_many_eager.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcf, 0x7, 0x17, 0x5e, 0xb5, 0x86, 0xc5, 0x49, 0xa5, 0x3f, 0xca, 0x49, 0x89, 0xee, 0x47, 0x28, 0x14, 0x31, 0x40, 0x2d, 0x2e, 0xaf, 0xad, 0x6c, 0xe9, 0x5d, 0x7a, 0x8, 0x88, 0x26, 0xb4, 0xd5}}
-	return a, nil
-}
-
-var _templates10_relationship_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5d\x73\xdb\xba\x11\x7d\xa6\x7e\xc5\x46\xe3\xa4\x64\x46\x61\x9a\x3e\xba\x75\x67\xdc\xf8\xa3\x6e\xee\x4d\x75\x2d\x7b\xfc\x90\xf1\xdc\x81\xc8\xa5\x8c\x06\x02\x14\x00\x8c\xed\xa1\xf1\xdf\x3b\x00\x41\x8a\x34\x49\x7f\xc7\xa3\x37\x91\xc0\x62\x0f\x76\x0f\x16\x67\xa9\xa2\xf8\x00\x34\x83\xf8\x84\xcc\x19\xc6\x47\xea\x3f\x82\x72\xf7\x1b\x3e\x18\x33\xb2\xa3\xc8\x54\xf9\x10\xd8\x27\x49\xf8\x02\x61\x2b\xfb\x8e\xd7\xb0\xbd\x53\xd9\x1d\x7c\xc1\x6b\x55\x4e\x72\xb3\xb6\x98\x76\x6b\x6c\xef\xc0\x56\xbc\xcb\x28\x51\xa8\xca\xa9\xa5\x69\xbc\x76\xe1\x0d\xb2\x7b\x0c\x0e\x84\x44\xba\xe0\x1d\x3b\x89\xcc\x19\x95\x0e\xe3\x63\x64\x44\x53\xc1\xd5\x05\x5d\x79\xcb\xaf\x64\xd9\xb2\x20\x72\x61\x2d\x56\x92\x72\x9d\xc1\x78\x49\xae\xe7\xf8\x56\x8d\xeb\x25\x4e\x57\x33\xca\x17\x39\x23\xb2\x69\x95\x88\x96\x9f\xcf\x82\xe5\x4b\xee\x3d\xf8\x87\xe6\x6e\xaa\xe9\x59\xcf\x74\xbf\x95\xae\x55\xae\x50\x4d\x25\x5d\x52\x4d\x7f\xa2\xb2\xf6\xb7\xde\x6c\x95\x21\x51\xad\x20\xb6\x30\xf4\x04\xeb\x1e\xa7\x2a\xb9\xc0\x25\x39\xa9\xa3\xdf\x58\xf9\x06\xb6\xe2\x59\x63\xd8\x11\x82\x66\x36\x43\x69\x7a\xc8\xc4\x9c\x30\xb7\xd2\xc7\x8f\x30\x43\x5d\x14\x36\x19\x95\x23\x63\x0e\x41\x64\xa0\x2f\x10\x8a\xa2\x8a\xda\x9e\xb8\xe4\x55\x70\x8d\x01\x2d\xdc\xb8\xb4\x39\xc3\x14\xa8\xc6\x65\xec\x17\x53\x20\xe2\xe3\xf8\xf6\x92\xd6\xc2\xcf\x76\x13\x77\xd3\x54\x81\x68\xbe\xad\x6d\x7e\x13\x09\x61\xc6\xb8\x69\xa7\x0a\x95\xf3\xb4\x28\x31\xa7\x44\x93\x39\x51\x08\x17\x84\xa7\x0c\xe3\x51\x96\xf3\x04\x42\x01\xef\xd7\x50\xd7\x2c\x30\x26\xea\xdd\x5e\xe8\x62\xc1\x85\x86\xad\xf8\xab\xf8\x2c\xb8\xc6\x2b\x6d\x4c\xa2\xaf\x20\x29\x1f\x62\xff\x72\x02\x45\x81\x3c\xb5\xb1\x02\xca\x15\x4a\x0d\x73\x21\xd8\xa4\xde\xb9\xf5\x9b\xf5\xf9\x45\x29\x85\x84\x62\x14\x48\xd4\xb9\xe4\x20\xe2\x1e\x24\xa1\x4f\x4a\x03\xc4\x5c\x50\x16\x1f\xa2\xde\xfb\x57\x18\x15\x85\x3d\xc1\x0e\xd8\x04\xaa\x01\x3f\xd3\x8f\xf3\xd4\x98\x89\x87\x56\xa3\x8a\x46\x66\x34\xaa\x81\x8f\x1a\xa9\x9f\x12\x4e\x93\x3b\x32\x3f\xdd\x98\xcc\x3b\xa4\x0a\x04\x2f\x23\xf9\xb4\x4c\x4f\x7b\x02\x8c\x57\x98\x94\xc1\xdc\xbf\xc2\x24\xd7\x42\x36\xc2\xdc\xcd\xff\x7a\xba\x7f\xd5\xb0\x6a\x06\xff\xa1\xbc\x28\x46\x01\xcd\xec\x9e\xec\x91\xbd\x83\x14\x7d\xec\x6c\xb2\xd1\xe2\xea\x26\xfe\xef\x6e\xe5\x37\x3b\xc0\x29\xb3\xae\x82\x95\x0d\x63\xe8\xf0\x9f\x49\xb2\xda\x97\x32\x44\x29\xa3\x68\x14\x98\x3e\x92\x10\x9e\xb6\x6a\xc4\x83\x48\x73\xb8\x39\xac\xb9\xa7\x5e\xb8\xfd\xad\x5e\x82\x59\x87\xd3\xd7\x28\x22\x0f\x25\xcb\xcb\x57\x90\x67\x10\xa9\x9f\x24\x9b\x41\x91\xa7\xa4\x7a\xf3\x6a\x48\x7d\xb7\xfc\x24\xd2\xe5\xc9\xbd\x70\x5c\xf1\x0b\xd9\x8c\x79\xe6\xec\xd4\xe1\x38\x72\x63\x8f\x29\x2f\x0e\xf3\x11\xcf\x50\x86\x51\x97\x12\xd5\xd5\xe6\xbc\x2b\x47\x0b\xcb\x89\x09\x8c\x33\x42\x19\xa6\x36\x15\x1e\x0f\xe5\x5a\x40\x56\x46\x14\xdc\x96\xc6\xd1\x28\x08\x8c\x65\xcf\x28\xc8\x57\x29\xd1\xf8\x47\x8e\xd2\x29\xd3\x6c\xa9\xe3\x59\x29\xf2\xc2\x51\x10\x8c\x4f\xa7\x7b\xbb\x27\xfb\x96\x39\x0d\xc5\x63\x0c\xcc\xf6\x4f\xe0\xad\x82\xb3\x7f\xef\x1f\xef\xc3\x5b\x35\x9e\x8c\x82\x40\x69\xb9\x24\x7c\xc1\xd0\x1e\x96\x29\x91\x64\x69\x45\xa4\x0a\xc7\x45\xb1\x15\xff\xf6\x87\x31\xe3\x09\xb8\xdf\xc7\xe5\x6f\x9f\xdb\x3d\x4a\x18\x26\x3a\x3e\x55\x78\xc4\x53\xbc\x9a\x32\x92\xe0\x85\x60\x29\x4a\x65\xcc\xa7\x2a\xbb\x7f\xad\x13\xf6\xed\x5c\x69\x49\xf9\xa2\x28\xc6\xc5\xd8\x98\x71\x51\x78\x1d\xe7\x7e\x8f\xcd\xd8\x98\xa8\x8d\xe7\xec\x02\x25\x7e\x66\x24\x57\xf8\x3c\x34\x7f\xeb\xa2\x19\x3a\x54\x56\x80\x12\x79\xfd\x05\xaf\x4b\x70\xca\x62\x8a\x2c\x6f\x58\x5e\xca\xd4\x6f\xe7\x94\x6b\x94\x19\x49\xb0\x30\x45\xc5\x14\x4b\xbc\x44\x30\xbb\xb4\xb0\x0f\xbe\x57\x98\x7e\xa9\xe5\xaa\x82\x1b\x28\x23\xf0\x3b\x59\x41\x48\xac\xee\xff\x2c\x98\xaa\x64\x76\x04\x37\xf0\x3f\x41\x39\x8c\xed\x12\x63\x63\x7c\x50\x46\xb6\x17\x69\x1f\xa7\x52\xd2\xd2\xac\x64\xdb\x1e\xce\xf3\xc5\xef\x22\x45\x47\x31\x4b\x85\x03\x47\x05\xc6\xc3\xf5\xf8\x99\xa4\x1a\xe5\x04\x1a\xc4\x89\xee\x9f\x5d\xee\xda\x55\xac\xa0\x8c\x61\xdb\xf5\x91\x72\xd3\xc3\x44\x5f\xb9\xd2\x1b\x5c\x3a\x43\x1b\xa6\xdb\x8b\x1d\x48\xb1\x74\xf3\x6e\x7b\xbd\x7c\x00\xb2\xcb\x7e\x3c\x55\xfd\x1c\x0e\xd0\x9f\x13\x7f\xa2\xed\xe9\x74\xa5\x27\x6c\xf8\xa9\x16\x8c\xe3\xb8\x7b\x56\x1f\x70\x54\xcb\xa5\x80\xd9\x5a\xb9\x3e\xa3\xbe\x79\x6c\x45\xab\x8b\xc3\x23\x0d\x5d\x15\x79\x35\x4c\x96\xfc\x75\xbc\x6e\x35\x5c\x0e\xab\x23\xaf\x23\x72\xa3\x0c\xd6\xe4\x6e\xb3\xe0\x47\x8e\x92\xa2\x8a\x77\x95\xa2\x0b\x1e\xbe\x5b\xdb\x4e\xba\xa6\x51\x3b\x63\x34\xb3\x37\x14\xec\xac\xf7\xe6\x1e\xe1\xdd\xd0\xc1\x3c\x76\xf5\xf3\xf6\x4d\xb3\x5d\x39\x9a\xf8\xda\x58\x86\xdd\xaf\xd7\xbd\x00\xeb\x3d\x95\x65\xd4\xc5\x21\x3e\xe5\xf4\x47\xbe\xce\x55\x7d\x17\x36\xd1\x35\x5e\x3a\x8c\xd9\xbd\x18\xfd\x0d\xba\x0d\xa2\x8b\x6d\xe8\xba\x85\x1d\x10\x7d\x87\xed\xa5\x21\xf5\xdf\xe5\x33\x46\x13\xf4\xe5\x59\xf8\xea\xf3\x28\xec\x64\xb5\x42\x9e\x86\x43\x33\x26\x20\xba\x54\xf4\x94\xe6\x94\x59\x51\x54\xf6\xe9\x36\x29\x5f\x73\xc6\xca\x5b\x6b\xb0\x0f\x3f\xc6\xa5\xf8\x89\xdd\x56\x5c\x36\xbe\x8b\xdc\x2f\x88\x38\x65\xf1\x7a\x35\xab\x87\x32\x29\x96\x40\x18\x83\x15\x51\xca\x0a\x2b\xde\xd2\x58\xea\x2f\x2d\x0f\xca\x56\xf5\x3c\xd1\x10\xfe\x77\x65\xdf\x11\x16\xbd\x50\x23\x3e\xb0\xbf\xa7\xc9\xe8\x27\xb4\xdf\xfd\xfe\x5f\x4a\x3f\x3f\xae\xf3\xee\xc7\x32\xdd\x90\x5c\x3f\xbe\xf5\x1e\xd8\xcf\x6b\x28\xe7\xc7\xf6\x50\x77\xd1\xe0\x61\xa2\xf8\xf5\x7a\xed\x81\x23\xb3\x29\x3c\xf9\x35\xcd\xf6\xd0\xa6\x7f\x51\xa1\x78\x04\x3d\x5e\xae\x4a\x3c\xaf\xbb\xee\xc7\xb8\x09\xa4\x78\x62\x72\x37\xa2\x4e\x0c\x34\xd5\x6b\x61\x38\x43\x3d\x4b\x08\xe7\x28\xdb\xe2\x90\x53\x16\xdd\x21\xda\x5b\xb4\x3d\x16\x97\x6a\x37\xcb\x30\xd1\x98\x1a\xf3\x67\xab\xb8\x38\x45\x2d\xe2\x53\x27\x79\xc3\x46\x03\x7e\x76\x41\x35\x32\xaa\x74\xd8\x6a\x33\xbb\x1d\xf9\x2d\x9d\xf5\x44\xcf\x8e\xc5\x4f\x74\xef\x59\xfa\x2c\x6d\x5f\xcb\xe9\x37\x2d\x39\xdd\x27\x7f\xad\xce\x0a\x5a\xa2\xb2\x92\x94\x37\x37\x43\x32\xb3\x16\x68\x03\x9a\xf9\x0e\x45\xe8\xac\x9a\x41\xce\x84\x04\x3a\x01\x49\x6d\xf1\x28\xff\x5f\x1b\x34\x2f\x9c\x1c\x1c\xea\x54\xca\x3d\xd7\xdd\xca\x9b\x1d\x90\x74\xfd\x58\xda\xae\xfd\xda\xd9\x15\x2d\xf7\x7f\xe4\x84\x85\xad\x6e\x65\x6d\x19\x55\xa6\x75\x62\x02\x7b\x76\x28\xcf\xd1\x49\xe1\x51\x10\x30\x6e\xc1\x33\xe4\x83\x4a\x37\x2a\x1d\x32\x0e\xff\x84\x4f\xf0\xee\x1d\x50\xf8\x07\x30\xfe\xe1\x53\xf5\x15\xa8\xdf\xec\x1b\x3d\x6f\x74\x5d\x9d\x51\xbb\xc0\x79\xa9\xc7\xef\x8a\xf9\xa0\xfd\x76\xb5\xc0\x5c\x22\xf9\xde\x69\xa2\x5b\x4a\xbc\x1e\x28\x8a\x8f\xef\xad\x20\xf7\x9f\xa2\xbe\xe3\x35\x70\x2f\xcd\xe1\xfd\xc7\xea\x6f\xd3\xc6\xdc\x32\xa9\xbd\x43\xee\xf3\x86\x5e\x9b\xfe\x3f\x00\x00\xff\xff\x8f\x1c\x2f\xa7\x90\x1d\x00\x00")
-
-func templates10_relationship_to_one_setopsGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates10_relationship_to_one_setopsGoTpl,
-		"templates/10_relationship_to_one_setops.go.tpl",
-	)
-}
-
-func templates10_relationship_to_one_setopsGoTpl() (*asset, error) {
-	bytes, err := templates10_relationship_to_one_setopsGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/10_relationship_to_one_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe6, 0x22, 0x3f, 0x2d, 0x39, 0x38, 0xd8, 0x6e, 0x19, 0x86, 0xcf, 0x30, 0x2, 0x34, 0xed, 0x89, 0x62, 0x16, 0x47, 0x21, 0x0, 0x8a, 0xe0, 0xe1, 0x37, 0x4, 0x6a, 0x51, 0x33, 0x42, 0x14, 0x82}}
-	return a, nil
-}
-
-var _templates11_relationship_one_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x4b\x73\xdb\x38\x12\x3e\x8b\xbf\x02\x51\x29\x59\x2a\xa5\xd0\xbb\x7b\xf4\x96\x0f\x5e\xdb\xf1\x78\x26\x0f\x45\xb6\xcb\x87\x54\x2a\x05\x93\x4d\x19\x33\x10\xa0\x01\x20\x3f\x8a\xc6\x7f\x9f\x42\x13\x7c\x99\xa4\x2d\x39\x4e\x4a\x37\x12\xec\x6e\x7c\xe8\xfe\xd0\xf8\x20\x65\xd9\x3b\xc2\x52\x12\x9d\xd1\x4b\x0e\xd1\x89\xfe\x5d\x32\x81\xcf\xe4\x9d\xb5\x81\xfb\x0a\x5c\xe7\x2f\x03\xf7\xa6\xa8\x98\x03\x19\x29\xe0\x64\x77\xaf\x70\x3b\x93\x9f\x05\xcc\x80\x53\xc3\xa4\xd0\x57\x6c\xa9\x73\x07\xf4\x18\x71\x83\xf1\x76\xf7\xc8\x28\xda\xe7\x8c\x6a\xd0\xb9\x1f\x86\x89\xaa\xd9\xbc\x7d\xfa\xb8\xfd\x7b\xa9\x80\xcd\x45\xcb\x4d\x01\x47\x6b\x74\xcc\x63\x44\x75\x4c\xb9\xf7\x27\xba\x68\x78\xc5\x12\x17\xe2\x41\x46\x07\x92\xaf\x16\x22\x37\xf5\xcf\x75\x64\x85\x75\xda\xb6\xf6\xb0\xda\x4e\x2b\x0d\x7a\xaa\xd8\x82\x19\x76\x0d\x88\xee\xc1\xc8\x28\x5f\x9d\xae\xa7\xa3\x0e\xa0\xbd\xea\xc7\x27\xd4\xf1\x15\x2c\x68\xc3\xc1\x61\x6e\x45\xb9\x27\xa3\xe8\x14\x6d\xdb\x25\xc8\xed\xa6\x7f\xc0\xdd\x81\xe4\x08\x3a\x9c\x83\xf1\xb3\x17\x78\x1b\xe1\xc6\x91\xb3\xf6\x98\x35\x41\xf2\xb0\xd4\x95\x30\x49\x8e\xb9\xbc\xa4\x1c\x27\xd8\xd9\x21\xa7\x60\xb2\xac\x2c\x57\xf4\x41\xc6\x94\x5b\x7b\x4c\x64\x4a\xcc\x15\x90\x2c\x2b\x8a\x71\x28\x6f\xc4\x29\x13\xf3\x15\xa7\xca\x5a\x62\x24\x7e\x57\xae\xa6\x90\x10\x66\x60\x11\xf9\x78\x9a\xc8\x68\x16\x75\x44\x75\x4e\xde\x01\x6d\xf7\x93\x44\x13\x59\x1f\x6d\xba\xf9\x15\x59\x8b\xd6\xe7\x1a\x34\xce\x39\xcf\x17\x90\x50\x43\x2f\xa9\x06\x72\x45\x45\xc2\x21\x0a\xd2\x95\x88\x49\x28\xc9\xdb\x0a\xf4\xf9\xb2\x82\x3c\xee\x5b\x6b\x88\xb9\x11\xd2\x90\x51\xf4\x49\x1e\x48\x61\xe0\xd6\x58\x1b\x9b\x5b\x12\xe7\x2f\x91\x1f\x9c\x90\x2c\x03\x91\xb8\xdc\x11\x26\x34\x28\x43\x2e\xa5\xe4\x93\x32\x0d\x6e\xea\xb4\x6b\x6a\x50\x4a\x2a\x92\x05\x03\x05\x66\xa5\x04\x91\x51\x37\x98\xd0\xd7\xa9\x86\xe3\x52\x32\x1e\x1d\x83\x39\xfc\x7f\x38\xce\x32\xd7\x00\x10\xdb\x84\x14\x1f\xbc\xa5\xff\x2e\x12\x6b\x27\x1e\x5d\x09\x6c\x1c\xd8\x20\x28\xb1\x07\x35\x36\x4c\xa9\x60\xf1\xe3\x64\x98\x6e\x21\x19\x10\xb6\x26\x52\xe4\x99\x7d\x76\xf1\xa7\x1d\x09\x87\x5b\x88\xf3\xe4\x1e\xdd\x42\xbc\x32\x52\xd5\xd2\xde\xa6\x44\x65\xee\x87\x6a\x5e\xf5\x62\xac\x4b\x95\x2c\x18\xb0\xd4\x2d\xcb\x6d\xf4\xc7\x79\xd2\xc5\xd9\x3a\x47\x1d\xb4\x36\x17\xfe\x87\xc1\x5f\xed\x11\xc1\xb8\x9b\x6d\xb0\x74\xc9\x0c\x71\x09\x17\x8a\x2e\x8f\x94\x0a\x41\xa9\xf1\x38\x18\xd8\x2e\xde\x50\x91\x34\x3a\xc9\xba\x3c\x3a\xde\x46\x22\x3d\xd1\x55\x70\xb1\xcb\x17\x22\xdb\xf1\xf4\x57\xb4\x9a\x0d\xf8\xf3\xf2\x7d\xe6\x07\xb8\xd5\xcb\x9b\x6d\x63\xcd\x33\xab\xbf\x7d\x9d\xa6\x3c\x94\xae\xa9\xc2\xba\xe1\x40\x80\xfc\xf1\x91\x32\x14\x21\x0e\xf7\x03\x9d\x94\xeb\x93\x22\x57\x6e\x86\x58\xba\xb4\x3a\xca\x65\xd9\x08\x5f\xd0\xb7\x52\xac\x83\xbf\x57\xa0\x18\xe8\x68\x5f\x6b\x36\x17\xe1\x9b\x96\xf7\xa4\xe6\x3c\xf6\xf2\x07\x57\x16\x04\x83\x82\xd4\x7b\x65\x81\x4e\x10\xe2\x26\x9d\x10\x73\x77\x22\x52\x50\xe1\xb8\x4d\xd5\xe2\x6c\xc6\x2c\x68\xa4\xab\xe3\xea\x84\x0c\x53\xca\x38\x24\x8e\x1c\x3e\x2d\x4c\x18\x49\xbc\x2e\x23\x98\xda\xa1\xc3\x6b\x83\x81\xcd\x25\xba\x8b\xb7\x5a\x26\xd4\xc0\x97\x15\xa8\x3b\xb7\x15\xd3\x85\x89\x4e\x97\x8a\x09\x93\x86\x6e\xb6\xe1\xf9\xf4\x70\xff\xec\xc8\xd1\xba\x2d\x12\xad\x25\xa7\x47\x67\xe4\xb5\x26\x17\xbf\x1d\xcd\x8e\xc8\x6b\x3d\x9c\x38\x27\x6d\xd4\x82\x8a\x39\x07\xb7\xaf\xa7\x54\xd1\x85\xd3\xd0\x3a\x1c\x66\xd9\x28\xfa\xf0\xc5\xda\xe1\x84\xe0\xf3\x2c\x7f\xf6\x9c\x3b\x64\x94\x43\x6c\xa2\x73\x0d\x27\x22\x81\xdb\x29\xa7\x31\x5c\x49\x9e\x80\xd2\xd6\xfe\xa7\x60\xdd\xbf\x4b\x22\x7d\xfd\xa6\x8d\x62\x62\x9e\x65\xc3\x6c\x68\xed\x30\xcb\x9a\x12\x17\x87\x86\x76\x68\xed\xf8\x01\xae\x8b\x2b\x50\x70\xc0\xe9\x4a\xc3\x8f\xa1\xfa\x6f\x1b\x55\x45\xe4\x66\x07\x70\xbc\xa4\xea\x2e\x17\xc8\x4e\xf1\x22\x28\x57\x91\x6b\xca\x57\xb9\xce\xff\xfa\x8d\x09\x03\x2a\xa5\x31\x64\x36\xab\x78\x56\xee\x13\xa4\xe1\x03\xa9\x7d\x4f\xf2\x34\x7c\xa4\x4b\x12\x52\xb7\x9b\x71\xd8\xa3\x18\x93\x7b\xf2\xa7\x64\x82\x0c\xab\x20\x43\x6b\x7d\x62\x82\x72\xeb\x54\xbc\xf4\x1b\x81\xa5\x39\x15\x0f\xe1\x72\x35\xff\x28\x93\x9c\x2f\x03\xc7\x90\xf7\xc8\x10\x2e\xc2\xca\xe0\x42\x31\x03\x6a\x42\x6a\x7c\x1a\xaf\x61\x9e\x2f\xdd\xd3\xb2\xb9\x11\x8b\xf9\x4f\x34\x7a\x84\xb1\xb9\xc5\x73\x63\x70\x83\xbe\x2e\x5d\x0f\xe3\xbd\x57\x72\x81\x76\xad\x99\x6f\xd6\x81\x77\xd3\x07\xaa\xe8\xfe\x8f\xe5\xea\xfb\xc4\xef\x7c\xb7\x8b\xb1\x55\x86\xb5\xc9\x8a\xa0\x51\x14\xb5\xf7\xf4\xc3\x65\xb7\x43\xf9\xd9\x42\x6c\x18\x1b\x84\xf5\xc0\xd7\x6b\x1b\x79\xdc\xce\x8e\xb1\x25\x0d\xd6\x21\xc1\xc6\x2f\xa3\x19\xd9\xab\x56\x8a\xaf\xe4\x4d\xdf\xd9\x3b\x43\xe2\x76\x9c\x76\xbb\xc5\x8e\x98\xb4\xfa\x62\xdf\x89\x5c\x76\x76\x04\xe3\xb0\x94\x47\x71\x1d\x51\x6d\x10\x71\x75\x76\x84\x36\xae\xf2\x00\xdf\x25\xb2\x8d\xe9\x89\x43\xdf\x65\xdd\xa1\x2a\x6a\x2d\x18\x0f\x7c\xe9\xf2\x5f\x6c\x1a\xcd\xf1\xd3\x8a\xf3\xbc\x89\xf7\x5e\xbb\x67\xb0\x90\xd7\xd0\x79\xf3\x56\xb5\x9f\x49\xd6\x92\x31\x82\xf1\xa8\x8a\xe9\x54\x4c\xaa\xe4\x82\x50\xce\xc9\x92\x6a\xed\x14\x91\x68\x88\x23\xfd\xaf\xc6\x24\xda\x35\xb9\x55\x6c\x48\xf8\x79\xe9\xc6\x28\x1f\xbf\xd0\x85\xbb\x7f\x95\xcf\x13\xc2\xcf\xb8\x66\xf7\x42\x78\x29\x05\xbc\xd9\x0d\xbb\x17\xce\x74\x7b\xea\xbe\xf9\xdd\xba\x7f\x55\xbf\x42\xf4\x6e\x7a\x23\x7a\x82\x12\xeb\x49\xc9\x5f\x77\x99\xee\xdf\x44\x5b\xc4\x99\x9f\x73\x8d\x7e\x64\xe9\x3f\xa9\x81\x6c\x46\x95\x97\xeb\x1e\x3f\x76\x6f\xee\x85\xb9\x25\x04\x79\x7e\xa1\xb7\xa2\x7f\xf4\xde\x93\x0b\xbd\x75\x0a\xe6\x34\xa6\x42\x80\xea\xd4\x5c\x82\xf1\x31\xf2\xaa\xc1\xd9\x99\xbc\xd1\xfb\x69\x0a\xb1\x81\xc4\xda\xef\x8d\x16\xd3\xb8\xe7\x9e\xa3\x78\xdc\xf8\x9e\x7b\x71\xc5\x0c\x70\xa6\x4d\xd8\x75\x7b\xeb\xb8\xff\xae\xaf\x63\xb9\x2b\x4e\xa5\x62\x6d\xa5\x1c\x5f\x35\x94\x63\x8f\xcc\x73\xea\xc9\x89\xa9\x4a\xe1\x15\xfa\xee\xfe\xbe\x4f\xf3\x95\xb2\x0b\x67\x7b\x5a\xae\xa1\x69\x43\xad\x95\x49\xca\xb2\x9d\xb7\x4e\xb4\x79\x35\xfe\x17\xdc\x11\xe1\x15\x1b\x79\xbb\x53\xfc\xd1\x56\xb3\xcd\xff\x66\xeb\xfc\x84\xd7\x3f\x53\xb9\xfe\x13\x00\x00\xff\xff\xf5\x22\x99\x12\xc2\x1b\x00\x00")
-
-func templates11_relationship_one_to_one_setopsGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates11_relationship_one_to_one_setopsGoTpl,
-		"templates/11_relationship_one_to_one_setops.go.tpl",
-	)
-}
-
-func templates11_relationship_one_to_one_setopsGoTpl() (*asset, error) {
-	bytes, err := templates11_relationship_one_to_one_setopsGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/11_relationship_one_to_one_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7a, 0x26, 0xad, 0xa2, 0x41, 0x10, 0x32, 0x6f, 0xa5, 0x73, 0x3d, 0xd1, 0x9c, 0xc0, 0xe9, 0xde, 0xde, 0x8d, 0x5b, 0x8c, 0xba, 0xb7, 0x98, 0xaf, 0xc, 0x53, 0x2a, 0xa, 0xf, 0x56, 0xaf, 0xc7}}
-	return a, nil
-}
-
-var _templates12_relationship_to_many_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xdd\x72\xd4\x3a\x12\xbe\x9e\x79\x8a\x66\x6a\x60\x3d\x94\x31\x0b\x97\xec\x66\x29\x16\x42\x96\x3d\x07\x6a\x48\xa0\xb8\xa0\x28\x4a\xb1\xdb\x13\x1d\x34\xd2\x20\x79\x48\x52\xc6\xef\x7e\x4a\xb2\xfc\x2f\x7b\x7e\x02\x24\x14\xdc\xc5\xb6\xd4\xea\x6e\x7d\xdd\xea\xfe\x46\x49\xd3\x7b\x40\x63\x08\xde\x90\x53\x86\xc1\x0b\xf5\x7f\x41\xb9\xf9\x1b\xee\x65\xd9\x58\x7f\x45\xa6\xf2\x87\x91\x7e\x9a\x26\xe6\xe3\xa3\x03\x3b\xa5\xfa\x22\x09\x5f\x20\x4c\x25\xb2\xea\x6b\xf0\x46\xbc\x24\xfc\xf2\x18\x19\x49\xa8\xe0\xea\x8c\xae\x54\x3e\x23\x17\xc6\x4a\x69\xd3\xe0\x09\xa3\x44\xa1\xb2\x62\xb5\x9c\xfa\x0a\xf9\xf8\x78\x78\xfc\x73\x21\x91\x2e\x78\x67\x9a\x44\x66\x46\x37\x27\xb6\x35\x73\xc8\x30\x6f\x5e\x91\xa5\xfd\xab\x72\x4e\xf9\xf8\xa7\x08\x09\x7b\xfe\x07\x5e\x9a\x51\xb5\x35\x43\x61\xfc\x60\x4d\x0c\x9e\x0a\xb6\x5e\xf2\x7c\x9e\xfd\xbb\x6e\x57\x31\x3a\xee\x8e\xb6\x0a\x75\x27\xad\x15\xaa\xb9\xa4\x4b\x9a\xd0\x2f\x68\x6c\x6b\xbd\x99\xe6\xbe\x51\x75\x67\xd6\x15\xe8\xb1\xb7\x77\x41\x15\x9e\xe1\x92\x34\x26\x68\x9d\x3b\x52\xbe\xc2\x34\x38\x31\x63\xf3\xe7\x9a\x9d\xf9\xb0\xf9\x1f\x78\xf9\x54\x30\xa3\xb3\xb7\xc0\xc4\x2e\xde\x50\xb7\x2e\x71\x16\xe8\x19\x56\x6d\x05\x06\x98\x34\xd6\x5b\x19\x45\x47\x4c\x9c\x12\x66\xd4\xbc\x7f\x1f\x9e\x44\x51\x9a\x96\xfb\x1d\x98\xdd\xc9\xb2\x23\x20\x51\xa4\x20\x39\x43\x58\xd0\x2f\xc8\x41\xea\x6d\xc7\x08\xc4\xe9\x5f\x18\x26\x0a\x12\x61\x3e\xe2\x05\x55\x09\xe5\x8b\xfc\x7b\x01\x58\x2d\x57\xc4\x66\x40\x9a\xe6\xf8\xcf\x31\xf1\x15\x14\xe5\x8b\x35\x23\x32\xcb\x7c\x10\x2b\x3d\x83\x30\x76\x09\x94\x2b\x94\x46\x50\x72\x86\x4b\x20\x0a\x38\x9e\x83\xc4\x50\xc8\x48\x05\x46\xcf\xd5\x0a\x79\xa4\x4a\x45\x12\x01\x22\x38\x0e\x1c\xba\x9b\xe1\x27\x98\x94\x63\x5b\xc3\xac\x9f\xb2\x0c\xc8\x6a\x25\xc5\x4a\x52\x92\x20\xbb\x34\xd3\xde\x2a\xb4\x56\xe7\x4e\x8a\x48\x42\x4e\x89\x42\x38\x23\x3c\x62\x18\x8c\xe3\x35\x0f\xc1\x13\x70\x37\x4d\x0b\xa0\xbe\x5d\x9d\x94\x46\xcd\xfa\xfc\xe9\x19\xff\x73\x91\xc0\x34\x78\x25\x9e\x0a\x9e\xe0\x45\x92\x65\x61\x72\x01\x61\xfe\x10\xd8\x97\x3e\xa4\x29\xf2\x48\xef\x8f\x75\x0b\x9c\x0a\xc1\xfc\xd2\xf2\x20\x08\xf4\xea\xb1\x6b\x75\x94\x52\x48\x48\xc7\x23\x89\xc9\x5a\x72\x10\x81\x5b\x1f\xcf\xc2\xa1\xa6\xca\xa9\xa0\x2c\x38\xc2\xe4\xd9\x7f\xbd\x59\x9a\xea\x1c\x66\xd4\xf3\xa1\xf8\x60\x47\xda\xef\x3c\xd2\x5b\x98\x2b\x58\xea\x16\x04\xc1\x6c\x9c\x8d\xc7\xa5\x05\xe3\x1a\xee\xe6\x84\xd3\x70\x18\x76\xf3\x5f\x14\x76\xc6\x35\x0a\x04\xcf\x37\x70\x6f\x98\xcd\x1d\xfb\x8a\x17\x18\xe6\x7b\x78\x78\x81\xe1\x3a\x11\xb2\xb6\xbb\x5d\xf0\x55\xc3\xed\xab\xda\xac\xfa\x9e\xef\x00\xca\x74\x3c\xa2\xb1\xb6\x4c\x67\xaf\x61\x44\xba\x02\xa4\x1e\x10\x5a\x3b\x27\xea\xfe\x65\xe4\xdf\x3a\x00\x4e\x99\x5e\x70\xb4\xd2\x2e\xf5\x8c\x21\xef\x24\x59\x1d\x4a\xe9\xa1\x94\xb3\xd9\x78\x94\xb9\x10\x4a\x78\xd4\xc8\x8e\xdb\x22\xf6\xe8\x57\x85\xec\x86\x4c\x69\x1c\xba\xfa\x46\xb0\x3e\x9a\xff\xa0\xf4\xb9\x03\x52\xbf\x4b\xee\xbc\x02\x8a\x7b\x11\xfa\x2b\xe2\x73\x4f\x9c\xdd\xc8\xec\x59\x1e\xe9\x5f\x88\x34\xf0\x30\x2f\xc6\xa3\x58\x48\xf8\x68\x44\x68\xb0\xe6\xbd\x44\x21\x4f\x43\x87\xc6\xc5\x5a\xfa\x69\x54\x06\x50\xf0\x46\x34\x5b\x96\x51\xf1\xb5\x5d\x1f\xdb\x8f\xba\xb6\xd4\xca\x85\x42\xa3\x49\xc7\x45\x9a\x4e\xcd\x83\x9d\x5a\xf5\x3b\xa3\xd1\xe7\x35\x4a\x8a\x2a\x78\xa2\x14\x5d\x70\xef\x4e\x63\xb2\x5f\x9b\x3b\x2b\x26\x5b\x00\x37\x1e\xf4\x93\x0d\xc4\x03\x6d\x54\xf0\xc2\x58\xb2\xcb\x19\x61\x36\xe1\x05\x8f\x51\x7a\xb3\x6e\x5c\x8d\x8a\x02\xc9\x38\x53\x99\xe0\xd2\x91\xe5\xc3\x24\x26\x94\xe5\xa8\xb4\xee\xa3\x3c\x11\x60\xeb\x70\x30\x3b\x34\x31\xca\x6b\x9d\x33\xa7\x5b\xb5\x12\xda\x27\x66\xa5\xf5\x2a\x22\x09\xbe\x5e\xa3\xbc\xd4\x1b\x15\x2f\x93\xe0\x64\x25\x29\x4f\x62\xcf\x28\x32\x79\x3b\x7f\xf6\xe4\xcd\xa1\x8e\xaf\x6e\xbb\x90\x65\x70\x72\xf8\x06\x6e\x2b\x78\xf7\xbf\xc3\xe3\x43\xb8\xad\x26\xbe\x99\xa5\x12\xb9\x24\x7c\xc1\x30\x38\xc1\x64\x4e\x24\x59\xea\xb0\x54\xde\x24\x4d\xa7\xc1\x9f\xaf\xb3\x6c\xe2\x83\xf9\xfb\x38\xff\xdb\x22\xfb\x19\x25\x0c\xc3\x24\x78\xab\xf0\x05\x8f\xf0\x62\xce\x48\x88\x67\x82\x45\x28\x55\x96\x3d\x28\xb0\xfd\xcf\x12\xae\xef\x3f\xa8\x44\x52\xbe\x48\xd3\x49\x3a\xc9\xb2\x49\x9a\x36\xbb\x1d\xf3\x6a\x92\x4d\xb2\x6c\xd6\x56\xec\xdd\x19\x4a\x7c\xca\xc8\x5a\xe1\xd5\xd4\x7a\xd8\x55\xab\x0a\x96\x67\xe2\x9c\x57\xe1\xa2\xd1\x4b\xe4\x65\xde\x2d\xe9\xd6\x27\xd7\xca\xec\xd7\x17\xc2\xd6\x79\xd7\xf7\xfe\x03\xe5\x09\xca\x98\x84\x98\x66\x69\x85\x49\x13\x4d\x06\xae\xad\xae\xeb\x2b\xe4\x5e\x78\x49\x56\xe0\x11\x9d\x32\xcc\x6b\xab\xc3\x0c\xbe\xc2\x5f\x82\x72\x98\xe4\x02\x26\x59\x66\x7d\x32\x2e\x23\xaf\x06\xd8\x02\xee\x34\xce\x41\xfa\x0c\x4f\xd7\x8b\x97\x22\xb2\x78\x19\x69\x84\x3c\x37\x08\x61\xdc\xab\x46\xbc\x93\x34\x41\xe9\x43\x0d\x4f\xb3\x6d\xc6\xe7\x66\x97\x88\x6d\xc5\x6b\xa1\xc4\x0b\x65\x26\x79\x61\x72\x61\x8e\xc1\xd1\xb9\x99\xae\xbd\xd5\x16\xf9\x5c\x8a\xa5\x19\xd7\x5d\xfd\x7c\x2b\x1d\xcf\xfb\x35\xab\xc5\xff\x80\xdb\x3e\xfa\x36\x35\xe8\x50\x37\x89\xd9\xab\xad\x58\x08\x76\x1e\xa8\x5d\xf3\xbb\xc2\xec\x82\x9e\xc9\x2b\xbb\x08\xae\x52\xd9\x36\xe9\x25\x97\xec\xce\x2c\xe3\x2b\xe5\xe4\xab\xa4\xe4\xba\x19\x59\xed\x41\xeb\x64\x34\xea\x9e\x1f\x9b\x4e\xa2\xcf\x45\xee\x9b\xd4\x33\x6a\x9a\xd6\xd8\xa1\x16\x01\x92\x65\xe0\xd9\xef\xe6\x68\xb6\xcc\x8a\x1e\xf5\x7a\x2d\x12\x54\x79\x0e\x30\x03\x9a\xe4\x4b\x7d\xc8\xcc\xee\xd7\x76\x59\xc6\x9b\x3e\xf0\x61\xfa\xb0\xac\xdf\xbc\xc7\x3e\x3c\x2e\xaa\xb5\xc9\xb8\x3f\x7f\xe4\x99\xd1\x95\x45\xcc\xa3\xd9\xcc\x1e\x30\xf7\xa4\x80\x0d\x11\xfd\xb9\x8c\xab\xad\x43\x3f\xe7\x93\x6a\xa8\xb8\x6a\xdc\x3b\x03\xba\x47\xb1\x6e\xbc\x5b\x75\xaa\x68\xef\xf1\x8f\x23\xd0\x3f\xb7\x23\xb1\x6d\xd9\x86\x78\xee\x99\x5f\x62\xde\x96\x1d\xf5\xc8\xde\xb1\x54\x30\x47\x41\x15\xcd\x26\x76\x1a\xd6\xd2\x58\x17\xbc\x70\x50\x2d\x61\x1e\xe1\x4e\x55\xad\x36\x4f\xb5\x63\x9b\x60\x3a\x05\xeb\xa3\x22\xce\x7c\xbb\x50\x55\x77\xf4\x94\xd4\x70\xa0\x6b\x65\xe4\x91\xd7\x33\xa0\xc9\xe5\x5c\x25\xec\x69\x6c\x02\xa1\x61\xe8\xc8\xbe\x31\xb6\x3a\x4f\xf0\xdc\xd6\x91\xb3\xcc\x7f\x04\xee\x7a\xfe\x84\xd1\x10\x8b\x38\xb4\x47\xaf\x5f\x96\x68\xb5\x5a\xcc\xac\xde\xd3\x42\x94\x8e\x19\x18\xe4\x83\x68\x6c\x69\x89\xba\xeb\xf0\x85\xd8\xc3\x44\xe1\x04\xa4\x05\x38\xa7\x6c\x5c\x1c\x3d\xe6\x37\x0e\x4f\x48\x68\x96\x7b\xaf\xd6\x8c\x19\x04\xd4\xe1\x30\x1b\xa0\x97\x4f\x30\x71\xd2\xcb\x12\x97\x42\x9f\x67\x84\x31\x58\x49\xfc\x42\xc5\x5a\xb1\xcb\xd2\x67\x34\xc1\xa5\xb2\x9d\xa7\x16\xd3\xdf\x7c\x82\xc4\x15\x23\x61\xd9\x70\x86\x62\xb9\x62\xa8\xdb\x40\x38\xa7\xc9\x99\x69\x5d\x57\x44\x29\x8c\xb4\x1c\xca\x9b\x4b\xec\xd8\xba\x9a\x5e\xb4\x1d\x38\xa5\x7f\xff\xa1\x4f\x1a\x47\xff\x1d\x6a\x01\x94\x2f\x2c\x73\x72\x6c\x14\xc6\xae\xa0\x62\x82\xd1\xbb\x88\xc2\x6d\x5b\xe0\x2d\x17\xbf\x3a\xc1\xdd\xb3\xa3\xd7\x46\x70\xbb\xf5\xb9\x76\x82\xdb\xad\xd6\xfc\x37\xf0\xaf\x09\xf8\xbb\x53\xec\x3d\x3b\x78\x23\x49\xa2\x16\x71\x39\x14\x13\x37\x98\x62\xef\x49\x2d\xbf\x83\xe6\x86\x9e\x16\x7b\x92\xfc\x7d\xdb\x7c\x3d\x24\xff\x0f\x3f\x3f\xae\x46\xf2\xbb\xd5\xfd\x1d\x21\xd7\x11\x21\x7b\x22\xfd\x46\x9e\x20\x65\x61\xd5\xd3\xed\x55\x24\x4e\x84\x1a\x0f\x10\x4b\xb1\xdc\x44\xe2\x9c\x9f\xa1\x44\xd8\xc0\xe4\xc0\xc1\x76\x04\xcd\xb4\xa4\xa7\x1f\x57\xac\xcc\xb6\xa4\x4c\xab\x5f\xab\xac\xb1\x2c\x5c\xc5\x6b\xf7\xd9\xa2\x30\x81\x36\xfb\xdd\xb6\x83\xaf\x19\xab\x8c\x1e\x1c\xfa\xa3\x4c\x2e\x88\x0d\x37\xc3\xe2\x26\xa0\xb6\xe5\x9f\xb6\xa5\x9f\x5a\xce\xbf\x22\xf7\x34\x4c\x3d\x0d\x33\x4f\xad\xc6\xd7\xed\x14\xcb\x20\x3d\xda\x40\x3b\xd5\x4d\x72\x4c\xd9\xc4\x3a\xd5\xf6\xa6\xcb\x39\x6d\x41\x39\xe5\x19\xbf\xf9\x73\x2d\x9c\x62\x2c\x24\x6a\xac\x4e\x86\xc9\x9b\x7c\xb6\x23\x39\x69\x4f\x3b\x13\xa2\x21\x59\x3c\x51\x66\x93\x59\x49\x64\xdd\x6a\x10\x59\x3d\xac\x13\xa7\xcc\x05\x84\xd6\x7c\x17\x8f\xd2\x27\x33\xad\x93\xdb\x27\x98\x9c\x84\x84\x73\x94\x1d\x82\x9b\x53\x36\xb3\x5c\x7f\x97\x83\x19\xe9\xdc\x4a\xf9\x1a\x2b\xde\x7d\x98\x41\x31\x76\xe4\x23\xb7\x32\xb6\x4d\xb2\x7c\xa7\xfb\x2f\xe3\x46\x27\xda\xe4\x60\x8e\xfb\xf6\xfa\xa8\x85\x1e\x93\xd2\x8b\x7b\x01\xf9\x21\x0f\x94\x07\x95\x0c\xd5\x2a\x28\xcc\x04\xb7\x13\xbc\xb5\xae\x1d\x57\xc2\x64\x28\x53\x45\x10\x49\x95\xe0\x7e\x0e\x5c\x5d\x4c\x0a\x54\xc6\xe0\x4f\x88\x2b\x10\x32\x42\x39\xdb\xf6\x74\xfe\x46\x5c\x46\xbf\x67\xf6\xab\x45\xf7\x63\x30\x7a\xb5\xf8\x56\x45\xe8\xce\xe4\x45\xaf\x46\xf3\x9f\x1b\x31\xbb\x93\x00\xfd\x9e\xf8\x11\x55\xdc\x1e\xed\xcc\x06\x30\x6d\x97\x60\x7e\x68\xd7\xdf\x1f\x84\x3f\x39\xda\xbe\x4f\xf7\x3c\xe0\xae\xef\x97\xb4\x76\x03\xd9\x37\xcd\x58\x57\x6b\x97\x7b\x35\xfd\x89\xa1\xb5\x3f\x44\x6e\x4a\xce\xea\xbb\xd3\xb6\xa9\xf7\x6c\x5d\x9e\xba\x41\xad\xa8\x09\xe6\x8d\x37\x12\x80\x72\xf0\x6e\xab\x99\xb9\xc4\x55\xdd\x94\xaa\x0b\xf7\xa2\x81\x95\x7d\x60\xc8\xbd\xa2\x00\xf7\xe1\xa1\x0f\x0f\x66\xfe\x78\x34\xdb\xa9\x2b\xdc\xf4\x63\xa5\x15\x55\xfe\x20\x9a\x3f\xb7\xae\x35\xd4\xdb\x8b\xdf\x6d\xe5\x0e\x6d\xe5\xee\x5d\xe5\x4d\x6b\x2a\x77\xfa\xe5\x7b\xfb\x06\x6d\xe8\x86\x6a\xd9\xbb\xdd\x6a\xff\x7e\xbe\xb1\x51\xeb\x5c\xef\x68\x1c\x92\xc7\xe2\x5c\x3d\x89\x63\x0c\x13\x8c\xb2\xec\x63\xa3\x14\x2a\x6f\x9f\xbe\x35\x1c\xd1\xce\xb7\x4f\xdf\x9d\xd1\x04\x19\x55\x89\xe7\xba\x36\xe9\xba\x95\x5a\xed\x90\xf3\x77\xfa\xef\xd9\xcb\x37\x91\xd3\xba\x9f\x52\xbb\x20\x60\xa2\x7e\xd3\xa6\xeb\xef\xd4\x07\x49\xb7\xec\xe2\xf3\xed\xd5\xae\x90\xb4\xb7\x2f\x67\x5c\x4b\xd3\x09\xb0\x47\x56\xd1\xe5\x33\x0e\xff\x81\x07\x70\xe7\x0e\x50\xf8\x37\x30\x7e\xef\x81\x95\xd9\x33\xef\x3d\xfd\x60\xae\xd0\xb9\x3f\xea\xf9\x1f\xca\x3b\x8a\xfd\x3d\x7f\xdf\xfc\x47\xa5\x80\x53\x89\xe4\x53\xb1\xb1\x8e\x5b\x17\x3d\xfb\x6b\x8e\xfb\xbd\xf7\xb8\xaf\x4a\xa8\x0e\xea\xf7\x1f\x86\xca\xbe\xbd\x6e\xb6\xd4\x36\x2f\x73\xc3\x61\x28\x76\xd3\x4d\xb7\x1f\x0d\x40\x8b\x03\x2d\x47\x4d\xf5\xe8\xbe\xe5\x79\xab\xc8\x43\x87\x9f\xd7\x84\x79\x8d\xdb\x7a\xd5\xe4\x99\xeb\x2a\xe7\x10\x12\x07\xcc\xd8\x88\xc6\x81\xb9\x39\x22\x87\x06\x34\x51\x39\x9c\x09\x87\xe4\xf4\xa0\x33\x6b\xde\xce\x4f\xd3\x7b\x70\xff\x2e\xd0\x18\xea\xe0\xbc\x7b\xbf\xba\xb9\x7f\x0f\x3a\x43\x79\x71\x57\xa8\xb8\xe1\xfa\x09\x2f\xcb\x39\xdd\x19\x35\x68\x95\x27\x8f\x1d\xed\x14\x5f\xff\xcf\x6b\x3b\xee\xef\x00\x00\x00\xff\xff\xf2\x75\xc7\x5f\x9b\x3d\x00\x00")
-
-func templates12_relationship_to_many_setopsGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates12_relationship_to_many_setopsGoTpl,
-		"templates/12_relationship_to_many_setops.go.tpl",
-	)
-}
-
-func templates12_relationship_to_many_setopsGoTpl() (*asset, error) {
-	bytes, err := templates12_relationship_to_many_setopsGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/12_relationship_to_many_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfc, 0x49, 0x80, 0x81, 0x75, 0xd3, 0x2d, 0xa4, 0xcc, 0x2a, 0xc5, 0xb0, 0xde, 0x45, 0xa5, 0xd8, 0xa3, 0xdd, 0x5d, 0xf8, 0xbb, 0x54, 0x27, 0x26, 0xf7, 0x22, 0xb5, 0x90, 0xba, 0x1f, 0x25, 0x63}}
-	return a, nil
-}
-
-var _templates13_allGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x4b\x6b\xe3\x30\x10\xc7\xcf\xd1\xa7\x18\x4c\x0e\x31\x6c\x26\xf7\x85\x1c\xc2\x86\x85\x1e\x1a\x1a\xd2\xd2\x63\x51\xad\x49\x6c\x90\x25\x47\x8f\xa6\x45\x99\xef\x5e\x2c\xb7\x38\x7d\x50\xe8\xc9\x83\xe7\xf7\x7f\x68\x52\x9a\xc3\x54\xea\x46\x7a\xf8\xbb\x04\x5c\xf5\x13\x79\xbc\x95\x8f\x9a\x60\xf8\xe0\x46\xb6\xc4\x2c\x32\xea\xab\x9a\x5a\x39\xac\x7b\xc1\x48\xc0\x19\x70\x37\x6e\xdf\x05\x95\x34\x3b\xbb\x0f\x6b\xd2\x14\x2e\x25\xff\x3e\xfc\x67\x16\x8b\x05\xa4\x34\x54\xc1\xbb\xee\x46\x47\x27\x35\x33\x38\x0a\xae\xa1\x27\xf2\x20\xb5\x86\x50\x13\x38\xaa\xac\x53\x1e\xa2\x6f\xcc\x01\xa4\x01\x7a\xa6\x2a\x06\xeb\x50\xec\xa3\xa9\xbe\x73\x99\xb5\x56\x79\x40\xc4\x63\x8b\xdb\x48\xee\xe5\xda\xaa\x72\x04\xd7\xf6\x64\x76\x8d\x39\x44\x2d\x1d\x73\x06\x20\x09\x00\x80\x94\x9a\x3d\x48\xa3\x00\x57\x4a\x8d\x7d\xfd\xe7\x77\xcd\x99\x33\x9f\x73\x96\x20\xbb\x8e\x8c\xca\xa9\x7f\xe0\xd8\xe2\x7f\x67\xdb\x59\x91\xd2\xe5\xf9\x98\x8b\xb2\x5f\xd6\xa4\x3b\x72\x78\x5f\x93\xa3\x2b\xbf\x89\x5a\x7f\x25\x31\xa5\x42\xe5\x24\xf5\x20\x43\x01\x67\x98\xe2\x36\xda\x40\xbe\x77\x29\xdf\xaa\x92\xf6\x43\x93\xc9\x2f\x6b\x94\x62\x92\x12\x19\x35\x88\x1d\x85\xe8\xcc\x8f\xd7\x49\x1b\x3a\xe5\x21\x7b\x23\x62\xc9\x82\xc5\x6b\x00\x00\x00\xff\xff\x4f\x7f\x93\x06\x4c\x02\x00\x00")
-
-func templates13_allGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates13_allGoTpl,
-		"templates/13_all.go.tpl",
-	)
-}
-
-func templates13_allGoTpl() (*asset, error) {
-	bytes, err := templates13_allGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/13_all.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x27, 0x53, 0x40, 0x98, 0x66, 0x0, 0xe0, 0x3e, 0x56, 0xe2, 0xb7, 0xcf, 0xc9, 0x50, 0xad, 0x3a, 0xcd, 0xb, 0x54, 0x38, 0x3c, 0xf4, 0xc3, 0x6b, 0xe4, 0x39, 0x6f, 0x3e, 0xb3, 0xec, 0x8e, 0xc4}}
-	return a, nil
-}
-
-var _templates14_findGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x5d\x6f\xdb\x36\x14\x7d\x96\x7e\xc5\x9d\xd0\x0d\x52\xa1\xb2\xdd\x6b\x81\x0c\x48\xed\xd4\xc8\xd6\x65\xfe\x68\xb1\xc7\x81\x96\xae\x1d\xa6\x34\xa9\x90\x54\x1d\x83\xe1\x7f\x1f\x48\xc9\xb2\xbc\xca\x71\x3b\x74\x43\x9f\x24\x92\x87\x97\x87\xe7\x5c\x5e\xd2\xda\x17\xf0\x8c\x72\x46\x35\xbc\xbe\x00\x72\xe9\xff\x50\x93\xf7\x74\xc9\x11\x9a\x0f\xb9\xa1\x1b\x84\x17\xce\xc5\x01\x5c\x48\x3e\xc6\x55\x80\xeb\x7b\x3e\x0a\x2d\x26\x98\x61\x52\xe8\xfd\x8c\x91\xe4\xf5\xe6\xd0\x9c\xfe\x86\xbb\xae\xaf\x0b\x54\x7d\xf4\x81\x43\xa0\x7d\x50\xd2\xf4\x3c\x82\x36\x8a\x89\xf5\xef\xb4\x82\x34\x90\x1b\x49\xae\x5b\x9e\xd9\xd1\x30\x59\x84\xdf\xb7\xb5\x28\x34\x29\xe8\x06\xf9\x88\x6a\x3c\x0d\x51\x58\x71\x5a\xe0\x1c\x35\xaa\x4f\x58\xf6\xd9\x5c\xaa\x75\x20\x73\x27\x99\x58\x70\x56\xa0\x86\x04\x92\x03\xcf\x8e\xe4\xfb\x5d\x15\x48\x7a\x20\x24\x39\x24\x3d\x71\xa8\x58\xc8\x95\x19\x23\x47\x83\x41\xd1\x56\x90\xa3\xfe\x80\x66\x2b\x20\x97\x65\x39\xe1\x72\x49\x79\x88\xf0\xf2\x25\xbc\x65\xa2\xb4\xb6\xd9\x28\xf9\x50\x2d\x98\x58\xd7\x9c\x2a\xe7\x26\xa0\xd0\x28\x86\x9f\x50\x03\x05\xcd\xc4\x9a\x23\x28\x2c\xa4\x2a\x61\xb9\x83\xeb\x31\x89\x57\xb5\x28\x9e\x08\x90\x86\x25\x85\x34\x40\x6e\xe4\x48\x0a\x83\x0f\xc6\xb9\xc2\x3c\x40\xd1\x34\x48\xdb\x99\x83\xb5\x28\x82\x34\x60\x6d\x2b\x8c\x73\x39\x68\xe4\x58\x98\x60\x05\x21\xa4\x11\x38\x83\xf4\xf9\xe0\x7a\x39\xa0\x52\x52\x65\x60\xe3\x48\xa1\xa9\x95\x38\xcd\xad\xa1\xd6\xa7\xb5\x94\x8c\x93\x09\x9a\xf1\x9b\x34\xb3\x16\xb9\xc6\x40\x35\x87\xfd\x40\x8b\x6c\xc7\x45\xe9\x17\x0c\x64\xf7\x19\xd4\x99\x73\xcc\x9c\x10\x92\xc5\x2e\x8e\xbb\x2d\xc6\x07\x2b\xa6\x54\xb0\xe2\xac\x13\xd3\x73\x4e\xc0\x96\x99\x5b\xa0\x02\xf0\x01\x8b\xda\x48\x95\x03\x15\x25\x54\x3e\xba\x06\x29\x1a\x61\xce\xf9\x35\xfd\x5c\x14\x1f\xaf\x11\xe0\xaa\x8d\xdc\x93\xe6\x73\x17\x0f\xf0\xb6\xab\x37\xab\x27\xd8\xd3\xee\x0e\x9b\xdb\x9a\x2a\x97\x77\xc1\x66\x9f\xe8\x67\xcc\x1d\xc8\xbb\x7e\x9e\x79\xae\x5f\x61\x60\xc4\x56\x61\xdd\x1f\x2e\x40\x30\xee\xd9\x44\x41\xde\x34\x6c\xf7\x4f\x45\xab\x2b\xa5\x52\x54\x2a\xcb\xe2\xc8\xc5\x5d\x06\x36\x9c\x87\xfc\xf7\x0e\xf5\x8e\xe3\x97\xa7\xc3\xe4\x6c\x3e\xfc\x2b\xfb\x27\xd3\xff\xea\xbc\x7e\x2b\x47\xff\xbf\xe3\xfa\x4d\xdd\x7e\xca\xcb\xaf\x3e\xd9\xc4\x87\xbb\x5e\xf5\x95\x66\x1a\x70\x53\x99\x5d\x58\x05\xb6\x8c\x73\x68\xe9\x50\xce\xa1\x68\x2e\xc1\x73\xee\x7f\x1f\x67\xff\x0b\x2a\x7b\x07\x18\xcb\xad\x38\x40\xfe\x58\xde\xf9\x0c\xfa\x69\x70\xbe\xf5\x16\x69\xe4\x1e\x91\x3c\x4f\x82\xbd\x1c\x45\x7a\x20\x91\xc1\x2f\xf0\x2a\xf8\xec\x61\x17\xed\x5d\xae\xc9\xaf\x92\x89\x54\x1b\xb5\xa1\xde\x1a\x72\x5d\xa2\x30\xb3\x5a\x1a\x0c\xd7\x75\x5a\x32\xea\x23\x90\x77\xb3\x1c\xf6\xff\xf3\x59\x7f\x77\x59\x0e\x49\x9e\x84\x2c\x89\xee\x6b\x54\x3b\xcf\x61\xb5\x31\x64\x51\x29\x26\xcc\x2a\x8d\xa3\x28\x69\xe0\xf0\xa3\x86\x95\x92\x1b\xb0\xb6\xff\x0c\x7a\x04\xb2\x28\x6e\x71\x43\x43\x9f\x73\xb0\xbd\x45\x85\xd0\xf8\x35\x6e\x17\xfd\xa0\xf1\x5a\x94\xf8\x30\xf5\x4f\x8d\x5b\xc9\x4b\x54\xda\x39\x6b\x03\x76\xc4\x69\xad\x11\xc8\xbb\x19\x90\xf9\x0c\x7e\x1e\x7a\x24\x79\x70\xe3\xee\xf0\xa4\x57\x27\x27\x79\x73\x8f\x0a\xda\xe1\xd9\xa1\xff\xf1\x3c\x71\x2e\x80\xac\x4d\xca\xd0\x2e\xff\xa2\x26\x81\x47\x78\x46\x82\xa6\xda\x39\x9f\xcc\xa2\xe6\xbc\x8d\x9b\x04\x29\xf3\x38\xca\xe2\x38\xba\xf7\xd2\x79\x0d\x19\x6a\x32\xa7\xdb\x34\xe8\x79\xfa\x54\xfb\x39\x6d\x61\xb9\x27\x6f\x98\x28\x4f\xd6\xb7\xfd\xd6\x05\xe3\x5d\xb6\x76\xf7\xc3\x89\x6c\x1b\x2c\x12\x4d\x8f\x54\x9a\x8c\xbc\x7a\xa1\x42\xc0\x45\x78\xb6\x92\x2b\xa5\x6e\xe4\x5c\x6e\x75\x40\xee\x2b\x86\x60\x3c\x3f\x1e\x8e\x23\x9f\x2b\x47\xe3\x6d\x4c\x5f\x77\x7c\xc8\x1c\x12\x6b\xc9\xf4\xe3\xda\x6f\xda\xb9\xd7\x50\x8b\xf0\x78\x36\xb2\x4d\xbc\x81\x34\x72\x2e\x39\x2e\x55\xa7\x77\x96\xfb\x45\x63\x17\xff\x1d\x00\x00\xff\xff\xa8\x25\xe6\x7a\xa9\x0b\x00\x00")
-
-func templates14_findGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates14_findGoTpl,
-		"templates/14_find.go.tpl",
-	)
-}
-
-func templates14_findGoTpl() (*asset, error) {
-	bytes, err := templates14_findGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/14_find.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf5, 0x24, 0xa2, 0x29, 0x23, 0xa5, 0x98, 0xc7, 0x1d, 0x82, 0xb8, 0xd1, 0x6a, 0x72, 0xff, 0xc0, 0x13, 0x86, 0x11, 0x85, 0xad, 0xa, 0xab, 0xe, 0x6f, 0x7d, 0x95, 0x49, 0x89, 0xc3, 0x71, 0x60}}
-	return a, nil
-}
-
-var _templates15_insertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x5f\x6f\xdb\x38\x12\x7f\x96\x3e\xc5\xac\xb1\x5e\x48\x07\xad\xb6\x05\x0e\xf7\xd0\x43\x1e\xd2\xd8\xc9\xe6\x9a\x26\xae\xed\x6c\x80\x2b\x8a\x82\x91\x46\x09\x11\x9a\xf4\x51\x54\x1c\x9f\x56\xdf\xfd\x40\x52\xb2\xfe\x58\x76\xdc\x36\xb9\x7d\x6a\x2d\x0e\xe7\xcf\xef\x37\x9c\x19\x32\x79\xfe\x2b\xfc\x4c\x18\x25\x29\xbc\x3b\x82\xf0\x58\xff\x0f\xd3\x70\x4e\x6e\x19\x82\xfd\x27\xbc\x24\x0b\x2c\x0a\xd7\x88\xa6\xd1\x3d\x2e\x88\x5d\xd6\x1b\x6a\x09\xf8\x13\xc2\x59\xbd\x6a\x36\xd0\x04\xc2\xe3\x38\x3e\x63\xe2\x96\x30\xf8\xb5\x28\xdc\xdf\x7e\x83\x73\x9e\xa2\x54\x67\x40\x20\xa5\xfc\x8e\x21\x48\x8c\x84\x8c\x43\x98\x21\x96\x8b\x90\x08\x09\xab\x7b\xaa\x90\xd1\x54\xc1\x2d\xde\x93\x47\x2a\x24\xc4\x98\x46\x92\x2e\x15\x15\x3c\x74\x93\x8c\x47\xe0\x09\xf8\x5b\x9e\xdb\x08\xc2\xeb\xe5\x8c\xf2\xbb\x8c\x11\x59\x14\x7e\x65\xc7\x33\x6e\x70\xa1\x20\xbc\x14\x27\x82\x2b\x7c\x52\x45\x11\xa9\x27\x88\xec\x8f\xb0\xfc\x18\x40\x9e\x23\x8f\xb5\x9b\x10\x09\x96\x2d\x78\x0a\xb7\x82\xb2\xf0\xc4\xfe\xf0\x01\xa5\x14\x12\x72\xd7\x91\xa8\x32\xc9\x41\x84\xd6\x86\x35\xd1\x54\x6f\xf6\x9d\xa1\x1a\xbd\xf7\xfc\x3c\x47\x96\xa2\x31\x19\x40\xb5\x50\x4a\x96\xeb\x3c\x2e\x8a\xa0\x32\xea\xbb\x85\xeb\x6e\x5c\x71\x6b\x18\x27\x84\xd3\xa8\x8d\xe2\xa4\x8b\x22\x64\xfa\x27\x10\x0e\xf8\x84\x51\xa6\x84\x0c\x80\xf0\x18\x96\x7a\x6f\x0a\x82\xdb\x20\x9a\x60\x6b\x6d\x2f\x87\xf7\x64\x1b\x0c\xed\x89\x0d\x7c\x5c\xfa\xd4\x80\x64\x9b\x85\x5a\xbc\xfc\xd4\xd8\xd5\x02\xaa\xc3\x4e\xee\x3a\x34\xd1\xe1\xe9\xc4\x6c\x53\xd3\xc3\x7e\x93\x6d\x6d\xb1\x86\xff\x9f\x46\xc7\x4f\x47\xc0\x29\xd3\x4a\x1d\x83\x9d\x67\x8c\xdd\x48\xb2\x1c\x4b\xe9\xa1\x94\xbe\xef\x3a\x45\x1f\x55\x1a\xee\x46\xd6\xef\x60\xee\x6c\x8b\xba\x67\x89\x6a\xb3\xa4\x55\xfd\xd0\xc1\x98\xbc\xe0\xc9\xd8\x83\xfd\x8b\x1d\x8b\x1f\xe0\x65\x83\xfa\xf3\xc7\x25\xd4\xc2\x1a\xf3\x66\x80\x65\x40\xf6\xd7\x0c\x15\xc4\x22\xca\x16\xc8\x15\xd1\x88\x83\x12\x90\xf1\x18\x65\xaa\x34\x83\xd6\x5f\x30\x27\x89\xf2\x04\x25\xf2\x08\x0d\x77\xd4\x68\x49\x0f\x65\xe8\x2f\x3b\x49\x9b\x3a\x47\x13\x10\x70\x54\x23\x5e\xd6\x3d\xb3\x9e\x86\x97\xb8\xf2\x06\x79\x1e\x4e\x1e\xee\x6c\x8b\x78\x07\x5c\x40\x9e\xb7\xda\x06\x2c\xa5\x78\xa4\x31\xc6\x0d\x04\xa8\xe0\x03\xc3\x92\xeb\x3c\x12\x69\x68\x35\x2a\x5d\x47\xf7\x18\x85\x8b\x25\x23\x0a\x61\xa0\xe8\x02\x53\x45\x16\xcb\xaf\x76\xdf\xd7\x7b\x64\x4b\x94\x03\x08\x41\xb3\xea\x34\xf3\xf7\x77\x21\x1e\x52\xc3\x76\x2b\x13\x63\xf1\x1e\x13\x21\xd1\x22\x6a\x84\x0e\x2e\x09\xdb\x19\x57\xc7\xaf\xbd\x37\xde\x1a\x20\x5d\xd7\xe1\xff\x1d\x61\x42\x32\xa6\x4c\x23\xfd\x4f\x86\x92\x62\x1a\x5e\x0a\xfe\x6f\x94\xa2\x5c\x9a\xa1\xe6\xb4\x64\x7c\x24\x56\xbc\xe6\xbc\xc4\xfe\x86\xaa\xfb\x52\x38\x00\xe1\xbb\xae\xf3\x80\x6b\xad\x70\x41\x1e\xf0\x84\x44\xf7\xf8\x01\xd7\x5e\xc9\x5a\x00\xb5\x51\x5f\x7b\xd3\xab\xb9\x4c\x5d\xbd\xf7\x63\xa6\xc2\xe9\x85\x88\x1e\x3c\xdf\x75\x22\xfd\x25\x00\xf3\x4f\xac\x4d\x3c\xbf\xff\xf3\x03\xae\xbf\x1c\x6c\xe8\x9a\x33\x6b\xca\x30\xf2\x53\x69\x48\xc3\xb8\x62\x01\x58\x28\xcb\xb0\xb5\xf9\xa8\xff\xa8\x79\xae\xe3\xec\xb2\x78\xcc\x58\xa9\x20\xd8\x23\xd5\x03\xed\x61\xd2\x22\x53\xcd\x0d\x35\xd8\xfa\xa7\x0e\xcb\x62\x18\x3e\x12\x96\xe1\x47\xb2\x5c\x52\x7e\x17\x98\x94\xa9\x13\xe0\x3d\xe5\x71\xb9\xb4\x8b\xfa\xf9\x7a\x89\xc1\x2e\xf4\x37\x6a\x57\xcc\x77\x9d\x2a\xb5\x1b\x29\xd9\xca\x49\x9d\x94\xa5\x53\x12\xd5\x6b\xbb\xd4\xa2\xf0\x50\xef\x68\x02\x0c\xb9\xb7\x62\xbe\x96\x7b\x63\xa5\xac\xcb\xda\xc1\x35\x1c\x41\xb2\x50\xe1\x6c\x29\x29\x57\x89\x37\x38\xbf\x9c\x8d\xa7\x73\x38\xbf\x9c\x5f\x69\x87\xd2\xe6\x84\x09\x5e\x9e\x87\x17\x9f\x8a\x62\x98\xe6\x79\x38\xfd\xa4\x4b\xe7\x70\x98\xfe\x71\x7c\x71\x3d\x9e\x81\x37\x4c\xfd\xe1\x30\x1d\x04\x90\x2a\x49\xf9\x5d\x1a\xfe\x4b\x50\x6d\x39\x80\x41\x29\x1e\x94\xfb\x07\xbe\x11\x5a\x10\xdd\x1a\xc2\x09\x23\x11\xde\x0b\xa6\x2b\xba\x17\x53\xc2\x30\x52\xe1\x75\x8a\xe7\x3c\xc6\xa7\xe6\x62\x50\x85\x12\xc0\xdb\x00\xde\xea\xce\xe3\x14\xa0\x0b\xb2\x0d\xcb\xd6\xef\x51\xad\xa1\x4c\xa0\x0f\xb8\x5e\xe9\xe6\x63\xaa\x55\x37\xfa\xfd\x11\x0f\xd3\xd1\xf8\xf4\xf8\xfa\x62\x0e\x36\xca\x61\x3a\xb0\x96\x8c\xd5\xef\x50\xe8\xf9\x50\xe1\xe5\x0f\xd3\x5a\x1d\xaf\xdc\xd3\xc5\xcd\x94\x69\xa3\xef\x2a\x53\xcb\x4c\x05\xf6\xc7\xd4\x90\xab\x1b\xa8\x45\xd8\xad\xf9\xed\x26\x61\x93\xed\x2d\x58\x2e\x48\xaa\xec\xb1\x3f\x1f\xb5\x63\x90\xa8\x3e\xf5\x65\xc5\x6c\x7c\x31\x3e\x99\x43\x97\x7e\x38\x9d\x5e\x7d\xdc\x8e\xf1\xe6\xf7\xf1\x74\x0c\xdb\xa9\xd0\x4a\xe0\xe7\xb2\xe2\xe6\x1e\x25\x9e\x30\x92\xa5\x68\xba\x9e\x91\xa8\x37\x0d\xf4\x79\xe9\xc4\xb5\x95\x30\x45\xf1\xb6\x6a\xd8\x6f\x36\x3d\x78\xc7\x31\x9b\x48\xba\x20\x72\xfd\x01\xd7\xd5\x09\xf3\xb7\x99\xde\xc6\xd2\x12\x64\xfd\xac\xa4\x1a\xcc\x75\x81\xbc\xba\x9e\x4f\xae\x75\x6e\xe8\x14\x19\x8f\xc2\x2d\x44\x0f\xc5\xac\xab\x61\x60\xdd\xed\xf8\xdb\x49\x9b\x8e\x33\x30\x1d\xcf\xaf\xa7\x97\xe7\x97\x67\x5b\xcc\x7e\x33\x75\x1b\xeb\x9b\x44\xde\xce\xea\xdd\x65\xa7\xb1\x12\xec\x4b\xfc\xcd\x14\xc3\x32\x73\x1f\x96\x98\x18\x22\xce\x79\x4c\x25\x46\xca\xab\x3e\xfc\xa1\x25\xae\x12\x4f\x68\xc7\x1e\x09\x6b\x4d\x09\x66\x31\x3d\x95\x62\x51\xd5\x65\xa3\xb0\x6c\xce\xad\xfe\xe2\x57\x63\x4f\x3d\xbb\x6c\x86\x1e\x33\xc4\x8d\xf0\x36\xbb\xfb\x28\x62\x5b\x82\x74\x4c\xa7\x26\x26\xc6\xbd\x7a\xfd\x46\x52\x85\xb2\xd2\x6f\x42\xf2\x9f\x97\xd6\x6e\xfb\xe5\xe0\x53\x93\x5a\x19\x3e\x4f\x8d\xb0\x17\xa9\x27\xdf\x76\x79\xb3\x4d\xc7\xd9\x55\xa5\x23\x35\x72\x5d\x9b\xab\x03\xfc\x5a\xf5\x79\x53\x0d\xfa\x87\xd4\x16\xf3\x8e\x11\x11\xde\x5a\xa9\xdf\x32\x4e\x3a\x2b\xe5\x1e\x9a\x6c\x6f\xb2\xa9\xd4\x4b\x87\xc4\xd4\xcc\x70\xe5\x20\xaa\xc7\x49\x33\xb3\xb7\x33\x4b\xc7\x10\x86\xa1\xef\xb6\xcf\xc9\xae\xcd\xa5\x05\xcf\xcc\xaa\x7b\x14\x55\x59\xde\x3a\x7b\xbd\x6e\x7e\xad\x86\x83\x6f\x73\x70\x7b\xdb\xb7\xbb\x56\x8d\xce\x3d\x53\x43\xfb\x9a\xa1\xef\x76\xfa\x62\x67\x4f\x79\xe3\xb2\x91\x71\xf3\x0c\xa5\x44\x79\xad\x00\xca\xd5\xd6\xfd\xa3\xba\x68\xec\x61\x50\x37\x37\xa6\xbf\x8e\xb4\x86\x7f\xfc\xbd\xe5\x9d\x5e\xa4\x31\x72\x45\x13\x8a\xf2\x44\xb0\x14\x3e\x7f\xa1\x5c\xa1\x4c\x48\x84\x79\x61\xa7\xdb\xfe\x96\x77\x54\xb5\xbc\x3b\xa1\x04\x98\xc9\xb8\xbc\xa8\x3c\xeb\x93\xf5\xa7\x82\xd9\x26\x44\xd8\x10\x8b\xf5\x08\xbf\x13\xb9\xb1\x94\xb3\x35\x8f\x4e\x09\x65\x95\xa5\x9f\x23\xc1\xcc\x33\xdd\xbb\x23\xa0\xba\x2f\x55\xf9\x3e\xf9\x80\xeb\xea\xea\x07\x6f\x9a\x47\x44\xb0\xc6\xb1\x30\x97\x73\x73\xb1\xdd\x68\x6a\x89\xce\xa9\x62\xf6\x1e\xb1\x59\xff\x13\x94\xfe\x78\x42\x74\xbf\x73\x1d\x11\x5a\x2f\xac\x64\x51\x80\xb9\x72\x44\x82\x85\x7a\xdc\x2c\x0a\xcf\xc6\x6c\xe3\x2a\xf9\x30\x43\xc3\x2f\xbf\xec\xc6\xf7\xad\x5e\xed\xae\x7c\x7e\xf3\x45\xaf\xed\x9f\x5f\x3f\x0f\x6a\x50\x8a\x62\xf0\x65\x37\x51\xad\x7b\x5e\x27\x17\x8e\xda\xd9\x90\x9b\x2c\x97\x84\xdf\x61\x2f\xbe\xf6\x28\x1a\x24\xac\x6b\x25\xa6\x61\x51\x04\xed\x03\x52\xbc\x42\xa1\xaf\xa6\xa9\x03\x6a\x7d\x3b\x4c\x7b\x7e\xff\x6f\x85\x7f\xa7\x9f\xab\x67\xbd\xeb\x34\x82\x0e\x76\x8d\xa2\x65\x0c\x4c\xc5\xca\x6b\x9b\xec\xd3\x1d\xce\x22\xc2\xbd\xaa\x59\x4f\x94\xdc\xdd\xaa\x1b\xd9\x69\xbd\x6a\x02\xd6\x63\xbd\xa7\x6c\xbe\xa2\x27\x55\x6e\xbd\x40\xc5\x5d\x8a\x65\x66\x1e\x69\x62\x7b\xa5\x01\x63\x3c\x35\x8f\x3c\xbd\x15\xd8\xa9\x06\xdf\x3d\xf5\x72\x73\x45\xe8\x25\x6f\x0f\x7b\x9d\x56\xf3\x23\x30\xb5\x9b\xe6\x61\x94\xbd\xb0\xf9\x4d\x09\xa8\xaf\x92\xfd\x80\x7c\x67\xf7\x7e\x81\xf6\x5d\xb6\xbd\x57\xef\xdb\x4e\x79\x4b\xaa\xfe\x0a\xb2\x6f\xb0\x6b\x96\xed\x77\x6e\x7b\xc0\x68\xbe\x3e\x1d\xf6\x7c\x55\x3d\x93\x1d\x20\x6e\x9e\xc5\xe0\xc8\xe2\x76\xb0\x81\xcd\xf3\x98\xb3\xe7\x29\x73\xf3\x87\xa6\x58\x1c\x27\x0a\xe5\x77\x3d\x63\x96\x0d\xac\x31\x5e\x1a\xa5\x5c\x0f\x07\x35\x4c\x85\xfb\xbf\x00\x00\x00\xff\xff\x55\x63\x97\xe6\x0c\x1c\x00\x00")
-
-func templates15_insertGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates15_insertGoTpl,
-		"templates/15_insert.go.tpl",
-	)
-}
-
-func templates15_insertGoTpl() (*asset, error) {
-	bytes, err := templates15_insertGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/15_insert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x13, 0xa3, 0x7e, 0x3e, 0xd8, 0xda, 0x3e, 0xd6, 0x78, 0x13, 0xdd, 0xd4, 0xbb, 0xc9, 0x46, 0xec, 0x92, 0x91, 0x6f, 0xad, 0xb9, 0x91, 0x6a, 0x8, 0x6d, 0x64, 0x60, 0xf8, 0x24, 0xb7, 0xc2, 0x23}}
-	return a, nil
-}
-
-var _templates16_updateGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x5b\x6f\xdb\x38\x16\x7e\xb6\x7f\xc5\x19\x63\x07\x90\xb6\xae\xda\x05\x16\xfb\xd0\x45\x1e\xdc\x36\x93\x29\xa6\xed\x3a\xb7\xcd\x43\x51\x04\x8c\x74\x64\x73\x42\x93\x0e\x49\xd5\x31\xbc\xfa\xef\x8b\x43\x52\xb6\x7c\x51\x62\x3b\xb7\x99\x79\x4a\x2c\x1d\x9d\xeb\xc7\xc3\x8f\x87\xb3\xd9\x6b\xf8\x1b\x13\x9c\x19\x78\x77\x00\x49\x8f\xfe\x43\x93\x9c\xb1\x2b\x81\xe0\xff\x24\x5f\xd9\x08\xe1\x75\x59\xb6\x9d\xb0\x49\x87\x38\x62\x5e\x80\x3e\xa9\xc9\xfc\x0f\x92\xd3\xc5\x5b\xf7\x01\xcf\x21\xe9\x65\xd9\x91\x50\x57\x4c\x38\x25\x6f\xde\xc0\xf9\x38\x63\x16\x8f\x80\x81\xe1\x72\x20\x10\x66\x33\xef\x43\x72\x3e\x3e\xe5\x72\x50\x08\xa6\xcb\x12\x34\xa6\x4a\x67\x50\x90\x10\xd8\x21\xc2\xc0\x6b\xc1\x5b\x4c\x0b\xab\x74\x42\xba\x4e\x11\x83\x3e\xc8\x95\x86\x91\xd2\x08\x99\x4a\x8b\x11\x4a\xcb\x2c\x57\x32\x69\xe7\x85\x4c\x21\x52\xf0\xf7\x8d\x66\xe2\xca\x9d\xc8\x79\x2b\x95\x85\xe4\xab\xfa\xa0\xa4\xc5\x5b\x5b\x96\xa9\xbd\x85\xd4\xff\x48\xc2\xc3\x2e\xcc\x66\x28\x33\x8a\x06\x52\x25\x8a\x91\x34\x70\xa5\xb8\x48\x3e\xf8\x1f\x31\xf8\xb8\xbf\xaa\x13\x35\x31\xbd\x3c\xc7\xd4\x62\x56\x96\xa8\xb5\xd2\xb3\x19\x0a\x83\x65\x19\x71\x69\xff\xf5\xcf\x2e\xb8\x87\xf1\x42\xe1\xac\xdd\xd2\x68\x0b\x2d\x41\x25\xde\xb1\xa8\xd2\x36\xf7\xc9\x19\x3b\x42\xfb\xf1\x7d\x14\x57\xfa\x52\x7b\xdb\x85\xea\x45\x90\x0c\xef\x65\x56\x96\xdd\xca\xd3\xb8\x5d\xb6\xdb\x73\x73\xed\x45\x89\xfa\x4c\xf2\x74\xb9\x42\x7d\x28\x0c\x1a\x60\x72\x9e\x72\xb0\x0a\x0a\x9f\x6d\x2a\xc8\xc6\x84\x76\x81\xc9\x0c\xc6\xa4\xce\x80\x92\x3e\xc2\xc7\xad\x55\x7f\x3d\x27\xe4\xa1\x8f\xff\x30\xf8\x5a\xcb\xcc\x7a\x05\x17\xe2\xe1\x51\xed\xab\xa5\x7c\x6d\xaa\x6c\xc0\xc8\x72\x75\x5d\x3d\x97\xea\xd8\x2c\xab\xfd\xaf\x00\x24\x87\x0c\x5a\x4b\xcb\x15\xdf\x80\xc4\x3a\xf2\x28\x82\x5a\x55\x5b\x3c\xa7\x4c\xc3\x4f\x07\x20\xb9\x20\xf3\x2d\x57\x82\xc8\xf9\x7f\xa1\xd9\xf8\x50\xeb\x08\xb5\x8e\xe3\x76\xab\x24\xe7\x5e\x43\x93\x7b\xed\x39\x06\x83\xa3\x24\x5e\x21\x66\x03\x7c\xa8\xde\xb5\x55\xde\x80\xa6\xa3\xfe\x83\x17\x3c\xf4\x9f\x12\x55\x47\xfd\xc7\x6e\x01\xcf\x03\x94\xc7\x6b\x0d\x2f\x04\xa2\x39\x44\xf6\xea\x37\x73\x10\xd4\x0b\x10\x12\xe4\x7f\x9d\xa2\x5d\x46\x84\x53\x2b\x33\xd4\xc6\x12\x76\x7d\xfc\x20\xb8\xb1\xc0\x65\x8e\x1a\x65\xea\xc1\xe4\x6d\x9b\xa4\xe6\x62\xa6\xd0\xb8\x88\x59\x61\xd5\x88\x59\x9e\x32\x21\xa6\x75\x2f\x03\x8c\xb9\x84\x94\x19\x04\x95\x43\x86\x39\x2b\x84\x85\x1f\x4c\x14\x68\x12\x38\x37\x08\xc9\x09\x0a\xc5\xb2\x28\x26\x67\x34\xe6\x1a\xcd\xb0\xf6\xb9\xd9\x16\xb5\x2f\xdb\x0a\xf7\xde\xe4\x08\x3a\x16\x47\x63\x41\x59\xeb\x58\x3e\x42\x63\xd9\x68\x7c\xe9\xf3\x78\x39\x44\x31\x46\xdd\x81\xc4\x23\xa4\xf5\x83\x69\x87\x4c\xa7\x69\x79\xc5\xfc\xaa\xd4\xb5\x71\x62\x15\x7c\x69\x81\x64\xea\x3d\xe6\x4a\xa3\x4f\x92\x93\xd9\xba\xad\xc6\xff\x5e\x5d\x05\x01\xc9\xcd\x0b\xf5\xed\x92\x0e\xad\xc3\xf2\x98\x43\xbc\x75\x8d\x53\x5a\xb9\x23\x76\x8d\x1f\x58\x3a\xc4\xdf\x70\x1a\x85\xbc\x76\xc9\x4c\x4c\xe2\xa1\xcc\x1f\xd5\x44\x2e\x0a\x1d\x90\x4c\x1f\x7d\x29\x6c\x72\xf2\x59\xa5\xd7\x51\xdc\x6e\xa5\xf4\xa4\x0b\xee\x4f\x46\xba\xef\xff\xfe\xdb\x35\x4e\xbf\x6f\x6d\xe8\x5c\x0a\x6f\xca\x25\xf6\xa7\x60\x88\xd2\x31\x11\x64\x2f\xdd\xbc\xd4\xa2\x76\xab\xd5\x64\xa2\x27\x44\xc0\x4f\xf7\x0e\xa9\xbe\xe6\x23\xa6\xa7\xbf\xe1\xb4\x26\x1c\xb7\x5b\xbe\xec\xc9\x47\xce\x04\xa6\x36\x39\x37\xd8\x2b\xac\x0a\x32\x1e\x01\xe4\xda\x01\x18\xab\x47\x8c\x36\x9a\xe4\x94\x3a\xde\x68\x2c\x90\xd6\x7e\x34\x11\xdd\xa6\x2c\x05\x2d\x17\xdc\x0e\x49\xa9\xb7\xe6\xf0\x5f\xd9\x0d\x75\xa7\xb7\x67\x15\x5c\x8d\x7b\xed\xb2\x13\x92\xf1\xc9\x5c\x0c\xb9\x45\xea\x25\x51\xec\x92\x75\xbf\x4b\xdf\xbe\x1b\xab\xb9\x1c\xcc\x3a\xa9\x46\x66\x31\xbb\x64\xb6\x53\x92\x0b\x65\xe5\x46\x88\x8e\xe7\x20\x50\x46\x13\x11\xc3\xc1\x01\xbc\xf5\xfa\x77\x06\xa7\xd2\x26\xf9\x8a\x93\xa8\x33\x9b\x25\xfd\xeb\x01\x71\xf7\xb2\x7c\x07\x85\x74\xa4\x7e\xd1\x72\x67\xb3\x1a\xbb\xf7\x8d\xa0\x10\x99\xb3\x71\x55\x70\x91\xc1\xa4\x0a\xb5\xe3\x9d\x6d\xb7\x3c\x2a\x93\x9b\x02\xf5\x14\x0e\x20\x1f\xd9\xe4\x74\xac\xb9\xb4\x79\xd4\x39\xef\x7f\xec\x9d\x1d\x52\x01\x4c\xfd\x94\x00\xa7\x87\x67\xf0\xb3\x81\x8b\x5f\x0f\x4f\x0e\xe1\x67\xd3\x71\xd0\x58\xca\x57\x9f\x69\x36\x22\x27\x8c\xf3\xf9\xf3\x71\x59\x76\xba\x40\xff\x9e\xf8\x7f\xd7\x80\xf1\x49\x66\x78\xdb\x17\x2c\xc5\xa1\x12\xd4\xe8\xcb\xf2\x1f\x55\x57\x7a\x3b\x6f\x6c\x13\x11\xaf\x18\xbb\x18\xa2\xc6\x0f\x82\x15\x06\x1f\x60\x2a\xd4\xe8\xd5\x06\x93\xdb\x42\x3e\xae\x30\xef\x13\xea\x76\x8e\x2f\x6c\x3c\xe6\x72\xd0\x0d\x4d\x8e\x92\xcc\xd1\x24\xef\xb9\xcc\xc2\xab\xa8\x41\xfd\xd9\x74\x8c\x8d\xb6\xe7\x6a\xd9\x78\x8c\x32\xbb\x6b\x95\xac\xb9\x99\x24\x09\x71\x81\x0d\xc4\x61\x9f\x9e\x49\x78\xf7\xdd\x9e\xf6\x49\xea\x31\x55\x8c\xff\x75\x4f\x7e\xd1\x6a\x54\x45\xaa\x31\x77\x15\xf8\x24\x33\xae\x31\xb5\xf3\x07\x4e\xf4\x3f\x79\xa4\xe2\x38\x74\xc7\xa5\xec\x51\x3b\x5b\xd9\x32\xe7\x9b\x87\xdb\xe0\x3e\xe2\x55\x31\xf8\xa2\x32\x74\x61\x10\x82\x7f\x71\x08\x16\x32\x5a\xbc\xbf\xd0\xdc\xa2\xae\xf4\x3b\xb8\xc7\xf7\x4b\xfb\xb8\x2a\xee\x44\xd0\x58\x36\xfd\xc9\x38\xf1\x28\xb5\xb7\xbe\x77\x4c\xdc\x87\x94\x88\x55\x65\x94\x0a\x27\xb7\x6a\x75\xb2\x85\x67\x93\xcd\xfe\xcc\x37\xab\x4d\x5b\x7b\xe8\x40\x1b\x53\x77\x59\x41\x92\x76\x4f\xc7\x3a\xa2\x9a\xf9\xca\x0e\x61\xc5\xf7\xb3\x79\xe0\xeb\x1f\x06\xbd\x91\xdb\x96\xef\x54\x52\x35\xc5\xba\x3e\xa2\x09\x1a\x0d\x71\x2d\x73\x23\x92\x13\xf7\x6f\x93\xd7\x5e\x70\x5f\xd7\x1b\xbe\xde\xcb\xff\xea\xdf\xf5\x55\xb4\x57\x6f\x27\xa2\x4e\x2c\xdd\xf7\xad\x1d\x3a\x3c\x51\xf5\x4e\xec\xd7\x60\x93\xc9\x10\x7d\x75\x30\x09\x27\x12\x9f\x8d\xa4\x2e\x18\x6d\x3c\x4f\x84\x80\xde\x76\xef\x75\x36\x67\x5c\x60\x46\xce\x0e\xd0\xba\x43\x04\xb0\xca\x87\xab\x39\xe1\x26\x96\xbe\x12\xc5\x22\x82\x05\xa2\x57\x08\xcc\x76\x0c\xa8\x62\x5a\x5b\x88\x3b\x66\x05\x07\xbe\xe2\x5b\x1b\x98\x33\xac\xb5\x8c\xd7\x48\xed\xbd\x10\x58\x3e\x24\x3a\x20\x10\xff\xed\xe5\x16\xf5\x5e\xf4\xd7\xb3\xf3\x3a\xd4\x77\xf7\x40\x72\x11\xd4\x38\x0e\x75\xcf\xa4\xa9\x27\x44\xbf\x3a\x6c\x01\x13\xc2\x97\x7b\xc2\xed\x10\x46\xcc\xa6\x43\x2e\x07\xd5\x29\x4d\x12\x0d\x68\x98\x31\xf9\x13\xd3\x4d\xd3\xee\x75\xec\x9a\x61\xcd\xe4\xf3\x9c\x9d\x0c\x7c\x79\x9a\x79\x40\xb5\xe6\xdd\x46\x99\xcc\xc3\xda\x6d\x7c\xf4\x72\xc7\xfe\xbb\xc7\xc3\x3d\x21\x8e\x1a\x20\x41\xa7\x64\x33\xc6\x94\xe7\x1c\xe7\xa7\xf7\xd0\x5e\x77\xc5\xc0\xde\x63\xdf\x45\x55\x1f\x3a\xe8\x5d\x2b\xdd\x63\x0c\x74\xd6\x06\xbd\x4b\x99\x7d\x86\xc4\x3e\xf7\xda\xda\xbb\x0a\x15\xc5\x3c\x45\x1b\x26\x2a\x37\xc9\xb1\xdf\xb2\x7d\x1e\xf7\xe6\x43\x6e\x59\x3a\x55\x9e\x58\x84\xe6\xba\x89\x01\xad\x88\x2e\xb1\x88\xda\x67\x9b\x38\xcf\xfd\xe4\x66\x1b\x3f\xee\x90\xdf\xc2\x99\x17\x23\x30\x84\xdf\x3b\x29\xc0\x0b\x93\x98\x85\xc3\x1a\xad\xe6\xf8\x03\x57\x98\xcc\x96\xfc\x65\xdf\x2d\xb8\x7c\xd2\x2e\xab\x36\x4f\x64\x4f\x05\x4f\xf1\x8f\xd5\x63\xd5\xb3\xf4\xd8\x1d\x6e\x43\x28\x2d\x4d\x94\xe7\x8e\xcc\xdf\x49\x7c\xb6\x2d\xc7\xde\xf7\x1c\xcf\xc2\x64\x9e\xaa\x54\x2f\x79\x43\xb6\x3b\xed\x7d\x62\x0c\xfc\x95\xa8\xef\x1a\x60\xfe\xf8\xd4\xf7\x61\x5c\xac\x1b\xae\x48\x6b\x17\x65\x3b\x96\xff\x4f\x43\xce\x84\xa4\x0a\xbb\xf7\x91\x40\x19\xa9\xd8\xd7\x4c\xc8\xc5\x00\x7c\x37\x8a\xe1\x0e\xa6\x61\x24\x40\x1a\x1d\x0e\xf6\x55\x76\xc7\x30\x7d\xc1\x4f\x34\xde\x14\x5c\x53\x81\x2d\x08\x64\xc6\x82\x92\x58\x55\x94\xe9\x81\xbb\x97\xac\x36\xfd\x54\x09\x37\xe8\xae\x2e\x8b\xa2\xea\x72\xa0\xbb\xf0\x36\x6e\xb7\x98\x1e\xd4\x45\xb8\xb4\xa8\x73\x96\xe2\xac\x5c\x92\x6b\xb7\x38\x49\xbd\x6d\xb7\x88\x67\xd0\xd1\x39\xcc\xa1\x1c\xdd\x61\x72\x80\xbe\x4e\x14\x79\x65\xf9\x1b\xff\x0e\x07\x4e\xb6\xdd\x72\x76\xfc\x03\xf7\x59\xbb\xd5\xe2\xaf\x5e\x79\x4f\xdf\xbc\x81\x9e\x1b\x18\xbb\x20\x55\xee\x10\x3b\xf6\x03\x62\xb8\xc6\x69\x00\xab\x63\x38\xc8\xd2\x61\x88\xd8\xbb\x72\xd9\x05\x75\xf5\xfb\xc2\x0b\xe5\x97\xde\x35\x4e\x7b\x21\xb2\x87\x4c\x7e\xaf\x7e\x8f\xe3\x2d\x66\xd8\xf3\x89\xb0\x8f\x13\x0e\xaa\x09\x38\xfd\xea\x42\xe5\x8d\x1f\xd9\x51\xc8\xe6\xc6\x5d\x7c\xed\x7d\xab\xf1\x2c\x97\x1a\x55\x1d\xe3\x65\x8b\xb5\x9b\x8d\x13\x1c\xbb\x6b\xa6\xc8\x23\x2b\xca\x82\x89\xcf\xc7\x71\x17\x56\x9e\x9d\x1c\xc7\xdb\xdf\x79\xcc\x4d\x3f\xe4\xe6\xc3\x03\x58\xc5\xf1\xe3\x4e\xea\xcd\x8d\xd8\x62\x42\xcf\x6a\xf5\x7e\xf2\x11\xfd\x26\x97\x26\x0d\x8e\x3c\x74\x36\xff\xae\x3e\xe1\x36\x37\xa2\x6e\xa1\xe1\x40\xba\x79\xa6\xbd\xe1\xdb\xbd\x4f\xa5\xdb\x79\xd4\xf4\xd1\x0e\x6e\xbd\xe8\xf9\x94\xcb\x26\xec\x83\xa1\x7d\xf9\xcf\x73\x58\x0d\xd1\xd4\x62\x6b\x08\xac\xb3\x8e\xdb\xfd\x4f\xb0\xff\x0f\x00\x00\xff\xff\x9e\x99\xbb\x40\xa4\x2a\x00\x00")
-
-func templates16_updateGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates16_updateGoTpl,
-		"templates/16_update.go.tpl",
-	)
-}
-
-func templates16_updateGoTpl() (*asset, error) {
-	bytes, err := templates16_updateGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/16_update.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0xef, 0xd3, 0xfb, 0x6a, 0x87, 0xf1, 0xc0, 0xfa, 0x0, 0x25, 0xc9, 0x44, 0x3e, 0x52, 0xce, 0x24, 0x37, 0x44, 0x60, 0xed, 0x3c, 0xae, 0x49, 0x18, 0xfb, 0xf6, 0x74, 0x46, 0x6a, 0x39, 0xdf}}
-	return a, nil
-}
-
-var _templates18_deleteGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xdd\x73\xdb\xb8\x11\x7f\xa6\xfe\x8a\xad\xa7\x9d\x21\x5b\x06\xf1\xdd\x74\xfa\xe0\x8e\x1f\x94\x58\xf1\x65\xce\xf1\xc9\x1f\x69\x1e\x6e\x6e\x32\x10\x09\xca\x48\x20\x40\x06\xa1\xc8\x1e\x96\xff\x7b\x07\x1f\xa4\x48\x89\xd4\x97\x65\x5b\x76\xef\x49\x14\xb9\x58\x2c\x16\xbf\x5d\xfc\xb0\x9b\x65\x6f\xe0\xaf\x98\x51\x9c\xc2\xd1\x31\xa0\xae\x7e\x22\x29\xba\xc6\x03\x46\xc0\xfe\xa0\x73\x3c\x22\xf0\x26\xcf\x3b\x46\x38\x8d\x6e\xc8\x08\x5b\x01\x3d\xa4\x22\xf3\x5f\x40\x57\x95\xaf\xe5\x90\x08\xf3\x2b\x91\xa8\x13\xc2\x88\xaa\x0e\x7a\x5f\x7b\x3f\x9b\x41\x24\x4a\x4b\x61\x1e\x03\xea\xc6\xf1\x4c\x26\x9d\xd7\x65\x86\xd0\xc4\x88\x9d\x32\x31\xc0\xcc\xa8\x79\xfb\x16\xac\xc0\x29\xc4\x6e\x20\x86\x94\xf2\x21\x23\x90\x65\x76\xbd\xe8\xf3\xf8\x8a\xf2\xe1\x84\x61\x99\xe7\x20\x49\x24\x64\x8c\xaa\x23\xa7\x94\x31\x18\x61\x15\xdd\x00\x1e\x62\xca\x53\x05\xea\x86\xc0\x58\xd2\x11\x96\xf7\xf0\x9d\xdc\x43\x24\xd8\x64\xc4\x41\x09\x48\x28\x8f\xcd\x67\xab\x48\xbf\xb2\x33\xa3\x4e\x32\xe1\x11\xf8\x02\xfe\xde\x38\x73\x50\xcc\xe7\x9b\x85\x70\xa1\x00\x9d\x8b\xf7\x82\x2b\x72\xa7\xf2\x3c\x52\x77\x10\xd9\x3f\xc8\xbd\x34\x72\xc6\x49\x79\x1e\xc2\x0d\x96\xb1\x73\xc6\x40\x08\x96\x65\x84\xc7\x79\x9e\x65\x84\xa5\x44\xff\xce\x64\x5b\x25\xf5\x4f\x00\xd6\x8f\xe7\xe2\x52\x4c\xd3\x6e\x92\x90\x48\x91\x38\xcf\x89\x94\x42\x16\xda\x7c\xca\xd5\xbf\xfe\x19\x82\x79\x19\x98\x91\xda\xdd\x90\x75\x3c\x49\xd4\x44\x72\x10\xc8\xce\xe0\x17\xda\xca\x85\x0c\x04\x65\xe8\x94\xa8\x93\x77\x7e\x50\xe8\x8b\xd4\x5d\x08\xc5\x07\x27\xe9\xbe\x5b\xd3\x9a\x17\x5a\x98\xdc\xc9\x3b\x9d\xd2\x88\xce\x0c\x08\x7d\xcc\x69\x54\xc7\x41\x7f\x33\x1c\xc0\x94\xaa\x1b\xc0\x1c\xc8\x1d\x89\x26\x4a\x48\x54\x55\xb5\x2b\x60\xbc\x7d\x0b\xc6\xd4\x14\x04\xb7\x3e\x5d\x17\x2c\xfd\x45\xff\x6a\x4b\xad\x2f\x7b\xce\xe6\x8a\x97\xe7\x21\x14\xc2\x4c\xdc\xbd\xaa\x8c\x5a\xe6\xfb\x2a\x74\x1c\x66\x1c\x64\xeb\xb8\x31\x48\xa9\x21\xa4\x5d\x56\xda\x7f\x21\x38\xbd\x44\x4a\x1d\xfe\x75\x2c\x35\x04\x86\x1b\x60\x26\xd0\xeb\x59\x89\x17\x8f\x26\xda\xcf\xf0\x97\x63\xe0\x94\x69\xa3\xbc\xb1\xde\x00\xdf\x38\xe2\x8b\xc4\xe3\x9e\x94\x3e\x91\x32\x08\x3a\x5e\xae\x4d\x7e\x03\x6d\x46\x77\x4a\xcc\x3b\xf3\xb5\x78\x81\xc5\x06\x60\x16\xc9\xcc\x65\xa9\x16\x9c\x9e\x6e\x08\x54\x54\x1b\xfa\xfc\xc0\x3c\xed\xef\x45\x1a\x7b\x1a\x48\x3e\x76\x7a\x7b\x26\xb8\x96\x88\xda\x5d\xce\x7c\xe2\xb3\x74\x9f\xb2\xe3\xd6\x27\x2a\x4d\x40\xc0\xf1\x6c\xeb\xdd\xf6\xb5\x63\xf6\xb0\x96\x0f\xb5\xc2\x14\x9d\x93\xa9\x7f\x90\x65\xa8\xff\x7d\xa8\x19\x5a\x9e\x1f\x01\x17\x2d\xdb\x38\x96\xe2\x07\x8d\x49\x0c\x89\x90\xce\xe1\x07\x06\x58\xf5\x40\xf9\x45\x88\xef\xa9\x41\x4a\x81\x4f\x13\x18\xb1\x78\x47\x12\x21\x89\x75\x84\x11\x5a\x3b\x71\x07\xff\x9e\xc7\xf9\xc6\x8b\x2d\x03\xc0\xf8\xbe\x30\xd9\x52\x49\x63\xeb\x0f\x2c\xc1\xef\x78\x5e\x7a\xcb\x20\x55\x92\xf2\x61\xc7\xf3\xb0\x1c\xa6\xf0\xfb\x1f\x94\x2b\x22\x13\x1c\x91\x2c\xef\x78\x36\xee\x2a\x7b\x9a\x15\x82\xc7\x70\x3b\x21\x92\x92\x14\xfd\x07\xb3\x09\x49\x3f\x48\x31\xfa\x84\xc7\x63\xca\x87\xbe\x24\x09\x23\x91\x42\x1f\x79\x4c\x25\x89\x54\xf9\xc2\x88\xfe\x96\xf8\x22\x08\xc2\x99\xe3\x4f\xc4\x94\xcf\x5c\xdf\xb7\x61\xf0\x2b\xb9\x77\xea\x02\x67\xe8\x31\x1c\x9c\xf4\xce\x7a\xd7\x3d\xf8\x70\xf9\xdb\x27\x3d\xbc\xc2\xbe\xf3\x1c\xbe\xfc\xd2\xbb\xec\x39\x9c\x9d\x50\x6c\x26\xfc\x9c\x92\x8f\x3c\x26\x77\x7d\x86\x23\x72\x23\x58\x4c\x64\xaa\x11\x3b\xbd\x21\x92\xbc\x67\x78\x92\x12\x40\x67\x17\x80\x2e\x2f\xe0\xa7\x82\x89\xf7\x7f\x25\xf7\xe8\xbd\x89\xc0\xb4\x9a\x76\x9b\x06\x1d\xb6\x0e\xd2\xae\x3f\xe8\x78\x39\xe8\xe1\xc6\x6f\xd1\x44\xca\x6b\x3a\x32\xa4\x5f\xd1\x11\x41\xe7\x62\xea\x07\xe8\x23\xf7\x8b\xbc\x78\x26\x22\xac\xa8\xe0\xbe\x4e\x62\x5e\x91\x60\xe3\xae\x82\x63\xe0\x13\xc6\x90\x1e\xae\x3d\xed\x17\xba\xb4\xdc\x94\x69\x8d\xbf\xff\x61\x77\x32\x3b\xb0\x70\x8d\xbf\x62\x75\x90\x97\xbe\x4b\x46\x0a\x5d\x8d\x25\xe5\x2a\xf1\x0f\x3e\xf7\x4f\xba\xd7\xbd\x45\x17\x5e\xf5\xae\xe1\x6f\xe9\x83\x3d\xf9\xf3\x23\x78\x32\xec\x78\x9e\x97\x2a\x39\xc2\x3a\xf9\xa2\x2b\xa2\xfa\x58\xe2\x91\x0e\xe4\xd4\x44\xf5\xd9\x85\x96\x02\xfd\x78\x69\x1f\xd7\x59\xc0\x4f\x85\x51\x87\x6e\xa2\x10\xa6\x2c\xd0\x93\x69\xcf\xfe\xd0\x78\x75\x30\x0c\x8b\xf0\x2e\x70\xff\x8e\xf2\xb8\x40\x7c\x0b\x96\xaf\xef\xc7\xa4\x15\xe8\xa5\x5e\x3c\x1e\x13\x1e\xfb\x53\xb6\x46\x4c\x38\xbf\x20\x84\x0c\x44\x16\x0f\xc5\x6d\xb2\x85\x4e\x17\xbb\x8a\xea\xaa\xcb\x8a\x93\xd8\x44\x80\x49\x3c\x66\x92\xa3\x27\xcd\x1d\x1a\xfe\x47\x2f\x33\x77\x2c\xa4\xf0\xd9\xd1\x51\x9e\x39\x26\x75\x9c\x90\xc1\x64\xf8\x49\xc4\x36\xcf\xe8\x50\xff\x60\x42\x9d\xb9\xd4\x62\xbe\x7f\x91\x54\x11\x19\x42\x7a\xcb\x82\xd5\x52\x7a\xa7\x34\xca\x16\xb6\xb0\x98\xf3\x63\x6a\xe4\xfd\x48\xdd\x05\x66\xda\xa9\x19\xa9\x7d\x3d\xaf\xcd\x66\x2c\x75\xb7\x30\xed\x74\x89\x49\xd3\x16\x43\x0a\x66\xe6\x35\x31\x0a\x6b\x62\xb3\xb3\xbe\x96\x11\xac\x8f\x59\xc3\x7d\xfc\xf4\x96\x55\x67\xa8\x2d\xb4\x41\xde\xe9\xf3\xcd\xb1\xdd\x30\xd6\xd9\x56\x53\xd3\x6c\x8c\x24\xe9\x84\xa9\x0d\x2d\x6a\x1b\xb4\x81\x59\xc5\xe3\x62\xe2\xd8\x8a\x52\x69\xde\xad\x49\xb7\xcd\xbb\x15\x62\x35\xe1\xa6\xea\x55\xb2\x55\x48\xa4\x18\x41\x96\x55\x2a\x64\x79\xde\xc4\xa8\x16\x77\xb3\xbc\x7e\xb8\x65\x5b\x2f\xa0\xaa\xa0\xdf\x78\x3f\x70\x2b\x3a\x0c\x57\x5a\x9b\x60\xca\x88\xe1\xd6\x43\xa2\xcc\xa5\x00\x70\x61\xc3\xe0\xbe\x5c\x82\x90\xed\x2b\x98\xc3\xe5\x2a\x7e\xd8\x4d\x14\x91\xfb\x42\x0f\x57\x6a\xa8\xdf\x00\x8d\x1e\x4e\x59\xa7\x5a\x67\xaa\x14\x1c\xed\xbd\xe4\xb6\x2d\x49\x5f\x4c\x88\xbc\x2f\x6e\x27\x5d\xc6\x36\x29\xf6\x3d\xd9\x85\xc3\xb9\xe4\x16\x95\x66\xee\x5b\x15\xaf\xcb\x58\xa5\x3e\xc2\x98\x81\x6d\x68\x4a\x2b\xe3\xe6\x7a\xc5\xda\x3b\xf2\x9a\x2b\x6a\x45\x10\x18\x16\x32\xbf\xbb\x2f\xb8\xae\x56\xc5\x45\x0d\x16\xa6\xd0\x40\xf9\xd0\xa8\xd8\x18\x0a\xfb\x84\x84\x87\x54\x0f\x6e\x91\x59\xd9\x63\xd7\x10\x1a\x9c\xd9\x54\x4a\xd0\x1b\x53\x3b\xfc\x2a\x77\xf3\xc5\xfb\x76\x41\x96\xaf\x88\xeb\xf4\xf8\x6e\x35\xc1\x83\xae\x97\x15\xb5\x9f\xc7\x31\x9e\xa9\x0d\xe1\x53\xed\x12\x79\x04\x85\xea\x7c\x91\x0e\x2e\x33\xae\x89\xbf\x6e\xce\xd6\x9c\x3e\x4b\x8f\xcc\x11\xd8\x4a\xd4\xaa\xa2\x35\x52\x54\x19\xb6\x35\x47\x5b\x69\xc7\x12\xf9\x35\x8c\x79\x36\x66\xa6\x93\xc4\xcb\x67\x67\x66\x15\xeb\x11\xb4\x1d\xd3\x9d\x6a\xe6\x3d\x5d\x38\x91\x81\x72\x53\xbe\x4d\x19\x8d\x2a\x35\xdb\xc6\xaa\xe3\x95\x96\xd9\x92\x19\xed\x7f\x1b\xf4\xa5\x70\xa8\xda\x8e\x85\x30\x49\xf5\xe1\x59\x29\xe5\x2f\xe5\x58\x6b\xee\xec\xff\x0b\xc3\x5a\xd8\xfb\x17\xcc\xb0\x36\xe8\x5c\xea\xd8\x5d\x09\xac\x87\xa3\xe8\x75\x36\x18\x97\xe2\xe7\xb5\xb7\x19\xe7\xd9\xfb\x3a\x09\x69\x43\xd4\xec\x53\xea\x79\x08\xa5\x67\x84\xfb\x22\xd0\x8c\xfe\x70\x0b\x9a\xa4\x0f\xf4\x15\xed\x3c\x3d\x41\x0b\xb3\x5f\x68\xef\x05\x1a\x31\xd6\x0e\xcd\x42\xbe\x86\x20\x06\xdf\x0c\x1f\xc2\x7c\x48\x40\xd8\xda\x7c\xa5\x06\x34\xf8\xb6\xe3\x2e\xe1\x76\x95\x7f\x53\xfa\xcf\x1f\xb9\x61\xd8\xe6\x11\x00\x00\xcf\x1b\x7f\x27\xf7\xdd\x1d\xf4\x05\x06\xdf\x36\xeb\x0c\xd8\xd9\x5d\xdb\xc3\xf5\x60\xf4\xbf\x10\x0a\x8b\x4c\x05\xd5\x88\xe5\x1b\xf5\x20\x0f\xe0\x1f\xf5\x6e\xd5\x97\x59\xf5\xff\x92\x8c\x09\xd6\xa4\xd8\xba\xd1\x8f\x5d\xb7\xe1\xec\x22\x08\x61\xee\xdd\xe5\x45\xb0\x75\x17\x6b\xdd\x4e\x52\xe8\xe2\xe8\x61\x77\xc9\x25\x98\x7f\xae\xed\xf5\xd6\xd8\x5b\xcf\xf3\x74\x20\xae\xd7\x66\xcd\x1f\xaf\xd5\xfa\xe4\x80\xf9\x79\x07\x80\x79\x96\x8e\x6c\x7d\x4b\x6b\xc9\x27\x2b\xab\x14\x4b\xba\x57\x3a\x91\x35\xe5\xad\x76\x00\x3f\x1f\x7e\x57\xc3\x57\xa7\xea\x0d\xfa\x9b\x16\x66\x2f\x2d\x2d\x3d\x77\x17\x74\x3f\x5a\xa0\x7f\xf6\x3f\x5f\x76\xff\xb3\x52\x65\x6b\x8c\x00\xcb\xee\x5f\x6d\xcd\x6d\x2b\x96\x3d\xdf\x24\xdd\x8e\x64\xef\xb0\xd5\xba\x53\x8e\xbd\x7d\x19\xf2\x7f\x01\x00\x00\xff\xff\x39\xb7\x6b\x64\xa8\x32\x00\x00")
-
-func templates18_deleteGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates18_deleteGoTpl,
-		"templates/18_delete.go.tpl",
-	)
-}
-
-func templates18_deleteGoTpl() (*asset, error) {
-	bytes, err := templates18_deleteGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/18_delete.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x62, 0x4b, 0x7c, 0x2b, 0xea, 0xf, 0xb9, 0x52, 0x63, 0xfa, 0x7f, 0x9c, 0xdb, 0x46, 0xb6, 0x68, 0x96, 0x83, 0x82, 0x41, 0x99, 0xff, 0xd6, 0xcc, 0x27, 0x85, 0x8e, 0x1, 0x68, 0xd, 0x11, 0x63}}
-	return a, nil
-}
-
-var _templates19_reloadGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x5f\x6f\xdb\x36\x10\x7f\x96\x3e\xc5\x55\x28\x06\xc9\x53\x99\xee\x35\x83\x1f\x5c\xc7\xcd\x8a\xfe\x73\xec\x76\x79\x18\x86\x82\x96\x4e\x36\x13\x9a\x54\x48\x2a\x8e\x21\xeb\xbb\x0f\xa4\x64\x5b\x71\xdc\xd4\x59\x83\x2c\xd8\x53\xcc\xe3\xf1\x74\xf7\xfb\x1d\xef\x8e\x29\xcb\x57\xf0\x92\x72\x46\x35\x1c\x77\x81\xf4\xec\x2f\xd4\xe4\x0b\x9d\x70\x84\xfa\x0f\xf9\x44\xe7\x08\xaf\xaa\xca\x77\xca\x3a\x99\xe1\x9c\xd6\x0a\xf6\x48\x4b\x67\x05\x64\xdc\xda\xdd\x1c\x49\xa8\x18\xcb\xcc\x9c\x20\x47\xd3\x3e\xd4\xbf\x25\x77\xda\x2c\x03\xd2\x4b\xd3\x53\x2e\x27\x94\x3b\x0b\x47\x47\x30\x42\x2e\x69\x7a\x0a\x0a\x33\x34\xc9\x0c\x35\x98\x19\x82\x9c\x5c\x60\x62\x20\x53\x72\xee\xd6\x29\x35\x74\x42\x35\x42\xa1\x99\x98\x3a\x51\xae\xd8\x9c\xaa\x25\x5c\xe2\x52\x13\x3f\x2b\x44\x02\xa1\x84\x4e\x59\xd6\x21\x93\xaf\xf9\x98\x89\x69\xc1\xa9\xaa\xaa\x68\xfd\x99\xd0\x79\x21\xa4\x01\xf2\x49\xf6\xa5\x30\x78\x63\xaa\x2a\x31\x37\x90\xd4\x0b\xd2\x08\xcb\x12\x45\x6a\x0f\xa2\x52\x52\x41\xe9\x7b\x2c\x03\x09\xdd\x2e\x08\xc6\xed\xd2\x53\x68\x0a\x25\xea\x7d\x4d\x3e\xe1\x22\x0c\xca\x92\x0c\x2f\xa7\x16\xae\xaa\x3a\x06\x21\x61\xaf\x33\x90\x2b\x79\xcd\x52\x4c\x21\x93\x0a\x94\x73\x2c\x88\x7c\xaf\xf2\xfd\xb5\x51\x49\x6a\x7f\x6b\x77\xdb\xae\x4e\x24\xe3\xe4\x14\xcd\xc9\x9b\x30\x2a\x4b\xe4\x1a\x9d\xfb\x31\xac\x37\x1a\xcd\x66\xdf\xc5\xe0\x57\xbe\xef\x7e\x3b\xcc\xb7\x44\x0c\xa9\x60\xc9\x6d\x1e\x86\x87\xf2\xb0\x60\x66\x06\x54\x00\xde\x60\x52\x18\xa9\x08\x38\x6b\x1a\x64\x03\xc9\xa1\x94\x0c\xef\xc6\x68\x6d\xd6\xf1\x0c\x1a\xeb\xad\x48\x77\x89\x8a\x61\xab\xde\x88\x5a\xa7\x6a\x0e\x6b\xf6\x50\x29\x9b\x9f\xb7\xb1\xdd\x93\x0a\x31\x6c\xc0\x72\xb6\xa3\xdf\xdd\xd1\x17\x5b\xea\x73\x1b\x6a\xe8\x3e\x79\xae\x68\x3e\x50\x2a\x44\xa5\x22\xc7\xe1\x1e\xac\xa9\x48\xdb\x89\xff\x1d\xe8\x4f\x0f\xc6\xde\xda\xcb\xff\x1d\xda\xa7\xc3\x87\xdf\x80\x7b\xd0\xfb\xd9\xcc\xfc\x09\x64\x37\xb8\x1d\x88\x9a\x3d\xb0\xbf\x78\xdc\xcd\xe5\x03\xc1\x7c\x82\xcc\xdd\x54\x1f\x85\x56\xbf\xe6\xe0\x2d\x13\xe9\x5e\xc7\x0e\x4e\x69\xbb\x6e\xea\xf4\xf0\x3d\x2e\x49\x5f\xf2\x62\x2e\x34\xac\x40\x1b\xc5\xc4\xf4\x23\xcd\x21\x74\xf6\xfb\x92\xeb\xa6\x89\x44\xb0\x82\x5c\x61\xc6\x6e\xc6\x4e\x69\xcc\x59\x82\x10\x48\x12\xc0\x0a\x2e\x24\x13\x10\xc4\x10\xd8\x7a\xb3\xce\x97\x17\xfb\xaa\x65\x5d\xe8\x3a\x12\xba\xd0\x51\x68\x36\x35\x4f\x30\xee\xb7\xab\xd3\xbe\x36\xd1\xe3\xbc\xdd\x29\xf0\x1a\xd5\x12\x94\x5c\xd4\x14\xce\xa9\x49\x66\x96\xe1\x16\xbb\x90\xb8\xd0\xe0\x9a\xf2\x02\xb5\xb5\x64\x6f\x8f\xbc\x46\xb5\x50\xcc\xac\x73\x46\xb1\x29\x13\x94\xaf\x93\x47\xbb\xc8\x9c\x4d\xbb\x2d\x70\xc1\x97\x50\xe4\x29\x35\x98\xd6\x9b\x3f\x4a\x11\x87\x4d\xd4\xf2\xfa\x29\x1b\x0f\xce\x73\xb3\xdc\xdf\x7b\x6a\xce\xf6\x34\x20\xa0\x9c\x7f\xa7\x09\xf5\x38\x7f\xf2\x3e\xd4\xe3\x7c\xf8\x4c\x88\x3e\x3a\x7a\x68\x6b\xdb\x25\xff\x3f\x6b\x71\x1b\xe6\x9e\x4f\x97\xb3\x77\xe1\xff\xc3\xec\xa3\xb5\xd3\x47\xbb\x63\x8f\xd1\x51\x7b\x9c\x3f\x13\x86\x1e\xc6\xc6\x53\xf6\xe3\x76\x51\x5e\xad\x80\xa3\x08\x3b\x32\xb2\x92\xd7\xed\x22\x6d\x9b\x9a\xab\xa9\x75\xb4\xc7\xdd\x7b\xca\x72\x59\xf9\xde\x35\x55\x40\xd5\x54\xc3\x5f\x7f\x33\x61\x50\x65\xb4\x96\xdb\x42\xfd\x2d\xb6\xd0\x59\x1b\x8a\x8a\x29\x42\x47\xd6\x04\x5f\xe2\xb2\x67\x8f\x1c\x77\xe1\xaa\x40\xc5\x50\x93\x3f\x1d\x11\x6f\x95\x9c\x7f\xa4\x79\xce\xc4\x34\x54\x98\x71\x4c\x0c\x79\x27\x52\xa6\x30\x31\x1b\x81\x53\xfd\x9c\x85\x72\x72\x11\x45\xf1\xd6\xbd\x13\xb9\x10\x5b\x07\x87\x35\xd9\xef\x71\xd9\x18\x8c\x7c\xcf\x73\x8e\x76\x81\xe6\x39\x8a\x34\xb4\xab\x18\xd6\xde\x10\x42\x9a\x6e\xa2\xaf\xb8\x75\x2d\x18\x0f\x3e\x0c\xfa\x5f\xec\x07\x5a\xaf\xcc\xaa\x22\x1d\x78\x3b\xfa\xfc\xf1\x8e\x1c\xce\xff\x18\x8c\x06\x10\xc0\xaf\xbe\xe7\x69\xa3\xe6\x54\x4c\x39\x92\xf3\x19\x2a\xec\x73\x5a\x68\x1c\x61\x8e\x36\x59\xc2\x7a\x66\x09\x53\x46\x5d\x44\x1f\xce\xa2\x18\x76\x64\xa3\x33\x17\x9b\x4d\x8f\x93\x46\xf4\x55\xe3\x3b\x91\xe2\xcd\x90\xd3\x04\x67\x92\xa7\xa8\x74\x55\xfd\xb6\x4e\x90\xd7\x0d\xe7\x07\x40\xd2\x4c\x4f\xf1\x3a\x0b\xa2\x5b\xf5\x70\xfb\x0a\xd6\x3b\xaf\xe5\xaa\x72\xc1\x05\x56\xb3\x2c\x83\xd4\x09\xd3\x6f\xd4\xd8\x81\xea\x25\x39\x2b\xa4\x41\x5d\x55\xc0\x34\x88\x82\xf3\xc0\xf7\x3c\xfb\xe4\x76\x6e\xf9\xbe\x77\xd5\x66\x7c\x44\x17\xa1\xbe\xe2\xb1\xcb\x1e\x07\xbe\xef\x35\x35\xe6\x8a\xbc\x61\x62\xcf\xb0\x2a\x18\x6f\xdd\x86\x4d\xb8\xf5\x7c\xf8\x8b\x4b\xd8\x1f\x8c\x72\x76\xfe\xb0\x45\xc5\x56\x94\x18\x76\xa6\x90\x42\xb8\x7f\x14\x18\xd9\x9a\x30\x80\x89\x7b\x2e\xc0\x7a\xfe\x70\xb3\xa1\xfb\xbe\xbf\x33\x1d\xfe\x13\x00\x00\xff\xff\xfd\x1c\x25\x2e\xd2\x10\x00\x00")
-
-func templates19_reloadGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates19_reloadGoTpl,
-		"templates/19_reload.go.tpl",
-	)
-}
-
-func templates19_reloadGoTpl() (*asset, error) {
-	bytes, err := templates19_reloadGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/19_reload.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x30, 0x41, 0x3a, 0xb2, 0xf, 0xea, 0xbe, 0xe5, 0x7a, 0x97, 0x5d, 0x62, 0xe5, 0x13, 0x8b, 0x79, 0x3f, 0xf, 0x2a, 0x7a, 0xd8, 0x41, 0x88, 0x38, 0xef, 0xf7, 0x20, 0x0, 0x36, 0xed, 0x4d, 0xf5}}
-	return a, nil
-}
-
-var _templates20_existsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\x51\x6f\xdb\x36\x10\x7e\xb6\x7e\xc5\x4d\x28\x06\x09\x70\xd8\xfa\x35\x40\x1e\x32\x3b\x31\x8a\xad\x85\x13\xb7\xc8\xe3\x40\x53\x27\x9b\x0b\x4d\x3a\x24\x55\x3b\x50\xf8\xdf\x07\x92\xb2\xa5\x38\xaa\xd3\x6e\x5d\x81\x3d\xd9\x3a\x1d\xbf\xfb\xf8\x7d\xe4\x9d\xea\xfa\x0c\xde\x50\xc1\xa9\x81\xf3\x0b\x20\x97\xfe\x1f\x1a\xf2\x89\x2e\x04\x42\xfc\x21\x1f\xe9\x1a\xe1\xcc\xb9\x24\x24\x33\x25\x26\x58\x86\x74\xf3\x20\xc6\xe1\x89\x4b\x6e\xb9\x92\x66\xbf\x62\xac\x44\xb5\x6e\x1f\x67\xbf\xe3\xe3\x21\x76\x00\xda\xdc\x7b\xe0\x00\xb4\x07\x25\x31\xf2\x04\xc6\x6a\x2e\x97\x1f\xe8\x06\xb2\x40\x6e\xac\x84\x69\x78\xe6\xcf\x5e\x93\x79\xf8\x7b\x5d\x49\x66\x08\xa3\x6b\x14\x63\x6a\xf0\xeb\x29\x1a\x37\x82\x32\xbc\x45\x83\xfa\x0b\x16\x5d\x36\x97\x7a\x19\xc8\xfc\xa5\xb8\x9c\x0b\xce\xd0\x40\x0a\x69\xcb\xf3\x40\xf2\xd3\xe3\x26\x90\xf4\x89\x90\x0e\x21\x6d\x51\x0c\x5b\xe1\x9a\x46\xf5\xbc\x9e\x1d\x01\x9f\x80\xcc\x3b\x6f\x5b\x3d\xa9\x9c\xab\xd2\x4e\x50\xa0\xed\x2e\x1a\x3f\x8b\x87\x6c\x5e\x02\xb9\x2c\x8a\xa9\x50\x0b\x2a\x02\xc2\xdb\xb7\x50\xd7\x51\x17\xf2\x79\x33\xe7\x72\x59\x09\xaa\x9d\xbb\xda\x71\x63\xcd\x14\xd8\x0a\xd9\xbd\x01\x5e\x82\x5d\x61\x7f\x2a\x68\xb5\x05\x0c\xf9\x24\x29\x2b\xc9\x4e\x22\x66\x81\x85\x54\x16\xc8\x47\x35\x56\xd2\xe2\xce\x3a\xc7\xec\x0e\x58\x7c\x20\x4d\x70\x08\x75\x8d\x32\x08\xec\x01\xa3\xbc\xce\xe5\x90\x2d\x94\x12\x43\x40\xad\x95\xce\xa1\x4e\x06\x1a\x6d\xa5\xe5\xa9\xaa\xb1\x68\xb7\xe0\x42\x71\x41\xa6\x68\x27\xbf\x65\x79\x5d\xa3\x30\x18\x48\x0c\x61\xff\xa2\xc9\x6c\xde\xcb\xc2\xb9\x61\xa4\xb1\x3f\x61\x07\xf3\x9c\xcb\x13\x97\x24\x07\xb6\x49\x2b\xf4\x8c\x4a\xce\xbe\x41\xe7\xd9\xf7\xea\x0c\x01\xd9\x80\x92\x51\x87\xd7\x85\x9f\xbd\xd4\x00\x77\xc8\xe2\x7e\xaf\x76\xc8\x2a\xab\x74\x47\x89\x97\x76\xb4\xe9\x4d\xa8\xb3\xaa\xa3\xcf\xde\x26\xef\x92\x77\x07\x83\x55\xfe\x5c\xbe\x6a\x50\xcf\xa9\xe8\x9e\x02\x4f\xe0\x94\x09\x03\x5e\x86\x52\xbf\x5c\x80\xe4\xa1\xf6\x60\xe3\x65\xca\x02\xe9\x3b\x4d\x37\x57\x5a\x67\xa8\x75\x9e\x27\x03\x97\x1c\x0e\x0e\xf6\xd9\x47\x65\xd1\xbd\x2b\xdf\xe3\xe6\xf4\x27\xd8\x39\x9d\xfd\xfb\x8b\xf4\x0f\x1c\xfa\x0f\xaf\xd0\x8f\x72\xef\xb4\x37\x3f\xbc\xa1\xfd\xec\x6b\xf5\xa2\xfb\x7d\xa1\xba\x21\x1b\x0c\x4d\x06\x91\xd0\x84\x53\x81\xcc\x92\xcf\x06\xfd\x40\xbb\x5b\xa1\x8c\x84\xc7\x82\x56\x26\x8e\x8f\x81\x79\x10\xde\xf6\xd4\xa0\xcf\x05\xe6\x27\xdf\x76\x85\xb2\x01\xcc\x9a\xb8\x55\x9b\x6c\x94\xc3\x08\x4a\xad\xd6\x9e\x4e\x67\x4a\x39\xe7\x57\x68\x2f\xe5\x51\xd9\xf7\xb2\xc0\xdd\xcc\x0f\xcb\x95\x12\x05\x6a\xe3\x5c\x5d\x87\xdc\x86\x02\xf9\xe3\x06\xc8\xed\x0d\x8c\xfa\xc6\xbc\x4f\x8e\xaa\xf5\x2f\x7a\xf7\xd5\x45\x5e\xb4\xdc\xbb\x2b\x61\x04\x1e\x02\xde\x01\xca\x22\xf5\xca\x9c\xc5\x40\xdf\xe6\x9f\x6f\xf9\x7f\xb4\xd7\x67\xed\xaa\x9d\xf8\xe6\xe8\xcb\xc0\xb9\x90\x54\xd7\x69\x11\x9e\x8b\x3f\xa9\x4d\xe1\x09\xde\x90\x9b\x4a\x59\x34\xce\x01\x37\x20\x2b\x21\x1a\x5c\x10\x7c\xcd\x2d\x8c\xf2\xbd\x72\x3e\x98\xec\xcf\xd7\xe1\xc0\x47\x31\x79\x19\xcf\xf0\x04\x17\xd5\xf2\x83\x2a\x30\x5c\xe0\x72\x6d\xc9\xf5\x46\x73\x69\x85\xcc\xda\xf7\x77\x9a\x5b\xd4\x43\xff\xf9\x97\xbf\x9e\x75\xa2\x65\x38\xcf\xa6\x75\x74\x4f\xe2\xbd\x09\x00\x19\xb3\xbb\x70\x45\x06\xdb\x00\xe5\xdd\x3e\x86\xbf\xd6\x6a\x1d\xf2\x8e\x79\x6c\x4f\x70\xdc\x7e\x2b\xb3\x7d\x4f\xea\xd7\xcc\xb7\x99\xf3\x8b\xd0\x00\xc8\x4d\x85\xfa\xf1\x56\x6d\x33\xf3\x20\x4e\x02\x77\xf7\xdb\x07\xd0\x54\xc8\x42\x27\x7e\x1d\xac\xb5\xb5\x19\x01\x5a\x6d\xc9\x9c\x51\x99\xfd\x1a\x2f\x44\x6f\x63\x6e\x5a\x6f\x49\x85\xc1\xa6\x17\x99\xd0\xa2\x7d\x7f\x1e\x42\x5a\xd7\x64\x76\xbf\xf4\x35\x9d\x3b\x87\x4a\x86\x0f\x56\xab\x62\xf3\xf5\xbd\xb7\xae\x3b\x5f\xb6\x61\xb4\xfb\x5a\xe9\x51\x67\x0f\xc1\xa1\xaf\x9a\xb8\xe4\xef\x00\x00\x00\xff\xff\xb2\x97\x5a\xaa\x69\x0c\x00\x00")
-
-func templates20_existsGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates20_existsGoTpl,
-		"templates/20_exists.go.tpl",
-	)
-}
-
-func templates20_existsGoTpl() (*asset, error) {
-	bytes, err := templates20_existsGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/20_exists.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x20, 0x4f, 0x2e, 0x63, 0x42, 0x4f, 0xc0, 0x62, 0x50, 0x3f, 0x35, 0x45, 0xdc, 0x4a, 0xd9, 0x6f, 0xf5, 0x4, 0x99, 0xbe, 0x50, 0xf1, 0x4, 0x8a, 0x8f, 0x6d, 0x3e, 0xd9, 0x30, 0x68, 0x64, 0x45}}
-	return a, nil
-}
-
-var _templates21_auto_timestampsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\x9c\x30\x10\x3d\xb3\xbf\x62\x8a\xa2\x0a\xa4\xc4\x3f\xa0\x52\x0e\x28\x87\x2a\x52\xbb\x97\xdd\x53\x2f\x2b\xc7\x0c\xad\x55\xb0\x89\x6d\xd4\x44\x94\xff\x5e\xf9\x8b\x65\xd9\x94\x96\xaa\x8a\x9a\xa8\x7b\x59\xc0\xef\xcd\x8c\x9f\xe7\x8d\xfb\xfe\x0a\x4a\xac\xb8\x40\x48\x0d\x6f\x50\x1b\xda\xb4\x07\x2e\x34\x2a\x73\xf8\x82\x75\x8b\x2a\x85\xab\x61\xd8\x24\x16\xc9\x2b\x10\xd2\x00\xd9\xca\xa2\x33\x72\x1f\xf1\xfa\x88\xb8\xa0\x35\xa7\x1a\xde\x5d\x03\x29\xec\x13\x6a\xb2\xa7\x77\x35\x82\xff\x23\x5b\xda\xe0\x04\xcd\x64\x6d\xbf\x78\x82\x47\xdc\xc8\xba\x6b\x84\x86\xef\xc0\xdc\x93\x5f\x0f\x14\x5e\x01\x93\xc2\x50\x2e\x74\x21\x1e\x27\xfc\x94\x29\xa4\x06\xcb\x03\x35\x29\xa4\x5d\x5b\xc6\x17\xcb\xf3\xc4\x50\xf9\x8d\x14\x06\x1f\x8c\x8f\xc8\x2b\x78\x73\x27\x79\x4d\x8e\x7b\x29\x14\xee\xbe\xf2\xb6\xc5\x32\x63\xe6\x21\x87\xde\xf1\x51\x94\x9e\x91\xb0\x4e\x29\x8b\xb6\x25\x5b\xc5\xc8\x56\x7e\xcb\x72\x72\x2b\x32\x17\xe8\x3d\x9a\x0f\x92\x51\xc3\xa5\xc8\xf2\xdc\x71\x15\x15\x9f\x11\x2e\xb8\x28\x2f\x5d\xc5\xe7\x9b\x75\x81\x01\x00\xa2\x28\x45\x54\xd1\xeb\x19\x70\x6e\x69\x22\x61\x12\x0f\x05\xef\x27\x4b\x27\x52\x04\xdc\x1c\xb8\x7f\x6c\xc3\x81\xbb\x9d\xa7\xe0\x60\xbc\x02\x49\xfa\x7e\x2c\x60\x18\xc8\xad\xfe\x84\x4a\x66\x5e\x86\x64\xb6\x0a\xd7\x10\xd5\xd8\x24\xc9\x31\x11\xd6\x1a\x63\xc0\xfb\x0e\x15\x47\x4d\x3e\x76\xda\x58\x60\x36\x8b\x91\xcf\x52\x44\xfc\x0e\xcd\x8e\x51\x21\x50\x65\x6f\x67\x94\xcb\x31\x6b\x7e\x9a\x76\x3c\xa3\xa7\x5e\xcf\x64\x9a\x34\xc9\xef\xca\xf4\xaa\x55\x72\x4d\xbe\x6c\x97\xe1\xd4\x0b\x23\x23\x04\x1a\x86\xcd\x34\xe4\xd3\xb3\xc5\xcb\xfe\xa2\x66\xcb\x4b\x1c\x27\x10\x7e\x7f\x65\xa2\xfc\xa9\x55\x16\x9d\x30\x73\xc1\xea\x96\x7e\x9e\x76\xfe\x55\x33\xff\xbf\x28\x7f\xd2\xd9\xaf\xf2\x9e\x0c\x52\x2c\xce\xff\xa5\xa6\x3f\x9f\xfe\xeb\x87\xff\x5a\xa3\x3c\xf7\x05\xb9\xc2\xf4\xff\xb8\xe7\x7f\x04\x00\x00\xff\xff\x7f\x29\xd3\xb4\x1d\x0b\x00\x00")
-
-func templates21_auto_timestampsGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates21_auto_timestampsGoTpl,
-		"templates/21_auto_timestamps.go.tpl",
-	)
-}
-
-func templates21_auto_timestampsGoTpl() (*asset, error) {
-	bytes, err := templates21_auto_timestampsGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/21_auto_timestamps.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6, 0xc7, 0xdc, 0xb2, 0x96, 0x8d, 0xe0, 0xd8, 0xb, 0x5f, 0x68, 0x24, 0xd5, 0xb1, 0xc6, 0xcd, 0xa, 0x4c, 0xee, 0xc, 0x46, 0x4c, 0x76, 0x24, 0xc0, 0x81, 0xdf, 0xdc, 0x22, 0x96, 0xd, 0x74}}
-	return a, nil
-}
-
-var _templatesSingletonBoil_queriesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd2\xcd\x8e\xda\x30\x14\x86\xe1\x75\x7c\x15\x47\x48\x45\x50\x21\xd3\x75\x24\x16\x08\xba\x40\xa5\x3f\x40\x51\xd7\x56\x7c\x68\x2c\x39\x4e\xe2\x63\x43\x98\x28\xf7\x3e\x82\x60\x86\xcc\x84\xd9\x7e\x7e\x9f\xd8\x8b\x1c\x85\x05\xa9\x84\xc6\xc4\xc1\x0c\xa4\x55\x47\xb4\xc4\x97\xed\x52\xb3\x68\xbd\x89\xe1\x5b\x55\xd7\x85\x55\xc6\x1d\x60\xf0\xa5\x1a\x40\x38\xe6\xeb\x4d\xd3\x4c\x58\xb4\xfd\xac\xd9\x5e\x1b\x16\xed\x09\x57\x46\x62\xf5\x47\x8b\x04\xd3\x5c\x4b\xb4\x14\x03\x00\xd4\xf5\xbd\xed\x6b\xae\x37\xec\x09\xd7\x82\xdc\xca\x10\x5a\xb7\x5a\x5e\x1d\x7c\xc4\x8f\x4d\x70\xbb\x24\xc5\x4c\xbc\x89\x3e\xd7\x36\x41\x2c\xf1\x20\xbc\x76\x3f\xf0\x7c\xca\xad\x8c\x7b\x45\xb7\x09\x72\xee\x5d\xbe\xc8\xb5\xcf\x0c\xc5\xcf\xee\x7a\x68\x02\xfb\x9b\x17\x0b\x2d\x3c\x61\xfc\xfc\x89\xf7\x26\xa0\xdf\xde\x15\xde\xbd\x77\x5d\xf4\xd8\x04\xb7\x10\x84\xff\x52\x34\xdf\x2b\x45\x8e\x82\xef\xba\xbe\xe6\xe2\x1b\xc6\xa6\x53\xf8\x85\xa7\x8d\x47\x7b\x06\x65\x94\x53\x42\xab\x17\x24\x10\x60\xf0\x04\xed\xee\x49\x99\xff\xe0\x52\x84\x42\x10\xa1\x04\x65\xda\x93\x9f\xb9\x24\x76\xf0\x26\xb9\x7f\x63\x94\xe5\x92\x80\x73\x5e\x66\x3c\x24\x63\xf8\x5a\x7a\xb4\x0a\xa9\x9d\xa0\x66\x51\x09\xf1\x0c\x86\x9d\xb9\x6e\x58\x14\x86\x1d\xba\xdb\xf3\x47\xe5\x04\x86\xb7\x1f\x7a\xcc\xa2\x32\xe3\xf3\xa2\xd0\xe7\xcb\x7c\xb9\x8a\x73\x3e\x66\x2c\xb2\xe8\xbc\x35\x50\xb2\x86\xbd\x06\x00\x00\xff\xff\xaf\x58\xd4\xae\x01\x03\x00\x00")
-
-func templatesSingletonBoil_queriesGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templatesSingletonBoil_queriesGoTpl,
-		"templates/singleton/boil_queries.go.tpl",
-	)
-}
-
-func templatesSingletonBoil_queriesGoTpl() (*asset, error) {
-	bytes, err := templatesSingletonBoil_queriesGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/singleton/boil_queries.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8f, 0x97, 0xe, 0x49, 0xf0, 0x80, 0x7, 0xf3, 0x8, 0x9f, 0x11, 0xca, 0x2, 0x9, 0x6d, 0xef, 0x56, 0x55, 0xb, 0x2c, 0xd2, 0x68, 0x6d, 0x7e, 0x5, 0x85, 0xba, 0x6d, 0x30, 0xa, 0x21, 0x2c}}
-	return a, nil
-}
-
-var _templatesSingletonBoil_table_namesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x4b\x2c\x52\x08\x49\x4c\xca\x49\xf5\x4b\xcc\x4d\x2d\x56\xb0\x55\x28\x2e\x29\x2a\x4d\x2e\x51\xa8\xe6\xe2\xac\xae\x2e\x4a\xcc\x4b\x4f\x55\x50\x29\x01\xc9\x2b\x58\xd9\x2a\xe8\x81\x55\x16\x2b\xe8\xd6\xd6\x82\xa4\x4b\x32\x4b\x72\x52\x9d\x13\x8b\x61\x4a\xf4\x40\x66\xd4\xd6\x82\x8c\xc8\xcc\x4b\x07\xa9\x48\xcd\x4b\x01\x2b\xae\xa5\xc0\x38\x2b\x05\xa5\xea\x6a\x14\x11\x25\x1d\x14\xb3\xb9\x00\x01\x00\x00\xff\xff\x43\x72\x83\x22\xc4\x00\x00\x00")
-
-func templatesSingletonBoil_table_namesGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templatesSingletonBoil_table_namesGoTpl,
-		"templates/singleton/boil_table_names.go.tpl",
-	)
-}
-
-func templatesSingletonBoil_table_namesGoTpl() (*asset, error) {
-	bytes, err := templatesSingletonBoil_table_namesGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/singleton/boil_table_names.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4f, 0xe7, 0x4d, 0x77, 0xa4, 0x5, 0xca, 0xf4, 0x78, 0xa2, 0xe3, 0x36, 0x28, 0x8e, 0x64, 0xa8, 0x5c, 0xe9, 0x60, 0x4, 0xb1, 0x55, 0x4a, 0xa9, 0x85, 0x96, 0xb4, 0xab, 0x58, 0x8b, 0x1f, 0x4b}}
-	return a, nil
-}
-
-var _templatesSingletonBoil_typesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\xdc\x38\x12\x3d\x37\x7f\x45\xad\x61\xc0\xad\xc0\x91\x73\x58\xec\xc1\x80\x0f\x6b\x27\x9b\x35\x12\x1b\x0e\xec\x4d\x0e\x41\x10\xb0\xa9\x52\x8b\x68\x8a\x54\xc8\x62\xf7\xca\x1a\xfd\xf7\x41\x91\x92\xbb\xed\x64\x06\x73\x99\x5c\xe2\x2e\xd6\xe7\x7b\x8f\x45\x9d\x9d\xc1\x0d\x50\xdf\x21\xe8\x00\xb5\xf3\xd0\x79\xb7\xd5\x95\xb6\x6b\x50\xce\xc4\xd6\x06\x90\xb6\x9a\xfe\x86\xad\x34\x11\x03\x90\x83\xff\x75\x95\x24\xfc\xb7\x31\xa5\x48\xd1\x37\xd0\xca\xee\x6b\x20\xaf\xed\xfa\x9b\xb6\x84\xbe\x96\x0a\x87\x51\x88\xb3\x33\x78\xe7\xfd\x7d\x6f\xd5\x7f\xa4\x36\xe0\x94\x8a\x3e\x40\x15\xd9\x13\xb4\x0d\xe8\x09\x76\x0d\x5a\xa0\x06\xc1\xa3\x72\x9e\xcb\x45\x53\x81\x75\x04\x2b\xb6\x91\xd7\xb8\xc5\x0a\xb4\xe5\x6c\xce\x57\xe8\xb9\x87\xce\x75\xd1\x48\x42\xa8\xb0\x96\xd1\x50\x6e\x0f\xb4\xad\x9d\x6f\x25\x69\x67\x4b\x78\x68\x74\x80\x18\xa2\x34\xa6\x87\x46\x76\x1d\xda\x90\xcb\x7d\x94\x81\xae\x53\xf9\xeb\x8a\xd3\xd6\x52\x9b\x00\xce\x73\x1f\x1e\x61\x27\x03\x48\xe8\xbc\x6e\xa5\xef\x61\x83\x3d\x28\x67\x6b\xbd\x8e\x3e\x65\x06\x6a\x24\x25\x27\xee\xd2\x63\x70\x66\x2b\x57\x06\x4b\xb1\x95\xfe\xd9\xc0\x17\x80\xde\x3b\x1f\xca\x5b\xdc\x2d\x8f\x86\xa1\xbc\xdb\xac\x6f\x65\x8b\xe3\x78\x9e\x6a\x62\xc5\xb3\x84\xde\xaa\xc6\x3b\xab\x1f\x11\x2a\x49\x12\x64\x4d\xe8\x27\x7c\x8e\x0a\x91\x51\xce\x3f\xaf\xa4\x6a\x10\x02\xf9\xa8\x08\x06\xb1\xf8\x11\xd1\xf7\x30\xfd\xcb\x14\x88\x85\x47\xfa\xf4\x64\x9f\x8d\x09\xa0\x1b\xd9\x75\x8c\xfd\xd7\x6f\x51\x5b\xfa\xd7\x3f\x93\xef\x6c\x84\xbd\x79\x9c\x8a\xc6\x44\xf5\x5f\x2a\xfa\xeb\xfc\xa3\x10\x75\xb4\x0a\x5a\xb9\xc9\x69\x3e\x60\xbf\x54\xce\x04\x58\x39\x6d\xca\xab\xac\xb3\x53\xb0\x8f\x6f\x33\x91\x01\xbe\x7e\xcb\x29\x8b\x29\x35\x57\x5c\xc5\x1a\xce\x2f\xd8\xd0\x4a\xbb\x36\x58\xbe\x47\xba\x8c\x75\x8d\x7e\x59\x88\x74\x5c\x7e\xf1\x9a\xf0\x3e\x45\x2c\x03\x79\xe5\xec\xb6\xbc\x26\x27\x53\xb5\xf2\x83\xb6\x55\x51\x88\x05\xcb\xfc\xfb\x29\xec\x38\x9b\x97\x76\x8d\x90\x8e\xaf\xb8\xa3\x41\x2c\x7e\xca\xb4\x2b\xc4\x62\x14\x62\xa1\x6b\x30\x68\x97\xfb\x36\x0b\xf8\xc7\x05\xbc\x79\x1e\x73\xd9\x13\x2e\x4f\xca\x93\x14\x33\x97\xb2\x8f\xfb\x5a\x07\x53\xfe\xaa\x98\x7d\x9c\xaa\x05\xf2\x1c\xc4\xe7\xd3\x51\x91\x8c\xd3\xf0\x77\x71\x1e\x7e\x15\xeb\x22\x71\x18\xbd\x65\x74\x18\xef\x61\x38\x7b\x25\x1e\x1a\x84\xda\x19\xe3\x76\xe9\xaa\xb1\x9e\x8d\x26\x32\x08\x2b\x4d\xe0\x6a\x58\x19\xa9\x36\xd0\xca\xb5\x56\xe9\x96\x57\x18\xd0\x6f\x31\x40\x70\x2d\x02\xfe\xbf\x33\xd2\x26\xb5\x0b\x71\x89\x4a\xc6\x80\xd0\xb9\x40\x6b\x8f\x79\x2b\xb4\x7d\xf8\x61\xf8\xf6\x69\x8b\x80\x36\xb6\x01\x94\x6b\x3b\x83\x84\xa6\x87\x4a\x73\x7b\x68\xc9\xf4\xb0\x74\x16\x41\x12\xdf\x2d\xc1\xf2\x5e\xc9\x80\x60\x70\x8b\x06\xd2\x3d\x53\x31\x90\x6b\x93\xf2\x59\x73\xa7\x29\xfd\x3e\x06\x88\xef\xd6\xbc\x85\xe6\x38\x21\x21\x5a\xfd\x23\x22\x50\xc3\x13\x76\xbc\x16\xd8\xb1\x28\x4b\xbe\xf9\xe8\xf1\x24\x25\x6f\xa4\x55\x98\xf2\x70\x93\x8c\x84\x95\x2d\x56\xb0\x9c\xa7\x29\x04\xd7\xe3\x9b\xbc\x4c\x33\x15\x25\xdc\x3b\xd8\x21\x28\x69\x4f\x08\x2a\xc7\x15\xc2\xbe\x00\x84\xc9\xa2\x5c\x95\x36\x27\xd7\x2a\x85\xf8\x82\x60\x9c\xeb\x80\x1a\xef\xe2\xba\x01\x94\xaa\x99\x22\x0e\xb6\xa8\x71\x6e\xc3\xfd\xb2\x38\x12\x6a\x25\x5c\xd7\xa0\xe9\x64\xea\xeb\x14\x76\x28\x88\x77\x14\x23\x9e\xb8\xa8\x74\x58\xc7\x40\x1c\x95\xe9\x22\x07\x3b\x56\x0d\x04\x4a\x1b\x30\xaf\xd3\x04\x15\xb6\x5d\xda\x8a\x4c\x85\x36\x08\xe4\x38\x19\x1c\x39\xab\xf0\x88\xd7\xf4\xb4\x15\x0d\xd2\x0c\x44\xe6\xce\x59\xd3\xf3\xc2\xcd\x84\x32\xfe\x0a\x41\xd7\x9c\xb5\x3f\xf1\xbc\x88\x13\x9f\x0a\x2b\xd1\x46\x43\xba\xe3\xe4\xba\xc5\x00\xda\x42\x2b\x2d\xd3\xec\x01\xb7\xd3\x2e\x0f\xb2\xc5\x22\x4f\x1f\x4a\xc1\x6a\xb4\x09\xd2\x06\xd5\x86\xd3\x4a\x63\xf2\xd0\xd3\xab\x22\x3d\x82\xe5\xcd\x6d\x4e\xe7\xaa\xc9\xc6\x31\x1e\x79\xa2\x99\x41\xe1\x22\x75\x91\x92\x1b\x93\xb6\x43\xc8\x16\x90\x50\x7b\x8d\xb6\x32\x7d\xde\xba\xd0\x62\x08\x72\x8d\x93\xca\x5c\xdb\xa2\x25\x06\x4f\xea\xf4\x9c\x54\xb8\x8a\xeb\xb5\xb6\xeb\x52\x88\xbb\x59\xda\x53\x2e\xa6\x29\x80\xd1\x1b\x3c\x87\x77\x36\xb6\xbc\xb4\xf9\xff\xcf\xe9\x95\xb9\x80\x23\x6e\x25\xf5\x7e\x24\x6e\xfa\xfb\x4f\x1f\x7f\x15\x08\x00\x0f\x8c\x00\x07\x5f\x39\xf3\x67\x39\xc4\x35\x65\x0a\x48\x93\x41\x25\x03\xbf\xb4\x0d\xc2\xde\xbf\x73\x3e\xbd\x3d\x7a\x52\x4b\xb0\x72\x83\xaf\xd9\xb3\x2a\xc5\xab\xb3\x71\x14\xc3\x70\x9c\x58\x3b\xbf\x48\xec\xdd\xe2\x2e\x19\x5f\x4f\xbb\xe7\x38\x6b\xf1\xfc\x02\xca\xd4\x55\x80\xd7\xe3\x28\x16\x07\x0e\xca\x19\x3e\xce\x8e\xf3\x6a\x86\xdf\xa0\xd6\x86\xd0\x4f\xbf\x2f\x7b\xee\x29\xc7\xa6\xe0\x63\x96\x11\xc7\x75\xd2\x07\x9c\xc1\x4a\xd9\xca\xb7\x97\x0f\xfc\x8a\x1c\x38\x6f\xa5\x09\xcf\x9c\x3f\xb3\xe1\x0f\x9c\x75\xb8\x4d\x12\x3d\xbf\x00\x8b\xb0\x34\x68\x73\xb5\x02\xde\x3c\x39\xb1\x98\x6c\xb5\xf7\x5d\xf2\xec\xff\x95\x01\x32\x18\x93\xff\x3e\x29\x9a\x30\xd7\x98\xe3\x9f\x62\x27\xf3\x62\x18\x8e\xbf\xcf\x30\xde\x45\x3a\x4c\xb5\x0f\x44\x9b\x03\x0e\x9a\x58\xae\x69\xea\x92\xc7\x2c\xe0\x4d\x01\x4b\x1d\x12\x24\x49\xdb\x93\x7d\x1c\xd3\x87\x11\xc3\x38\xc9\x9f\xb7\xc1\x30\x1c\xb4\x32\x8e\xc3\x30\xd5\x1b\x06\x6e\x39\x19\x32\x31\xf9\x03\xa2\x1c\x86\x84\xda\xed\xec\x64\xab\x71\x14\xca\xd9\x40\xb0\x7c\x46\xeb\x56\x66\x5a\x13\xf4\x4f\x9c\xf3\x4f\x7e\x5b\xba\x2e\x03\xcc\x6f\x6d\xf7\xa5\xd1\x84\xa1\x93\x6a\x0a\x7b\xf2\x7e\xd1\x5a\x52\xe9\x15\xef\xf1\x9f\x9a\x3c\x38\x3a\x6c\xf7\xd9\xc1\x8b\xbe\xe7\x32\xba\x86\xd0\xf0\xd7\xdf\xc3\xec\x9a\x30\x3a\xec\xf4\x45\xa2\x17\x27\x4f\x40\xbd\xb4\x33\x36\x7c\xe3\xf2\xd1\x38\x1e\x71\xb9\xb9\x72\x21\x66\x5d\xfc\x7d\xc4\x4c\x0b\x8e\xf8\x13\x9b\x5f\x92\xf7\x0e\x74\x85\x96\x74\xad\xd1\x87\x53\x7e\x6b\xf8\x14\x5b\x4d\x90\x38\x94\x96\x82\x38\x94\xd9\x73\xd1\xfd\xa4\xc0\xf9\xef\xdf\x03\x00\x00\xff\xff\xbd\x92\xdd\xd2\xd4\x0b\x00\x00")
-
-func templatesSingletonBoil_typesGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templatesSingletonBoil_typesGoTpl,
-		"templates/singleton/boil_types.go.tpl",
-	)
-}
-
-func templatesSingletonBoil_typesGoTpl() (*asset, error) {
-	bytes, err := templatesSingletonBoil_typesGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates/singleton/boil_types.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf5, 0xb1, 0xf1, 0xb6, 0xda, 0x1c, 0xe8, 0x60, 0x98, 0x80, 0x7b, 0x39, 0x12, 0x58, 0x34, 0xc0, 0xc7, 0x46, 0x9, 0xf8, 0x45, 0x81, 0xe4, 0x1f, 0x58, 0x90, 0x89, 0x0, 0xf1, 0x97, 0x6c, 0x44}}
-	return a, nil
-}
-
-var _templates_test00_typesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcc\x31\xae\xc2\x30\x10\x84\xe1\x3e\xa7\x98\xee\x3d\x9a\xe4\x04\x14\x14\x5c\x80\x0b\xa0\x95\x33\x49\x56\x38\x6b\xc7\x6b\x23\xe5\xf6\x08\x50\x0a\xca\x91\xe6\xff\x9e\x52\xf0\xdf\x01\xc0\x30\xe0\xc6\x28\x55\x93\xf9\xa2\xd9\xe1\x69\x65\xd5\x95\x8e\xe6\x44\x5d\x88\xc2\x29\x32\xbc\x1f\x58\x18\x33\x0b\xb6\xc6\xa2\xf4\xfe\xba\x35\x89\xc3\xb1\x2e\xee\x3a\xdb\xa1\x7a\xc2\x94\x4a\x20\x04\x59\xc2\x43\x66\x62\x64\xa6\x8d\xb4\xb0\x43\x0d\x41\xbe\xfe\x8e\x31\xd9\x5f\xed\x3f\xe1\x1d\xe7\x5f\xbd\x3b\x75\xaf\x00\x00\x00\xff\xff\x1f\x1b\x4a\xa6\xad\x00\x00\x00")
-
-func templates_test00_typesGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_test00_typesGoTpl,
-		"templates_test/00_types.go.tpl",
-	)
-}
-
-func templates_test00_typesGoTpl() (*asset, error) {
-	bytes, err := templates_test00_typesGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/00_types.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8a, 0x5b, 0xb4, 0xa5, 0xa8, 0x8f, 0x90, 0x1, 0x6b, 0x12, 0xf3, 0xc3, 0x94, 0xea, 0xa4, 0x65, 0x16, 0x5a, 0x87, 0xf0, 0x3e, 0x96, 0xae, 0xa1, 0xb4, 0xad, 0x7, 0xd7, 0xc7, 0xe3, 0xde, 0x1d}}
-	return a, nil
-}
-
-var _templates_testAllGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcd\x41\xaa\x83\x40\x0c\x06\xe0\xf5\xcc\x29\x82\xbc\x85\x3e\x9e\x39\xc0\x03\x17\x5d\x74\x5b\x2c\xd8\x03\xa4\x36\xda\x81\x74\xb4\x63\x84\x96\x61\xee\x5e\x46\xb7\x5d\x25\x90\xef\xcf\x1f\x63\x0d\x3f\x24\x8e\x16\xf8\x6f\x00\x0f\x79\xe3\x05\x3b\xba\x0a\xc3\x3e\xf0\x44\x0f\x86\x3a\x25\x3b\xac\xbe\x07\xe5\x45\x63\xdc\x33\x78\x99\x5b\x59\x03\x49\x4a\xa5\xc2\x6f\x3e\x39\x3f\x62\x57\x41\xb4\x46\xb1\xa5\x40\x22\x2c\x65\x65\xad\x79\xae\x1c\xde\xb9\xe4\x5b\x38\x03\x37\xc0\x66\xf0\xbc\xc9\xa6\x01\xef\x24\x3f\x32\x8a\xc7\x10\xa6\x50\x16\xfc\x9a\xb9\x57\xbe\x01\xed\xf4\x0f\xc6\x49\xc1\x4f\x7a\x77\x7e\x2c\x2a\x6b\x92\x4d\xf6\x13\x00\x00\xff\xff\x39\x32\xe3\xcd\xd3\x00\x00\x00")
-
-func templates_testAllGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testAllGoTpl,
-		"templates_test/all.go.tpl",
-	)
-}
-
-func templates_testAllGoTpl() (*asset, error) {
-	bytes, err := templates_testAllGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/all.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x28, 0xb7, 0x94, 0xce, 0x20, 0x86, 0x0, 0xbf, 0x3d, 0x3a, 0x19, 0xcb, 0xe0, 0xfa, 0x2d, 0x8c, 0x8c, 0x59, 0x1d, 0x14, 0x1b, 0xe2, 0x74, 0x5f, 0xcf, 0x4e, 0xe0, 0xa, 0x29, 0x52, 0x99, 0xf8}}
-	return a, nil
-}
-
-var _templates_testDeleteGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x98\x41\x4f\xe3\x3a\x10\xc7\xcf\xf1\xa7\x98\x17\xbd\xf7\x94\x3c\x05\xeb\xed\x95\x15\x87\xd2\xee\x81\xc3\x56\x2c\x2d\xda\xe3\xca\x4d\x26\x25\xc2\xd8\xc8\x76\x68\xc1\xf2\x77\x5f\xd9\xa1\x4d\x8b\x00\x55\x82\xd2\x5d\xad\x0f\x08\x1a\xfd\x67\xe6\x3f\x63\xe7\x27\xa6\xd6\x1e\xc1\xdf\x8c\x37\x4c\xc3\xf1\x09\xd0\x81\xff\x0b\x35\x9d\xb2\x19\x47\xe8\x7e\xd1\x31\xbb\x41\x38\x72\x8e\x04\x71\xc9\xc4\x44\xd6\x66\x84\x1c\x0d\x86\xa0\x4e\x35\xdc\x7a\xbe\x96\x6b\x59\x1b\xaf\x62\xa2\x02\x3a\xa8\xaa\x5e\xa3\x9f\xe6\x0a\x21\x4d\xfd\x18\xe3\x33\xd4\xad\x28\xc1\xa0\x36\xd6\x76\x26\xe9\xe5\xed\x39\x6f\x15\xe3\xce\xf5\x81\x99\x81\xff\xbc\xa8\x11\x73\x3a\xcd\xc1\x92\xc4\xd0\x73\xa6\x18\xe7\xc8\xb3\x9c\x90\x44\x23\x56\xde\x83\x62\xa2\x92\x37\xcd\x03\xd2\x31\x2e\x26\x88\x55\x96\x93\xe4\x8e\x29\x40\x15\x7e\xa4\x22\x89\xf4\xc2\x7f\x37\xea\x4d\x1a\x31\x6f\x39\x53\xce\x59\x47\x92\xa6\x0e\xe2\xcd\x5c\x13\xa3\xda\xd2\x64\xbe\x48\x01\xb2\x80\x75\xec\x48\x2e\x44\x1f\x3d\x3a\x9d\xde\xdf\xa2\x2e\xc0\xa8\x16\x5f\x54\x0d\x25\x6f\x6f\x84\xfe\xde\x98\xab\x11\xd6\xac\xe5\x86\x52\x9a\x7f\x0e\x45\xff\x3a\x01\xd1\x70\xdf\x5f\x62\xe8\x17\x6f\xb7\xce\xd2\x4b\x11\x8e\xca\xc8\xde\x11\x3c\xeb\x1e\x74\xf0\x79\x0c\xff\xe8\xb4\xf0\xf9\x72\x92\x38\x42\x92\x30\x72\x21\x0d\xd0\xb1\x1c\x4a\x61\x70\x69\x9c\x2b\xcd\xd2\xcf\xa1\xec\x3e\xd3\x53\x56\x5e\xcf\x95\x6c\x45\x95\xe5\xd6\xa2\xa8\x9c\x23\x49\x27\xf9\xda\x6a\x33\x5d\x66\x21\xcb\x66\x86\x99\x6c\x38\x3d\xc5\x79\x23\x42\x08\xd7\xb8\xf9\x6c\xba\xcc\x4a\xb3\x2c\x7c\x3f\xab\x84\x39\x49\x2a\xac\x51\x81\x3f\xf4\x2c\x07\x0b\x3f\xe0\x04\xcc\x92\x5e\x48\xce\x67\xac\xbc\xce\x72\x70\xfe\xc0\xd6\x47\x20\xe9\x99\xd0\xa8\x4c\xf6\x52\x0b\x7e\xca\x28\x2a\x7f\x95\xc0\x7f\x0a\xf5\xcf\x44\x8d\x2a\xcb\x5f\x9c\x69\xf6\x64\x34\x74\x2c\x2f\xe4\x42\x0f\xea\x1a\x4b\x83\x21\xd9\x96\x87\xc7\x3b\xb8\xab\x87\x9a\x71\x8d\xbb\x15\xf7\x43\x5b\x97\x53\x9d\x87\x70\x72\x7e\xee\x7b\x2a\x0c\xa1\x68\x5f\xcf\x4b\x3f\x6d\x09\x53\x7d\x25\x5b\x5e\x81\x14\xfc\x1e\xae\xd8\x1d\x42\x15\x8c\xf8\x27\xe8\xc3\x0a\x98\xb5\x06\xd8\xe3\xbc\x8e\xd3\x62\x95\xab\x6f\xac\xb3\x45\x48\x52\xca\x56\x98\x75\x4f\xcf\xbc\xe5\x59\x4e\x87\x5e\xb3\x63\x9b\xfd\xf5\x78\x75\xb6\x4d\x0d\xa1\xb2\x57\xfd\xbf\xdd\xdd\x82\x09\x03\x0f\xa8\x24\x28\x2c\xa5\xaa\x74\x01\x73\x69\x7c\x17\x21\x22\x24\x70\xe4\x55\x32\x7d\x6b\x51\xdd\xf7\x78\x1a\x70\x1e\x09\x15\x09\x75\x28\x42\x3d\xff\x52\xf5\x57\xf3\xa3\xc1\xf5\xb1\x7e\x22\xcf\xde\xce\xb3\x09\x6f\x4a\x8c\x3c\x8b\x3c\xdb\x3f\xcf\xb4\xbf\x6a\x4f\x5e\x9d\x7e\xa0\xe1\x22\x5a\x9b\xda\xd4\x39\x69\x6d\xea\x52\xb7\x23\x04\x43\xde\x03\x42\x6f\xbf\xf5\x23\xe4\x76\x83\xdc\x46\x13\xaf\xf1\x2e\x6e\x95\x91\x71\xbf\xd5\x56\x09\xd6\x1e\xc1\xea\x8b\x13\xe7\xba\xcb\xb0\x1a\xc0\x87\xaf\x9a\x6f\x72\x13\x51\xf6\x4e\xfb\x67\xfc\x5f\x2d\x72\xec\xe0\x1c\x7b\x9f\x5d\x6f\xcf\x78\xfb\x05\x4c\x46\xea\xbd\xd3\x96\x1a\xa9\x17\xa9\xf7\x27\x6d\xa8\x7b\x46\xe3\x01\x4c\x45\x14\xee\x84\xc2\x9f\x01\x00\x00\xff\xff\x31\xe4\x1e\xca\xb8\x1d\x00\x00")
-
-func templates_testDeleteGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testDeleteGoTpl,
-		"templates_test/delete.go.tpl",
-	)
-}
-
-func templates_testDeleteGoTpl() (*asset, error) {
-	bytes, err := templates_testDeleteGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/delete.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x56, 0x4f, 0xd2, 0xa8, 0x8b, 0xf, 0x31, 0x26, 0x99, 0xa3, 0xb3, 0x4c, 0x4b, 0xec, 0xe1, 0x3d, 0x7e, 0x3d, 0x9, 0x88, 0xe6, 0x68, 0x3a, 0xbe, 0x58, 0x2a, 0x40, 0xe9, 0xf8, 0xd2, 0x28, 0x4c}}
-	return a, nil
-}
-
-var _templates_testExistsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xcd\x6e\xdb\x3c\x10\x3c\x8b\x4f\xb1\x11\x92\x0f\xd4\x07\x85\x0f\x90\x22\x87\xfc\x1d\x82\x22\x86\x51\x3b\xe8\xb1\xa0\xa5\x95\xc2\x9a\x26\x05\x72\xd5\xc8\x55\xf8\xee\x05\x29\x37\x11\x82\xb8\xed\x41\x90\x28\xcc\xce\xee\xce\x0c\xc7\xf1\x1c\x4e\xa5\x56\xd2\xc3\xc5\x25\x88\xab\xf8\x85\x5e\xac\xe5\x46\x23\x4c\x2f\xb1\x90\x3b\x0c\x81\x35\xbd\xa9\x80\xd0\xd3\x38\x4e\x15\xe2\xb1\x5b\xea\xde\x49\x1d\xc2\xdd\xa0\x3c\x79\x4e\xf0\x7f\x04\x28\xd3\x8a\x75\x01\x23\xcb\x48\x2c\xa5\x93\x5a\xa3\xe6\x05\x63\x99\x47\xac\x63\x1f\x27\x4d\x6d\x77\xea\x27\x8a\x05\x3e\xaf\x10\x6b\x5e\xb0\xec\x87\x74\x80\x2e\x3d\xd6\xb1\xcc\x46\xe0\x7f\xb3\x5e\x2b\x65\xda\x5e\x4b\x17\xc2\x18\x58\xa6\x9a\x04\x9e\x73\xad\xc8\xf5\x15\xf1\xd8\xa4\x04\x5b\xc2\x6b\xed\xad\x7d\x36\x6f\xd5\xb7\xd7\xeb\x7d\x87\xbe\x04\x72\x3d\x1e\x45\xdd\x58\xdd\xef\x8c\xff\xaa\xe8\xe9\x16\x1b\xd9\x6b\x12\x42\x14\x9f\x52\xd3\x93\x4b\x30\x4a\xc7\xfd\x32\x12\x77\x71\xdc\x86\xe7\x8f\x26\x69\x46\xf6\x6d\x22\xf8\x70\x7a\xf0\x69\xce\x0b\x38\xf3\x79\x19\xf9\x0a\x96\x05\xc6\xb2\x71\x54\x0d\x18\x4b\x20\x16\xf6\xc6\x1a\xc2\x81\x42\xa8\x68\x88\x3a\x54\xd3\x59\x5c\xcb\x6a\xdb\x3a\xdb\x9b\x9a\x17\xe3\x88\xa6\x0e\x81\x65\x13\xe4\xa1\xf7\xb4\x1e\x78\x62\x99\x33\x6c\xac\xd2\xe2\x1a\x5b\x65\x52\x89\xf6\x38\xff\xb7\x1e\x78\x45\x43\x19\xf7\xf9\x4d\x58\xb0\xac\xc6\x06\x1d\x44\xc3\x79\x01\x23\x7c\x83\x4b\xa0\x41\x7c\xb1\x5a\x6f\x64\xb5\xe5\x05\x84\x68\xd8\xab\x05\x56\xdc\x1b\x8f\x8e\xf8\xb1\x15\xa2\xca\x68\x6a\x38\x0f\x01\xe2\x29\xf5\xbf\x37\x0d\x3a\x5e\x1c\xd5\x94\xcf\xa5\x39\xed\xb6\xb8\xbf\x72\xed\x14\xd3\x29\x97\xcb\xcf\xb8\x17\x07\x9f\xe0\x25\xca\xaa\x4c\xfb\x20\x3b\xe0\x49\xf4\x1b\xab\xfd\x21\xdb\x05\xbc\x40\xe7\xb0\x51\xc3\x2a\x81\x56\x5a\x55\x08\xbc\x73\xca\x50\x03\xf9\x99\x17\x39\xe4\x36\x8f\xb0\xef\x56\x19\xc8\x4b\xc8\xe3\xb0\x2c\xc3\xe4\x50\x6c\xfa\xa1\x97\x87\xdc\xff\xeb\xde\xb3\x3d\x92\xce\x07\x05\xff\x92\xa7\xea\x09\xab\x2d\xa8\xe6\x48\x9c\x30\xcd\xf0\x2e\x4e\x91\xfa\x04\xdf\x51\xde\x0d\x1d\x56\x84\xf5\x9f\x76\x49\x01\x46\xea\x9d\x39\xdc\x8f\x4d\x4f\xd0\x5a\x82\x46\x6a\x8f\x22\x4f\xec\x81\xfd\x0a\x00\x00\xff\xff\x77\xbd\x12\x76\x38\x04\x00\x00")
-
-func templates_testExistsGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testExistsGoTpl,
-		"templates_test/exists.go.tpl",
-	)
-}
-
-func templates_testExistsGoTpl() (*asset, error) {
-	bytes, err := templates_testExistsGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/exists.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb4, 0xdb, 0xf5, 0x58, 0xac, 0x52, 0x4d, 0x5e, 0xc5, 0x5a, 0x10, 0xe6, 0xb8, 0x26, 0x44, 0xd5, 0x1f, 0xf4, 0x27, 0xf7, 0x22, 0xe, 0x8b, 0x9b, 0x5e, 0xd6, 0x1a, 0x99, 0x3b, 0x22, 0x13, 0x9d}}
-	return a, nil
-}
-
-var _templates_testFindGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x51\x6f\xd3\x30\x10\xc7\x9f\xe3\x4f\x71\x44\x0c\x39\x28\xf3\x07\x18\xea\x03\x6d\x99\x34\xa1\x55\x15\xed\xc4\x23\x72\x93\x4b\x30\x73\xed\xca\xbe\xd0\x0c\xcf\xdf\x1d\xd9\x2d\x5b\x25\x5a\xc4\x43\x94\x38\xf9\xdf\xff\xee\x7e\x77\x09\xe1\x1a\xde\x4a\xad\xa4\x87\x9b\x09\x88\x8f\xe9\x09\xbd\x58\xcb\x8d\x46\x38\xdc\xc4\x42\x6e\x31\x46\xd6\x0d\xa6\x01\x42\x4f\x21\x1c\x22\xc4\xc3\x6e\xa9\x07\x27\x75\x8c\xb7\xca\xb4\x9c\xe0\x7d\xfa\xac\x4c\x2f\xd6\x15\x04\x56\x90\x58\x4a\x27\xb5\x46\xcd\x2b\xc6\x0a\x8f\xd8\xa6\x2c\x4e\x9a\xd6\x6e\xd5\x2f\x14\x0b\xdc\xaf\x10\x5b\x5e\xb1\xe2\xa7\x74\x80\x2e\x5f\xd6\xb1\xc2\x26\xe1\xbb\x93\x4c\x2b\x65\xfa\x41\x4b\x17\x63\x88\xac\x50\x5d\x16\x9f\x7a\xad\xc8\x0d\x0d\xf1\x94\xa4\x06\x5b\xc3\x4b\xec\xdc\xee\xcd\x6b\xf4\x7c\xba\x7e\xda\xa1\xaf\x81\xdc\x80\x17\x55\x33\xab\x87\xad\xf1\x5f\x15\x7d\x9f\x63\x27\x07\x4d\x42\x88\xea\x43\x4e\xfa\x66\x02\x46\xe9\xd4\x5f\x41\xe2\x53\x2a\xb7\xe3\xe5\x83\xc9\xc4\xc8\xbe\x56\x04\x67\xab\x07\x9f\xeb\xbc\x81\x2b\x5f\xd6\xc9\xaf\x62\x45\x64\xac\x08\x41\x75\x60\x2c\x81\x58\xd8\x99\x35\x84\x23\xc5\xd8\xd0\x98\x38\x34\x87\xb3\x98\xca\xe6\xb1\x77\x76\x30\x2d\xaf\x42\x40\xd3\xc6\xc8\x8a\x83\xe4\x7e\xf0\xb4\x1e\x79\x76\x39\x75\xd8\x58\xa5\xc5\x14\x7b\x65\x72\x88\xf6\x78\xfa\x6e\x3d\xf2\x86\xc6\x3a\xf5\xf3\xc7\xb0\x62\x45\x8b\x1d\x3a\x48\xe3\xe6\x15\x04\xf8\x06\x13\xa0\x51\x7c\xb1\x5a\x6f\x64\xf3\xc8\x2b\x88\x69\x60\x2f\x23\xb0\xe2\xce\x78\x74\xc4\x2f\xb5\x90\x28\xa3\x69\xe1\x3a\x46\x48\xa7\x9c\xff\xce\x74\xe8\x78\x75\x91\x29\x3f\x45\x73\x76\x46\xb7\x09\x44\x46\x98\x00\xa4\x0d\x3c\x0b\xfc\xbf\xcb\x0a\xe1\xb8\xef\xcb\xcf\xf8\x24\x8e\x1b\x00\xcf\x69\x60\xca\xf4\xf7\x72\x07\x3c\xbb\xcf\xac\xf6\xc7\x7f\xa6\x82\x67\xd8\x39\xec\xd4\xb8\xca\xa2\x95\x56\x0d\x02\xdf\x39\x65\xa8\x83\xf2\xca\x8b\x12\x4a\x5b\x26\xd9\x0f\xab\x0c\x94\x35\x94\x19\xf1\x11\xde\x3f\xdb\x56\xdd\xa5\xed\xcc\x9d\xc3\xe4\xef\xe0\x72\x2f\x0d\x81\x04\x87\x8d\x75\x6d\x0d\xbd\xa5\xa4\x29\xb3\x63\x64\xbf\x03\x00\x00\xff\xff\x53\x90\x79\x25\xed\x03\x00\x00")
-
-func templates_testFindGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testFindGoTpl,
-		"templates_test/find.go.tpl",
-	)
-}
-
-func templates_testFindGoTpl() (*asset, error) {
-	bytes, err := templates_testFindGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/find.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x38, 0x8c, 0x42, 0xd5, 0x43, 0xac, 0xdf, 0x79, 0x3a, 0x79, 0x69, 0x38, 0xf, 0x58, 0x11, 0x72, 0x69, 0xdb, 0x9, 0x52, 0x1f, 0xd7, 0xcb, 0x4d, 0x3f, 0xa7, 0x86, 0x83, 0x72, 0x68, 0x77, 0x33}}
-	return a, nil
-}
-
-var _templates_testFinishersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x51\x4f\xdb\x30\x10\xc7\x9f\xe3\x4f\x71\xab\xb6\xc9\x99\x82\x1f\x78\x64\xea\x03\x85\x3d\xf0\x30\x40\x23\x68\x8f\x93\x49\x2e\x9d\x85\xb1\x91\x7d\x59\xb3\x45\xfe\xee\x93\x5d\x28\xdd\x20\xa4\xda\x40\x62\x5a\x1f\xaa\x36\xcd\x9d\xff\x77\xe7\xff\xfd\xfa\x7e\x07\x5e\x4b\xad\xa4\x87\xbd\x29\x88\xfd\xf8\x0b\xbd\x28\xe5\x85\x46\x58\x7e\x89\x63\x79\x85\x21\xb0\xa6\x35\x15\x10\x7a\xea\xfb\x65\x86\x38\xbf\x3e\xd5\xad\x93\x3a\x84\x99\x32\x35\x27\x78\x17\x5f\x2b\x33\x17\x65\x0e\x3d\xcb\x48\x9c\x4a\x27\xb5\x46\xcd\x73\xc6\x32\x8f\x58\x47\x15\x27\x4d\x6d\xaf\xd4\x0f\x14\xc7\xb8\x38\x43\xac\x79\xce\xb2\x6f\xd2\x01\xba\xf4\xb1\x8e\x65\x36\x06\xbe\x5d\x53\x3a\x53\x66\xde\x6a\xe9\x42\xe8\x03\xcb\x54\x93\x82\xd7\xcf\x3a\x23\xd7\x56\xc4\xa3\x48\x01\xb6\x80\x55\xee\xa1\x5d\x98\xbb\xec\xc3\x59\xf9\xfd\x1a\x7d\x01\xe4\x5a\x1c\x8c\x3a\xb0\xba\xbd\x32\xfe\xb3\xa2\xaf\x87\xd8\xc8\x56\x93\x10\x22\x7f\x9f\x44\x5f\x4d\xc1\x28\x1d\xfb\xcb\x48\x7c\x88\xe5\x36\x7c\x72\x6e\xd2\xc4\xc8\xde\x55\x04\x0f\x56\x0f\x3e\xd5\xb9\x07\x6f\xfc\xa4\x88\xe7\xe5\x2c\x0b\x8c\x65\x7d\xaf\x1a\x30\x96\x40\x1c\xdb\x03\x6b\x08\x3b\x0a\xa1\xa2\x2e\xce\xa1\x5a\x3e\x8b\x99\xac\x2e\xe7\xce\xb6\xa6\xe6\x79\xdf\xa3\xa9\x43\x60\xd9\x32\xe4\x63\xeb\xa9\xec\x78\x3a\x65\xfd\x84\x0b\xab\xb4\x98\xe1\x5c\x99\x94\xa2\x3d\xae\xff\x57\x76\xbc\xa2\xae\x88\xfd\xdc\x1e\x98\xb3\xac\xc6\x06\x1d\xc4\xeb\xe6\x39\xf4\xf0\x05\xa6\x40\x9d\xf8\x64\xb5\xbe\x90\xd5\x25\xcf\x21\xc4\x0b\x5b\x5d\x81\x15\x47\xc6\xa3\x23\x3e\xd4\x42\x9c\x32\x9a\x1a\x76\x42\x80\xf8\x94\xf4\x8f\x4c\x83\x8e\xe7\x83\x33\xe5\x77\xa3\x59\x29\x3d\x60\x3c\x9e\x8b\xe4\xbd\x7b\x8d\x1b\xa5\x6f\xfb\xad\xa8\xbb\x69\xae\x48\xfa\x76\x5c\x34\xb0\x47\xed\x7e\x62\x70\xeb\xf6\xad\xdb\x9f\xcb\xed\x5d\x1a\x55\x6c\xf4\x61\xc7\x47\xfb\x6d\x26\x3f\x2a\x08\x71\x48\x10\x35\x61\x7a\x3f\x68\x82\xdd\x35\x56\x84\x75\xbc\xea\x39\x12\x48\x30\xd6\xa4\x30\x87\x95\x75\xf5\x64\x93\x6d\xd9\xd7\xfa\x49\xb7\x65\xc0\xc5\x27\x06\x1f\x5f\xa3\x81\xbc\x72\xf1\x97\xeb\x37\x5c\xcf\xf8\x5e\x36\x52\xfb\x97\xb3\x98\x7f\xda\x6a\xb9\xd8\x00\x41\x2f\xab\xd5\x7f\x99\x41\xc3\x86\x7b\x5e\x38\x8d\x56\x50\x2e\x9e\x1d\x8f\x5e\xab\x0a\x47\xf8\x18\x81\xb3\x21\x1f\x57\x3d\x8d\x31\x59\xa3\xe1\x49\x3b\x8f\xa1\xbb\xbf\x62\x72\x21\x0d\xc1\xee\x0d\x13\x7d\x01\x73\x4b\x7b\x93\x62\x2d\x67\x13\x4c\x1e\xd8\xd6\xd0\x28\x28\x7f\x63\xe1\xa3\xdc\xdc\x82\x72\x0b\xca\x2d\x28\xff\x53\x50\x56\x11\x27\x23\xa0\x5c\x22\xe7\xa9\x51\x99\x94\x37\xa7\x64\x0a\xbf\x01\xe4\xcf\x00\x00\x00\xff\xff\xde\xf3\x38\x87\x8f\x10\x00\x00")
-
-func templates_testFinishersGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testFinishersGoTpl,
-		"templates_test/finishers.go.tpl",
-	)
-}
-
-func templates_testFinishersGoTpl() (*asset, error) {
-	bytes, err := templates_testFinishersGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/finishers.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1f, 0x6b, 0xe9, 0x2a, 0x23, 0x1b, 0x2a, 0x8d, 0x5e, 0xbb, 0x58, 0x3f, 0xe3, 0xae, 0x5b, 0x35, 0x8d, 0xd0, 0x95, 0x56, 0x3, 0x2a, 0x59, 0xe9, 0xbf, 0xc9, 0x9, 0x1e, 0x88, 0x20, 0x3b, 0x9c}}
-	return a, nil
-}
-
-var _templates_testHooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x4d\x4f\xe3\x30\x10\x3d\xc7\xbf\x62\x60\x3f\x94\xa0\x90\x1f\xc0\xaa\x07\xd8\x56\xda\xbd\x20\xa4\xc2\x69\xb5\x07\x37\x99\x54\x59\x8c\xdd\x75\x1c\x28\x1b\xf9\xbf\xaf\xec\x74\x71\x64\x25\xd0\x83\x83\xb4\x39\x41\x3b\x2f\xf3\xe6\xf9\x79\xec\x69\xdb\xb6\xe7\x50\x95\xc0\x85\x82\xec\x5a\x7c\x13\xe2\xbe\x86\x73\xad\x89\x79\xff\x23\x65\x15\xad\xe1\x62\x01\xd9\xa5\xf9\x0f\xeb\xec\x96\x6e\x18\x42\xf7\x27\xbb\xa6\x0f\xa8\x35\x29\x1b\x9e\x43\xdb\x76\xe8\x6c\x29\x9e\xf8\xba\xe2\xdb\x86\x51\xa9\xf5\x15\x96\x42\xe2\x77\x5e\xa3\x54\x26\x79\xdc\xb6\x55\x69\x98\xbe\x0a\xae\x70\xaf\xb4\x46\xd8\x88\x8a\x65\xab\x3d\xe6\x8d\x12\xb2\x6d\x91\xd5\xa8\x75\xae\xf6\x90\x77\x98\xec\x80\x4d\xe1\x80\x3d\xbc\xee\x3d\xc2\x0b\xad\x53\x10\x70\xf6\x52\xc6\xdd\xce\x15\x91\x00\x4a\x29\x24\xb4\x24\x3a\x13\xb0\x80\x41\x50\xab\x49\x24\x51\x35\x92\x03\xaf\x18\xd1\xe4\x55\x5d\x97\xa5\x42\x39\x53\x59\x6b\x64\x98\xcf\x4a\x56\xb7\x0b\xef\x76\x05\x55\x38\x27\x5d\xd6\xae\xf9\xc9\xea\xec\x5a\x22\xc3\x79\xe9\xb2\x76\xcd\x4f\xd6\xbf\xee\x9a\xe5\x61\xf8\x1f\xcb\x52\x58\xab\x1e\xfe\x86\x35\x92\x32\xad\xed\x1d\x1f\x2b\x38\x33\xf1\x8a\x6f\xb3\xdb\xc4\xa4\x57\xd9\x0d\x95\x94\x31\x64\x71\x42\x48\xf4\x48\xa5\xa1\xee\xe8\x09\x89\xac\xf8\xc3\x94\xf0\xb2\x00\x46\xe9\xc5\xe2\x45\xec\x15\xcd\xef\xb7\x52\x34\xbc\x88\x93\x83\x32\x12\xe1\xc3\x4e\x3d\x1b\xd4\xe7\xd1\xda\xc5\xab\x61\x12\xd5\x88\x85\x81\x48\xca\x0b\xf1\x50\xfd\xc1\xec\x1a\x9f\xd6\x88\x45\x9c\x90\xa8\x2a\x6d\x9d\xfd\xe8\x5a\xc9\x26\x57\xb1\x79\x2c\x05\x91\x8e\xf9\xbb\xbc\xba\x7d\xde\x61\x9d\x42\x49\x59\x8d\xc9\x17\x9b\xe7\x64\x61\xd6\xd0\x2c\x48\xa4\xb2\x95\x11\x5f\xc6\xa7\x77\xdc\x8e\x3d\x4a\x38\x92\x61\x27\x40\x6c\x7e\x61\xae\x2e\xe0\x53\x7d\x9a\x9a\x7c\x09\x89\x8c\x82\xcb\xa2\x18\xc4\xdb\x7d\x65\x37\x86\x3f\x28\x8d\x56\xed\x03\xfb\x4b\x20\xb2\x42\xf8\xf1\x3a\x1e\xb3\xce\x50\x20\x2f\xcc\xb8\x67\x34\x1f\xb7\x00\x68\x77\x2e\xc2\x00\x91\xa7\xda\x94\x75\x22\xb1\x34\xc3\x44\xb6\x44\xdc\xad\x7e\x37\x94\xc5\x22\x05\xbb\x25\x12\x8f\x62\xb5\xdf\x61\xae\xb0\x00\x3f\x2f\x98\xcd\xac\x2a\xc1\x2d\xbd\xdd\x4d\xdd\x2a\xa7\xb0\x69\x14\x6c\x85\x59\xee\x0f\x8f\xa7\x29\x88\x8e\xf7\xc8\x85\xab\x61\x01\x3f\x7e\x8e\xda\xd2\x1e\xe7\x9b\x37\x08\x8e\xda\xe6\xe1\x7c\xd7\xbc\xf0\x64\xa6\xf9\x3c\x81\x3c\xf3\xd2\x86\xb2\xcc\xaf\x36\x9c\x63\x6e\xc6\x7d\xdd\x31\x87\x1b\x74\xcc\x85\xa7\x75\xac\xc7\x13\xd2\x31\x97\x36\xa8\x63\xbd\x6a\x83\x38\xe6\x8f\xef\x6f\x9c\x8d\x0e\x38\x7c\x36\xba\xf8\xc4\x67\x63\x8f\x28\xe8\xd9\xe8\xf2\x86\x3d\x1b\x7b\xf5\x86\xeb\xb4\x23\x6c\xf3\x70\x83\x9d\xf6\x0e\xa6\xf9\x3c\x21\x3b\x2d\xbc\x65\x7e\xb5\x01\x3b\xcd\x7d\x44\x79\xa3\xd3\x1c\x70\xb8\xd3\x5c\x7c\xe2\x4e\xeb\x11\x05\xed\x34\x97\x37\x6c\xa7\xf5\xea\x0d\xd7\x69\x47\xd8\xe6\xe1\x06\x3b\xed\x1d\x4c\xf3\x79\x42\x76\x5a\x78\xcb\xfc\x6a\x83\xde\x69\x47\xce\xfb\x0e\x38\x76\xa7\xbd\xd3\xbc\xdf\x23\x0a\x7c\xa7\x4d\x33\xef\xf7\xea\x0d\x79\xa7\x1d\x37\xef\x8f\xbb\xe6\x85\xa7\xbe\xd3\x26\x99\xf7\xc3\x5b\xe6\x57\xfb\xa6\x63\xdd\xef\x14\xdd\x37\x0d\x7f\x03\x00\x00\xff\xff\x93\xd5\x90\x1f\xca\x18\x00\x00")
-
-func templates_testHooksGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testHooksGoTpl,
-		"templates_test/hooks.go.tpl",
-	)
-}
-
-func templates_testHooksGoTpl() (*asset, error) {
-	bytes, err := templates_testHooksGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/hooks.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x28, 0x58, 0x7a, 0x86, 0xea, 0xf1, 0xe0, 0xb1, 0xe, 0x7e, 0xd4, 0xcc, 0xd6, 0xea, 0x25, 0x23, 0xf6, 0x18, 0xa6, 0x30, 0xef, 0x1, 0x51, 0x2f, 0x47, 0xad, 0xf3, 0x2f, 0x83, 0x93, 0x26, 0xb9}}
-	return a, nil
-}
-
-var _templates_testInsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x41\x6f\x13\x3d\x10\x3d\xaf\x7f\xc5\x7c\xd1\x07\xb2\xd1\xd6\x12\xd7\xa2\x1c\x48\xc2\xa1\x07\xa2\x8a\xa4\xea\x11\x39\xbb\xb3\xa9\x55\xc7\xae\xec\x31\x59\xb0\xfc\xdf\x91\x1d\x68\x82\xd4\x40\x0f\x5c\x40\x1c\x56\xbb\x5e\xbd\x99\xf7\xe6\x8d\x67\x52\xba\x80\xff\x95\xd1\x2a\xc0\xe5\x14\xe4\xdb\xf2\x85\x41\xae\xd5\xc6\x20\x1c\x5e\x72\xa9\x76\x98\x33\x1b\xa2\xed\x80\x30\x50\x4a\x87\x08\x79\xf3\x70\x6d\xa2\x57\x26\xe7\x2b\x1b\xd0\x13\x27\x78\x55\x00\xda\x6e\xe5\x5a\x40\x62\x0d\xc9\x6b\xe5\x95\x31\x68\xb8\x60\xac\x09\x88\x7d\xe1\xf1\xca\xf6\x6e\xa7\xbf\xa0\x5c\xe2\x7e\x85\xd8\x73\xc1\x9a\x4f\xca\x03\xfa\xfa\x38\xcf\x1a\x57\x80\x2f\x4f\xb8\x56\xda\x6e\xa3\x51\x3e\xe7\x94\x59\xa3\x87\x0a\x3e\xcd\xb5\x22\x1f\x3b\xe2\x85\xa4\x05\xd7\xc2\x63\xec\xc2\xed\xed\x31\x7a\x31\x5b\x7f\x7e\xc0\xd0\x02\xf9\x88\x67\x51\x73\x67\xe2\xce\x86\x5b\x4d\x77\x0b\x1c\x54\x34\x24\xa5\x14\x6f\x2a\xe9\x7f\x53\xb0\xda\x94\xfa\x1a\x92\xef\x8a\xdc\x81\x4f\x6e\x6c\xf5\x8c\xdc\x51\x11\x3c\xa9\x1e\x42\xd5\x79\x09\x2f\xc2\xa4\x2d\xf9\x04\x6b\x32\x63\x4d\x4a\x7a\x00\xeb\x08\xe4\xd2\xcd\x9d\x25\x1c\x29\xe7\x8e\xc6\xe2\x43\x77\x38\xcb\x99\xea\xee\xb7\xde\x45\xdb\x73\x91\x12\xda\x3e\x67\xd6\x1c\x20\xef\x63\xa0\xf5\xc8\x6b\x96\xd3\x0c\x1b\xa7\x8d\x9c\xe1\x56\xdb\x1a\x62\x02\x9e\xfe\x5b\x8f\xbc\xa3\xb1\x2d\xf5\x7c\x4f\x28\x58\xd3\xe3\x80\x1e\x4a\xc3\xb9\x80\x04\x1f\x61\x0a\x34\xca\x0f\xce\x98\x8d\xea\xee\xb9\x80\x5c\x1a\xf6\xd8\x02\x27\xbf\xf5\xff\x5c\x09\xc5\x65\xb4\x3d\x5c\xe4\x0c\xe5\x54\xf9\xaf\xec\x80\x9e\x8b\xb3\x9e\xf2\xa3\x35\x9d\x8b\x96\xaa\x57\xa5\xd2\x27\xee\x1f\x17\x72\x5e\x30\xcf\x54\x70\x14\xff\x53\x5a\x3d\x40\x65\x2e\xa8\xd7\x3f\x60\x26\x7b\x65\x09\x9c\x45\xf0\xd8\x39\xdf\xb7\xb0\x75\x74\x39\x69\x0f\xf8\x1a\x9e\xd9\x33\x26\xe6\xf6\x4e\x13\x1a\x1d\xfe\xc4\xd1\xf9\x37\x0c\xbf\x71\x18\x8e\x17\xe1\xd7\xfb\xc8\x45\x3a\x59\x49\x7f\xef\xfc\x7c\x0d\x00\x00\xff\xff\x8d\x4a\xa9\x88\x9c\x06\x00\x00")
-
-func templates_testInsertGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testInsertGoTpl,
-		"templates_test/insert.go.tpl",
-	)
-}
-
-func templates_testInsertGoTpl() (*asset, error) {
-	bytes, err := templates_testInsertGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/insert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd2, 0xab, 0x6d, 0xb5, 0x29, 0x72, 0xba, 0x67, 0x4, 0xd5, 0x8b, 0x12, 0x80, 0x44, 0xd2, 0x22, 0x9e, 0x4a, 0x7b, 0x7a, 0x14, 0xa9, 0xf3, 0xdd, 0x52, 0x85, 0xef, 0xe5, 0x84, 0x52, 0xb7, 0x9d}}
-	return a, nil
-}
-
-var _templates_testRelationship_one_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x51\x6f\xdb\x36\x10\x7e\xb6\x7e\xc5\xc5\x70\x03\xca\x50\xd9\xf7\x0c\x7e\x68\x9a\x06\xc8\x90\x25\x43\xe2\x60\x0f\xc3\x30\x30\xd2\x49\xe6\xc2\x90\x29\x49\x39\xde\x08\xfd\xf7\x81\xa4\x15\xc9\x31\xdd\x06\xc3\x0a\xf4\xcd\xe4\xdd\x7d\xf7\xdd\x7d\xc7\xb3\x9c\x7b\x0f\xbc\x06\xba\x64\xf7\x02\xe9\x85\xf9\x59\x71\x19\x7e\xc3\xfb\xae\xcb\xbc\x15\x85\x89\x87\x89\x3f\x69\x26\x1b\x84\x99\x46\x01\x27\x8b\x3e\x6c\xa9\xae\x25\xde\xa0\x60\x96\x2b\x69\x56\xfc\xc9\xc4\x80\x10\x31\x13\x36\xe0\x9d\x2c\x60\x46\x3f\x0a\xce\x0c\x9a\x18\x17\x60\xe8\x90\x6d\xeb\x5f\x7f\xdd\xff\x5c\x69\xe4\x8d\xdc\x0b\xd3\x28\x82\x77\x08\x8c\x18\x74\xcc\x29\x46\x5f\xb1\xc7\x9d\xa8\xd6\xa0\xf9\x55\xf3\x47\x6e\xf9\x1a\x43\xec\xab\x9b\x59\xcc\x6d\xc6\x64\xc3\xcf\x4f\x4a\xb4\x8f\x32\xc1\x69\x7c\xb3\x75\x1a\x25\x2c\x95\x38\xe7\x28\xaa\x40\x33\xb6\x66\x07\x6a\x3f\xa2\xde\x09\xa9\xf7\x43\x76\x73\x75\x5d\x56\xb7\xb2\x04\x8b\xc6\x3a\xd7\xa7\xb8\x7b\xba\xe5\xb2\x69\x05\xd3\x5d\x77\x2d\x31\x28\xe6\x5c\x8f\x36\xb6\xde\x19\x2e\x1b\xe7\x5e\xfa\x49\x2f\x55\xc9\x44\xd7\x11\x0b\x73\x8f\xc9\x65\x43\x97\x39\x38\x3f\x0f\xbc\x06\xa9\x2c\xcc\xe8\x95\xfa\xa4\xa4\xc5\x8d\xed\xba\xd2\x6e\x3c\xd1\x32\x9e\xe9\x29\x2b\x1f\x1a\xad\x5a\x59\x91\xdc\x39\x94\x95\x2f\x2c\xba\xfc\xd2\x1a\xbb\xdc\x90\x00\xb3\x03\x71\xaf\xb8\xa0\xa7\xd8\x70\x19\x62\x84\xc1\xf1\xdd\x72\x43\x4a\xbb\x29\x40\x72\xd1\x23\xe6\xd9\xa4\xc2\x1a\x35\xf8\xca\x49\x0e\x0e\xfe\x84\x05\xd8\x0d\xbd\x51\x42\xdc\xb3\xf2\x81\xe4\xd0\x91\x3c\xcb\x26\x6b\xa6\xa1\x8e\xfd\x82\x74\xfd\xd1\x47\xf8\xa2\x21\xdd\xbf\x2c\x9b\x18\xc4\x20\x87\x66\xb2\x52\x8f\xfc\x1f\xa4\x57\xf8\x7c\x8b\x58\x91\x3c\x9b\xf0\x1a\x50\xeb\x5d\xf3\xad\xd5\x6d\x69\x89\x8f\x2b\xe0\x78\xcb\xa0\x18\x51\x38\x53\xcf\x72\x48\x71\x76\xba\xfc\xfb\x09\x4d\x01\x56\xb7\x78\xd8\x2d\x4a\x6e\x7e\xe3\x76\x75\x86\x35\x6b\x85\xa5\x94\xe6\x3f\x85\xf4\x47\x0b\xdf\x21\xaf\xd3\xc4\xd2\xcf\x5a\x2b\x5d\x93\xe9\x9d\x0c\x23\x6a\xd5\x40\xed\x40\x1b\xc0\x04\xc6\x27\xf0\xce\x4c\x0b\x0f\x98\x67\x93\xee\x2d\xb5\x85\xce\x15\xa3\xd6\x7d\xab\xb2\xa4\xdb\xff\x55\x59\x42\xbe\x74\x65\xe3\xd2\x42\x09\xf4\x42\x1a\xd4\x96\x1c\x9c\x72\x4f\x1e\x65\xe5\x9f\x2a\xf8\x53\x98\xd0\x0b\x59\xa3\x26\x79\x8a\xe9\x39\xb3\x4c\x90\x21\x5f\x9c\xfb\x57\xeb\x26\xbc\xfb\xed\x78\x50\xaf\x4c\xff\xf8\xbb\x0e\x7a\x62\xce\xcd\x86\x5b\x8f\x33\xac\xe8\x2f\x2d\x6a\x8e\x86\x7e\x34\x86\x37\x92\x1c\xa7\x91\x8a\x14\x50\x1e\x90\x62\x3d\xe3\x66\xf4\x10\xdf\xbb\x1d\xe5\x0a\xcb\x87\x62\x57\x82\xd4\x1a\xca\xe9\xb5\xc4\xb7\xd2\x18\x5e\xe3\x7f\x94\x82\xd7\x10\x88\xbd\xd6\xe2\x68\x68\xcc\x2b\x8b\xdb\x55\x84\xd7\x70\xd4\xab\xf2\xf9\x4b\xcb\x04\x49\xe1\x15\x07\xd0\xb6\x7b\xf6\x45\x96\xd1\xc0\x3f\x33\xe9\x67\x78\x5d\x40\xa3\x2c\xbc\x5b\x4f\x0f\x61\x14\xc9\x0a\xb6\x95\x1b\xc1\xcb\xf0\x5f\x9b\x7e\x2b\xb7\xde\xec\xe2\x9b\x1e\xa6\xa2\x97\xe7\x92\x5e\x2a\x56\xa5\x44\x7a\xf3\x94\xd4\x4c\x18\x2c\x80\xcc\x7f\xff\x63\x9e\xa6\x90\x93\xe3\x40\x32\x8f\x1b\xff\x9b\x93\xe4\x49\x46\x7a\x37\xa9\xf9\x81\xc5\xde\xf6\x20\xd3\xb8\x11\xc0\xac\x54\x2b\x2a\x58\xb1\x35\xc2\x3d\xa2\x04\x64\x0d\xfa\xff\x02\x56\x61\x35\xdd\x76\xec\xab\xd8\x1e\xfa\x7b\xb4\x29\x2e\xcb\x7e\xb7\xfe\x00\x7d\xe8\xb2\xec\x85\xa1\x73\x1f\xe6\xdb\x0f\xc3\xf9\x87\xfe\xab\x71\x64\xfa\x4b\x71\x09\xf1\xa3\x2e\xd8\xff\x0d\x00\x00\xff\xff\x86\x1e\xbd\x93\x74\x0a\x00\x00")
-
-func templates_testRelationship_one_to_oneGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testRelationship_one_to_oneGoTpl,
-		"templates_test/relationship_one_to_one.go.tpl",
-	)
-}
-
-func templates_testRelationship_one_to_oneGoTpl() (*asset, error) {
-	bytes, err := templates_testRelationship_one_to_oneGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/relationship_one_to_one.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5, 0x16, 0xc7, 0xd, 0x8f, 0xc7, 0x1e, 0xbc, 0x75, 0x7f, 0xb3, 0xe9, 0x79, 0xeb, 0xba, 0xcf, 0x7b, 0xff, 0x8e, 0x9e, 0x18, 0xd4, 0x77, 0x3c, 0x92, 0xbc, 0xdf, 0xeb, 0x21, 0xac, 0xf5, 0x3d}}
-	return a, nil
-}
-
-var _templates_testRelationship_one_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x6f\xdb\xb6\x17\x7d\xb6\x3e\xc5\xad\x11\xa4\x52\xa0\xaa\xbf\xe7\xfe\x90\x87\xd6\x69\x80\x6c\x6b\x52\x38\xe9\x06\x6c\x18\x0a\x5a\xba\x52\xb9\xd2\xa4\x4b\x52\xb1\x52\x55\xdf\x7d\xb8\xa4\x64\x4b\xb6\x92\xb9\x2b\xf2\xb0\xad\x0f\x01\x2c\xe9\xfe\x39\xe7\xde\x4b\xf2\x30\x75\xfd\x0c\x78\x0e\xc9\x0d\x5b\x08\x4c\x2e\xcc\x0f\x8a\x4b\xf7\x1b\x9e\x35\x4d\x40\x5f\x51\x18\xff\x30\xa1\x27\xcd\x64\x81\x70\xa4\x51\xc0\x8b\xd3\xce\xed\x46\x5d\x49\x9c\xa3\x60\x96\x2b\x69\x3e\xf0\x95\xf1\x0e\xce\xe3\x48\x58\x17\xef\xc5\x29\x1c\x25\x2f\x05\x67\x06\x8d\xf7\x73\x61\x92\x6d\xb6\xd6\x3e\x7f\xd8\xfe\x5c\x69\xe4\x85\xdc\x73\xd3\x28\x9c\xb5\x73\xf4\x31\x92\x3e\x26\xef\x7d\xc9\x96\x03\xaf\xd2\xa0\x79\xab\xf9\x92\x5b\x7e\x8b\xce\x77\xe7\xcd\x91\xcf\x6d\xfa\x60\xdd\xcf\x99\x12\xe5\x52\x8e\x60\xea\xbf\x69\x8d\x7a\x09\x53\x25\xce\x39\x8a\xcc\xc1\xf4\xa5\x19\x84\xda\xf7\xc8\x07\x2e\xf9\xbe\xcb\xbd\xb9\x72\xff\xe1\xed\x8f\x78\x37\x53\xc2\xb1\x0b\x0b\xb4\x2d\xcc\x8e\xd8\x00\x7d\x94\x90\x75\x1b\xde\x40\x0f\x37\x93\xd7\x2a\xb7\x67\x28\xd0\xe2\x61\x91\x66\x03\x97\xa6\x09\xf2\x52\xa6\x60\xd1\xd8\xba\xee\xa8\xbf\x5b\x5d\x73\x59\x94\x82\xe9\xa6\xb9\x92\xe8\x26\xe9\x1a\xed\xd5\xaa\xae\x3b\xaa\x7d\x93\x77\x86\xcb\xa2\xae\x37\xcd\x4e\x7e\x52\x29\x13\x4d\x13\x5a\x38\xa1\xc0\x5c\x16\xc9\x4d\x04\x75\x30\xb9\x65\x1a\x50\xbb\x3f\xa5\x03\x1a\x5e\x9e\x83\x54\x16\x8e\x92\x4b\x35\x53\xd2\x62\x65\x9b\x26\xb5\x15\x71\x49\xfd\x73\xf2\x8a\xa5\x1f\x0b\xad\x4a\x99\x85\x51\x5d\xa3\xcc\x88\xbf\x37\x79\x53\x1a\x7b\x53\x85\x2e\xcc\x20\xc4\x42\x71\x91\xbc\xc2\x82\x4b\xe7\x23\x0c\xf6\xdf\xdd\x54\x61\x6a\xab\x18\x24\x17\x5d\xc4\x28\x98\x64\x98\xa3\x06\x2a\x47\x18\x41\x0d\xef\xe1\x14\x6c\x95\xcc\x95\x10\x0b\x96\x7e\x0c\x23\x68\xc2\x28\xf0\x1c\x18\x8c\x17\xcb\x7f\x5d\xc4\x90\xc2\x78\xa9\x82\x60\x62\x10\xdd\xd0\x68\x26\x33\xb5\xe4\x9f\x31\xb9\xc4\xf5\x35\x62\x16\x46\xc1\x84\xe7\xae\x3e\xfd\xaf\xd7\x56\x97\xa9\x0d\xc9\x2d\x86\x63\x16\xf7\x52\x9f\xa9\xb5\xdc\xc6\x3e\x7b\x75\x73\xb7\x42\x13\x43\xce\x84\xc1\x18\x8c\xd5\x4b\x26\x0b\x81\xc9\x35\xda\x99\x5a\xae\x04\x2e\x51\xda\xf0\x3e\x7f\x5a\x5f\x4c\xdf\xf9\xb9\xa4\x41\xbb\x3f\x55\x6b\xf0\x0b\xb7\x1f\x54\x69\xcf\x30\x67\xa5\xb0\x51\x92\x24\xd1\xff\x1d\xfe\x27\xa7\x54\x5b\xea\xf8\xc4\x26\xe7\xcc\x32\x11\xa2\xd6\x51\x30\x69\x0e\xa0\xb8\x88\x7b\xc5\xfb\xdb\x14\x47\xfd\xc7\x29\x8e\x9a\x3e\x2a\xc5\xf4\x9f\x4e\x71\xc3\xf1\xc5\x29\xb0\xe4\x42\x1a\xd4\x36\xbc\x77\x35\x13\x04\x94\x19\xed\x82\x40\x4f\x6e\x25\x5e\xc8\x1c\x75\x18\x7d\x4d\x39\x17\x8f\x9c\x29\x98\xe4\x4a\x03\x8f\xa1\x6a\x17\x68\x81\xf0\xdb\xef\x27\xe3\x4b\xb9\xa6\x41\x3d\x4e\x1b\x17\xc9\xe3\x63\xd4\xa3\xb1\x8d\xf0\x60\xbc\x9c\xe0\xfd\x2f\x86\x2a\x0a\x26\x1d\xef\x1e\xe0\x1d\xc4\x0e\x32\x99\xb1\x64\x9e\x8c\xe4\x25\xd7\xaa\x73\x7c\x4d\x7b\x6e\x38\xd5\xfd\xd3\xd7\xb8\xa9\x74\xc8\x0c\x5a\xb0\x0a\x52\xa5\x35\xa6\x16\x6e\x99\x28\x71\xea\x73\xb8\x14\xd5\x4e\x8a\xf6\x54\xf1\x49\x8e\xd9\x4e\x96\x9c\x71\x81\x19\x05\x64\xab\x15\x11\xb4\x0a\xda\x83\x0f\x46\x10\x4c\x37\x64\xfc\x56\xbe\x73\xdc\xfb\xd3\xd3\xf1\xac\xeb\xcd\x61\xdd\xf2\xa3\x57\x79\xef\x5d\xed\xa2\x6c\x05\x12\xf9\x3d\xf9\x54\xa2\xe6\x68\x92\xd7\x9f\x4a\x26\xc2\x9d\x30\xf1\x5e\x90\xa8\x8b\xe2\x5b\x33\xa4\xd6\xd2\xf8\x88\x77\xb0\x66\x06\xd6\x5a\xc9\xa2\xad\x57\xbc\x8b\x70\xc8\xcb\xa0\xbd\x90\xa9\x28\x33\x84\x1d\x7d\xb0\xa7\x0a\x36\xd0\xb1\xe2\xc6\x9a\xb8\x5b\x6c\xe3\xb3\xf8\xda\x19\x1d\x3e\x66\x9e\xef\x4e\xca\x2f\xd4\x0c\x2e\x8b\x37\x6c\x05\x47\xb4\x61\x71\x59\x9c\x97\x32\x35\x89\xe5\x56\xe0\x8c\x19\x84\x2f\xf0\x87\xe2\x12\xa6\x14\x62\xda\x34\xfb\x4b\x6a\x6f\x42\xbd\x54\xa5\x1e\x78\x26\x3b\x83\xb2\x66\xd2\xc2\xd3\xea\x29\x0d\x88\x33\xd8\xcc\xdc\xa0\x87\x9f\x51\x2b\xb7\x26\x31\x17\x98\xda\xe4\x57\xd4\x2a\xec\x1e\x68\xc3\xbc\xca\xc3\xbd\x26\x52\xa4\xce\xe6\x42\x66\x9c\x06\x7b\xe3\xf4\x33\x35\xec\x2a\x0f\x8f\xf7\xdd\x68\x15\x87\x94\x31\x0a\xb6\xab\x90\x26\x6d\x8e\x42\xb1\xec\xd0\x32\x3f\x50\x9c\xde\xfa\xd0\x2e\xe6\xd4\x35\x78\x4b\xfd\x19\x78\x9d\xf3\xef\x5b\x11\x23\xa1\x7b\x1b\xd9\xa0\xb4\x73\xb5\x36\x2f\xf3\x1c\x53\x8b\x59\xd3\xbc\xef\x57\xb7\xeb\x88\x97\xb1\x87\x76\x04\xda\x8b\x15\x93\x19\x5d\x63\xb2\x6c\xab\x84\xcd\x8e\x98\x26\xa0\x56\x97\xd8\xc9\xc3\x83\x7a\x99\x79\x51\x5d\x0d\xba\xd9\x04\xfe\xca\x46\x5d\xdc\xbb\x18\x5c\x96\x42\xd0\x6a\xa6\x56\x1f\x28\xc4\xe7\xb8\x54\xb7\xf8\x5d\x8b\x1f\xa8\xc5\xbf\x0b\xf1\xff\xae\x10\xef\x71\x7c\x6c\x91\x3a\x48\xf5\xad\x2a\x90\x36\x1e\xaa\xff\x57\xa6\xf5\x3b\xc3\x37\x65\x1e\xcf\xb9\x27\xea\xb4\xcb\x34\x50\x72\xd3\x16\x4f\xaa\x4a\x69\xe3\xed\xdd\x60\x0c\x4e\x94\xcc\xc8\xea\xe0\x83\x34\x18\x91\xc2\x1d\xaa\xfe\x38\xbb\xdc\x4e\x41\x0f\x81\x3b\x91\x21\xd5\x00\xaf\x21\x12\x8c\x4b\x2e\x8b\xe9\xb6\x94\x0f\x08\xe9\xbd\x72\xcc\x3b\xf9\x8c\xd2\xea\x3b\x30\x1f\x54\x29\x32\x58\x20\x59\xf6\x42\x6e\x4e\xda\x0b\xe3\x34\x87\xbe\xe4\x22\x5c\x8c\x1e\xaf\xa3\x27\x6a\xea\x15\xe2\x7d\xe1\x17\x0f\xe9\xf2\xbf\x6e\x21\x83\x5c\xab\x25\x2c\x9e\x9a\x61\x75\x7c\x06\x3a\xb9\xda\x3e\xd4\xf5\xf3\x13\x12\x25\x74\x8e\xf5\xe1\xc9\xf6\x04\x83\x93\xe7\xdd\xff\x26\x7b\x0e\xfe\x0e\x35\xfa\xc9\x09\x49\xbb\x75\xfd\x33\x00\x00\xff\xff\x39\xa7\x87\xa5\xf5\x14\x00\x00")
-
-func templates_testRelationship_one_to_one_setopsGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testRelationship_one_to_one_setopsGoTpl,
-		"templates_test/relationship_one_to_one_setops.go.tpl",
-	)
-}
-
-func templates_testRelationship_one_to_one_setopsGoTpl() (*asset, error) {
-	bytes, err := templates_testRelationship_one_to_one_setopsGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/relationship_one_to_one_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7d, 0xb7, 0xbf, 0x3a, 0x3e, 0x58, 0x5c, 0x2c, 0x60, 0x35, 0x68, 0x2c, 0x59, 0x14, 0x6e, 0x6, 0xdf, 0x8f, 0x8e, 0xd0, 0x1, 0x69, 0x5a, 0x65, 0x7d, 0xcd, 0xd8, 0x5, 0xd8, 0x9b, 0x3c, 0xd2}}
-	return a, nil
-}
-
-var _templates_testRelationship_to_manyGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x8b\xe7\x06\x54\xa0\xb2\x58\x1f\x53\x18\x45\xd2\x24\x40\xb6\x34\xe8\x12\x07\x7b\x18\x86\x80\xa6\x4e\x0a\x17\x9a\x4c\x49\xca\x71\xa6\xe9\x7f\x1f\x48\xc9\xb6\x6c\xcb\xa9\x1f\x56\x6c\xd8\x83\x61\x49\xbc\xfb\xee\xbb\x1f\xfa\x48\x55\xd5\x5b\x10\x39\xd0\x31\x9b\x48\xa4\x97\xf6\x27\x2d\x54\xb8\x86\xb7\x75\x1d\xfb\x55\x94\xb6\xb9\x89\xfc\xdd\xd0\x85\xc5\xe3\x51\xeb\x02\x8b\x05\xc3\x54\x81\x30\x34\x28\x57\x8b\x74\xac\x3f\x33\xf5\x72\x83\x92\x39\xa1\x95\x7d\x10\x4f\xb6\x81\x6a\xb0\xe4\x12\x6c\x48\x4f\xa4\x60\x16\x6d\x8b\xea\x71\xe8\x8a\x47\x6b\x9f\xbf\x6e\x7f\xa1\x0d\x8a\x42\x6d\xb9\x19\x94\xc1\x7a\xdd\x71\x93\x59\x0f\x46\x78\x72\xcd\xa6\xed\xd5\xaa\x36\xcb\xdb\x2b\xcd\x99\xbc\xf8\x19\x5f\x82\x55\x27\x26\xd7\xf2\x42\xa0\xcc\x42\xcc\x26\x4f\xfa\x49\xcb\x72\xaa\x1a\xe7\xf6\xba\x9b\xdc\x9a\x4b\xbe\xed\xd2\x52\xdb\xf6\x2c\x2d\xda\x2f\x46\x4c\x85\x13\x33\x0c\x59\x6e\x3c\x19\x36\x55\xb2\xdd\xb2\x76\x59\xec\xc8\x7c\x67\x40\xcb\x1f\x70\xca\xd6\x1c\x7c\xcf\xd7\x1e\xfc\x05\x43\x7a\x1b\xec\x96\x73\x92\x97\x8a\x83\x43\xeb\xaa\x6a\x51\x92\xbb\xa7\x5b\xa1\x8a\x52\x32\x53\xd7\xcd\xb0\x54\xd5\xb2\x5f\x34\x54\xb7\xae\x89\x83\x23\xef\x26\x54\x41\xc7\x09\x54\x71\x34\x63\x06\xd0\x84\x9f\x36\x7e\xfe\x44\x0e\x4a\x3b\x18\xd2\x6b\xfd\x49\x2b\x87\x73\x57\xd7\xdc\xcd\x3d\x2d\xde\xdc\xd3\x53\xc6\x1f\x0b\xa3\x4b\x95\x91\xa4\xaa\x50\x65\x3e\x9f\xc6\xe4\x73\x69\xdd\x78\x4e\x02\xcc\x1a\xc4\x44\x0b\x49\x4f\xb1\x10\x2a\xf8\x48\x8b\xdd\x67\xe3\x39\xe1\x6e\x9e\x82\x12\x72\x81\x98\xc4\x51\x86\x39\x1a\xf0\xb9\x92\x04\x2a\xb8\x87\x11\xb8\x39\xbd\xd1\x52\x4e\x18\x7f\x24\x09\xd4\x24\x89\x9b\x14\x18\xf4\x57\xa2\x59\x9d\xa4\xc0\xbd\x41\xde\x63\x10\x47\x16\x31\x4c\x8a\x61\x2a\xd3\x53\xf1\x27\xd2\x6b\x7c\xbe\x45\xcc\x48\x12\x47\x22\x0f\xe5\xe9\xae\xde\x3a\x53\x72\x47\xbc\x5b\x0a\x87\x2c\xed\x84\x3e\xd3\xcf\x6a\x85\x7d\x76\x3a\x7e\x79\x42\x9b\x82\x33\x25\xee\x36\x6b\xa6\xc2\xfe\x2a\xdc\xc3\x19\xe6\xac\x94\x8e\x52\x9a\x7c\x08\x71\x0f\x46\xbe\x26\xbe\x51\x91\xa3\xe7\xbe\x47\x39\x19\xdc\xa9\x30\x07\x4e\xaf\x48\xed\x48\x1f\x6c\xe0\x7a\x0c\x6f\xec\x20\xf5\x80\x49\x1c\xf9\x94\xdb\xac\x8e\x47\xc0\xe8\xa5\xb2\x68\x1c\xd9\xd9\x79\x4f\x1c\x55\xe6\xa7\x16\xfc\x5d\xe8\xda\xa5\xca\xd1\x90\xa4\x8f\xe5\x05\x73\x4c\x92\xad\x58\xbb\x2b\x38\x49\x3b\xbd\xd9\x51\xc1\x9c\x49\x8b\xbb\xed\xf6\x2e\xe1\x3a\xb9\x6f\x73\xe3\xff\x1a\xb7\xce\xbb\x48\xc7\x7a\x7d\x33\x89\x9a\xb5\x0d\xb9\xf2\x0b\x13\xea\x69\x2c\xf4\xaf\xae\xc1\x77\xb8\xaa\x86\xab\x27\x71\xc4\xf7\xb0\x89\x9a\x77\xb4\x89\xf6\xb5\x44\x23\xd0\xd2\x13\x6b\x45\xa1\xc8\xe1\x66\x90\x74\xd3\x3f\xd9\xf6\xd9\x0c\xda\xe7\x13\x24\xb1\x95\x93\xce\xe5\xb2\x49\x93\xef\x3c\xab\xab\x48\xfc\xbb\xbf\x15\x01\x78\xbb\xb1\xf7\x69\xcb\xc0\xcd\xe9\xf9\x1c\x39\x19\x88\x40\x04\x84\x72\x1a\xaa\xaa\xb3\x73\x6e\x6c\x0b\x75\x0d\xa4\x5d\x0f\x62\xdf\xee\x35\xde\xea\x97\x52\x3b\x3f\x1e\xe9\x02\x60\x7d\x3b\xea\x9a\x24\x30\x63\xb2\x44\x0b\xad\x82\x9f\x09\x26\x91\x3b\x7a\x67\xf1\x52\x65\x38\xff\x22\x19\xc7\x07\x2d\x33\x34\xb6\xae\xc9\xf0\xc7\x14\x86\xef\x97\x82\x4e\x3e\xa6\xf0\x71\x21\xe0\x83\xad\x16\xa7\xb0\x39\x39\x2b\x81\x7d\xad\x2d\xff\xf3\xa2\x6c\xbe\x1a\xfb\x15\x65\xb1\xf1\xc6\x11\x7f\x40\xfe\x98\xae\x04\xbd\x6f\xdf\x4f\xe8\x89\x94\xfb\x4e\xf3\x5e\x04\xe2\x68\x72\xe1\x8f\x00\x29\xf0\xf0\xef\x63\xb7\x4a\x18\xfe\xe2\x28\xd7\x06\xee\x53\x98\xb5\x7b\x6b\x81\x10\x98\x06\xb4\x3e\xfd\x6a\xa5\x4d\xe4\x30\xdb\x52\xa8\xd1\xd6\xe8\x04\x98\x96\x83\x1f\x0d\x53\x62\x1c\x45\xaf\x00\x6c\xc9\x5e\x00\xe0\x3d\x00\x5d\xed\xf3\x68\x0b\x2d\x3b\xff\x5a\x32\x49\x36\xb1\x7b\xa6\xfa\x55\x6e\xdf\x42\xdb\x1a\x87\x57\x89\x36\x4d\x5b\xee\xb4\x07\x6d\xd0\xce\x81\x81\x0c\x70\xfe\x84\xdc\x61\xe6\x4f\x0c\xb9\x50\x19\x4c\x06\x4b\xbd\x3b\xe0\xfb\x38\xf0\x41\xdb\x73\x2b\x05\x0f\x47\xd4\xfe\xf3\xc6\xad\x5f\xae\x0e\x59\x57\x4b\x19\xbd\xa2\x57\x9a\x65\x7d\x63\xb9\xb7\xbc\xb6\x93\x45\x8e\x7e\xfb\xfd\xa8\x3f\x74\x42\x0e\x03\xb9\xa4\x39\x45\xee\x25\xf6\x85\x76\x3e\x17\x89\x8a\x30\x7a\xd3\xf7\xe2\x24\x1f\x82\xd1\xc1\x08\xde\xaf\x97\x48\x95\xd3\x09\x1a\xd0\x39\x20\x2b\xd0\x80\xd4\x2c\xc3\x0c\x0c\x72\x6d\x32\x0b\xcf\x46\xab\x22\xf5\xbe\xc7\x83\xf0\xd7\xd6\x6f\x47\x18\x08\x3c\xff\xe9\xa2\x35\xc7\x4e\x7f\x42\xfd\x0f\x57\x44\xe4\xe0\x89\x08\xe9\xcf\xdb\x2d\xe2\x95\x2e\x72\x32\x78\xf3\xc3\x6c\x90\x36\x9a\x11\x6c\xeb\x38\x5e\xe6\xe7\x8f\x07\xef\x8e\x5a\x59\x39\x7a\xb7\xfa\xc4\x5e\x5b\xd6\xa5\x43\xe3\x3f\xca\xff\xd0\x42\x41\xf3\xc9\xdb\x1a\xff\x1d\x00\x00\xff\xff\xd0\x0f\xd8\x40\xae\x0f\x00\x00")
-
-func templates_testRelationship_to_manyGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testRelationship_to_manyGoTpl,
-		"templates_test/relationship_to_many.go.tpl",
-	)
-}
-
-func templates_testRelationship_to_manyGoTpl() (*asset, error) {
-	bytes, err := templates_testRelationship_to_manyGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/relationship_to_many.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x76, 0x21, 0x1d, 0x86, 0xfc, 0xf0, 0xa7, 0xa1, 0x52, 0xeb, 0xdc, 0x95, 0xe5, 0x3f, 0x41, 0x26, 0xe6, 0x4, 0xaa, 0x87, 0xba, 0xb9, 0x45, 0xf9, 0x92, 0xd2, 0x88, 0xd1, 0xf, 0x7a, 0xef, 0xe8}}
-	return a, nil
-}
-
-var _templates_testRelationship_to_many_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xdb\x38\x12\x7f\xb6\x3e\xc5\x6c\x10\xa4\x52\x56\x95\xdb\x62\x71\x0f\x5d\xf4\xa1\x4d\x37\x40\x6e\x77\x73\x87\x38\x77\xf7\x10\x04\x0b\x5a\x1a\xd9\xbc\xd0\xa4\x97\xa4\x62\xfb\x0c\x7d\xf7\x03\x49\x59\x92\x1d\xc9\x51\x12\x3b\x8b\x16\x7e\x08\xa0\x3f\xc3\xf9\xcd\x0c\x87\x33\xf3\x93\xb3\x5c\xbe\x05\x9a\x42\x74\x4d\x86\x0c\xa3\x0b\xf5\x77\x41\xb9\xbd\x86\xb7\x79\xee\x99\xb7\xc8\x94\xbb\xe9\x99\xbb\x63\x6d\x5f\x7e\xfc\x54\x2c\xa9\xde\x48\xc2\x47\x08\xc7\x12\x59\xf5\x36\xba\x16\xbf\x13\xbe\xb8\x42\x46\x34\x15\x5c\x8d\xe9\x54\xb9\x15\x4e\x19\x2b\xb5\x1d\x47\x9f\x19\x25\x0a\x55\xa1\xd6\xe8\xa9\x23\x38\xf9\x74\xbb\xfc\xb9\x90\x48\x47\xfc\xc1\x32\x89\xcc\x4a\xaf\x2f\xdc\xb4\xac\x41\x87\x7d\x72\x49\x26\xc5\x55\x15\x9c\xf2\xf6\x37\x11\x13\x76\xfe\x2b\x2e\xac\x54\x0d\x33\x53\xa8\xfe\x29\xe9\x84\x6a\x7a\x8f\x16\x79\xe3\xc9\xb1\xb3\x5c\xd5\x5d\xb5\x97\x67\x82\x65\x13\xde\x66\x4d\xf1\xa4\x10\xaa\x01\xc6\x82\x9d\x53\x64\x89\x75\xd2\x05\x76\x4d\xd5\xc3\x15\xe9\xda\x92\xf4\xe1\x92\x75\xac\x3c\xf7\xd2\x8c\xc7\xa0\x51\xe9\xe5\x72\x05\xf1\xaf\xe9\x80\xf2\x51\xc6\x88\xcc\x73\xb7\xdb\x9f\x93\xe4\x1f\xd3\xe5\xb2\x8c\x7a\x64\x63\x94\xe7\xbe\x86\x53\xb3\x96\xf2\x51\x74\x1d\xc0\xd2\xeb\xdd\x13\x09\x28\xed\x9f\x90\x9e\x49\x23\x9a\x02\x17\x1a\x8e\xa3\x4b\x71\x26\xb8\xc6\xb9\xce\xf3\x58\xcf\x8d\x81\xb1\xbb\x8f\xbe\x90\xf8\x6e\x24\x45\xc6\x13\x3f\x58\x2e\x91\x27\xc6\x21\x27\xf2\x7b\xa6\xf4\xf5\xdc\xb7\x6a\xd6\x54\x0c\x05\x65\xd1\x17\x1c\x51\x6e\xd7\x30\x85\xf5\x67\xd7\x73\x3f\xd6\xf3\x10\x38\x65\x2b\x8d\x81\xd7\x4b\x30\x45\x09\xc6\x63\x3f\x80\x25\xfc\x01\x9f\x40\xcf\xa3\x2b\xc1\xd8\x90\xc4\x77\x7e\x00\xb9\x1f\x78\xce\x07\x02\xcd\xf1\x70\x6f\x87\x21\xc4\x21\x24\x21\xa0\x11\x4b\x1b\xc4\xbc\x9e\x42\xb4\xbb\x20\x09\x4f\xc4\x84\xfe\x0f\xa3\x4b\x9c\x0d\x10\x13\x3f\xf0\x7a\x34\xb5\x51\xaa\xbf\x1d\x68\x99\xc5\xda\x37\xcb\x42\x38\x21\x61\xcd\x80\xaf\x62\xc6\x2b\xdd\x5f\xbf\x5c\x2f\xa6\xa8\x42\x48\x09\x53\x18\x82\xd2\x72\x42\xf8\x88\x61\x34\x40\x7d\x26\x26\x53\x86\x13\xe4\xda\x6f\x5b\x6f\x12\x96\xc8\xc5\xaf\xb8\x70\x59\xa0\xda\xa1\x0a\x81\xff\x50\x3d\x16\x99\xfe\x8a\x29\xc9\x98\x0e\xa2\x28\x0a\x7e\xb6\xf6\xff\xf0\xc9\x44\xd8\xec\x7b\x4f\x47\xe7\x44\x13\xe6\xa3\x94\x81\xd7\xcb\xbd\x5e\xea\x32\x0d\xa5\x3d\x29\x37\xb7\xa7\xcd\x91\x5a\x9e\x0c\x43\x38\x89\x43\x38\x31\x6e\xa3\x5b\x08\x7f\x84\x30\x2f\x82\x37\x42\xa8\xa9\x32\x50\x8f\x05\x6f\x1e\xd6\x76\xe5\xd9\xb1\x6b\x5c\xdf\x1c\xbb\x46\xd1\x27\xc4\x6e\x23\x78\x26\x7a\x26\x83\x0a\x3f\x3f\x7e\x02\x12\x5d\x70\x85\x52\xfb\xad\xc7\xc9\xd8\x81\x3c\x31\xb5\x00\xcc\x9d\x3d\x0a\x17\x3c\x45\xe9\x07\x1d\x36\xab\x0c\xe9\xf0\xd5\x90\xe2\x3d\x23\xd5\x33\x70\x30\x65\x54\x7f\x59\x38\x40\x2a\xb8\xcb\xc8\xf6\x9c\x34\xe5\xd4\xe5\x65\x1e\xda\x6b\x97\x9b\x61\xa9\x17\x68\x4b\x82\x3e\x40\x32\xba\x9c\xc3\x24\xfa\x9c\x24\x4d\x45\xb4\x73\x00\xa8\xf1\xf7\x5d\x08\x73\x93\x45\xd5\x41\xd8\x9a\x49\x5e\xaf\x97\x52\xa9\xb4\x31\x76\x7e\xf3\xee\xd6\xeb\xf5\x14\xc6\x82\x27\xee\xc1\xfb\xdb\xa2\x75\xd8\x89\x41\x94\xed\xd0\x96\x30\x03\x60\x17\x47\x57\x51\xdd\xf0\xa2\x89\xe4\xf9\xcd\xbb\x5b\x83\x7e\x42\x56\xe0\xbf\x98\xb2\xef\x1f\xc9\x7a\x0f\x9e\x11\x65\xbd\x23\x49\x82\x09\x4c\xa5\x98\xa2\x64\x0b\xd0\x02\xf4\x18\x41\x31\x1a\xe3\x51\x91\xf5\x06\xd0\x59\xf7\x6a\x88\xab\x79\xc8\x39\xec\xfa\xcc\x46\x5b\x77\xfd\x95\xa6\x40\x8c\x4d\xab\x0e\x9b\xe7\xc6\x10\x17\x1e\x93\x46\xb5\xe7\xeb\xa6\x15\xc9\x01\x77\xb8\xb0\x96\xcd\xa4\xe0\x23\xb8\x27\x2c\xc3\xa3\x70\x53\x67\xd8\xa8\xb1\x16\x9e\x06\x1b\x8a\x88\xed\xd2\x88\x46\x95\x55\xc8\xaa\x01\xd2\x98\xf4\xc3\x9f\x19\x4a\x8a\x2a\xfa\xe5\xcf\x8c\x30\xbf\x9b\x47\x7b\x0d\xd2\x63\x16\x35\xbb\xb7\xd7\x90\xbd\x05\x37\xd7\x74\x38\x55\x2f\x4d\xf0\x95\xe1\x4f\x3c\x5a\xfb\x81\xdd\xf4\x9c\x6c\xc0\x17\x45\xf0\x86\x9e\x7e\xb8\x2d\x0f\xd4\x36\x23\x94\x6d\xf5\x0e\xc5\x5a\xa3\x50\x1b\x13\x62\x21\x25\xc6\xba\xd8\xa0\xb5\x13\xd3\x8a\xf8\xe3\xfb\xdb\xea\x00\xed\x0a\xd4\xeb\xf5\x62\x91\x71\x1d\x56\xdd\xbb\xa9\xea\x07\xd1\x99\x91\xea\x5a\xfd\xbb\xd6\x7b\x2b\x35\x23\xdc\x16\x7c\xca\xf5\xdf\x7e\xf2\x7d\xfa\xe3\xfb\xe0\xf4\x43\xf0\x33\x58\xbb\xcc\x7a\x2b\xb0\xee\xaf\x79\x74\x14\xda\x37\x21\x1c\x8d\x84\xb9\xb1\xf2\xe5\x44\xe2\xc8\x23\x4d\xc1\x17\xb2\x81\x4d\x5c\x66\x8c\xd5\x88\x5e\xd5\x4b\x02\xb3\xf9\x9d\x78\xc6\x00\xf5\x81\x67\x1c\x78\xc6\x81\x67\xfc\x15\x3c\xe3\x40\x33\x9e\x47\x33\x56\xb1\x1b\xa0\x7e\xd1\x74\x5f\xa4\x8a\xcb\xd3\xea\xec\x6e\xc5\xde\x4f\xa3\x79\x1c\xd9\x88\x94\xcd\xe4\x43\x21\x52\x34\x12\xf7\xbc\x9c\x98\x3e\xd6\xfa\xc8\x2e\xc3\xa5\x65\x86\xab\xd3\xfc\xf4\x68\x7d\x23\xc1\x6a\x63\x66\xfd\x3e\x5c\xdb\x91\x8b\x31\x31\xa3\x7c\x04\xf1\x18\xe3\x3b\x05\x31\xe1\xc6\xe8\x21\x02\x5d\xd5\x18\x4c\x40\x51\x1e\x23\xcc\x10\xc6\xe4\xde\x8c\x2f\x30\x26\x3c\x61\x68\xd5\xb8\xe1\x4d\x21\xcc\xc6\xc8\x8d\x4c\x4c\x18\x83\x01\x6a\x3f\x88\xe0\x37\x24\xf7\x46\xbb\x1e\xe3\x04\xc6\x28\x11\x8c\xa1\x54\x8d\xd3\x8c\x81\x1e\x53\x7e\x47\xf9\xc8\xaa\x21\x3c\x31\xaa\x18\x6a\x98\xa2\x98\x32\x84\x3b\x2e\x66\x66\xa1\xc4\x37\x0a\x12\x49\x46\x82\xab\xc8\xc8\x5a\x79\x9a\x02\x43\xee\x0f\xdb\x86\xd1\xc0\x52\x5d\x13\xab\x7e\x1f\x1e\x19\x47\x25\x4e\xc4\x7d\x7d\x20\x4d\xa5\x98\xac\x53\xbd\x7e\x1f\xf2\x3a\x6e\xfc\xaa\xb8\x34\x85\x8e\x84\xf6\x45\x7c\xb6\x28\x7b\xaf\x83\xb4\xc6\x9b\xeb\x94\xeb\x42\xfd\xdb\x0c\xc3\xf2\x92\x32\x7f\xd8\x48\xb1\xd6\x46\x4e\x18\xbe\x51\x50\xa7\x5a\x76\x94\x36\x88\x43\x34\x27\xaa\x72\xac\x11\x21\xee\x80\x10\x77\x42\x68\xa7\xfe\xcd\xac\xbb\x91\x70\x3f\x97\x3c\x36\xf0\xc6\x56\x60\xdc\x25\xf0\xa6\xb2\x55\x2c\x2a\x86\xdf\x81\x4e\x37\x33\xe9\x9d\xc7\xe2\x31\x33\x1e\xf8\xb2\x97\xc8\x54\x74\x96\xa6\xd0\x5a\xbf\xd6\xfb\xc1\xf3\xaa\x48\x49\xa8\xed\xd0\x59\x1d\x84\xd6\xe2\xb5\x4f\xd0\x2e\x9f\x0d\x5e\xf4\xd5\x60\x03\xaf\xb5\x8c\xed\x03\x6f\x63\x57\xdb\xbe\x18\x14\x05\x34\xd9\x02\xde\xf9\x43\x41\xbe\x15\xc9\x7d\x97\x38\xc1\xdd\x20\x75\xa5\xdf\x57\x36\x2b\x0e\x0c\xfc\xc0\xc0\x0f\x0c\xfc\x3b\xfd\xa5\xcf\xdb\xd9\x8f\x51\x8e\x7f\x55\x1b\xe6\x7e\x93\xfa\x66\x48\xeb\x4f\xcf\x22\xad\xae\x42\xbe\x8c\xe6\x97\x11\xbb\xf9\xf8\xe1\xf6\x59\x51\xfb\xc6\xc9\x6b\x77\xee\xf7\x72\x02\x96\x97\x70\x1d\x28\xdf\xae\xe0\xf6\x48\xf6\x9e\x3c\x2e\xed\x09\xf2\x7b\xa3\x7f\x87\x69\xbe\xe3\x74\xad\x05\x10\x50\x63\x91\xb1\xc4\x7d\x4e\x1a\x22\x72\x98\x4a\x54\x28\xef\x31\xd9\xc5\x00\xdf\x0d\x62\x63\x66\x37\x47\xbc\x65\x9a\x0e\x1a\x4a\x57\x5d\x7d\xa9\x19\xf4\x4c\x40\xdd\x14\xb5\x4a\x8e\x7e\x1f\x6c\xe9\x27\x0c\x12\x81\x8a\xbf\xd1\x90\x58\x2b\xdd\x7f\x2f\x26\xc8\xd0\xfe\xb7\x87\x99\x68\xa6\x28\x53\x61\x86\x8d\x18\x41\x89\xf2\xb3\x9b\x16\x90\x32\xe3\xdd\x18\x41\xc8\x04\x65\x17\x02\xb0\x8d\x6a\x68\x01\x49\xa7\x9d\x78\x84\xce\x6c\x23\x19\x5a\x00\x3e\x8a\x91\x7b\x65\x57\xf1\x8a\x5d\x31\xd7\xe6\xb2\x7f\x5a\x0c\x76\x6b\x51\x85\xd3\x7e\x8b\xb0\xc8\x34\x4a\xd3\x34\xfe\x2b\x28\x07\x17\xdc\x42\xf8\xff\x01\x00\x00\xff\xff\x90\xab\x62\x85\xd7\x2a\x00\x00")
-
-func templates_testRelationship_to_many_setopsGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testRelationship_to_many_setopsGoTpl,
-		"templates_test/relationship_to_many_setops.go.tpl",
-	)
-}
-
-func templates_testRelationship_to_many_setopsGoTpl() (*asset, error) {
-	bytes, err := templates_testRelationship_to_many_setopsGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/relationship_to_many_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x73, 0x52, 0x36, 0x36, 0x86, 0xdb, 0x1e, 0xee, 0x2c, 0x24, 0xe9, 0x2b, 0x10, 0xc8, 0xac, 0xe5, 0xab, 0xad, 0xf3, 0xcb, 0xa5, 0x1b, 0x49, 0xdc, 0x47, 0xa4, 0x0, 0x76, 0x4, 0xc0, 0xff, 0xe9}}
-	return a, nil
-}
-
-var _templates_testRelationship_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\xd1\x6e\xdb\x36\x14\x7d\xb6\xbe\xe2\x36\x70\x03\xca\x50\xd9\xf7\x0c\x7e\x68\x9a\x06\xc8\xd6\xa5\x43\xe2\x60\x0f\xc3\x30\x30\xd2\x95\xcc\x85\x21\x53\x92\x72\x9c\x11\xfc\xf7\x81\xa4\x54\xc9\xb1\x8c\x04\x43\x87\xbe\x99\x26\xcf\xe1\xb9\xe7\x1e\x5d\xc9\xb9\x77\xc0\x6b\xa0\x2b\x76\x2b\x90\x5e\x98\x9f\x15\x97\xf1\x37\xbc\xf3\x3e\x0b\xbb\x28\x4c\x5a\xcc\xc2\x4a\x33\xd9\x20\xcc\xeb\x3b\x7c\x82\x93\x65\x8f\x3b\xff\x05\x9f\x4c\x3a\x14\x4f\xcd\x85\x8d\x1c\x27\x4b\x98\xd3\x0f\x82\x33\x83\x26\x1d\x4d\x50\x3a\x5c\xd1\x01\xea\x17\x00\xe7\x4a\x23\x6f\xe4\x1e\x4e\xa3\x88\xa0\x74\x21\xbd\x42\xc1\x2c\x57\xd2\xac\xf9\x43\x87\xbc\x64\xf7\x3b\x88\x52\x89\x73\x8e\xa2\x1a\xc3\x3e\x2a\xd1\xde\xcb\x0e\xd0\x2d\xc6\xe2\x76\x30\xf5\x04\xa6\x93\xb7\x0f\x6d\x0d\x9a\xdf\x34\xbf\xe7\x96\x6f\xd0\x04\xfc\xb3\x7f\xe6\xa9\x4c\xb3\x63\xcc\x8e\x90\x09\x03\xa6\x2e\xf5\x3e\xab\x5b\x59\x82\x45\x63\x9d\xeb\x2b\xbb\x79\xb8\xe6\xb2\x69\x05\xd3\xde\xaf\xd4\x17\x89\xce\xf5\x05\x8c\xb7\x6e\x0c\x97\x8d\x73\xc1\xce\x9e\xd6\x7b\x62\x61\x11\xd8\xb8\x6c\xe8\x2a\x07\x17\x12\xc0\x6b\x90\xca\xc2\x9c\x5e\xaa\x8f\x4a\x5a\xdc\x5a\xef\x4b\xbb\x0d\x85\x95\x69\x4d\x4f\x59\x79\xd7\x68\xd5\xca\x8a\xe4\xce\xa1\xac\x82\x1b\xe9\xc8\xaf\xad\xb1\xab\x2d\x89\x34\x3b\x14\xb7\x8a\x0b\x7a\x8a\x0d\x97\x11\x23\x0c\x8e\xff\x5b\x6d\x49\x69\xb7\x05\x48\x2e\x7a\xc6\x3c\x9b\x55\x58\xa3\x86\x50\x33\xc9\xc1\xc1\x5f\xb0\x04\xbb\xa5\x57\x4a\x88\x5b\x56\xde\x91\x1c\x3c\xc9\xb3\x6c\xb6\x61\x1a\x84\x2a\x99\x80\x69\x57\xd2\x89\x3a\x15\x0d\xd3\xf6\x64\xd9\xcc\x20\xc6\xf6\x6b\x26\x2b\x75\xcf\xff\x41\x7a\x89\x8f\xd7\x88\x15\xc9\xb3\x19\xaf\x01\xb5\xde\xdd\xbe\xb6\xba\x2d\x2d\x09\xb8\x02\x8e\xa3\x82\x62\x24\xe1\x4c\x3d\xca\xe1\x82\xb3\xd3\xd5\xd3\x03\x9a\x70\x20\x58\x93\x82\xdb\x0a\x11\x8e\x7a\x6f\x75\x8b\xbd\x2b\x35\x13\x06\x3b\x13\x0e\xf3\xa5\x4c\x98\xdf\xb9\x5d\x9f\x61\xcd\x5a\x61\x29\xa5\xf9\x4f\x51\xe5\x9b\x65\x30\x32\xb4\x73\x66\xe9\x27\xad\x95\xae\xc9\xd1\x8d\x8c\xc1\xb2\x6a\xa8\xe0\x80\x5f\x60\x62\x61\x27\xf0\xd6\x1c\x15\x81\x30\xcf\x66\xfe\x35\x16\x74\x16\x17\x23\x8f\x5f\x36\x61\x27\xe3\xaf\x74\x64\x92\xfc\x7b\x39\x32\x91\x8e\x69\x47\xc6\x96\x74\xa5\xd3\x0b\x69\x50\x5b\x72\xf0\x31\x0a\xf2\x51\x56\x61\x80\x40\x58\xc5\x47\xe0\x42\xd6\xa8\x49\x3e\xa5\xf5\x9c\x59\x26\xc8\x70\x63\x32\xee\xd9\x84\x89\xd3\x28\xe6\x8f\x3a\xf7\x6d\xfc\x79\x0f\x83\xae\x50\xd7\xb0\x11\x78\x86\xa9\xff\xb5\x45\xcd\xd1\xd0\x0f\xc6\xf0\x46\x92\xe3\x09\xa6\xe2\x00\x51\x1e\x99\x52\x3d\x63\x3b\x12\xc5\xff\x6d\x46\xb9\xc6\xf2\xae\xd8\xbd\x73\x6f\xc4\xe5\xf4\x8b\xc4\xd7\x6a\x18\x9e\xf4\xff\xd8\x05\x5e\x43\x54\xf5\xcc\xa7\x40\x37\xed\x60\x1a\xba\x43\x33\x78\x0d\x6f\xfa\x86\x7c\xfa\xda\x32\x41\xa6\xf8\x0e\xf6\xa3\xa3\xeb\x3b\x32\x4a\xfb\x23\x93\x21\xc0\x9b\x02\x1a\x65\xe1\xed\xe6\xe8\x10\x47\x31\x59\x41\x57\xb9\x11\xbc\x8c\x2f\xf1\xe9\xd1\x71\x1d\xb6\x5d\x4a\xd0\x10\x88\xbe\x37\x9f\xe9\x67\xc5\xaa\xbd\x0e\xbd\x3a\x1f\x71\x0e\x14\x40\x16\x7f\xfc\xb9\x98\xbe\x3f\x27\xc7\x51\x61\x9e\x5e\x25\x2f\x66\x28\x28\x4c\xda\xae\xf6\x92\x03\xcb\xbd\xa1\x41\x8e\xd2\x20\x00\xb3\x56\xad\xa8\x60\xcd\x36\x08\xb7\x88\x12\x90\x35\x18\xde\x42\xac\xc2\xea\xa8\xf3\xea\x30\x71\xe0\xfd\xee\xee\x84\x89\x39\xbc\x87\x7e\x74\xf9\x3e\xcb\xbe\xc9\x73\xee\xfd\xa2\xfb\xae\x5c\xbc\xef\x3f\x3a\x47\x5b\x7f\x2b\x2e\x21\x7d\x1e\xc6\xfd\x7f\x03\x00\x00\xff\xff\xd3\x88\x32\x01\xb3\x0a\x00\x00")
-
-func templates_testRelationship_to_oneGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testRelationship_to_oneGoTpl,
-		"templates_test/relationship_to_one.go.tpl",
-	)
-}
-
-func templates_testRelationship_to_oneGoTpl() (*asset, error) {
-	bytes, err := templates_testRelationship_to_oneGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/relationship_to_one.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x24, 0xf9, 0x25, 0xf8, 0x3d, 0xf2, 0x48, 0x13, 0xfd, 0x7b, 0x6, 0xe, 0xd6, 0xf0, 0xeb, 0x8e, 0xa, 0x4f, 0xc, 0xd2, 0x3e, 0xb1, 0x3c, 0xaa, 0xd, 0x6d, 0x68, 0xf, 0xb0, 0x26, 0x3b, 0x41}}
-	return a, nil
-}
-
-var _templates_testRelationship_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x4d\x6f\xdb\x46\x10\x3d\x8b\xbf\x62\x22\x18\x0e\x69\x30\x4c\xce\x29\x7c\x68\x9c\x18\x50\xd3\xd8\x81\xac\xb4\x40\x83\xa0\x58\x91\x43\x66\x9b\xd5\xae\xbc\xbb\xb4\xa5\x30\xfc\xef\xc5\x2c\x49\x89\x5f\x6e\xe4\xb4\x3e\xb4\xcd\x21\x40\x48\xee\xcc\x7b\xf3\xb1\x33\x4f\x2e\x8a\x27\xc0\x53\x88\x16\x6c\x29\x30\x9a\x99\x9f\x14\x97\xee\xff\xf0\xa4\x2c\x3d\xfa\x8a\xc2\x54\x0f\x13\x7a\xd2\x4c\x66\x08\x47\xe9\x27\xdc\xc2\xf3\xd3\xc6\xee\xfc\x35\x6e\x4d\x75\xc8\x9d\x3a\x12\xd6\xf9\x78\x7e\x0a\x47\xd1\x8f\x82\x33\x83\xa6\x3a\x5a\x99\x46\x7b\x88\xda\x20\xfd\x8a\xc1\xb9\xd2\xc8\x33\x39\xb0\xd3\x28\x9c\x51\x05\x18\xcd\x51\x30\xcb\x95\x34\x1f\xf9\xba\xb6\xbc\x60\xab\x8e\x45\xac\xc4\x39\x47\x91\xb4\xcd\xce\x94\xc8\x57\xb2\x36\xa8\x1f\xda\xe4\x3a\x36\xe9\x88\x4d\x4d\x6f\x68\x9a\x1b\x34\x6f\x35\x5f\x71\xcb\x6f\xd0\x90\x7d\xef\xcd\x51\x15\xa6\xe9\x24\xa6\x43\x64\x24\x01\x63\xa0\x65\xe9\xa5\xb9\x8c\xc1\xa2\xb1\x45\xd1\x44\xf6\x6e\x7d\xc5\x65\x96\x0b\xa6\xcb\x72\xa1\x2e\x25\x5e\xa1\xbd\x5c\x17\x45\x13\x45\xfb\xfb\x3b\xc3\x65\x56\x14\x94\xd3\xc6\x77\x59\xfa\x16\x4e\xc8\x25\x97\x59\xb4\x08\xa0\xf0\x26\x37\x4c\x03\x6a\xf7\x4f\x69\x8f\xda\x82\xa7\x20\x95\x85\xa3\xe8\x42\x9d\x29\x69\x71\x63\xcb\x32\xb6\x1b\x8a\x36\xae\x9e\xa3\x17\x2c\xfe\x94\x69\x95\xcb\xc4\x0f\x8a\x02\x65\x42\x29\xaa\x8e\xbc\xc9\x8d\x5d\x6c\x7c\xe7\xa6\xe3\x62\xa9\xb8\x88\x5e\x60\xc6\xa5\xb3\x11\x06\xdb\xef\x16\x1b\x3f\xb6\x9b\x10\x24\x17\x8d\xc7\xc0\x9b\x24\x98\xa2\x06\x4a\x84\x1f\x40\x01\xbf\xc3\x29\xd8\x4d\x34\x57\x42\x2c\x59\xfc\xc9\x0f\xa0\xf4\x03\xaf\x8a\x81\xc1\x78\x9a\xaa\xaf\xcb\x10\x62\x18\xcf\x93\xe7\x4d\x0c\xa2\x6b\x06\xcd\x64\xa2\x56\xfc\x33\x46\x17\x78\x7b\x85\x98\xf8\x81\x37\xe1\xa9\xcb\x4f\xfb\xeb\x95\xd5\x79\x6c\x7d\x32\x0b\xe1\x98\x85\x2d\xe8\x97\xea\x56\xee\x7d\xbf\x7c\xb1\xd8\xae\xd1\x84\x90\x32\x61\x30\x04\x63\xf5\x8a\xc9\x4c\x60\x74\x85\xf6\x4c\xad\xd6\x02\x57\x28\xad\x7f\x97\x3d\x75\x16\xd3\xdb\xd7\xb8\xad\xda\xc2\xdc\x0d\x55\x1f\xf8\x95\xdb\x8f\x2a\xb7\x2f\x31\x65\xb9\xb0\x41\x14\x45\xc1\x0f\x8e\xff\xa3\x53\xca\x2d\x55\x7c\x62\xa3\x73\x66\x99\xf0\x51\xeb\xc0\x9b\x94\x07\x84\xb8\x0c\x5b\xc9\xfb\xe6\x10\x47\xed\xc7\x43\x1c\x3d\xfa\xa0\x21\xc6\xff\xf6\x10\x77\x31\x3e\x3f\x05\x16\xcd\xa4\x41\x6d\xfd\x3b\x6f\x33\x51\x40\x99\xd0\x70\x03\x7a\x72\x37\x71\x26\x53\xd4\x7e\x70\x9f\x74\x2e\x1f\x18\xc9\x9b\xa4\x4a\x03\x0f\x61\x53\x5f\xd0\x0c\xe1\xfd\x87\x93\xf1\xab\x5c\x50\xa3\x1e\xc7\xa5\xf3\x54\xf1\x63\x54\xa3\xc1\x14\x3c\x98\x2c\x27\x6e\xcf\x42\xd8\x04\xde\xa4\x09\xba\xc5\xb6\x47\xd7\xf1\xa5\x63\x2c\x9a\x47\x7d\x50\xb2\xdb\x34\x56\xaf\x68\xda\xfa\x53\xdd\x5e\x70\xc6\xf5\xa3\xa3\x65\xd0\x82\x55\x10\x2b\xad\x31\xb6\x70\xc3\x44\x8e\xd3\x1d\x40\x35\x5b\xdd\xd2\x78\x27\xf9\x75\xde\x2c\x44\x9e\xc2\x66\x0f\xfc\xb3\x8a\x99\xa8\x60\x8f\x59\x0f\x37\x65\x5c\x60\x42\x10\x6c\xbd\xa6\x78\xad\x82\xb4\x22\x0a\x23\x9c\x6a\x68\x42\xde\xeb\x87\x71\xb8\xf7\xcf\x3e\xfc\xd3\x88\x55\x39\xf6\x81\xf7\x56\xee\x8e\x0c\x23\x2a\xcd\x6e\xaf\xf3\x4d\xaf\xd2\xd6\xbb\x62\x24\x88\x47\xd7\x39\x6a\x8e\x26\x7a\x75\x9d\x33\xe1\xf7\xdc\x84\x03\x27\x41\xe3\xa5\x26\xd6\x09\xb3\x0e\x89\x04\xd5\x2d\x33\x70\xab\x95\xcc\xea\xfa\x85\x7d\x86\xdd\x82\x1a\xb4\x33\x19\x8b\x3c\xe9\x0b\x86\x5a\x95\xbd\x7d\xbd\x7b\xd7\x0a\x1a\x37\xdc\x58\x13\x36\x17\x7f\x7c\x07\xbe\x72\x87\x0e\xef\x7a\xc7\x73\x0c\xf6\x0b\x95\x87\xcb\xec\x0d\x5b\x83\xcf\x48\xd8\x9d\x29\x61\x1a\xe1\x15\xc0\x17\xf8\x43\x71\x09\x2e\xd4\x69\x59\x0e\xaf\xf7\xe0\xc2\x54\x82\x94\xaa\x50\x45\xd2\x6b\x9b\x5b\x26\x2d\x3c\x66\x8f\xa9\x5d\xdc\x81\xf1\x56\xfc\x8c\x5a\xb9\xf9\x80\xa9\xc0\xd8\x46\xbf\xa1\x56\x7e\xf3\x40\xc3\xfb\x32\xed\xd7\x35\x20\x47\xcd\x91\x99\x4c\x38\xdd\xb4\x9d\xcd\x2f\x54\xb1\xcb\xd4\x3f\x1e\x58\xd1\x3c\xf1\x09\x2f\xf0\xf6\x23\x81\xe6\xcc\x1c\x85\x62\xc9\xa1\x49\xfe\x8b\xd4\xb4\xee\x8a\x76\x3e\xa7\xae\xbc\xbd\xeb\xff\x9f\xb9\x05\x23\xae\x77\x25\x7e\x02\xb5\xb6\x2c\xbd\xea\xe7\xcb\x6e\xf0\x5d\xe4\x42\x50\xcf\xd1\x60\x38\x44\x28\xcf\x71\xa5\x6e\xf0\xbb\x56\x3e\x44\x2b\x7f\x17\xca\xff\x5f\xa1\xec\xb5\x47\xda\x43\x4b\xbb\x16\xd4\xdf\x52\x69\x56\xe7\x48\xc9\xbf\x27\x66\x35\x13\xbe\x1d\x76\x1c\x70\x20\x78\xb4\x83\xe9\xa8\x9c\x69\x4d\x26\x56\xb9\xb4\xe1\x5e\xb5\x0f\xb8\x04\xd1\x19\x1d\x39\x78\xab\x78\x23\x22\xb5\xa1\xd4\xee\x62\x07\xec\xb4\x6d\x97\xb5\xdb\xb7\x52\x75\xc8\x1a\x8a\x80\x71\xc9\x65\x36\xdd\x27\xf1\x2e\x89\x3b\x48\xc4\xbc\x11\xb6\x28\xad\xde\x82\xf9\xa8\x72\x91\xc0\x12\xe9\x64\xcb\xdf\x6e\xff\xcc\x8c\x5b\xbe\xfa\x82\x0f\xb6\x50\xd0\x4b\x71\x6b\xcd\xb8\xe5\x32\xee\xfc\x0e\xb9\xcc\x53\x58\x8e\xaa\xe5\xaf\x97\x92\x41\xaa\xd5\x0a\x96\x8f\x4d\x37\x51\x15\x62\x67\xb5\xf2\x14\x04\x4a\x7f\x88\x14\x8c\x64\xff\xfe\x40\xcd\x82\xa4\xed\x58\xb7\x41\x51\x3c\x3d\xa1\xb7\xb4\x2b\xdb\x19\x92\xf5\xc6\x84\x93\xa7\xcd\xdf\x02\x5b\x06\xd5\x2f\xab\xd1\x4f\x4e\xd2\xd9\xbd\xe9\x9f\x01\x00\x00\xff\xff\x40\x9e\xb0\x48\x65\x14\x00\x00")
-
-func templates_testRelationship_to_one_setopsGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testRelationship_to_one_setopsGoTpl,
-		"templates_test/relationship_to_one_setops.go.tpl",
-	)
-}
-
-func templates_testRelationship_to_one_setopsGoTpl() (*asset, error) {
-	bytes, err := templates_testRelationship_to_one_setopsGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/relationship_to_one_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc6, 0xda, 0xa9, 0x73, 0x15, 0xfa, 0xfa, 0xc1, 0x56, 0x62, 0xb3, 0x95, 0xb4, 0x37, 0x8b, 0xef, 0x49, 0x30, 0xe0, 0xa7, 0xf1, 0x42, 0x94, 0x95, 0x1c, 0x71, 0xdd, 0x6d, 0x78, 0x46, 0xee, 0x77}}
-	return a, nil
-}
-
-var _templates_testReloadGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x53\xb1\x6e\xdb\x30\x10\x9d\xc9\xaf\xb8\x0a\x6d\x41\x16\x0a\x3f\x20\x85\x87\x38\xee\x90\xa1\x46\x10\x2b\xe8\x58\xd0\xd2\xc9\x25\x42\x93\x01\x49\x35\x6a\x89\xfb\xf7\x82\x72\x1b\xab\x40\x8c\x66\x68\xb6\x0c\x86\x45\xe9\xde\x7b\x77\xef\x1e\x73\x3e\x83\xb7\xda\x1a\x1d\xe1\x7c\x01\xea\xa2\x3c\x61\x54\x8d\xde\x5a\x84\xc3\x9f\x5a\xeb\x3d\x12\xf1\x7e\x70\x2d\x24\x8c\x29\xe7\x03\x42\xdd\xde\x5f\xdb\x21\x68\x4b\x74\x83\xd6\xeb\x4e\x24\xf8\x50\x0a\x8c\xdb\xa9\x46\x42\xe6\x2c\xa9\x6b\x1d\xb4\xb5\x68\x85\xe4\x9c\x45\xc4\xae\xe8\x04\xed\x3a\xbf\x37\x3f\x51\xad\xf1\x61\x83\xd8\x09\xc9\xd9\x77\x1d\x00\xc3\xf4\xf3\x81\x33\x5f\x0a\xdf\xcf\xb4\x36\xc6\xed\x06\xab\x03\x51\x26\xce\x4c\x3f\x15\xcf\xb9\x36\x29\x0c\x6d\x12\x45\xa4\x06\x5f\xc3\x23\x76\xe5\x1f\xdc\x11\xbd\x5a\x36\x3f\xee\x31\xd6\x90\xc2\x80\x27\xab\x2e\xbd\x1d\xf6\x2e\x7e\x31\xe9\xdb\x0a\x7b\x3d\xd8\xa4\x94\x92\x1f\x27\xd1\x37\x0b\x70\xc6\x96\xf9\x58\x52\x9f\x4a\xbb\xbd\xa8\x6e\xdd\xe4\x59\xf2\xc7\x8e\xe0\xc9\xee\x21\x4e\x7d\x9e\xc3\xbb\x58\xd5\x85\x4f\x72\x46\x9c\xb3\x9c\x4d\x0f\xce\x27\x50\x6b\x7f\xe9\x5d\xc2\x31\x11\xb5\x69\x2c\x3e\xb4\x87\xb3\x5a\xea\xf6\x6e\x17\xfc\xe0\x3a\x21\x73\x46\xd7\x11\x71\x76\x28\xf9\x3c\xc4\xd4\x8c\x62\x62\x99\x33\x6c\xbd\xb1\x6a\x89\x3b\xe3\x26\x88\x8d\x38\x7f\xd7\x8c\xa2\x4d\x63\x5d\xe6\xf9\x43\x28\x39\xeb\xb0\xc7\x00\x65\xe1\x42\x42\x86\xaf\xb0\x80\x34\xaa\x1b\x6f\xed\x56\xb7\x77\x42\x02\x95\x85\x3d\xae\xc0\xab\x2b\x17\x31\x24\x71\x6a\x84\xe2\x32\xba\x0e\xce\x88\xa0\x9c\x26\xfd\x2b\xd7\x63\x10\xf2\xa4\xa7\xe2\x68\xcd\x4c\xe9\x77\xd2\x9e\xa7\xf4\x6f\x6e\xe2\xcf\xc8\xf5\x85\xb5\xaf\xd1\x7e\x8d\xf6\x8b\x44\x3b\x5a\xd3\x62\x19\xf2\x49\x43\x37\xe5\x6b\xce\x55\xae\x88\x7c\xce\x15\x55\xf4\xd7\x7d\x98\xd0\xea\x98\xd2\xff\x77\x2d\x7e\x05\x00\x00\xff\xff\x7b\x53\x0d\x40\x19\x06\x00\x00")
-
-func templates_testReloadGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testReloadGoTpl,
-		"templates_test/reload.go.tpl",
-	)
-}
-
-func templates_testReloadGoTpl() (*asset, error) {
-	bytes, err := templates_testReloadGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/reload.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x89, 0xb0, 0x6a, 0xf2, 0x66, 0x3b, 0x8e, 0x6b, 0xd3, 0xb3, 0x72, 0x5, 0x93, 0x9e, 0xfc, 0x20, 0xff, 0xd6, 0xfb, 0xda, 0xbc, 0x57, 0x27, 0x40, 0x5c, 0xd6, 0x3b, 0x23, 0x97, 0x6d, 0x7e, 0x65}}
-	return a, nil
-}
-
-var _templates_testSelectGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x8b\xdb\x3c\x10\x3e\x5b\xbf\x62\xde\xf0\xb6\x48\xc5\x2b\xe8\x35\x25\x87\xcd\xa6\x87\x3d\x34\x2c\x4d\x96\x1e\x8b\x62\x8f\x53\xb1\x13\x69\x91\xc6\x8d\x5b\xa1\xff\x5e\xa4\xd0\x4d\x0a\x9b\xd2\x83\xb1\x65\x9e\xaf\x79\x46\x29\xdd\xc0\xff\x86\xac\x89\x30\x5f\x80\xbe\x2d\x5f\x18\xf5\xd6\xec\x08\xe1\xf4\xd2\x6b\x73\xc0\x9c\xc5\x30\xba\x0e\x18\x23\xa7\x74\x62\xe8\xc7\xe7\x07\x1a\x83\xa1\x9c\x37\x48\xd8\xb1\x64\x78\x57\x00\xd6\xed\xf5\x56\x41\x12\x0d\xeb\x07\x13\x0c\x11\x92\x54\x42\x34\x11\xb1\x2f\x3e\xc1\xb8\xde\x1f\xec\x4f\xd4\x6b\x3c\x6e\x10\x7b\xa9\x44\xf3\xdd\x04\xc0\x50\x1f\x1f\x44\xe3\x0b\xf0\xed\x85\xd7\xc6\xba\xfd\x48\x26\xe4\x9c\xb2\x68\xec\x50\xc1\x97\x5a\x1b\x0e\x63\xc7\xb2\x98\xb4\xe0\x5b\x78\xe1\xae\xfc\xd1\x9d\xd9\xab\xe5\xf6\xc7\x33\xc6\x16\x38\x8c\x78\x15\x75\xe7\x69\x3c\xb8\xf8\xc5\xf2\xb7\x15\x0e\x66\x24\xd6\x5a\xab\x0f\xd5\xf4\xbf\x05\x38\x4b\x65\xbe\x86\xf5\xc7\x12\x77\x90\xb3\x47\x57\x3b\x63\x7f\x4e\x04\xaf\xa6\x87\x58\x73\xce\xe1\x4d\x9c\xb5\x45\x4f\x89\x26\x0b\xd1\xa4\x64\x07\x70\x9e\x41\xaf\xfd\x9d\x77\x8c\x13\xe7\xdc\xf1\x54\x7a\xe8\x4e\x67\xbd\x34\xdd\xd3\x3e\xf8\xd1\xf5\x52\xa5\x84\xae\xcf\x59\x34\x27\xc8\xa7\x31\xf2\x76\x92\x55\xe5\x52\x61\xe7\x2d\xe9\x25\xee\xad\xab\x14\x8a\x78\xf9\x6f\x3b\xc9\x8e\xa7\xb6\xcc\xf3\x5b\x50\x89\xa6\xc7\x01\x03\x94\x85\x4b\x05\x09\xbe\xc2\x02\x78\xd2\x9f\x3d\xd1\xce\x74\x4f\x52\x41\x2e\x0b\x7b\x59\x81\xd7\xf7\x2e\x62\x60\x79\x6d\x84\xd2\x32\xba\x1e\x6e\x72\x86\x72\xaa\xfe\xf7\x6e\xc0\x20\xd5\xd5\x4e\xe5\xb9\x9a\x48\xb6\xc3\xda\x55\x99\xf4\x95\xfb\x27\x95\xbe\x25\xfa\x47\xff\x73\xf4\xbf\x9a\xda\x01\x08\x9d\xac\xde\xaa\x40\xdf\xff\x01\x9c\x1d\x8d\x63\xf0\x0e\x21\x60\xe7\x43\xdf\xc2\xde\xf3\x7c\xd6\x5e\x90\xaa\x50\x16\xbf\x02\x00\x00\xff\xff\x77\x31\xb2\xd3\x64\x03\x00\x00")
-
-func templates_testSelectGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testSelectGoTpl,
-		"templates_test/select.go.tpl",
-	)
-}
-
-func templates_testSelectGoTpl() (*asset, error) {
-	bytes, err := templates_testSelectGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/select.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x44, 0x5a, 0xad, 0x68, 0xde, 0x6a, 0x9a, 0x52, 0x9e, 0xda, 0x62, 0x3e, 0x13, 0x9e, 0x81, 0x0, 0xe3, 0xa7, 0xec, 0x8b, 0x5d, 0x64, 0xe5, 0x80, 0x88, 0x33, 0x6e, 0x81, 0xe8, 0x9d, 0x27, 0xc7}}
-	return a, nil
-}
-
-var _templates_testTypesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\x41\x4b\x03\x31\x10\x85\xcf\xcd\xaf\x78\x2c\x7b\x50\xe8\x06\xcf\x85\x3d\xa8\xbd\xea\x41\x7b\x13\xb1\x53\x3b\x2e\x81\xec\x6c\x49\x5a\xa5\x0c\xf3\xdf\x25\x89\x78\x7a\x21\xf3\xcd\x7c\x4f\x75\x40\x4f\x31\x50\xc6\x66\x84\xbf\x2f\x2f\xce\x7e\x47\x87\xc8\x68\xe1\x9f\x69\x66\x33\xf7\x4d\x09\x37\x6e\xa5\xda\x78\xbf\x5d\x7e\xe4\x35\xc8\x74\x89\x94\xcc\xb6\x0f\xbb\xeb\x89\x33\x46\xcc\x74\x7a\xcb\xe7\x14\x64\x7a\x6f\xa1\xda\x69\x67\xa6\x9a\x48\x26\x46\x1f\xd6\xe8\x3f\x97\x58\x85\xcd\xf0\xb8\xc4\xcb\x2c\x19\x43\xa1\x06\x84\x2f\x48\xe1\x70\x67\xb6\x56\x65\x39\x9a\xed\xff\xbd\x0d\xae\x27\xfe\xaa\xed\x37\x28\xe3\xf2\xd1\x6a\x54\xbc\xae\xa9\x76\xd6\x99\xb9\xd5\x07\x46\x1c\xae\x67\xce\xfe\x29\xc8\x0b\xd3\xd1\xdd\xba\xdf\x00\x00\x00\xff\xff\x45\xa6\x71\x4c\xfd\x00\x00\x00")
-
-func templates_testTypesGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testTypesGoTpl,
-		"templates_test/types.go.tpl",
-	)
-}
-
-func templates_testTypesGoTpl() (*asset, error) {
-	bytes, err := templates_testTypesGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/types.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3f, 0x21, 0xc3, 0x34, 0xf, 0xc, 0x2a, 0x8b, 0x6d, 0xc6, 0x42, 0xa5, 0xfe, 0xe0, 0xfa, 0x80, 0x85, 0x65, 0x9a, 0xe6, 0x8c, 0x62, 0x28, 0xfc, 0x9b, 0xa, 0x64, 0x7a, 0x6d, 0x8f, 0x6d, 0x86}}
-	return a, nil
-}
-
-var _templates_testUpdateGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xe3\x36\x10\x3d\x4b\xbf\x62\x2a\xb4\x05\xd5\x75\xb8\xdb\x6b\x52\x1f\xf2\xb1\x2d\x82\x22\x69\x90\x38\xed\x61\xb1\x58\x30\xd2\x48\x21\x42\x91\x2a\x35\x8a\xed\x0a\xfc\xef\x05\x29\xd9\x72\xba\xb1\xd7\x40\x37\x7b\xca\x21\x1f\xb6\xe6\xe3\xcd\xd3\xe3\x1b\x76\xdd\x01\x7c\x2f\x94\x14\x0d\x1c\x4e\x81\x1f\xfb\xff\xb0\xe1\x33\x71\xa7\x10\xfa\x3f\xfc\x52\x54\xe8\x5c\x5c\xb4\x3a\x03\xc2\x86\xba\xae\xcf\xe0\xb7\xf5\x95\x6a\xad\x50\xce\xdd\xd6\xb9\x20\x64\x04\x3f\xf9\x00\xa9\x4b\x3e\x4b\xa1\x8b\x23\xe2\x57\xc2\x0a\xa5\x50\xb1\x34\x8e\x23\x59\xc0\x3b\x98\x4e\x41\xa1\x66\xeb\x2a\x67\x66\xae\x6f\xa4\x2e\x5b\x25\xac\x73\x57\x56\x56\xc2\x2e\x7f\xc7\xe5\xa9\x51\x6d\xa5\x9b\x50\x27\x22\x7e\xf3\x20\x6b\x96\xf8\xdf\xb5\xd4\x25\x50\x40\x38\x97\x74\x0f\xda\x40\xdd\x67\xc1\x03\x2e\x21\xeb\xf3\x92\x34\x8e\x5c\x68\xb9\xa3\xdb\xb1\x52\xeb\x36\x5f\x1d\x97\xd1\x6a\xb9\x1d\x59\x1c\x35\x88\xb9\x67\xdd\x0a\x9d\x9b\x4a\xfe\x83\xfc\x12\xe7\x37\x88\x39\x4b\xe3\xe8\x51\x58\x40\x1b\x7e\x8c\x8d\x23\xe3\x03\x7f\xdc\x60\x7e\x44\xd6\xf5\x53\xfa\xe0\xcd\x5a\x37\x64\xdb\x8c\x98\x6f\x32\x01\x33\x81\x2d\x73\x9d\x9d\xcc\x96\x35\x36\x13\x20\xdb\xe2\xd6\xa8\x61\xe6\xbf\x24\xdd\x9f\x61\x21\x5a\x45\x9c\xf3\xf4\x28\x34\xfd\x6e\x0a\x5a\xaa\x81\x8d\xf7\x1e\x6e\xc1\x92\x5b\x1d\x78\x20\x33\x22\x82\x67\xd1\x43\x13\x70\x1e\xc2\x0f\x4d\x32\xf1\xf5\x06\x72\xba\x4e\x16\xa0\x0d\x01\xbf\x34\xa7\x46\x13\x2e\xc8\xb9\x8c\x16\x9e\x87\xac\xff\xcc\x4f\x44\xf6\x50\x5a\xd3\xea\x9c\xa5\x5d\x87\x3a\x77\x2e\x8e\xfa\x90\x8b\xb6\xa1\xd9\x82\x85\x2a\x9b\x15\xee\x8c\x54\xfc\x04\x4b\xa9\x43\x8a\x6a\x70\xf3\xbb\xd9\x82\x65\xb4\x98\xf8\x79\x56\x05\xd3\x38\xca\xb1\x40\x0b\x5e\xfe\x2c\x85\x0e\x3e\xc1\x14\x68\xc1\xaf\x8d\x52\x77\x22\x7b\x60\x29\x38\xff\xc2\xd6\xaf\xc0\xf0\x73\xdd\xa0\x25\xb6\x6d\x04\xcf\x32\xea\x1c\x0e\x9c\x03\xff\x29\xf4\x3f\xd7\x05\x5a\x96\x6e\xe5\x94\x8d\xd4\x64\xa6\xd5\x14\xb8\xf2\x93\x3e\x73\x1a\x59\xca\x4f\x7d\xcc\x9e\x08\x46\xf0\x3b\xdb\xca\x02\x42\x67\x1f\xf5\xf3\x93\x98\x64\x2e\x34\x81\xd1\x08\x16\x33\x63\xf3\x09\x94\x86\x0e\x93\x49\x1f\x3f\xa6\xbf\xa8\x44\x3f\x3b\xa0\xdf\x44\xa1\xfc\xd2\x5c\x9b\x79\x73\x5c\x14\x98\x11\x06\x46\x9f\x48\x61\x30\xc6\x97\x91\x42\xd4\x2b\x78\xdd\xd4\xf6\x48\xd6\xd2\x78\xd9\xf6\x10\x7a\x8f\x6d\x9f\xd1\x45\x73\x6f\x5a\x95\xf7\x46\x28\x02\x45\xbd\x4a\xcc\x1c\xee\x5a\x1a\xbe\xc2\x3c\x99\xac\x6a\xac\xc7\xea\x41\xc5\x2e\xde\xb9\x76\x6e\x94\xcc\xb0\x9f\xf1\x58\xa9\x7d\xd6\xcf\xeb\x2e\x78\xdd\x05\xaf\xbb\xe0\x75\x17\x7c\x65\x85\xbe\x7d\x0b\xd7\x58\x99\x47\x84\xab\xf1\x8c\x37\x20\x74\x0e\xad\x96\x7f\xb7\xb8\x3a\xef\x50\x58\x53\xc1\xfc\x5e\x10\xcc\x11\x6a\x25\xb4\xef\xda\x06\x0b\xeb\x0f\x7a\x21\x51\xe5\x0d\x7c\xf8\xd8\x90\x95\xba\x0c\x64\x35\x64\x2b\xa1\x4b\x15\x68\x92\xba\x0c\xbe\x77\x21\x28\xbb\xff\xb2\x99\xed\x4f\x52\xef\x62\x43\xff\xe9\xb6\xb4\xb1\xf2\x7a\x07\x3c\x49\xdb\xc0\x8a\x74\x6a\xaa\x5a\x61\x85\x9a\x58\x1c\x45\xd1\x97\xc1\xee\x88\xfa\x0c\xaf\x0f\x4e\x63\x1f\x7f\xe0\xd7\x10\x3f\x93\x42\x61\x46\xfc\xb6\xc1\xe3\x96\xcc\x10\x05\xde\x08\xf6\x83\xd7\x07\xed\xc2\xb0\xe1\x7d\xbe\xc5\x26\x82\xc1\x72\xbc\x1a\x1e\x85\x6a\x31\xb8\x39\x16\x01\xd1\xb9\xce\xa5\xc5\x8c\xd8\xea\x8b\x3f\x7d\xc4\x1f\x05\x33\x69\x1a\x47\xb4\xac\x37\x83\xbd\xc0\xc3\x23\xfe\x5e\x61\xe5\xed\x44\xfb\xc7\xb4\xac\xf9\x65\x5b\xfd\xea\x31\x86\x6d\xd6\x8b\xe6\x42\x84\xe4\x0b\xef\xfc\x85\xb1\xf0\xc9\x9f\x34\x35\xac\x92\x12\x57\x72\x0a\xaf\xc8\x58\x90\xfe\xc9\xbb\x23\x90\xf0\x0b\xe8\x23\x90\x6f\xde\x84\x47\x51\xb1\x6a\xd1\xd7\x97\x7e\x2a\xaf\xbc\x82\xcf\x44\xc9\x7f\x43\x62\x89\xb7\xa6\x24\xac\x46\xdf\x20\x64\x8d\x18\x3e\x64\x46\x7d\x84\x29\x84\xd1\xd7\x45\xf8\xb9\x26\xb4\x85\xc8\x90\x85\x82\xfe\x4d\x0c\x1c\x35\x5e\xc2\xff\xf1\xac\x91\xe7\x20\xf0\xae\x4b\xba\xc4\x39\xd3\x75\x89\x4b\x9c\xdb\xeb\xc6\x15\xca\xf2\xf1\x4a\xb0\xaf\xef\xae\x07\xf9\xff\xb7\xae\x97\x87\xb0\xc7\xcd\xcb\xbb\x30\xe6\x1b\x3e\x3c\x94\xcf\xc3\xc5\xab\x34\xb4\xeb\xce\xf5\x6f\x00\x00\x00\xff\xff\x40\xe5\xdf\x78\x15\x10\x00\x00")
-
-func templates_testUpdateGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testUpdateGoTpl,
-		"templates_test/update.go.tpl",
-	)
-}
-
-func templates_testUpdateGoTpl() (*asset, error) {
-	bytes, err := templates_testUpdateGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/update.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7, 0xf5, 0x2a, 0x19, 0x29, 0xc0, 0xb4, 0x32, 0x3, 0x4f, 0x9c, 0xe3, 0x96, 0xcd, 0x64, 0xf7, 0x90, 0xf8, 0x2, 0xbf, 0x90, 0xe4, 0x1f, 0x1f, 0x28, 0x9f, 0xff, 0xba, 0x25, 0xc, 0x25, 0x73}}
-	return a, nil
-}
-
-var _templates_testSingletonBoil_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xdf\x6f\xe3\x36\x0c\x7e\xb6\xfe\x0a\xce\x0f\x07\xf9\x96\x29\xdb\xb0\xbd\x74\xc8\x80\xb5\xe9\xf5\xba\x5d\x7f\xac\xbd\x1b\x06\x0c\x43\xa1\x5a\x74\x22\x4c\xa1\x5c\x49\x8e\x5b\x14\xf9\xdf\x07\xc9\x8e\xe3\xe4\xb2\x01\xf7\xe0\x07\x93\x22\xf9\x7d\xe4\x27\x6a\x2d\x1d\x54\x46\x2e\xe6\xf8\xd8\x2c\xae\xac\x42\x98\xa5\x7f\x71\x6a\xad\xe1\x79\x40\x1f\x84\x7f\x32\x2a\xba\xf3\x09\x54\xd2\x78\x9c\x40\xfe\xb1\x71\xe4\xc1\x12\x24\x07\xac\x62\x60\x65\x1d\xdc\xff\xfe\x01\x7c\x90\x01\x57\x48\xc1\xe7\x05\xdb\xe6\x3f\xb3\x54\xe9\xc5\x3b\x6d\x86\x02\xf7\xc1\x69\x5a\xf4\x25\xca\xe4\xce\x27\x90\xc7\xef\x66\x8d\xce\x69\x85\x1e\xc2\x12\x41\x61\x25\x1b\x13\xa0\x3f\x53\x30\x56\x5a\xf2\x01\x6c\x13\xea\x26\xcc\xb5\x9b\x63\x1d\x96\x30\x83\xd7\x57\x71\xb3\x67\xdb\x6c\x58\x02\xc0\x59\xa6\x1e\xaf\xa4\x26\x88\xc5\xd0\xb1\x82\xb1\xf0\x52\x63\xff\x0b\x9a\x02\xba\x4a\x96\x08\xaf\x2c\xf3\x18\x9a\x9a\x17\x80\xce\x59\xc7\xb2\xd2\x12\xf1\x02\xf8\x5b\xff\x64\xc4\xfc\x74\xd2\xd9\x0b\x96\x05\x94\x4e\xd9\x96\x86\xa3\x1b\xc6\xaa\x86\x4a\xf8\x88\x3e\xc4\x62\x7c\x05\x6f\x63\x01\x4d\x0b\x71\x55\xc4\xd4\xba\x82\x1e\xc7\x6c\x06\xa4\x4d\xb4\x65\xd5\x2a\x88\x5b\xa7\x29\x18\xe2\x39\x59\xd8\x43\x3a\x82\xd6\x4a\x0f\x0e\xa5\x7a\xc9\x0b\x96\x65\xd6\x8b\xf3\x67\x1d\xf8\x37\xdf\x15\x2c\xdb\x30\x96\x39\x49\x4a\xdc\x23\x2a\x1e\xf4\x0a\xc5\xb5\x6d\x79\x21\x3e\x91\x7e\xbe\x96\x64\x79\x51\x30\x96\xa5\xb6\xdf\x4a\xe7\x91\xc7\xdf\xd8\x19\x74\xae\x47\xcf\xb2\xe9\x14\x3e\x58\xa9\xfa\x3e\x37\x4e\x06\x6d\x89\x65\xf1\xc8\x0c\x34\xe9\xf0\x87\xae\xd1\xf1\x22\xf1\x88\xd6\xaf\xfe\x83\x44\x43\xf2\xd1\x20\x04\x0b\x66\x97\x0f\x2a\x6d\x70\x1f\xfa\xf7\x3d\xf4\xe9\x14\xee\x31\xc0\x4e\x82\xde\x42\x8b\x50\x4a\x02\x8f\x08\x0b\x24\x74\x32\xa0\x02\xff\x64\x46\xea\x62\xd9\xa3\xd5\x46\x8c\x95\xfb\x76\x4f\xca\x6c\x40\x3a\xeb\xbb\x2a\xfa\xe1\xfe\xf4\xbf\xf8\x3f\x0d\xf8\xf1\x19\xcb\x26\x20\xa4\xb0\x93\x3c\x0d\x7f\x8f\xc2\x0f\x1d\x05\x88\x24\x29\xb9\xe1\x64\x28\xd6\x49\x87\x01\x1c\xf6\x0b\x00\x60\xaf\x62\x25\xb5\x41\x15\x2b\x2e\x30\x09\x9d\xb0\x8c\xdd\x1f\x4a\x02\x6c\xfa\x89\x95\x91\xa9\xa6\xd0\xb3\xbf\xc7\x30\x3f\xe5\x31\xa2\x88\x5a\x4d\x5d\x58\x89\xbb\x86\xd2\x88\x0f\xe9\xef\x34\xfb\xa5\x1d\xd8\x46\x1e\x6b\xc2\x8f\xfd\x1c\xb7\x86\x08\xa3\x18\xae\xc3\x48\x39\x9d\xd4\x62\x03\x22\xb2\x83\xb5\xd0\x63\x79\xf3\xa6\x9b\xe2\xbe\x27\xcf\x13\xc8\x75\xcc\x13\x39\xef\xbc\xfc\xe0\x74\x44\xd6\xd3\x3e\x99\x41\x17\x70\x87\x52\x5d\x52\x77\xe6\x08\xf5\xcc\x61\x68\x1c\x45\x33\xcb\xb2\x0d\x1b\x0c\xa4\x4d\x37\xde\xcf\xee\xca\x01\x90\x6b\xb9\x42\x9e\xfb\x27\x13\x67\x82\x2e\xee\xa8\xac\x93\xfd\x7b\xbb\xc2\x08\xc4\x7a\x71\x81\x01\x69\xcd\xf3\x3f\xe7\x17\x0f\x67\x37\xd7\xef\x2e\x2f\x1e\xde\xdf\x5c\x9d\xc7\x3b\xb1\xb4\x2b\xbc\x95\x61\x79\x70\x72\xeb\x6e\xd5\x20\xad\xce\xdb\xaa\xe3\xd7\xb0\x55\x30\x03\x9f\x16\xab\x17\x77\x58\xa3\x0c\x3c\x17\x62\x9a\x4f\x0e\xb6\x65\x9c\x18\xa0\xf1\xb8\x0b\x6b\x15\x7c\xfd\x59\xec\x54\x88\xa3\xb1\x03\xbf\x88\xda\x47\x60\x7f\xfd\xdd\xc5\xbe\xb6\x6a\x93\xa0\x19\x24\xbe\xeb\x41\x01\x3f\xc3\xb7\xa9\xd8\x38\x6e\x06\xb2\xae\x91\x14\x1f\x19\x27\x69\x53\xd4\x32\x2c\xc5\xaf\x56\x8f\x73\x4c\x60\xdc\xe2\x7d\x0a\x5f\x9a\x75\xdb\xf1\x09\xe4\xfd\xe3\x33\x3d\xc8\x1d\x17\xa6\x75\xf0\x30\x81\x3a\xf2\x73\x92\x16\x08\xe3\x32\x3b\x41\xfe\xa2\xd4\xd9\xe0\xe0\xf5\x6e\xad\x5d\x2e\xc8\x3a\xec\x54\xe3\x61\x89\x0e\xd3\x13\x6a\xe0\x51\x96\xff\xc4\xeb\xd5\xbf\x6d\x1e\x24\x29\x58\x4b\xa3\x55\xda\xba\xd1\x55\x3b\xbb\xd6\x2a\x05\x7b\x96\x3d\xc0\x71\x31\x8f\xa4\x78\x4e\xeb\xdf\xf0\xe5\x0e\x6b\x23\x4b\x74\x7c\x3b\xca\x6b\x6c\x07\x5b\x1e\xa7\x99\x3f\x24\x82\x3d\xf4\x26\xd8\x95\x0c\xba\x3c\xa7\x75\x5a\x19\x23\xe9\x6f\xd8\xbf\x01\x00\x00\xff\xff\xde\xdd\x84\xb7\x1e\x08\x00\x00")
-
-func templates_testSingletonBoil_main_testGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testSingletonBoil_main_testGoTpl,
-		"templates_test/singleton/boil_main_test.go.tpl",
-	)
-}
-
-func templates_testSingletonBoil_main_testGoTpl() (*asset, error) {
-	bytes, err := templates_testSingletonBoil_main_testGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/singleton/boil_main_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7f, 0x5d, 0x75, 0x55, 0x23, 0x41, 0x66, 0xaf, 0x2a, 0xfe, 0x42, 0x22, 0xc7, 0x5f, 0xc9, 0x6a, 0x1b, 0xc4, 0x2f, 0xd9, 0x78, 0xc4, 0xfe, 0x4b, 0x11, 0xdc, 0xd, 0xd2, 0xf4, 0x95, 0x46, 0xbe}}
-	return a, nil
-}
-
-var _templates_testSingletonBoil_queries_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x52\xcd\x8e\xd3\x30\x10\x3e\xc7\x4f\x31\xac\x04\xb5\xab\xac\x05\xd7\x4a\x3d\xc0\x22\x2e\x88\x1e\xca\xde\x58\x0e\x4e\x32\xae\x2c\x79\xed\x68\xe2\xb0\xa9\x22\xbf\x3b\xb2\x9d\xfe\x01\x82\x1b\x87\xca\xf6\x74\xe6\xfb\x99\x2f\x3f\x14\x41\xd7\xec\xd4\x33\xee\x95\xeb\x60\x4d\xca\x75\x32\x5d\x19\x9b\x67\xa3\x41\xee\xfc\x83\x77\x01\xa7\x00\xf7\x31\x32\x3d\xba\x16\xbe\x8c\x43\x78\x9c\x78\x20\xe5\x06\xd5\x06\x4f\xd0\x78\x63\xe5\xe3\xf9\x5d\x03\x12\xa5\x9f\x27\xf1\xeb\x7f\x30\xb3\xca\xe8\xdc\xf0\x6a\x0b\xce\xd8\x54\xa8\x7a\xe5\x4c\xcb\xf5\x73\x90\x5f\x7b\x32\x2e\x68\x7e\xf7\xa0\x9c\xf3\x01\x5a\x42\x15\x10\x14\x5c\xe8\x36\xf0\x7a\xb8\xcb\x1c\x42\xb0\x2a\xb2\x8a\x30\x8c\xe4\xae\x3a\x58\x64\xf3\x7c\x0f\x68\x07\xfc\xa7\xec\xc5\xde\xdf\xd4\xff\xd6\xf2\x5f\x4d\xb8\x2e\x46\x56\x2c\x38\x7c\xf9\xf4\x19\x8f\x1f\x71\x08\xe4\x8f\x48\x9c\xf0\x80\x13\xac\xf3\xd1\xcb\x7d\x3e\x6a\x20\x54\x1d\x12\x18\x2f\xf7\xf9\x26\x2e\xd7\x24\x74\x61\x7a\xa3\xaf\xa1\x92\x81\x32\xb7\x59\xe6\xeb\x54\x39\x4c\x1b\x00\x80\x4c\x50\x27\x9d\x91\xb1\x70\xec\x11\x6e\x86\x61\x08\x34\xb6\xa1\x80\xdf\x72\xb3\xaa\x19\x75\x82\x58\x37\xc7\x80\x83\xfc\x30\x6a\x9d\xaa\x74\x98\x72\xf5\x46\x3a\x3b\x19\xe5\x1a\xd6\x37\x0c\x02\x12\x1c\x6f\xe0\xdb\xf7\x84\x23\x80\x1b\x17\xea\x53\x4a\x25\x0e\x2d\x13\xd5\xf6\x12\x88\xb2\xb6\x84\xb9\xd9\x82\xf1\x63\x30\x36\x8b\x7a\x6f\x2d\xd7\xb2\x08\x15\xac\xfa\x43\x92\xa7\x15\xbd\xcd\xe3\xac\xaa\x22\x2b\x70\xb0\x85\x62\x63\x8f\xbd\x55\x2d\xf2\x4c\x51\x34\xcd\xab\x27\x5a\xd5\xb0\x7a\x72\xab\x78\x55\xcb\xaf\xfb\x77\xe2\x0c\xa0\x25\x1d\xa6\x13\x40\xd2\x72\x8d\x11\x53\xdf\x62\x64\xa1\xda\xe1\x4b\x59\x5a\x6a\xcc\xdf\xca\x39\xc2\xdc\x28\xcb\x66\x44\x5a\xde\xcf\x00\x00\x00\xff\xff\x1d\xae\x6b\x43\xcf\x03\x00\x00")
-
-func templates_testSingletonBoil_queries_testGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testSingletonBoil_queries_testGoTpl,
-		"templates_test/singleton/boil_queries_test.go.tpl",
-	)
-}
-
-func templates_testSingletonBoil_queries_testGoTpl() (*asset, error) {
-	bytes, err := templates_testSingletonBoil_queries_testGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/singleton/boil_queries_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc3, 0x6f, 0xe3, 0x63, 0x7c, 0x8f, 0x6b, 0x38, 0x2b, 0x53, 0x75, 0xd0, 0x1e, 0x40, 0xc9, 0x9b, 0xa3, 0xd5, 0x30, 0xa3, 0xbe, 0x36, 0xd9, 0xdc, 0xfb, 0xae, 0x3f, 0x42, 0x27, 0xaf, 0xad, 0xd3}}
-	return a, nil
-}
-
-var _templates_testSingletonBoil_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9a\xdf\x53\xe3\x36\x10\xc7\x9f\xc3\x5f\xb1\x73\xc3\x03\x61\x38\x67\xda\x7b\xbb\x99\x3e\xe4\xe8\x31\xa5\x3f\x08\x25\x61\xfa\x2c\xec\x75\xa2\x22\xa4\x8c\x24\x5f\x2f\xe3\xc9\xff\xde\x91\xe4\x9f\xb1\x63\x6c\x70\x0a\xa6\xf0\x42\x1c\x49\xab\xfd\xae\x3e\x5a\xaf\x1c\x4f\x26\xb0\x58\x51\x05\x1a\x95\x06\x15\x51\x8d\x20\x23\xae\x00\x89\xbf\x02\xb1\x46\x49\x34\x15\xdc\x35\x53\x0e\x6b\x22\x09\x63\xc8\xbc\xa3\xc9\x04\xbe\x7e\x27\x0f\x6b\x86\x67\x40\x43\xd8\x88\x48\x42\x40\x34\xb9\x23\x0a\x61\x45\x14\x7c\x02\x4d\xee\x18\xaa\x33\xd0\x2b\x4c\x4c\xff\x43\x19\x33\xf6\x3f\x9b\xe1\xb6\xf9\x87\x33\xf7\xff\x47\x20\x3c\x70\x1f\x3f\xc1\xcf\xc8\x50\x63\x71\xbe\xe6\xfe\x97\x5c\xa1\x2c\xf9\x77\x66\x9b\x95\x80\x50\x48\xbd\xb2\xde\x5e\x6a\x08\x04\x2a\xb8\x9a\x2d\x8c\x0b\xbb\x0a\x97\x52\x44\xeb\x8a\xc4\x39\x9a\x4b\x4d\xf9\xd2\xaa\x30\x61\x50\xa0\x57\x91\x62\x1b\x58\x4a\xc2\xb5\x02\xf2\x4d\xd0\x80\x70\x1f\x41\x84\x70\x2d\x94\x5e\x4a\x54\x10\x20\x09\x98\xf0\xef\x95\x77\x14\x46\xdc\x87\x05\x2a\x7d\x4d\x24\x72\x7d\xa2\xe1\xd4\xd8\xa1\x7c\xe9\x2d\xc6\x10\x1f\x01\xc4\xf1\x47\x90\x84\x2f\x11\xbc\x85\x8d\xd9\x76\x9b\x7c\x4b\x43\xf0\x2e\xd5\xaf\x82\x72\xdb\x00\x1f\xb3\x16\x64\xaa\x78\x79\x4c\x18\x25\x0a\x3e\xff\x04\xc7\xde\xd4\x7c\x44\xe5\x6c\x81\x77\x45\x1e\xd2\x9e\xda\xbb\x89\xf8\xc9\x87\x38\x76\xdd\xbd\xdb\xf5\x35\x8b\x24\x61\xdb\xed\x87\x33\x2b\xae\xa6\x65\x6c\x67\x40\x1e\x14\x27\x4f\xae\xb6\x47\x47\x71\x6c\x7c\x9c\x06\xc1\x5c\x84\xda\x2d\x9c\xb2\x6d\x99\xec\xbc\xa1\x7f\xe9\xa3\xb4\xe7\x39\xe1\xf9\x3c\x49\xa3\xf9\x6b\x1f\x1b\xf3\xf7\x94\xf8\xe4\xd3\x8e\x9d\x43\xc5\x50\xed\x0d\x5b\x16\x9d\x3f\x23\x94\x9b\xdc\xc6\x94\xb1\x37\x19\xa5\xaa\xcc\x27\x45\x6b\xce\xa8\x8f\x6f\x3f\x5a\x55\x99\x1d\xa2\x95\x5c\x6d\x8b\x71\x3b\xd4\xfe\x3b\x6c\xea\xc9\xb7\x55\xeb\x9d\x74\x40\x2e\x0e\xab\xb5\xec\x7d\x5b\xcd\x16\x94\xc1\x6a\x2e\x7b\xdf\x56\xf3\xd7\xef\x54\x69\x35\x34\xad\xce\xeb\xb6\x1a\x2f\x28\x0f\x86\xa6\xd0\xf8\xdc\x56\xdf\x97\x01\xea\xfb\xd2\x41\xdf\x8c\x0f\x2e\xd9\xce\x78\xeb\x4c\x3b\xc0\x54\xd3\x21\xbf\x9c\x8b\x68\x78\x55\xba\x75\xba\x4d\xa9\xce\x85\x06\xef\x4a\xfc\x22\xc4\xfd\x4e\x9d\x6e\xbf\x1a\x9a\x6e\xeb\x74\xb3\xee\xba\x7a\xc8\x9d\x18\x87\x26\xd6\x79\x3d\x7e\xd6\xe8\xbf\x56\x54\x23\xa3\xea\x31\x58\x26\x13\x1b\xa8\x85\x98\xf1\xf4\xdc\xeb\x13\x6e\xe8\xb9\xb3\x8f\x08\x76\x4f\xe7\x42\xe6\x67\x5e\xd3\x13\x84\xef\x47\xb2\x70\xfa\xb5\x96\x2a\x11\x7f\x66\xbc\x8b\x0b\x76\x1c\xde\xe3\xc6\x84\xdd\xbb\xf8\x0d\x37\x6a\xb7\x60\x67\xf6\x61\x41\xdd\xb2\xd8\x81\x5e\x71\x96\x6c\x50\xf8\xc8\xa0\x0b\x21\x91\x2e\x79\xed\x58\x89\x6c\x9a\x91\xe0\x66\xf7\x6e\x90\xd9\xc7\x0d\x6a\x45\xd7\x89\x89\x5a\x26\x92\xee\xb7\xeb\x39\xe5\xcb\x88\x11\xb9\xdd\x2e\x44\x1c\x27\xfe\x94\xbe\xbf\x55\x94\x2f\xe3\x38\x9b\x2e\xf5\xa9\x88\x42\xad\xb9\x19\xc7\xae\x16\xc7\x49\xc8\x13\x4e\x8c\xcc\xc9\x29\xd8\xb8\xbb\x35\x38\x9d\x54\x69\x4a\x7a\xd1\x10\xfe\x16\x94\xbb\x67\x36\x69\xc7\x6a\x37\xf7\xd0\xa8\x6c\x2e\xc7\x71\xc6\xb1\x3f\x22\x53\x63\x2d\xd3\xc0\x68\x1f\x95\xa3\x12\x94\xa3\x12\x93\x12\x99\x45\xd2\x4e\x54\x5c\xfd\x2e\x7c\x4a\x64\x9d\xf1\x34\x63\x5a\xd3\x19\xd6\xd1\x69\x2c\x64\x70\x8e\xfa\x61\xf3\x77\xe1\x97\x93\x54\x8d\xb1\x74\x59\xba\x99\x1c\xbb\xc0\x97\x61\x2a\x51\x34\xaa\xc2\x26\x22\x8d\xb2\x9e\xcc\x3a\x84\x5d\xf7\x66\x42\x17\xe2\x0f\xc2\x37\x3d\x65\x4c\x63\xaa\xf5\x4d\xaa\x29\x6d\xd6\x24\xce\x3d\x98\x9a\x29\xf7\x71\xfa\x34\x52\xeb\x80\xcb\xc6\xed\x4e\x57\x03\x6e\x0e\xa2\xfd\x94\x4b\xcb\x2e\x2d\x02\x26\xe9\x77\xca\xa5\x9d\xa0\x74\x81\xa9\xe5\x2e\xd5\xd8\x80\x5e\xb6\x00\x87\xa7\x6f\xc6\x71\x8e\xba\xbf\x3b\xf6\x1c\xab\x65\xd2\xfb\x4d\xbb\xf7\x9b\x76\x3b\x06\xdd\x7a\xcc\xd6\x83\xbb\x6f\x27\xb7\xbf\x07\xf1\xad\xc7\x62\xd2\xd9\x7b\x39\x3a\x69\x98\xd2\x10\x31\x56\x9f\xf4\xba\xf2\xfb\x3c\x82\x87\xc2\xb0\x5b\xb8\xa7\x62\x5c\x05\x99\x86\x10\xba\x3e\x60\xd6\x8b\xa7\xcb\xd1\x98\x7d\x5f\xa0\x6a\xed\x2b\x31\x17\xec\xbd\xd7\xae\xaf\xb2\x76\xed\x92\xa5\x1f\x2f\x60\xb5\x00\xc1\x11\x64\x69\x09\xfe\xd3\xaa\x36\xd5\xd5\x63\x0a\x2f\x9b\x7c\x41\x8e\x47\xa9\xd1\x22\x77\xe7\x82\x45\x0f\xbc\x26\xb1\xbf\xf3\x5e\xc7\x7b\xc7\x8c\x9e\x23\x3f\x6a\xc8\xe5\xbe\x5d\x83\x4a\x3a\x1f\xd5\x41\xfc\x62\x27\xbd\x69\x10\xf4\x78\xd8\x9b\x06\xed\x7f\x16\x79\x3f\xef\x1d\xfc\xbc\x37\x0d\x02\xcb\xf4\x6b\x3f\xf4\x19\x5f\xfb\x3b\xf5\x25\xd6\x5e\x1d\x88\xc9\xaf\x17\x27\x42\x36\xa5\x6a\xc7\xa4\xc8\x1c\x19\xef\x58\xd9\xf1\xe5\xe9\x94\xbf\x21\xce\xd3\x72\x65\x1f\xe7\x8d\x25\xf7\x9e\x34\x5d\x88\xf8\xab\xd9\x23\xbd\x9e\x40\x73\x83\xef\x3b\xe5\x7f\xb3\x53\x0a\x85\xce\x1b\xdc\x2c\x19\xdd\x37\xc8\x04\x19\xdc\x1b\x1a\xce\xeb\xb6\xbf\xf3\xbb\xde\x03\x7c\x97\x21\x73\xbc\xf5\x5b\x62\xc8\xd0\x1f\xdc\xaf\xdd\xce\xeb\xb6\x1a\x6f\xd7\x01\x19\xde\x1b\x8e\xce\xeb\x4e\x6f\xfb\xb9\x21\x03\xc4\xb6\xec\x7d\xb3\xe6\x7f\x03\x00\x00\xff\xff\x70\x75\xff\x03\x97\x30\x00\x00")
-
-func templates_testSingletonBoil_suites_testGoTplBytes() ([]byte, error) {
-	return bindataRead(
-		_templates_testSingletonBoil_suites_testGoTpl,
-		"templates_test/singleton/boil_suites_test.go.tpl",
-	)
-}
-
-func templates_testSingletonBoil_suites_testGoTpl() (*asset, error) {
-	bytes, err := templates_testSingletonBoil_suites_testGoTplBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "templates_test/singleton/boil_suites_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe6, 0xb4, 0x44, 0x36, 0x3d, 0x2e, 0xd9, 0xae, 0xe8, 0x6b, 0xff, 0x17, 0xdb, 0x55, 0xd, 0xd6, 0xd, 0xc1, 0xde, 0xa, 0x5a, 0x73, 0x69, 0x17, 0x46, 0xbc, 0xbb, 0x74, 0x46, 0xe4, 0xcd, 0x6b}}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// AssetString returns the asset contents as a string (instead of a []byte).
-func AssetString(name string) (string, error) {
-	data, err := Asset(name)
-	return string(data), err
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// MustAssetString is like AssetString but panics when Asset would return an
-// error. It simplifies safe initialization of global variables.
-func MustAssetString(name string) string {
-	return string(MustAsset(name))
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetDigest returns the digest of the file with the given name. It returns an
-// error if the asset could not be found or the digest could not be loaded.
-func AssetDigest(name string) ([sha256.Size]byte, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s can't read by error: %v", name, err)
-		}
-		return a.digest, nil
-	}
-	return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s not found", name)
-}
-
-// Digests returns a map of all known files and their checksums.
-func Digests() (map[string][sha256.Size]byte, error) {
-	mp := make(map[string][sha256.Size]byte, len(_bindata))
-	for name := range _bindata {
-		a, err := _bindata[name]()
-		if err != nil {
-			return nil, err
-		}
-		mp[name] = a.digest
-	}
-	return mp, nil
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"templates/00_struct.go.tpl":                           templates00_structGoTpl,
-	"templates/01_types.go.tpl":                            templates01_typesGoTpl,
-	"templates/02_hooks.go.tpl":                            templates02_hooksGoTpl,
-	"templates/03_finishers.go.tpl":                        templates03_finishersGoTpl,
-	"templates/04_relationship_to_one.go.tpl":              templates04_relationship_to_oneGoTpl,
-	"templates/05_relationship_one_to_one.go.tpl":          templates05_relationship_one_to_oneGoTpl,
-	"templates/06_relationship_to_many.go.tpl":             templates06_relationship_to_manyGoTpl,
-	"templates/07_relationship_to_one_eager.go.tpl":        templates07_relationship_to_one_eagerGoTpl,
-	"templates/08_relationship_one_to_one_eager.go.tpl":    templates08_relationship_one_to_one_eagerGoTpl,
-	"templates/09_relationship_to_many_eager.go.tpl":       templates09_relationship_to_many_eagerGoTpl,
-	"templates/10_relationship_to_one_setops.go.tpl":       templates10_relationship_to_one_setopsGoTpl,
-	"templates/11_relationship_one_to_one_setops.go.tpl":   templates11_relationship_one_to_one_setopsGoTpl,
-	"templates/12_relationship_to_many_setops.go.tpl":      templates12_relationship_to_many_setopsGoTpl,
-	"templates/13_all.go.tpl":                              templates13_allGoTpl,
-	"templates/14_find.go.tpl":                             templates14_findGoTpl,
-	"templates/15_insert.go.tpl":                           templates15_insertGoTpl,
-	"templates/16_update.go.tpl":                           templates16_updateGoTpl,
-	"templates/18_delete.go.tpl":                           templates18_deleteGoTpl,
-	"templates/19_reload.go.tpl":                           templates19_reloadGoTpl,
-	"templates/20_exists.go.tpl":                           templates20_existsGoTpl,
-	"templates/21_auto_timestamps.go.tpl":                  templates21_auto_timestampsGoTpl,
-	"templates/singleton/boil_queries.go.tpl":              templatesSingletonBoil_queriesGoTpl,
-	"templates/singleton/boil_table_names.go.tpl":          templatesSingletonBoil_table_namesGoTpl,
-	"templates/singleton/boil_types.go.tpl":                templatesSingletonBoil_typesGoTpl,
-	"templates_test/00_types.go.tpl":                       templates_test00_typesGoTpl,
-	"templates_test/all.go.tpl":                            templates_testAllGoTpl,
-	"templates_test/delete.go.tpl":                         templates_testDeleteGoTpl,
-	"templates_test/exists.go.tpl":                         templates_testExistsGoTpl,
-	"templates_test/find.go.tpl":                           templates_testFindGoTpl,
-	"templates_test/finishers.go.tpl":                      templates_testFinishersGoTpl,
-	"templates_test/hooks.go.tpl":                          templates_testHooksGoTpl,
-	"templates_test/insert.go.tpl":                         templates_testInsertGoTpl,
-	"templates_test/relationship_one_to_one.go.tpl":        templates_testRelationship_one_to_oneGoTpl,
-	"templates_test/relationship_one_to_one_setops.go.tpl": templates_testRelationship_one_to_one_setopsGoTpl,
-	"templates_test/relationship_to_many.go.tpl":           templates_testRelationship_to_manyGoTpl,
-	"templates_test/relationship_to_many_setops.go.tpl":    templates_testRelationship_to_many_setopsGoTpl,
-	"templates_test/relationship_to_one.go.tpl":            templates_testRelationship_to_oneGoTpl,
-	"templates_test/relationship_to_one_setops.go.tpl":     templates_testRelationship_to_one_setopsGoTpl,
-	"templates_test/reload.go.tpl":                         templates_testReloadGoTpl,
-	"templates_test/select.go.tpl":                         templates_testSelectGoTpl,
-	"templates_test/types.go.tpl":                          templates_testTypesGoTpl,
-	"templates_test/update.go.tpl":                         templates_testUpdateGoTpl,
-	"templates_test/singleton/boil_main_test.go.tpl":       templates_testSingletonBoil_main_testGoTpl,
-	"templates_test/singleton/boil_queries_test.go.tpl":    templates_testSingletonBoil_queries_testGoTpl,
-	"templates_test/singleton/boil_suites_test.go.tpl":     templates_testSingletonBoil_suites_testGoTpl,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"},
-// AssetDir("data/img") would return []string{"a.png", "b.png"},
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		canonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(canonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-
-var _bintree = &bintree{nil, map[string]*bintree{
-	"templates": &bintree{nil, map[string]*bintree{
-		"00_struct.go.tpl":                         &bintree{templates00_structGoTpl, map[string]*bintree{}},
-		"01_types.go.tpl":                          &bintree{templates01_typesGoTpl, map[string]*bintree{}},
-		"02_hooks.go.tpl":                          &bintree{templates02_hooksGoTpl, map[string]*bintree{}},
-		"03_finishers.go.tpl":                      &bintree{templates03_finishersGoTpl, map[string]*bintree{}},
-		"04_relationship_to_one.go.tpl":            &bintree{templates04_relationship_to_oneGoTpl, map[string]*bintree{}},
-		"05_relationship_one_to_one.go.tpl":        &bintree{templates05_relationship_one_to_oneGoTpl, map[string]*bintree{}},
-		"06_relationship_to_many.go.tpl":           &bintree{templates06_relationship_to_manyGoTpl, map[string]*bintree{}},
-		"07_relationship_to_one_eager.go.tpl":      &bintree{templates07_relationship_to_one_eagerGoTpl, map[string]*bintree{}},
-		"08_relationship_one_to_one_eager.go.tpl":  &bintree{templates08_relationship_one_to_one_eagerGoTpl, map[string]*bintree{}},
-		"09_relationship_to_many_eager.go.tpl":     &bintree{templates09_relationship_to_many_eagerGoTpl, map[string]*bintree{}},
-		"10_relationship_to_one_setops.go.tpl":     &bintree{templates10_relationship_to_one_setopsGoTpl, map[string]*bintree{}},
-		"11_relationship_one_to_one_setops.go.tpl": &bintree{templates11_relationship_one_to_one_setopsGoTpl, map[string]*bintree{}},
-		"12_relationship_to_many_setops.go.tpl":    &bintree{templates12_relationship_to_many_setopsGoTpl, map[string]*bintree{}},
-		"13_all.go.tpl":                            &bintree{templates13_allGoTpl, map[string]*bintree{}},
-		"14_find.go.tpl":                           &bintree{templates14_findGoTpl, map[string]*bintree{}},
-		"15_insert.go.tpl":                         &bintree{templates15_insertGoTpl, map[string]*bintree{}},
-		"16_update.go.tpl":                         &bintree{templates16_updateGoTpl, map[string]*bintree{}},
-		"18_delete.go.tpl":                         &bintree{templates18_deleteGoTpl, map[string]*bintree{}},
-		"19_reload.go.tpl":                         &bintree{templates19_reloadGoTpl, map[string]*bintree{}},
-		"20_exists.go.tpl":                         &bintree{templates20_existsGoTpl, map[string]*bintree{}},
-		"21_auto_timestamps.go.tpl":                &bintree{templates21_auto_timestampsGoTpl, map[string]*bintree{}},
-		"singleton": &bintree{nil, map[string]*bintree{
-			"boil_queries.go.tpl":     &bintree{templatesSingletonBoil_queriesGoTpl, map[string]*bintree{}},
-			"boil_table_names.go.tpl": &bintree{templatesSingletonBoil_table_namesGoTpl, map[string]*bintree{}},
-			"boil_types.go.tpl":       &bintree{templatesSingletonBoil_typesGoTpl, map[string]*bintree{}},
-		}},
-	}},
-	"templates_test": &bintree{nil, map[string]*bintree{
-		"00_types.go.tpl":                       &bintree{templates_test00_typesGoTpl, map[string]*bintree{}},
-		"all.go.tpl":                            &bintree{templates_testAllGoTpl, map[string]*bintree{}},
-		"delete.go.tpl":                         &bintree{templates_testDeleteGoTpl, map[string]*bintree{}},
-		"exists.go.tpl":                         &bintree{templates_testExistsGoTpl, map[string]*bintree{}},
-		"find.go.tpl":                           &bintree{templates_testFindGoTpl, map[string]*bintree{}},
-		"finishers.go.tpl":                      &bintree{templates_testFinishersGoTpl, map[string]*bintree{}},
-		"hooks.go.tpl":                          &bintree{templates_testHooksGoTpl, map[string]*bintree{}},
-		"insert.go.tpl":                         &bintree{templates_testInsertGoTpl, map[string]*bintree{}},
-		"relationship_one_to_one.go.tpl":        &bintree{templates_testRelationship_one_to_oneGoTpl, map[string]*bintree{}},
-		"relationship_one_to_one_setops.go.tpl": &bintree{templates_testRelationship_one_to_one_setopsGoTpl, map[string]*bintree{}},
-		"relationship_to_many.go.tpl":           &bintree{templates_testRelationship_to_manyGoTpl, map[string]*bintree{}},
-		"relationship_to_many_setops.go.tpl":    &bintree{templates_testRelationship_to_many_setopsGoTpl, map[string]*bintree{}},
-		"relationship_to_one.go.tpl":            &bintree{templates_testRelationship_to_oneGoTpl, map[string]*bintree{}},
-		"relationship_to_one_setops.go.tpl":     &bintree{templates_testRelationship_to_one_setopsGoTpl, map[string]*bintree{}},
-		"reload.go.tpl":                         &bintree{templates_testReloadGoTpl, map[string]*bintree{}},
-		"select.go.tpl":                         &bintree{templates_testSelectGoTpl, map[string]*bintree{}},
-		"singleton": &bintree{nil, map[string]*bintree{
-			"boil_main_test.go.tpl":    &bintree{templates_testSingletonBoil_main_testGoTpl, map[string]*bintree{}},
-			"boil_queries_test.go.tpl": &bintree{templates_testSingletonBoil_queries_testGoTpl, map[string]*bintree{}},
-			"boil_suites_test.go.tpl":  &bintree{templates_testSingletonBoil_suites_testGoTpl, map[string]*bintree{}},
-		}},
-		"types.go.tpl":  &bintree{templates_testTypesGoTpl, map[string]*bintree{}},
-		"update.go.tpl": &bintree{templates_testUpdateGoTpl, map[string]*bintree{}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory.
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	return os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-}
-
-// RestoreAssets restores an asset under the given directory recursively.
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(canonicalName, "/")...)...)
-}
@@ -0,0 +1,72 @@
+// Package templatebin gives access to sqlboiler's default templates, which
+// are embedded in the binary. Templates are named by their path from the root
+// of the repository, eg. templates/00_struct.go.tpl.
+package templatebin
+
+import (
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/templates"
+	templatestest "github.com/volatiletech/sqlboiler/v4/templates_test"
+)
+
+// dirs maps the directory the templates are in to their files
+var dirs = map[string]fs.FS{
+	"templates":      templates.FS,
+	"templates_test": templatestest.FS,
+}
+
+// Asset returns the contents of the template called name.
+func Asset(name string) ([]byte, error) {
+	dir, file := name, "."
+	if i := strings.IndexByte(name, '/'); i >= 0 {
+		dir, file = name[:i], name[i+1:]
+	}
+
+	files, ok := dirs[dir]
+	if !ok {
+		return nil, errors.Errorf("asset %s not found", name)
+	}
+
+	b, err := fs.ReadFile(files, file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "asset %s can't be read", name)
+	}
+	return b, nil
+}
+
+// AssetString returns the contents of the template called name as a string.
+func AssetString(name string) (string, error) {
+	b, err := Asset(name)
+	return string(b), err
+}
+
+// MustAsset is like Asset but panics when name can't be read.
+func MustAsset(name string) []byte {
+	b, err := Asset(name)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// AssetNames returns the names of all the templates, sorted.
+func AssetNames() []string {
+	var names []string
+	for dir, files := range dirs {
+		// The embedded files can always be walked
+		_ = fs.WalkDir(files, ".", func(name string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() && path.Ext(name) == ".tpl" {
+				names = append(names, path.Join(dir, name))
+			}
+			return err
+		})
+	}
+
+	sort.Strings(names)
+	return names
+}
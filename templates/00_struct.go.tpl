@@ -6,25 +6,39 @@ type {{$alias.UpSingular}} struct {
 	{{- range $column := .Table.Columns -}}
 	{{- $colAlias := $alias.Column $column.Name -}}
 	{{- $orig_col_name := $column.Name -}}
+	{{- $jsonTag := tagName (or $.JSONTagCasing $.StructTagCasing) $column.Name $colAlias -}}
+	{{- if ignore $orig_tbl_name $orig_col_name $.JSONIgnore -}}
+	{{- $jsonTag = "-" -}}
+	{{- else if and $column.Nullable (not $.NoJSONOmitEmpty) -}}
+	{{- $jsonTag = printf "%s,omitempty" $jsonTag -}}
+	{{- end -}}
+	{{- $xmlTag := tagName $.StructTagCasing $column.Name $colAlias -}}
+	{{- if ignore $orig_tbl_name $orig_col_name $.XMLAttributes -}}
+	{{- $xmlTag = printf "%s,attr" $xmlTag -}}
+	{{- end -}}
+	{{- if $column.Nullable -}}
+	{{- $xmlTag = printf "%s,omitempty" $xmlTag -}}
+	{{- end -}}
 	{{- range $column.Comment | splitLines -}} // {{ . }}
 	{{end -}}
 	{{if ignore $orig_tbl_name $orig_col_name $.TagIgnore -}}
-	{{$colAlias}} {{$column.Type}} `{{generateIgnoreTags $.Tags}}boil:"{{$column.Name}}" json:"-" toml:"-" yaml:"-"`
+	{{$colAlias}} {{$column.Type}} `{{generateIgnoreTags $.Tags}}boil:"{{$column.Name}}" json:"-" toml:"-" yaml:"-"{{if $.AddXMLTags}} xml:"-"{{end}}`
 	{{else if eq $.StructTagCasing "title" -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name | titleCase}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name | titleCase}}" yaml:"{{$column.Name | titleCase}}{{if $column.Nullable}},omitempty{{end}}"`
+	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$jsonTag}}" toml:"{{$column.Name | titleCase}}" yaml:"{{$column.Name | titleCase}}{{if $column.Nullable}},omitempty{{end}}"{{if $.AddXMLTags}} xml:"{{$xmlTag}}"{{end}}{{if $.AddValidateTags}}{{with validateTag $column}} validate:"{{.}}"{{end}}{{end}}`
 	{{else if eq $.StructTagCasing "camel" -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name | camelCase}}" yaml:"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}"`
+	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$jsonTag}}" toml:"{{$column.Name | camelCase}}" yaml:"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}"{{if $.AddXMLTags}} xml:"{{$xmlTag}}"{{end}}{{if $.AddValidateTags}}{{with validateTag $column}} validate:"{{.}}"{{end}}{{end}}`
 	{{else if eq $.StructTagCasing "alias" -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $colAlias}}boil:"{{$column.Name}}" json:"{{$colAlias}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$colAlias}}" yaml:"{{$colAlias}}{{if $column.Nullable}},omitempty{{end}}"`
+	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $colAlias}}boil:"{{$column.Name}}" json:"{{$jsonTag}}" toml:"{{$colAlias}}" yaml:"{{$colAlias}}{{if $column.Nullable}},omitempty{{end}}"{{if $.AddXMLTags}} xml:"{{$xmlTag}}"{{end}}{{if $.AddValidateTags}}{{with validateTag $column}} validate:"{{.}}"{{end}}{{end}}`
 	{{else -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name}}" yaml:"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}"`
+	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$jsonTag}}" toml:"{{$column.Name}}" yaml:"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}"{{if $.AddXMLTags}} xml:"{{$xmlTag}}"{{end}}{{if $.AddValidateTags}}{{with validateTag $column}} validate:"{{.}}"{{end}}{{end}}`
 	{{end -}}
 	{{end -}}
 	{{- if .Table.IsJoinTable -}}
 	{{- else}}
-	R *{{$alias.DownSingular}}R `{{generateTags $.Tags $.RelationTag}}boil:"{{$.RelationTag}}" json:"{{$.RelationTag}}" toml:"{{$.RelationTag}}" yaml:"{{$.RelationTag}}"`
-	L {{$alias.DownSingular}}L `{{generateIgnoreTags $.Tags}}boil:"-" json:"-" toml:"-" yaml:"-"`
-	{{end -}}
+	R *{{$alias.DownSingular}}R `{{generateTags $.Tags $.RelationTag}}boil:"{{$.RelationTag}}" json:"{{$.RelationTag}}" toml:"{{$.RelationTag}}" yaml:"{{$.RelationTag}}"{{if $.AddXMLTags}} xml:"{{$.RelationTag}}"{{end}}`
+	L {{$alias.DownSingular}}L `{{generateIgnoreTags $.Tags}}boil:"-" json:"-" toml:"-" yaml:"-"{{if $.AddXMLTags}} xml:"-"{{end}}`
+	{{end}}
+	changed [{{len .Table.Columns}}]bool `boil:"-"`
 }
 
 var {{$alias.UpSingular}}Columns = struct {
@@ -105,6 +119,27 @@ var {{$alias.UpSingular}}Rels = struct {
 	{{- $relAlias := $.Aliases.ManyRelationship .ForeignTable .Name .JoinTable .JoinLocalFKeyName -}}
 	{{$relAlias.Local}} string
 	{{end -}}{{/* range tomany */}}
+
+	{{range $.PolymorphicSources -}}
+	{{.Name}} string
+	{{range .Types -}}
+	{{.Local}} string
+	{{end -}}
+	{{end -}}
+
+	{{range $.PolymorphicTargets -}}
+	{{.Type.Foreign}} string
+	{{end -}}
+
+	{{range .Table.CompositeFKeys -}}
+	{{- $relAlias := $alias.Relationship .Name -}}
+	{{$relAlias.Foreign}} string
+	{{end -}}
+
+	{{range $.CompositeFKeysTo -}}
+	{{- $relAlias := ($.Aliases.Table .Table).Relationship .Name -}}
+	{{$relAlias.Local}} string
+	{{end -}}
 }{
 	{{range .Table.FKeys -}}
 	{{- $relAlias := $alias.Relationship .Name -}}
@@ -121,6 +156,27 @@ var {{$alias.UpSingular}}Rels = struct {
 	{{- $relAlias := $.Aliases.ManyRelationship .ForeignTable .Name .JoinTable .JoinLocalFKeyName -}}
 	{{$relAlias.Local}}: "{{$relAlias.Local}}",
 	{{end -}}{{/* range tomany */}}
+
+	{{range $.PolymorphicSources -}}
+	{{.Name}}: "{{.Name}}",
+	{{range .Types -}}
+	{{.Local}}: "{{.Local}}",
+	{{end -}}
+	{{end -}}
+
+	{{range $.PolymorphicTargets -}}
+	{{.Type.Foreign}}: "{{.Type.Foreign}}",
+	{{end -}}
+
+	{{range .Table.CompositeFKeys -}}
+	{{- $relAlias := $alias.Relationship .Name -}}
+	{{$relAlias.Foreign}}: "{{$relAlias.Foreign}}",
+	{{end -}}
+
+	{{range $.CompositeFKeysTo -}}
+	{{- $relAlias := ($.Aliases.Table .Table).Relationship .Name -}}
+	{{$relAlias.Local}}: "{{$relAlias.Local}}",
+	{{end -}}
 }
 
 // {{$alias.DownSingular}}R is where relationships are stored.
@@ -128,20 +184,44 @@ type {{$alias.DownSingular}}R struct {
 	{{range .Table.FKeys -}}
 	{{- $ftable := $.Aliases.Table .ForeignTable -}}
 	{{- $relAlias := $alias.Relationship .Name -}}
-	{{$relAlias.Foreign}} *{{$ftable.UpSingular}} `{{generateTags $.Tags $relAlias.Foreign}}boil:"{{$relAlias.Foreign}}" json:"{{$relAlias.Foreign}}" toml:"{{$relAlias.Foreign}}" yaml:"{{$relAlias.Foreign}}"`
+	{{$relAlias.Foreign}} *{{$ftable.UpSingular}} `{{generateTags $.Tags $relAlias.Foreign}}boil:"{{$relAlias.Foreign}}" json:"{{$relAlias.Foreign}}" toml:"{{$relAlias.Foreign}}" yaml:"{{$relAlias.Foreign}}"{{if $.AddXMLTags}} xml:"{{$relAlias.Foreign}}"{{end}}`
 	{{end -}}
 
 	{{range .Table.ToOneRelationships -}}
 	{{- $ftable := $.Aliases.Table .ForeignTable -}}
 	{{- $relAlias := $ftable.Relationship .Name -}}
-	{{$relAlias.Local}} *{{$ftable.UpSingular}} `{{generateTags $.Tags $relAlias.Local}}boil:"{{$relAlias.Local}}" json:"{{$relAlias.Local}}" toml:"{{$relAlias.Local}}" yaml:"{{$relAlias.Local}}"`
+	{{$relAlias.Local}} *{{$ftable.UpSingular}} `{{generateTags $.Tags $relAlias.Local}}boil:"{{$relAlias.Local}}" json:"{{$relAlias.Local}}" toml:"{{$relAlias.Local}}" yaml:"{{$relAlias.Local}}"{{if $.AddXMLTags}} xml:"{{$relAlias.Local}}"{{end}}`
 	{{end -}}
 
 	{{range .Table.ToManyRelationships -}}
 	{{- $ftable := $.Aliases.Table .ForeignTable -}}
 	{{- $relAlias := $.Aliases.ManyRelationship .ForeignTable .Name .JoinTable .JoinLocalFKeyName -}}
-	{{$relAlias.Local}} {{printf "%sSlice" $ftable.UpSingular}} `{{generateTags $.Tags $relAlias.Local}}boil:"{{$relAlias.Local}}" json:"{{$relAlias.Local}}" toml:"{{$relAlias.Local}}" yaml:"{{$relAlias.Local}}"`
+	{{$relAlias.Local}} {{printf "%sSlice" $ftable.UpSingular}} `{{generateTags $.Tags $relAlias.Local}}boil:"{{$relAlias.Local}}" json:"{{$relAlias.Local}}" toml:"{{$relAlias.Local}}" yaml:"{{$relAlias.Local}}"{{if $.AddXMLTags}} xml:"{{$relAlias.Local}}"{{end}}`
 	{{end -}}{{/* range tomany */}}
+
+	{{range $.PolymorphicSources -}}
+	{{range .Types -}}
+	{{- $ftable := $.Aliases.Table .Table -}}
+	{{.Local}} *{{$ftable.UpSingular}} `{{generateTags $.Tags .Local}}boil:"{{.Local}}" json:"{{.Local}}" toml:"{{.Local}}" yaml:"{{.Local}}"{{if $.AddXMLTags}} xml:"{{.Local}}"{{end}}`
+	{{end -}}
+	{{end -}}
+
+	{{range $.PolymorphicTargets -}}
+	{{- $ltable := $.Aliases.Table .Table -}}
+	{{.Type.Foreign}} {{printf "%sSlice" $ltable.UpSingular}} `{{generateTags $.Tags .Type.Foreign}}boil:"{{.Type.Foreign}}" json:"{{.Type.Foreign}}" toml:"{{.Type.Foreign}}" yaml:"{{.Type.Foreign}}"{{if $.AddXMLTags}} xml:"{{.Type.Foreign}}"{{end}}`
+	{{end -}}
+
+	{{range .Table.CompositeFKeys -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable -}}
+	{{- $relAlias := $alias.Relationship .Name -}}
+	{{$relAlias.Foreign}} *{{$ftable.UpSingular}} `{{generateTags $.Tags $relAlias.Foreign}}boil:"{{$relAlias.Foreign}}" json:"{{$relAlias.Foreign}}" toml:"{{$relAlias.Foreign}}" yaml:"{{$relAlias.Foreign}}"{{if $.AddXMLTags}} xml:"{{$relAlias.Foreign}}"{{end}}`
+	{{end -}}
+
+	{{range $.CompositeFKeysTo -}}
+	{{- $ltable := $.Aliases.Table .Table -}}
+	{{- $relAlias := $ltable.Relationship .Name -}}
+	{{$relAlias.Local}} {{if .Unique}}*{{$ltable.UpSingular}}{{else}}{{printf "%sSlice" $ltable.UpSingular}}{{end}} `{{generateTags $.Tags $relAlias.Local}}boil:"{{$relAlias.Local}}" json:"{{$relAlias.Local}}" toml:"{{$relAlias.Local}}" yaml:"{{$relAlias.Local}}"{{if $.AddXMLTags}} xml:"{{$relAlias.Local}}"{{end}}`
+	{{end -}}
 }
 
 // NewStruct creates a new relationship struct
@@ -1,5 +1,7 @@
 {{- if not .NoHooks -}}
 {{- $alias := .Aliases.Table .Table.Name}}
+{{- $audited := containsAny .AuditTables .Table.Name}}
+{{- $outboxed := containsAny .OutboxTables .Table.Name}}
 
 var {{$alias.DownSingular}}BeforeInsertHooks []{{$alias.UpSingular}}Hook
 var {{$alias.DownSingular}}BeforeUpdateHooks []{{$alias.UpSingular}}Hook
@@ -15,7 +17,7 @@ var {{$alias.DownSingular}}AfterUpsertHooks []{{$alias.UpSingular}}Hook
 // doBeforeInsertHooks executes all "before insert" hooks.
 func (o *{{$alias.UpSingular}}) doBeforeInsertHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
-	if boil.HooksAreSkipped(ctx) {
+	if boil.HookIsSkipped(ctx, boil.BeforeInsertHook) {
 		return nil
 	}
 
@@ -32,7 +34,7 @@ func (o *{{$alias.UpSingular}}) doBeforeInsertHooks({{if .NoContext}}exec boil.E
 // doBeforeUpdateHooks executes all "before Update" hooks.
 func (o *{{$alias.UpSingular}}) doBeforeUpdateHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
-	if boil.HooksAreSkipped(ctx) {
+	if boil.HookIsSkipped(ctx, boil.BeforeUpdateHook) {
 		return nil
 	}
 
@@ -43,13 +45,25 @@ func (o *{{$alias.UpSingular}}) doBeforeUpdateHooks({{if .NoContext}}exec boil.E
 		}
 	}
 
+	{{if $audited -}}
+	if err := o.auditUpdate(ctx, exec); err != nil {
+		return err
+	}
+
+	{{end -}}
+	{{if $outboxed -}}
+	if err := o.publishUpdate(ctx, exec); err != nil {
+		return err
+	}
+
+	{{end -}}
 	return nil
 }
 
 // doBeforeDeleteHooks executes all "before Delete" hooks.
 func (o *{{$alias.UpSingular}}) doBeforeDeleteHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
-	if boil.HooksAreSkipped(ctx) {
+	if boil.HookIsSkipped(ctx, boil.BeforeDeleteHook) {
 		return nil
 	}
 
@@ -66,7 +80,7 @@ func (o *{{$alias.UpSingular}}) doBeforeDeleteHooks({{if .NoContext}}exec boil.E
 // doBeforeUpsertHooks executes all "before Upsert" hooks.
 func (o *{{$alias.UpSingular}}) doBeforeUpsertHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
-	if boil.HooksAreSkipped(ctx) {
+	if boil.HookIsSkipped(ctx, boil.BeforeUpsertHook) {
 		return nil
 	}
 
@@ -83,10 +97,22 @@ func (o *{{$alias.UpSingular}}) doBeforeUpsertHooks({{if .NoContext}}exec boil.E
 // doAfterInsertHooks executes all "after Insert" hooks.
 func (o *{{$alias.UpSingular}}) doAfterInsertHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
-	if boil.HooksAreSkipped(ctx) {
+	if boil.HookIsSkipped(ctx, boil.AfterInsertHook) {
 		return nil
 	}
 
+	{{end -}}
+	{{if $audited -}}
+	if err := o.audit(ctx, exec, AuditInsert, nil, o); err != nil {
+		return err
+	}
+
+	{{end -}}
+	{{if $outboxed -}}
+	if err := o.publishChange(ctx, exec, ChangeInsert, nil, o); err != nil {
+		return err
+	}
+
 	{{end -}}
 	for _, hook := range {{$alias.DownSingular}}AfterInsertHooks {
 		if err := hook({{if not .NoContext}}ctx, {{end -}} exec, o); err != nil {
@@ -100,7 +126,7 @@ func (o *{{$alias.UpSingular}}) doAfterInsertHooks({{if .NoContext}}exec boil.Ex
 // doAfterSelectHooks executes all "after Select" hooks.
 func (o *{{$alias.UpSingular}}) doAfterSelectHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
-	if boil.HooksAreSkipped(ctx) {
+	if boil.HookIsSkipped(ctx, boil.AfterSelectHook) {
 		return nil
 	}
 
@@ -117,7 +143,7 @@ func (o *{{$alias.UpSingular}}) doAfterSelectHooks({{if .NoContext}}exec boil.Ex
 // doAfterUpdateHooks executes all "after Update" hooks.
 func (o *{{$alias.UpSingular}}) doAfterUpdateHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
-	if boil.HooksAreSkipped(ctx) {
+	if boil.HookIsSkipped(ctx, boil.AfterUpdateHook) {
 		return nil
 	}
 
@@ -134,10 +160,22 @@ func (o *{{$alias.UpSingular}}) doAfterUpdateHooks({{if .NoContext}}exec boil.Ex
 // doAfterDeleteHooks executes all "after Delete" hooks.
 func (o *{{$alias.UpSingular}}) doAfterDeleteHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
-	if boil.HooksAreSkipped(ctx) {
+	if boil.HookIsSkipped(ctx, boil.AfterDeleteHook) {
 		return nil
 	}
 
+	{{end -}}
+	{{if $audited -}}
+	if err := o.audit(ctx, exec, AuditDelete, o, nil); err != nil {
+		return err
+	}
+
+	{{end -}}
+	{{if $outboxed -}}
+	if err := o.publishChange(ctx, exec, ChangeDelete, o, nil); err != nil {
+		return err
+	}
+
 	{{end -}}
 	for _, hook := range {{$alias.DownSingular}}AfterDeleteHooks {
 		if err := hook({{if not .NoContext}}ctx, {{end -}} exec, o); err != nil {
@@ -151,10 +189,22 @@ func (o *{{$alias.UpSingular}}) doAfterDeleteHooks({{if .NoContext}}exec boil.Ex
 // doAfterUpsertHooks executes all "after Upsert" hooks.
 func (o *{{$alias.UpSingular}}) doAfterUpsertHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
-	if boil.HooksAreSkipped(ctx) {
+	if boil.HookIsSkipped(ctx, boil.AfterUpsertHook) {
 		return nil
 	}
 
+	{{end -}}
+	{{if $audited -}}
+	if err := o.audit(ctx, exec, AuditUpsert, nil, o); err != nil {
+		return err
+	}
+
+	{{end -}}
+	{{if $outboxed -}}
+	if err := o.publishChange(ctx, exec, ChangeUpsert, nil, o); err != nil {
+		return err
+	}
+
 	{{end -}}
 	for _, hook := range {{$alias.DownSingular}}AfterUpsertHooks {
 		if err := hook({{if not .NoContext}}ctx, {{end -}} exec, o); err != nil {
@@ -188,4 +238,19 @@ func Add{{$alias.UpSingular}}Hook(hookPoint boil.HookPoint, {{$alias.DownSingula
 			{{$alias.DownSingular}}AfterUpsertHooks = append({{$alias.DownSingular}}AfterUpsertHooks, {{$alias.DownSingular}}Hook)
 	}
 }
+
+// Add{{$alias.UpSingular}}QueryCacheHooks adds hooks that drop the rows of {{.Table.Name}}
+// cached by cache after a {{$alias.UpSingular}} is inserted, updated, upserted or
+// deleted. Writes that don't run hooks, like UpdateAll and DeleteAll on a
+// query, have to call cache.InvalidateTable themselves.
+func Add{{$alias.UpSingular}}QueryCacheHooks(cache *boil.QueryCache) {
+	invalidate := func({{if .NoContext}}boil.Executor{{else}}context.Context, boil.ContextExecutor{{end}}, *{{$alias.UpSingular}}) error {
+		cache.InvalidateTable("{{.Table.Name}}")
+		return nil
+	}
+
+	for _, hookPoint := range []boil.HookPoint{boil.AfterInsertHook, boil.AfterUpdateHook, boil.AfterDeleteHook, boil.AfterUpsertHook} {
+		Add{{$alias.UpSingular}}Hook(hookPoint, invalidate)
+	}
+}
 {{- end}}
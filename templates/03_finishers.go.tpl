@@ -1,5 +1,24 @@
 {{- $alias := .Aliases.Table .Table.Name}}
 
+// scan{{$alias.UpSingular}} scans the current row of rows, which has the columns cols,
+// into o by assigning the fields directly instead of binding with reflection.
+// Columns o has no field for are discarded.
+func scan{{$alias.UpSingular}}(rows *sql.Rows, cols []string, o *{{$alias.UpSingular}}) error {
+	dest := make([]interface{}, len(cols))
+	for i, col := range cols {
+		switch col {
+		{{range $column := .Table.Columns -}}
+		case "{{$column.Name}}":
+			dest[i] = &o.{{$alias.Column $column.Name}}
+		{{end -}}
+		default:
+			dest[i] = new(interface{})
+		}
+	}
+
+	return rows.Scan(dest...)
+}
+
 {{if .AddGlobal -}}
 // OneG returns a single {{$alias.DownSingular}} record from the query using the global executor.
 func (q {{$alias.DownSingular}}Query) OneG({{if not .NoContext}}ctx context.Context{{end}}) (*{{$alias.UpSingular}}, error) {
@@ -40,10 +59,12 @@ func (q {{$alias.DownSingular}}Query) One({{if .NoContext}}exec boil.Executor{{e
 
 	queries.SetLimit(q.Query, 1)
 
-	err := q.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, o)
+	err := q.BindScan({{if .NoContext}}nil{{else}}ctx{{end}}, exec, o, {{$alias.DownSingular}}AllColumns, func(rows *sql.Rows, cols []string) error {
+		return scan{{$alias.UpSingular}}(rows, cols, o)
+	})
 	if err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
-			return nil, sql.ErrNoRows
+			return nil, boil.NewNotFoundError("{{.Table.Name}}")
 		}
 		return nil, errors.Wrap(err, "{{.PkgName}}: failed to execute a one query for {{.Table.Name}}")
 	}
@@ -95,7 +116,14 @@ func (q {{$alias.DownSingular}}Query) AllP({{if .NoContext}}exec boil.Executor{{
 func (q {{$alias.DownSingular}}Query) All({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) ({{$alias.UpSingular}}Slice, error) {
 	var o []*{{$alias.UpSingular}}
 
-	err := q.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &o)
+	err := q.BindScan({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &o, {{$alias.DownSingular}}AllColumns, func(rows *sql.Rows, cols []string) error {
+		obj := &{{$alias.UpSingular}}{}
+		if err := scan{{$alias.UpSingular}}(rows, cols, obj); err != nil {
+			return err
+		}
+		o = append(o, obj)
+		return nil
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "{{.PkgName}}: failed to assign all query results to {{$alias.UpSingular}} slice")
 	}
@@ -39,5 +39,191 @@ func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}(mods ...qm.QueryMod) {{$fta
 	return query
 }
 
+{{if $.AddGlobal -}}
+// Has{{$relAlias.Local}}G checks if the {{$ltable.DownSingular}} has any {{$relAlias.Local}} without loading them.
+func (o *{{$ltable.UpSingular}}) Has{{$relAlias.Local}}G({{if not $.NoContext}}ctx context.Context, {{end -}} mods ...qm.QueryMod) (bool, error) {
+	return o.Has{{$relAlias.Local}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, mods...)
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// Has{{$relAlias.Local}}P checks if the {{$ltable.DownSingular}} has any {{$relAlias.Local}} without loading them, and panics on error.
+func (o *{{$ltable.UpSingular}}) Has{{$relAlias.Local}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, mods ...qm.QueryMod) bool {
+	e, err := o.Has{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} exec, mods...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// Has{{$relAlias.Local}}GP checks if the {{$ltable.DownSingular}} has any {{$relAlias.Local}} without loading them, and panics on error.
+func (o *{{$ltable.UpSingular}}) Has{{$relAlias.Local}}GP({{if not $.NoContext}}ctx context.Context, {{end -}} mods ...qm.QueryMod) bool {
+	e, err := o.Has{{$relAlias.Local}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, mods...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+{{end -}}
+
+// Has{{$relAlias.Local}} checks if the {{$ltable.DownSingular}} has any {{$relAlias.Local}} without loading
+// them, the mods narrow down which ones count.
+func (o *{{$ltable.UpSingular}}) Has{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, mods ...qm.QueryMod) (bool, error) {
+	query := o.{{$relAlias.Local}}(mods...)
+	queries.SetExists(query.Query)
+
+	var exists bool
+	{{if $.NoContext -}}
+	err := query.QueryRow(exec).Scan(&exists)
+	{{else -}}
+	err := query.QueryRowContext(ctx, exec).Scan(&exists)
+	{{end -}}
+	if err != nil {
+		return false, errors.Wrap(err, "{{$.PkgName}}: failed to check if {{$ltable.DownSingular}} has {{$relAlias.Local}}")
+	}
+
+	return exists, nil
+}
+
+{{if $.AddGlobal -}}
+// {{$relAlias.Local}}CountG counts the {{$relAlias.Local}} of the {{$ltable.DownSingular}}.
+func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}CountG({{if not $.NoContext}}ctx context.Context, {{end -}} mods ...qm.QueryMod) (int64, error) {
+	return o.{{$relAlias.Local}}Count({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, mods...)
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// {{$relAlias.Local}}CountP counts the {{$relAlias.Local}} of the {{$ltable.DownSingular}}, and panics on error.
+func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}CountP({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, mods ...qm.QueryMod) int64 {
+	c, err := o.{{$relAlias.Local}}Count({{if not $.NoContext}}ctx, {{end -}} exec, mods...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// {{$relAlias.Local}}CountGP counts the {{$relAlias.Local}} of the {{$ltable.DownSingular}}, and panics on error.
+func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}CountGP({{if not $.NoContext}}ctx context.Context, {{end -}} mods ...qm.QueryMod) int64 {
+	c, err := o.{{$relAlias.Local}}Count({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, mods...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+{{end -}}
+
+// {{$relAlias.Local}}Count counts the {{$relAlias.Local}} of the {{$ltable.DownSingular}}, the mods narrow
+// down which ones count.
+func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}Count({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, mods ...qm.QueryMod) (int64, error) {
+	return o.{{$relAlias.Local}}(mods...).Count({{if not $.NoContext}}ctx, {{end -}} exec)
+}
+
+		{{- $keyType := ((getTable $.Tables $rel.Table).GetColumn $rel.Column).Type -}}
+		{{- $countCol := printf "%s.%s" $schemaForeignTable ($rel.ForeignColumn | $.Quotes) -}}
+		{{- if $rel.ToJoinTable -}}
+			{{- $countCol = printf "%s.%s" (id 0 | $.Quotes) ($rel.JoinLocalColumn | $.Quotes) -}}
+		{{- end}}
+		{{- /* slices can't be map keys */ -}}
+		{{- if not (hasPrefix $keyType "[]")}}
+
+{{if $.AddGlobal -}}
+// Count{{$relAlias.Local}}G counts the {{$relAlias.Local}} of every {{$ltable.DownSingular}} in the slice.
+func (o {{$ltable.UpSingular}}Slice) Count{{$relAlias.Local}}G({{if not $.NoContext}}ctx context.Context, {{end -}} mods ...qm.QueryMod) (map[{{$keyType}}]int64, error) {
+	return o.Count{{$relAlias.Local}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, mods...)
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// Count{{$relAlias.Local}}P counts the {{$relAlias.Local}} of every {{$ltable.DownSingular}} in the slice, and panics on error.
+func (o {{$ltable.UpSingular}}Slice) Count{{$relAlias.Local}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, mods ...qm.QueryMod) map[{{$keyType}}]int64 {
+	counts, err := o.Count{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} exec, mods...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return counts
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// Count{{$relAlias.Local}}GP counts the {{$relAlias.Local}} of every {{$ltable.DownSingular}} in the slice, and panics on error.
+func (o {{$ltable.UpSingular}}Slice) Count{{$relAlias.Local}}GP({{if not $.NoContext}}ctx context.Context, {{end -}} mods ...qm.QueryMod) map[{{$keyType}}]int64 {
+	counts, err := o.Count{{$relAlias.Local}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, mods...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return counts
+}
+
+{{end -}}
+
+// Count{{$relAlias.Local}} counts the {{$relAlias.Local}} of every {{$ltable.DownSingular}} in the slice with
+// a single query. The counts are keyed by {{$rel.Column}}, the ones without any
+// {{$relAlias.Local}} are left out.
+func (o {{$ltable.UpSingular}}Slice) Count{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, mods ...qm.QueryMod) (map[{{$keyType}}]int64, error) {
+	counts := make(map[{{$keyType}}]int64)
+	if len(o) == 0 {
+		return counts, nil
+	}
+
+	args := make([]interface{}, len(o))
+	for i, obj := range o {
+		args[i] = obj.{{$ltable.Column $rel.Column}}
+	}
+
+	queryMods := []qm.QueryMod{
+		qm.Select("{{$countCol}}", "count(*)"),
+		{{if $rel.ToJoinTable -}}
+		qm.InnerJoin("{{$rel.JoinTable | $.SchemaTable}} as {{id 0 | $.Quotes}} on {{$schemaForeignTable}}.{{$rel.ForeignColumn | $.Quotes}} = {{id 0 | $.Quotes}}.{{$rel.JoinForeignColumn | $.Quotes}}"),
+		{{end -}}
+		qm.WhereIn("{{$countCol}} in ?", args...),
+		qm.GroupBy("{{$countCol}}"),
+	}
+	queryMods = append(queryMods, mods...)
+
+	query := {{$ftable.UpPlural}}(queryMods...)
+	{{if $.NoContext -}}
+	rows, err := query.Query.Query(exec)
+	{{else -}}
+	rows, err := query.QueryContext(ctx, exec)
+	{{end -}}
+	if err != nil {
+		return nil, errors.Wrap(err, "{{$.PkgName}}: failed to count {{$relAlias.Local}}")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key {{$keyType}}
+		var count int64
+		if err = rows.Scan(&key, &count); err != nil {
+			return nil, errors.Wrap(err, "{{$.PkgName}}: failed to scan {{$relAlias.Local}} count")
+		}
+		counts[key] = count
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "{{$.PkgName}}: failed to iterate over {{$relAlias.Local}} counts")
+	}
+
+	return counts, nil
+}
+
+		{{end -}}{{- /* if comparable key */ -}}
 {{end -}}{{- /* range relationships */ -}}
 {{- end -}}{{- /* if isJoinTable */ -}}
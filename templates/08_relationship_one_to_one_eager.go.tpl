@@ -63,25 +63,27 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 		mods.Apply(query)
 	}
 
-	{{if $.NoContext -}}
-	results, err := query.Query(e)
-	{{else -}}
-	results, err := query.QueryContext(ctx, e)
-	{{end -}}
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load {{$ftable.UpSingular}}")
-	}
-
 	var resultSlice []*{{$ftable.UpSingular}}
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice {{$ftable.UpSingular}}")
-	}
+	for _, query := range queries.SplitIn(query) {
+		{{if $.NoContext -}}
+		results, err := query.Query(e)
+		{{else -}}
+		results, err := query.QueryContext(ctx, e)
+		{{end -}}
+		if err != nil {
+			return errors.Wrap(err, "failed to eager load {{$ftable.UpSingular}}")
+		}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for {{.ForeignTable}}")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{.ForeignTable}}")
+		if err = queries.Bind(results, &resultSlice); err != nil {
+			return errors.Wrap(err, "failed to bind eager loaded slice {{$ftable.UpSingular}}")
+		}
+
+		if err = results.Close(); err != nil {
+			return errors.Wrap(err, "failed to close results of eager load for {{.ForeignTable}}")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{.ForeignTable}}")
+		}
 	}
 
 	{{if not $.NoHooks -}}
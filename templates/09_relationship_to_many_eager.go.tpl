@@ -74,51 +74,72 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 	    {{- end}}
     )
 		{{end -}}
-	if mods != nil {
-		mods.Apply(query)
-	}
-
-	{{if $.NoContext -}}
-	results, err := query.Query(e)
+	{{if .ToJoinTable -}}
+	queries.SetPartitionBy(query, "{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}}")
 	{{else -}}
-	results, err := query.QueryContext(ctx, e)
+	queries.SetPartitionBy(query, "{{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}}")
 	{{end -}}
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load {{.ForeignTable}}")
+	if mods != nil {
+		mods.Apply(query)
 	}
 
 	var resultSlice []*{{$ftable.UpSingular}}
 	{{if .ToJoinTable -}}
-	{{- $foreignTable := getTable $.Tables .ForeignTable -}}
 	{{- $joinTable := getTable $.Tables .JoinTable -}}
 	{{- $localCol := $joinTable.GetColumn .JoinLocalColumn}}
 	var localJoinCols []{{$localCol.Type}}
-	for results.Next() {
-		one := new({{$ftable.UpSingular}})
-		var localJoinCol {{$localCol.Type}}
-
-		err = results.Scan({{$foreignTable.Columns | columnNames | stringMap (aliasCols $ftable) | prefixStringSlice "&one." | join ", "}}, &localJoinCol)
+	{{end -}}
+	for _, query := range queries.SplitIn(query) {
+		{{if $.NoContext -}}
+		results, err := query.Query(e)
+		{{else -}}
+		results, err := query.QueryContext(ctx, e)
+		{{end -}}
 		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for {{.ForeignTable}}")
+			return errors.Wrap(err, "failed to eager load {{.ForeignTable}}")
 		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice {{.ForeignTable}}")
+
+		{{if .ToJoinTable -}}
+		{{- $foreignTable := getTable $.Tables .ForeignTable -}}
+		{{- $joinTable := getTable $.Tables .JoinTable -}}
+		{{- $localCol := $joinTable.GetColumn .JoinLocalColumn -}}
+		cols, err := results.Columns()
+		if err != nil {
+			return errors.Wrap(err, "failed to get columns of eager loaded results for {{.ForeignTable}}")
 		}
+		for results.Next() {
+			one := new({{$ftable.UpSingular}})
+			var localJoinCol {{$localCol.Type}}
 
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
-	}
-	{{- else -}}
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice {{.ForeignTable}}")
-	}
-	{{- end}}
+			dest := []interface{}{ {{- $foreignTable.Columns | columnNames | stringMap (aliasCols $ftable) | prefixStringSlice "&one." | join ", "}}, &localJoinCol}
+			// A per parent limit can add a row number column
+			for len(dest) < len(cols) {
+				dest = append(dest, new(interface{}))
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on {{.ForeignTable}}")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{.ForeignTable}}")
+			err = results.Scan(dest...)
+			if err != nil {
+				return errors.Wrap(err, "failed to scan eager loaded results for {{.ForeignTable}}")
+			}
+			if err = results.Err(); err != nil {
+				return errors.Wrap(err, "failed to plebian-bind eager loaded slice {{.ForeignTable}}")
+			}
+
+			resultSlice = append(resultSlice, one)
+			localJoinCols = append(localJoinCols, localJoinCol)
+		}
+		{{- else -}}
+		if err = queries.Bind(results, &resultSlice); err != nil {
+			return errors.Wrap(err, "failed to bind eager loaded slice {{.ForeignTable}}")
+		}
+		{{- end}}
+
+		if err = results.Close(); err != nil {
+			return errors.Wrap(err, "failed to close results in eager load on {{.ForeignTable}}")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{.ForeignTable}}")
+		}
 	}
 
 	{{if not $.NoHooks -}}
@@ -192,5 +213,54 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 	return nil
 }
 
+		{{- $keyType := ((getTable $.Tables $rel.Table).GetColumn $rel.Column).Type -}}
+		{{- if not (hasPrefix $keyType "[]")}}
+
+{{if $.AddGlobal -}}
+// {{$relAlias.Local}}MapG loads the {{$relAlias.Local}} of every {{$ltable.DownSingular}} in the slice
+// keyed by {{$rel.Column}}, using the global database handle.
+func (o {{$ltable.UpSingular}}Slice) {{$relAlias.Local}}MapG({{if not $.NoContext}}ctx context.Context, {{end -}} mods ...qm.QueryMod) (map[{{$keyType}}]{{$ftable.UpSingular}}Slice, error) {
+	return o.{{$relAlias.Local}}Map({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, mods...)
+}
+
+{{end -}}
+
+// {{$relAlias.Local}}Map loads the {{$relAlias.Local}} of every {{$ltable.DownSingular}} in the slice
+// the way Load{{$relAlias.Local}} does, but returns them keyed by {{$rel.Column}} instead
+// of attaching them to the R structs of the slice. That's the shape
+// dataloaders need: build a slice with just the keys set and look the
+// results up by key.
+func (o {{$ltable.UpSingular}}Slice) {{$relAlias.Local}}Map({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, mods ...qm.QueryMod) (map[{{$keyType}}]{{$ftable.UpSingular}}Slice, error) {
+	result := make(map[{{$keyType}}]{{$ftable.UpSingular}}Slice, len(o))
+	if len(o) == 0 {
+		return result, nil
+	}
+
+	// Load into copies so the R structs of the slice are left alone
+	copies := make([]*{{$ltable.UpSingular}}, len(o))
+	for i, obj := range o {
+		c := *obj
+		c.R = nil
+		copies[i] = &c
+	}
+
+	var applicator queries.Applicator
+	if len(mods) != 0 {
+		applicator = qm.QueryModFunc(func(q *queries.Query) {
+			qm.Apply(q, mods...)
+		})
+	}
+	if err := ({{$ltable.DownSingular}}L{}).Load{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} exec, false, &copies, applicator); err != nil {
+		return nil, err
+	}
+
+	for _, c := range copies {
+		result[c.{{$col}}] = append(result[c.{{$col}}], c.R.{{$relAlias.Local}}...)
+	}
+
+	return result, nil
+}
+
+		{{end -}}{{/* if comparable key */}}
 {{end -}}{{/* range tomany */}}
 {{- end -}}{{/* if IsJoinTable */}}
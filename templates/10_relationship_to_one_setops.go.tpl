@@ -59,8 +59,8 @@ func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil
 
 	updateQuery := fmt.Sprintf(
 		"UPDATE {{$schemaTable}} SET %s WHERE %s",
-		strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.Column}}"{{"}"}}),
-		strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$ltable.DownSingular}}PrimaryKeyColumns),
+		dialect.SetParamNames(1, []string{{"{"}}"{{.Column}}"{{"}"}}),
+		dialect.WhereClause(2, {{$ltable.DownSingular}}PrimaryKeyColumns),
 	)
 	values := []interface{}{related.{{$fcol}}, o.{{$.Table.PKey.Columns | stringMap (aliasCols $ltable) | join ", o."}}{{"}"}}
 
@@ -69,12 +69,6 @@ func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil
 		fmt.Fprintln(boil.DebugWriter, updateQuery)
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
 	{{end -}}
 
 	{{if $.NoContext -}}
@@ -82,7 +76,7 @@ func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil
 		return errors.Wrap(err, "failed to update local table")
 	}
 	{{- else -}}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+	if _, err = boil.ExecContext(ctx, exec, updateQuery, values...); err != nil {
 		return errors.Wrap(err, "failed to update local table")
 	}
 	{{- end}}
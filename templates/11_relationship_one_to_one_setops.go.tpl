@@ -65,8 +65,8 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 	} else {
 		updateQuery := fmt.Sprintf(
 			"UPDATE {{$schemaForeignTable}} SET %s WHERE %s",
-			strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.ForeignColumn}}"{{"}"}}),
-			strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$ftable.DownSingular}}PrimaryKeyColumns),
+			dialect.SetParamNames(1, []string{{"{"}}"{{.ForeignColumn}}"{{"}"}}),
+			dialect.WhereClause(2, {{$ftable.DownSingular}}PrimaryKeyColumns),
 		)
 		values := []interface{}{o.{{$col}}, related.{{$foreignPKeyCols | stringMap (aliasCols $ftable) | join ", related."}}{{"}"}}
 
@@ -75,18 +75,12 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 			fmt.Fprintln(boil.DebugWriter, updateQuery)
 			fmt.Fprintln(boil.DebugWriter, values)
 		}
-		{{else -}}
-		if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
-		}
 		{{end -}}
 
 		{{if $.NoContext -}}
 		if _, err = exec.Exec(updateQuery, values...); err != nil {
 		{{else -}}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		if _, err = boil.ExecContext(ctx, exec, updateQuery, values...); err != nil {
 		{{end -}}
 			return errors.Wrap(err, "failed to update foreign table")
 		}
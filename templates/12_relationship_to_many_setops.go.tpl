@@ -72,8 +72,8 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 		}{{if not .ToJoinTable}} else {
 			updateQuery := fmt.Sprintf(
 				"UPDATE {{$schemaForeignTable}} SET %s WHERE %s",
-				strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.ForeignColumn}}"{{"}"}}),
-				strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$ftable.DownSingular}}PrimaryKeyColumns),
+				dialect.SetParamNames(1, []string{{"{"}}"{{.ForeignColumn}}"{{"}"}}),
+				dialect.WhereClause(2, {{$ftable.DownSingular}}PrimaryKeyColumns),
 			)
 			values := []interface{}{o.{{$col}}, rel.{{$foreignPKeyCols | stringMap (aliasCols $ftable) | join ", rel."}}{{"}"}}
 
@@ -82,18 +82,12 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 				fmt.Fprintln(boil.DebugWriter, updateQuery)
 				fmt.Fprintln(boil.DebugWriter, values)
 			}
-			{{else -}}
-			if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
-			}
 			{{end -}}
 
 			{{if $.NoContext -}}
 			if _, err = exec.Exec(updateQuery, values...); err != nil {
 			{{else -}}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			if _, err = boil.ExecContext(ctx, exec, updateQuery, values...); err != nil {
 			{{end -}}
 				return errors.Wrap(err, "failed to update foreign table")
 			}
@@ -108,7 +102,7 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 
 	{{if .ToJoinTable -}}
 	for _, rel := range related {
-		query := "insert into {{.JoinTable | $.SchemaTable}} ({{.JoinLocalColumn | $.Quotes}}, {{.JoinForeignColumn | $.Quotes}}) values {{if $.Dialect.UseIndexPlaceholders}}($1, $2){{else}}(?, ?){{end}}"
+		query := "insert into {{.JoinTable | $.SchemaTable}} ({{.JoinLocalColumn | $.Quotes}}, {{.JoinForeignColumn | $.Quotes}}) values ({{$.Dialect.Placeholder 1}}, {{$.Dialect.Placeholder 2}})"
 		values := []interface{}{{"{"}}o.{{$col}}, rel.{{$fcol}}}
 
 		{{if $.NoContext -}}
@@ -116,18 +110,12 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 			fmt.Fprintln(boil.DebugWriter, query)
 			fmt.Fprintln(boil.DebugWriter, values)
 		}
-		{{else -}}
-		if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
 		{{end -}}
 
 		{{if $.NoContext -}}
 		_, err = exec.Exec(query, values...)
 		{{else -}}
-		_, err = exec.ExecContext(ctx, query, values...)
+		_, err = boil.ExecContext(ctx, exec, query, values...)
 		{{end -}}
 		if err != nil {
 			return errors.Wrap(err, "failed to insert into join table")
@@ -223,10 +211,10 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}GP({{if not $.NoContext}}
 // Sets related.R.{{$relAlias.Foreign}}'s {{$relAlias.Local}} accordingly.
 func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related ...*{{$ftable.UpSingular}}) error {
 	{{if .ToJoinTable -}}
-	query := "delete from {{.JoinTable | $.SchemaTable}} where {{.JoinLocalColumn | $.Quotes}} = {{if $.Dialect.UseIndexPlaceholders}}$1{{else}}?{{end}}"
+	query := "delete from {{.JoinTable | $.SchemaTable}} where {{.JoinLocalColumn | $.Quotes}} = {{$.Dialect.Placeholder 1}}"
 	values := []interface{}{{"{"}}o.{{$col}}}
 	{{else -}}
-	query := "update {{.ForeignTable | $.SchemaTable}} set {{.ForeignColumn | $.Quotes}} = null where {{.ForeignColumn | $.Quotes}} = {{if $.Dialect.UseIndexPlaceholders}}$1{{else}}?{{end}}"
+	query := "update {{.ForeignTable | $.SchemaTable}} set {{.ForeignColumn | $.Quotes}} = null where {{.ForeignColumn | $.Quotes}} = {{$.Dialect.Placeholder 1}}"
 	values := []interface{}{{"{"}}o.{{$col}}}
 	{{end -}}
 	{{if $.NoContext -}}
@@ -234,18 +222,12 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 		fmt.Fprintln(boil.DebugWriter, query)
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
 	{{end -}}
 
 	{{if $.NoContext -}}
 	_, err := exec.Exec(query, values...)
 	{{else -}}
-	_, err := exec.ExecContext(ctx, query, values...)
+	_, err := boil.ExecContext(ctx, exec, query, values...)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "failed to remove relationships before set")
@@ -318,8 +300,8 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exe
 	var err error
 	{{if .ToJoinTable -}}
 	query := fmt.Sprintf(
-		"delete from {{.JoinTable | $.SchemaTable}} where {{.JoinLocalColumn | $.Quotes}} = {{if $.Dialect.UseIndexPlaceholders}}$1{{else}}?{{end}} and {{.JoinForeignColumn | $.Quotes}} in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+		"delete from {{.JoinTable | $.SchemaTable}} where {{.JoinLocalColumn | $.Quotes}} = {{$.Dialect.Placeholder 1}} and {{.JoinForeignColumn | $.Quotes}} in (%s)",
+		dialect.Placeholders(len(related), 2, 1),
 	)
 	values := []interface{}{{"{"}}o.{{$col}}}
 	for _, rel := range related {
@@ -331,18 +313,12 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exe
 		fmt.Fprintln(boil.DebugWriter, query)
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
 	{{end -}}
 
 	{{if $.NoContext -}}
 	_, err = exec.Exec(query, values...)
 	{{else -}}
-	_, err = exec.ExecContext(ctx, query, values...)
+	_, err = boil.ExecContext(ctx, exec, query, values...)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "failed to remove relationships before set")
@@ -42,23 +42,34 @@ func Find{{$alias.UpSingular}}GP({{if not .NoContext}}ctx context.Context, {{end
 func Find{{$alias.UpSingular}}({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
 	{{$alias.DownSingular}}Obj := &{{$alias.UpSingular}}{}
 
-	sel := "*"
-	if len(selectCols) > 0 {
-		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
-	}
-	query := fmt.Sprintf(
-		"select %s from {{.Table.Name | .SchemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if and .AddSoftDeletes $canSoftDelete}} and {{"deleted_at" | $.Quotes}} is null{{end}}", sel,
-	)
+	q := queries.Raw(find{{$alias.UpSingular}}Query(selectCols), {{$pkNames | join ", "}})
 
-	q := queries.Raw(query, {{$pkNames | join ", "}})
-
-	err := q.Bind({{if not .NoContext}}ctx{{else}}nil{{end}}, exec, {{$alias.DownSingular}}Obj)
+	err := q.BindScan({{if not .NoContext}}ctx{{else}}nil{{end}}, exec, {{$alias.DownSingular}}Obj, {{$alias.DownSingular}}AllColumns, func(rows *sql.Rows, cols []string) error {
+		return scan{{$alias.UpSingular}}(rows, cols, {{$alias.DownSingular}}Obj)
+	})
 	if err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
-			return nil, sql.ErrNoRows
+			return nil, boil.NewNotFoundError("{{.Table.Name}}")
 		}
 		return nil, errors.Wrap(err, "{{.PkgName}}: unable to select from {{.Table.Name}}")
 	}
 
 	return {{$alias.DownSingular}}Obj, nil
 }
+
+// Find{{$alias.UpSingular}}SQL returns the statement and arguments Find{{$alias.UpSingular}} sends
+// to the database, for use as expectations with go-sqlmock and similar
+// libraries.
+func Find{{$alias.UpSingular}}SQL({{$pkArgs}}, selectCols ...string) (string, []driver.Value) {
+	return find{{$alias.UpSingular}}Query(selectCols), []driver.Value{ {{- $pkNames | join ", " -}} }
+}
+
+func find{{$alias.UpSingular}}Query(selectCols []string) string {
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(dialect.QuoteIdentSlice(selectCols), ",")
+	}
+	return fmt.Sprintf(
+		"select %s from {{.Table.Name | .SchemaTable}} where {{.WhereClause 1 .Table.PKey.Columns}}{{if and .AddSoftDeletes $canSoftDelete}} and {{"deleted_at" | $.Quotes}} is null{{end}}", sel,
+	)
+}
@@ -48,52 +48,9 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 
 	nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
 
-	key := makeCacheKey(columns, nzDefaults)
-	{{$alias.DownSingular}}InsertCacheMut.RLock()
-	cache, cached := {{$alias.DownSingular}}InsertCache[key]
-	{{$alias.DownSingular}}InsertCacheMut.RUnlock()
-
-	if !cached {
-		wl, returnColumns := columns.InsertColumnSet(
-			{{$alias.DownSingular}}AllColumns,
-			{{$alias.DownSingular}}ColumnsWithDefault,
-			{{$alias.DownSingular}}ColumnsWithoutDefault,
-			nzDefaults,
-		)
-
-		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, wl)
-		if err != nil {
-			return err
-		}
-		cache.retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, returnColumns)
-		if err != nil {
-			return err
-		}
-		if len(wl) != 0 {
-			cache.query = fmt.Sprintf("INSERT INTO {{$schemaTable}} ({{.LQ}}%s{{.RQ}}) %%sVALUES (%s)%%s", strings.Join(wl, "{{.RQ}},{{.LQ}}"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
-		} else {
-			{{if .Dialect.UseDefaultKeyword -}}
-			cache.query = "INSERT INTO {{$schemaTable}} %sDEFAULT VALUES%s"
-			{{else -}}
-			cache.query = "INSERT INTO {{$schemaTable}} () VALUES ()%s%s"
-			{{end -}}
-		}
-
-		var queryOutput, queryReturning string
-
-		if len(cache.retMapping) != 0 {
-			{{if .Dialect.UseLastInsertID -}}
-			cache.retQuery = fmt.Sprintf("SELECT {{.LQ}}%s{{.RQ}} FROM {{$schemaTable}} WHERE %s", strings.Join(returnColumns, "{{.RQ}},{{.LQ}}"), strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns))
-			{{else -}}
-				{{if .Dialect.UseOutputClause -}}
-			queryOutput = fmt.Sprintf("OUTPUT INSERTED.{{.LQ}}%s{{.RQ}} ", strings.Join(returnColumns, "{{.RQ}},INSERTED.{{.LQ}}"))
-				{{else -}}
-			queryReturning = fmt.Sprintf(" RETURNING {{.LQ}}%s{{.RQ}}", strings.Join(returnColumns, "{{.RQ}},{{.LQ}}"))
-				{{end -}}
-			{{end -}}
-		}
-
-		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	key, cache, cached, err := {{$alias.DownSingular}}InsertStatement(columns, nzDefaults)
+	if err != nil {
+		return err
 	}
 
 	value := reflect.Indirect(reflect.ValueOf(o))
@@ -104,12 +61,6 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 		fmt.Fprintln(boil.DebugWriter, cache.query)
 		fmt.Fprintln(boil.DebugWriter, vals)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
-	}
 	{{end -}}
 
 	{{if .Dialect.UseLastInsertID -}}
@@ -118,17 +69,17 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 	result, err := exec.Exec(cache.query, vals...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
+	result, err := boil.ExecContext(ctx, exec, cache.query, vals...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	_, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-	_, err = exec.ExecContext(ctx, cache.query, vals...)
+	_, err = boil.ExecContext(ctx, exec, cache.query, vals...)
 		{{end -}}
 	{{- end}}
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to insert into {{.Table.Name}}")
+		return errors.Wrap(boil.WrapConstraintErr(err), "{{.PkgName}}: unable to insert into {{.Table.Name}}")
 	}
 
 	{{if $canLastInsertID -}}
@@ -166,18 +117,12 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
 		fmt.Fprintln(boil.DebugWriter, identifierCols...)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.retQuery)
-		fmt.Fprintln(writer, identifierCols...)
-	}
 	{{end -}}
 
 	{{if .NoContext -}}
 	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 	{{else -}}
-	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	err = boil.QueryRowContext(ctx, exec, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
@@ -187,18 +132,18 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 		{{else -}}
-		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+		err = boil.QueryRowContext(ctx, exec, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 		{{end -}}
 	} else {
 		{{if .NoContext -}}
 		_, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-		_, err = exec.ExecContext(ctx, cache.query, vals...)
+		_, err = boil.ExecContext(ctx, exec, cache.query, vals...)
 		{{end -}}
 	}
 
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to insert into {{.Table.Name}}")
+		return errors.Wrap(boil.WrapConstraintErr(err), "{{.PkgName}}: unable to insert into {{.Table.Name}}")
 	}
 	{{end}}
 
@@ -210,6 +155,7 @@ CacheNoHooks:
 		{{$alias.DownSingular}}InsertCache[key] = cache
 		{{$alias.DownSingular}}InsertCacheMut.Unlock()
 	}
+	o.ClearChanges()
 
 	{{if not .NoHooks -}}
 	return o.doAfterInsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
@@ -217,3 +163,166 @@ CacheNoHooks:
 	return nil
 	{{- end}}
 }
+
+// {{$alias.DownSingular}}InsertStatement returns the statement that inserts columns
+// and the non-zero defaults, building it when it isn't cached yet. Insert
+// adds statements that were built to the cache once they have been used.
+func {{$alias.DownSingular}}InsertStatement(columns boil.Columns, nzDefaults []string) (key string, cache insertCache, cached bool, err error) {
+	key = makeCacheKey(columns, nzDefaults)
+	{{$alias.DownSingular}}InsertCacheMut.RLock()
+	cache, cached = {{$alias.DownSingular}}InsertCache[key]
+	{{$alias.DownSingular}}InsertCacheMut.RUnlock()
+
+	if cached {
+		return key, cache, true, nil
+	}
+
+	wl, returnColumns := columns.InsertColumnSet(
+		{{$alias.DownSingular}}AllColumns,
+		{{$alias.DownSingular}}ColumnsWithDefault,
+		{{$alias.DownSingular}}ColumnsWithoutDefault,
+		nzDefaults,
+	)
+	if unknown := strmangle.SetComplement(wl, {{$alias.DownSingular}}AllColumns); len(unknown) != 0 {
+		return "", cache, false, errors.Errorf("{{.PkgName}}: unknown columns %s for inserting into {{.Table.Name}}", strings.Join(unknown, ", "))
+	}
+
+	cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, wl)
+	if err != nil {
+		return "", cache, false, err
+	}
+	cache.retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, returnColumns)
+	if err != nil {
+		return "", cache, false, err
+	}
+	if len(wl) != 0 {
+		cache.query = fmt.Sprintf("INSERT INTO {{$schemaTable}} ({{.LQ}}%s{{.RQ}}) %%sVALUES (%s)%%s", strings.Join(wl, "{{.RQ}},{{.LQ}}"), dialect.Placeholders(len(wl), 1, 1))
+	} else {
+		{{if .Dialect.UseDefaultKeyword -}}
+		cache.query = "INSERT INTO {{$schemaTable}} %sDEFAULT VALUES%s"
+		{{else -}}
+		cache.query = "INSERT INTO {{$schemaTable}} () VALUES ()%s%s"
+		{{end -}}
+	}
+
+	var queryOutput, queryReturning string
+
+	if len(cache.retMapping) != 0 {
+		{{if .Dialect.UseLastInsertID -}}
+		cache.retQuery = fmt.Sprintf("SELECT {{.LQ}}%s{{.RQ}} FROM {{$schemaTable}} WHERE %s", strings.Join(returnColumns, "{{.RQ}},{{.LQ}}"), dialect.WhereClause(1, {{$alias.DownSingular}}PrimaryKeyColumns))
+		{{else -}}
+			{{if .Dialect.UseOutputClause -}}
+		queryOutput = fmt.Sprintf("OUTPUT INSERTED.{{.LQ}}%s{{.RQ}} ", strings.Join(returnColumns, "{{.RQ}},INSERTED.{{.LQ}}"))
+			{{else -}}
+		queryReturning = fmt.Sprintf(" RETURNING {{.LQ}}%s{{.RQ}}", strings.Join(returnColumns, "{{.RQ}},{{.LQ}}"))
+			{{end -}}
+		{{end -}}
+	}
+
+	cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+
+	return key, cache, false, nil
+}
+
+// InsertSQL returns the statement and arguments Insert sends to the database
+// for o, for use as expectations with go-sqlmock and similar libraries.
+// Hooks and automatic timestamps are not applied.
+func (o *{{$alias.UpSingular}}) InsertSQL(columns boil.Columns) (string, []driver.Value, error) {
+	nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
+	_, cache, _, err := {{$alias.DownSingular}}InsertStatement(columns, nzDefaults)
+	if err != nil {
+		return "", nil, err
+	}
+
+	vals := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+	return cache.query, queries.DriverValues(vals), nil
+}
+
+{{- $returning := not .Dialect.UseLastInsertID}}
+{{- $ret := "error"}}
+{{- if $returning}}{{$ret = printf "(%sSlice, error)" $alias.UpSingular}}{{else if not .NoRowsAffected}}{{$ret = "(int64, error)"}}{{end}}
+{{if .AddGlobal -}}
+// Insert{{$alias.UpPlural}}FromQueryG inserts the rows selected by query, using the global executor.
+func Insert{{$alias.UpPlural}}FromQueryG({{if not .NoContext}}ctx context.Context, {{end -}} columns []string, query *queries.Query) {{$ret}} {
+	return Insert{{$alias.UpPlural}}FromQuery({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, columns, query)
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// Insert{{$alias.UpPlural}}FromQueryP inserts the rows selected by query, and panics on error.
+func Insert{{$alias.UpPlural}}FromQueryP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns []string, query *queries.Query) {{if $returning}}{{$alias.UpSingular}}Slice {{else if not .NoRowsAffected}}int64 {{end}}{
+	{{if or $returning (not .NoRowsAffected) -}}
+	o, err := Insert{{$alias.UpPlural}}FromQuery({{if not .NoContext}}ctx, {{end -}} exec, columns, query)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+	{{- else -}}
+	if err := Insert{{$alias.UpPlural}}FromQuery({{if not .NoContext}}ctx, {{end -}} exec, columns, query); err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- end}}
+}
+
+{{end -}}
+
+// Insert{{$alias.UpPlural}}FromQuery inserts the rows selected by query into the
+// given columns of {{.Table.Name}} with a single INSERT ... SELECT, eg. to copy or
+// backfill rows from another table. When query doesn't select anything the
+// columns are selected by name, otherwise it has to select one value for each
+// column. Hooks and automatic timestamps are not applied.
+{{- if $returning}}
+// The inserted rows are returned, including any generated keys and defaults.
+{{- else if not .NoRowsAffected}}
+// The number of inserted rows is returned.
+{{- end}}
+func Insert{{$alias.UpPlural}}FromQuery({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns []string, query *queries.Query) {{$ret}} {
+	if unknown := strmangle.SetComplement(columns, {{$alias.DownSingular}}AllColumns); len(unknown) != 0 {
+		return {{if $returning}}nil, {{else if not .NoRowsAffected}}0, {{end -}} errors.Errorf("{{.PkgName}}: unknown columns %s for inserting into {{.Table.Name}}", strings.Join(unknown, ", "))
+	}
+
+	q, err := queries.InsertFrom(query, "{{$schemaTable}}", columns...)
+	if err != nil {
+		return {{if $returning}}nil, {{else if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to insert into {{.Table.Name}} from query")
+	}
+
+	{{if $returning -}}
+	queries.SetReturning(q, "*")
+
+	var o []*{{$alias.UpSingular}}
+	if err := q.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &o); err != nil {
+		return nil, errors.Wrap(err, "{{.PkgName}}: unable to insert into {{.Table.Name}} from query")
+	}
+
+	return o, nil
+	{{- else -}}
+	{{if .NoRowsAffected -}}
+		{{if .NoContext -}}
+	_, err = q.Exec(exec)
+		{{else -}}
+	_, err = q.ExecContext(ctx, exec)
+		{{end -}}
+	{{else -}}
+		{{if .NoContext -}}
+	result, err := q.Exec(exec)
+		{{else -}}
+	result, err := q.ExecContext(ctx, exec)
+		{{end -}}
+	{{end -}}
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to insert into {{.Table.Name}} from query")
+	}
+
+	{{if not .NoRowsAffected -}}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by insert from query for {{.Table.Name}}")
+	}
+
+	{{end -}}
+
+	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
+	{{- end}}
+}
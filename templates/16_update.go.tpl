@@ -1,5 +1,6 @@
 {{- $alias := .Aliases.Table .Table.Name -}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $colNames := .Table.Columns | columnNames}}
 {{if .AddGlobal -}}
 // UpdateG a single {{$alias.UpSingular}} record using the global executor.
 // See Update for more documentation.
@@ -44,6 +45,9 @@ func (o *{{$alias.UpSingular}}) UpdateGP({{if not .NoContext}}ctx context.Contex
 // Update uses an executor to update the {{$alias.UpSingular}}.
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+// With boil.Infer only the columns set through the SetX methods, including by
+// BeforeUpdate hooks, are updated when there are any, see ChangedColumns.
+// Columns can be set to SQL expressions with columns.WithExpr.
 func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
 	{{- template "timestamp_update_helper" . -}}
 
@@ -54,69 +58,48 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 	}
 	{{end -}}
 
-	key := makeCacheKey(columns, nil)
-	{{$alias.DownSingular}}UpdateCacheMut.RLock()
-	cache, cached := {{$alias.DownSingular}}UpdateCache[key]
-	{{$alias.DownSingular}}UpdateCacheMut.RUnlock()
-
-	if !cached {
-		wl := columns.UpdateColumnSet(
-			{{$alias.DownSingular}}AllColumns,
-			{{$alias.DownSingular}}PrimaryKeyColumns,
-		)
-		{{if .Dialect.UseAutoColumns -}}
-		wl = strmangle.SetComplement(wl, {{$alias.DownSingular}}ColumnsWithAuto)
-		{{end}}
-		{{if not .NoAutoTimestamps}}
-		if !columns.IsWhitelist() {
-			wl = strmangle.SetComplement(wl, []string{"created_at"})
-		}
-		{{end -}}
-		if len(wl) == 0 {
-			return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: unable to update {{.Table.Name}}, could not build whitelist")
+	// After the hooks so the columns they set are written too
+	if columns.IsInfer() {
+		if changed := strmangle.SetComplement(o.ChangedColumns(), {{$alias.DownSingular}}PrimaryKeyColumns); len(changed) != 0 {
+			{{if and (not .NoAutoTimestamps) (containsAny $colNames "updated_at") -}}
+			changed = strmangle.SetMerge(changed, []string{"updated_at"})
+			{{end -}}
+			exprs := columns.Exprs
+			columns = boil.Whitelist(changed...)
+			columns.Exprs = exprs
 		}
+	}
 
-		cache.query = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE %s",
-			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
-			strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}len(wl)+1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns),
-		)
-		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, append(wl, {{$alias.DownSingular}}PrimaryKeyColumns...))
-		if err != nil {
-			return {{if not .NoRowsAffected}}0, {{end -}} err
-		}
+	key, cache, cached, values, err := {{$alias.DownSingular}}UpdateStatement(columns)
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
 	}
 
-	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+	values = append(values, queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)...)
 
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, cache.query)
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, values)
-	}
 	{{end -}}
 
 	{{if .NoRowsAffected -}}
 		{{if .NoContext -}}
 	_, err = exec.Exec(cache.query, values...)
 		{{else -}}
-	_, err = exec.ExecContext(ctx, cache.query, values...)
+	_, err = boil.ExecContext(ctx, exec, cache.query, values...)
 		{{end -}}
 	{{else -}}
 	var result sql.Result
 		{{if .NoContext -}}
 	result, err = exec.Exec(cache.query, values...)
 		{{else -}}
-	result, err = exec.ExecContext(ctx, cache.query, values...)
+	result, err = boil.ExecContext(ctx, exec, cache.query, values...)
 		{{end -}}
 	{{end -}}
 	if err != nil {
-		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to update {{.Table.Name}} row")
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(boil.WrapConstraintErr(boil.WrapRetryable(err)), "{{.PkgName}}: unable to update {{.Table.Name}} row")
 	}
 
 	{{if not .NoRowsAffected -}}
@@ -127,11 +110,12 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 
 	{{end -}}
 
-	if !cached {
+	if !cached && len(columns.Exprs) == 0 {
 		{{$alias.DownSingular}}UpdateCacheMut.Lock()
 		{{$alias.DownSingular}}UpdateCache[key] = cache
 		{{$alias.DownSingular}}UpdateCacheMut.Unlock()
 	}
+	o.ClearChanges()
 
 	{{if not .NoHooks -}}
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} o.doAfterUpdateHooks({{if not .NoContext}}ctx, {{end -}} exec)
@@ -140,6 +124,78 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 	{{- end}}
 }
 
+// {{$alias.DownSingular}}UpdateStatement returns the statement that updates columns,
+// building it when it isn't cached yet. Update adds statements that were
+// built to the cache once they have been used. Statements with expressions
+// aren't cached, args are the arguments of their expressions which come
+// before the values of the mapped fields.
+func {{$alias.DownSingular}}UpdateStatement(columns boil.Columns) (key string, cache updateCache, cached bool, args []interface{}, err error) {
+	if len(columns.Exprs) == 0 {
+		key = makeCacheKey(columns, nil)
+		{{$alias.DownSingular}}UpdateCacheMut.RLock()
+		cache, cached = {{$alias.DownSingular}}UpdateCache[key]
+		{{$alias.DownSingular}}UpdateCacheMut.RUnlock()
+
+		if cached {
+			return key, cache, true, nil, nil
+		}
+	}
+
+	wl := columns.UpdateColumnSet(
+		{{$alias.DownSingular}}AllColumns,
+		{{$alias.DownSingular}}PrimaryKeyColumns,
+	)
+	{{if .Dialect.UseAutoColumns -}}
+	wl = strmangle.SetComplement(wl, {{$alias.DownSingular}}ColumnsWithAuto)
+	{{end}}
+	{{if not .NoAutoTimestamps}}
+	if !columns.IsWhitelist() {
+		wl = strmangle.SetComplement(wl, []string{"created_at"})
+	}
+	{{end -}}
+
+	exprs := make(M, len(columns.Exprs))
+	for name, expr := range columns.Exprs {
+		exprs[name] = expr
+		wl = strmangle.SetComplement(wl, []string{name})
+	}
+	if len(wl) == 0 && len(exprs) == 0 {
+		return "", cache, false, nil, errors.New("{{.PkgName}}: unable to update {{.Table.Name}}, could not build whitelist")
+	}
+
+	set, args := queries.UpdateSet(&dialect, exprs, 1)
+	if len(wl) != 0 {
+		if len(set) != 0 {
+			set += ", "
+		}
+		set += dialect.SetParamNames(len(args)+1, wl)
+	}
+
+	cache.query = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE %s",
+		set,
+		dialect.WhereClause(len(args)+len(wl)+1, {{$alias.DownSingular}}PrimaryKeyColumns),
+	)
+	cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, append(wl, {{$alias.DownSingular}}PrimaryKeyColumns...))
+	if err != nil {
+		return "", cache, false, nil, err
+	}
+
+	return key, cache, false, args, nil
+}
+
+// UpdateSQL returns the statement and arguments Update sends to the database
+// for o, for use as expectations with go-sqlmock and similar libraries.
+// Hooks and automatic timestamps are not applied.
+func (o *{{$alias.UpSingular}}) UpdateSQL(columns boil.Columns) (string, []driver.Value, error) {
+	_, cache, _, values, err := {{$alias.DownSingular}}UpdateStatement(columns)
+	if err != nil {
+		return "", nil, err
+	}
+
+	values = append(values, queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)...)
+	return cache.query, queries.DriverValues(values), nil
+}
+
 {{if .AddPanic -}}
 // UpdateAllP updates all rows with matching column names, and panics on error.
 func (q {{$alias.DownSingular}}Query) UpdateAllP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, cols M) {{if not .NoRowsAffected}}int64{{end -}} {
@@ -166,6 +222,7 @@ func (q {{$alias.DownSingular}}Query) UpdateAllG({{if not .NoContext}}ctx contex
 
 
 // UpdateAll updates all rows with the specified column values.
+// A value can be a boil.Expr to set a column to an SQL expression.
 func (q {{$alias.DownSingular}}Query) UpdateAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, cols M) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
 	queries.SetUpdate(q.Query, cols)
 
@@ -183,7 +240,7 @@ func (q {{$alias.DownSingular}}Query) UpdateAll({{if .NoContext}}exec boil.Execu
 		{{end -}}
 	{{end -}}
 	if err != nil {
-		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to update all for {{.Table.Name}}")
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(boil.WrapConstraintErr(boil.WrapRetryable(err)), "{{.PkgName}}: unable to update all for {{.Table.Name}}")
 	}
 
 	{{if not .NoRowsAffected -}}
@@ -236,6 +293,9 @@ func (o {{$alias.UpSingular}}Slice) UpdateAllP({{if .NoContext}}exec boil.Execut
 {{end -}}
 
 // UpdateAll updates all rows with the specified column values, using an executor.
+// A value can be a boil.Expr to set a column to an SQL expression. Slices with
+// more primary key values than the dialect allows in one statement are updated
+// with several, which are only atomic together when exec is a transaction.
 func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, cols M) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
 	ln := int64(len(o))
 	if ln == 0 {
@@ -246,14 +306,34 @@ func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executo
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: update all requires at least one column argument")
 	}
 
-	colNames := make([]string, len(cols))
-	args := make([]interface{}, len(cols))
+	set, args := queries.UpdateSet(&dialect, cols, 1)
+	setArgs := len(args)
+
+	// Split slices whose primary keys don't fit in a single statement
+	if size := queries.RowsPerStatement(&dialect, setArgs, len({{$alias.DownSingular}}PrimaryKeyColumns)); size > 0 && len(o) > size {
+		{{if not .NoRowsAffected -}}
+		var rowsAff int64
+		{{end -}}
+		for start := 0; start < len(o); start += size {
+			end := start + size
+			if end > len(o) {
+				end = len(o)
+			}
+
+			{{if .NoRowsAffected -}}
+			if err := o[start:end].UpdateAll({{if not .NoContext}}ctx, {{end -}} exec, cols); err != nil {
+				return err
+			}
+			{{- else -}}
+			n, err := o[start:end].UpdateAll({{if not .NoContext}}ctx, {{end -}} exec, cols)
+			rowsAff += n
+			if err != nil {
+				return rowsAff, err
+			}
+			{{- end}}
+		}
 
-	i := 0
-	for name, value := range cols {
-		colNames[i] = name
-		args[i] = value
-		i++
+		return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 	}
 
 	// Append all of the primary key values for each column
@@ -263,37 +343,31 @@ func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executo
 	}
 
 	sql := fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE %s",
-		strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, colNames),
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}len(colNames)+1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)))
+		set,
+		dialect.WhereClauseRepeated(setArgs+1, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)))
 
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
 		fmt.Fprintln(boil.DebugWriter, args...)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args...)
-	}
 	{{end -}}
 
 	{{if .NoRowsAffected -}}
 		{{if .NoContext -}}
 	_, err := exec.Exec(sql, args...)
 		{{else -}}
-	_, err := exec.ExecContext(ctx, sql, args...)
+	_, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	result, err := exec.Exec(sql, args...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, sql, args...)
+	result, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{end -}}
 	if err != nil {
-		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to update all in {{$alias.DownSingular}} slice")
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(boil.WrapConstraintErr(boil.WrapRetryable(err)), "{{.PkgName}}: unable to update all in {{$alias.DownSingular}} slice")
 	}
 
 	{{if not .NoRowsAffected -}}
@@ -65,13 +65,13 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 	)
 	if hardDelete {
 		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$alias.DownSingular}}PrimaryKeyMapping)
-		sql = "DELETE FROM {{$schemaTable}} WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}"
+		sql = "DELETE FROM {{$schemaTable}} WHERE {{.WhereClause 1 .Table.PKey.Columns}}"
 	} else {
 		currTime := time.Now().In(boil.GetLocation())
-		o.DeletedAt = null.TimeFrom(currTime)
+		{{if .NullablePointers}}o.DeletedAt = &currTime{{else}}o.DeletedAt = null.TimeFrom(currTime){{end}}
 		wl := []string{"deleted_at"}
-		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 2 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}",
-			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
+		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE {{.WhereClause 2 .Table.PKey.Columns}}",
+			dialect.SetParamNames(1, wl),
 		)
 		valueMapping, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, append(wl, {{$alias.DownSingular}}PrimaryKeyColumns...))
 		if err != nil {
@@ -81,7 +81,7 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 	}
 	{{else -}}
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$alias.DownSingular}}PrimaryKeyMapping)
-	sql := "DELETE FROM {{$schemaTable}} WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}"
+	sql := "DELETE FROM {{$schemaTable}} WHERE {{.WhereClause 1 .Table.PKey.Columns}}"
 	{{- end}}
 
 	{{if .NoContext -}}
@@ -89,25 +89,19 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 		fmt.Fprintln(boil.DebugWriter, sql)
 		fmt.Fprintln(boil.DebugWriter, args...)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args...)
-	}
 	{{end -}}
 
 	{{if .NoRowsAffected -}}
 		{{if .NoContext -}}
 	_, err := exec.Exec(sql, args...)
 		{{else -}}
-	_, err := exec.ExecContext(ctx, sql, args...)
+	_, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	result, err := exec.Exec(sql, args...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, sql, args...)
+	result, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{end -}}
 	if err != nil {
@@ -198,6 +192,129 @@ func (q {{$alias.DownSingular}}Query) DeleteAll({{if .NoContext}}exec boil.Execu
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 }
 
+{{- $returning := and (not .Dialect.UseLastInsertID) (not .Dialect.UseOutputClause)}}
+{{if .AddGlobal -}}
+// DeleteAllReturningG deletes all matching rows and returns them, using the global executor.
+func (q {{$alias.DownSingular}}Query) DeleteAllReturningG({{if not .NoContext}}ctx context.Context{{if $soft}}, {{end}}{{end}}{{if $soft}}hardDelete bool{{end}}) ({{$alias.UpSingular}}Slice, error) {
+	return q.DeleteAllReturning({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}{{if $soft}}, hardDelete{{end}})
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// DeleteAllReturningP deletes all matching rows and returns them, and panics on error.
+func (q {{$alias.DownSingular}}Query) DeleteAllReturningP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{$alias.UpSingular}}Slice {
+	o, err := q.DeleteAllReturning({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+{{end -}}
+
+// DeleteAllReturning deletes all matching rows and returns them, eg. to pop
+// rows off a queue table or to archive them.
+{{- if $returning}}
+// The rows come from the RETURNING clause of the delete.
+{{- else}}
+// The rows are selected and then deleted by their primary key, inside a
+// transaction when exec can begin one, otherwise exec should already be a
+// transaction.
+{{- if .Dialect.UseTopClause}}
+// The selected rows aren't locked, so concurrent calls can return the same
+// rows and this isn't safe for popping rows off a queue.
+{{- else}}
+// The rows are selected FOR UPDATE unless the query has a locking clause
+// already, so concurrent calls wait for each other instead of returning the
+// same rows. Use qm.For("UPDATE SKIP LOCKED") to skip the locked rows instead
+// where the database supports it.
+{{- end}}
+{{- end}}
+func (q {{$alias.DownSingular}}Query) DeleteAllReturning({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) ({{$alias.UpSingular}}Slice, error) {
+	if q.Query == nil {
+		return nil, errors.New("{{.PkgName}}: no {{$alias.DownSingular}}Query provided for delete all returning")
+	}
+
+	{{if $returning -}}
+	{{if $soft -}}
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+	{{else -}}
+	queries.SetDelete(q.Query)
+	{{end -}}
+	queries.SetReturning(q.Query, "*")
+
+	var o []*{{$alias.UpSingular}}
+	if err := q.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &o); err != nil {
+		return nil, errors.Wrap(err, "{{.PkgName}}: unable to delete all from {{.Table.Name}}")
+	}
+
+	return o, nil
+	{{- else -}}
+	{{if not .Dialect.UseTopClause -}}
+	if len(queries.GetFor(q.Query)) == 0 {
+		queries.SetFor(q.Query, "UPDATE")
+	}
+
+	{{end -}}
+	var o {{$alias.UpSingular}}Slice
+	run := func({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+		var err error
+		o, err = q.All({{if not .NoContext}}ctx, {{end -}} exec)
+		if err != nil {
+			return err
+		}
+
+		{{if .NoRowsAffected -}}
+		return o.DeleteAll({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}})
+		{{- else -}}
+		_, err = o.DeleteAll({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}})
+		return err
+		{{- end}}
+	}
+
+	{{if .NoContext -}}
+	if beginner, ok := exec.(boil.Beginner); ok {
+		tx, err := beginner.Begin()
+		if err != nil {
+			return nil, errors.Wrap(err, "{{.PkgName}}: unable to begin transaction for delete all returning")
+		}
+		if err = run(tx); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if err = tx.Commit(); err != nil {
+			return nil, errors.Wrap(err, "{{.PkgName}}: unable to commit delete all returning")
+		}
+
+		return o, nil
+	}
+
+	if err := run(exec); err != nil {
+		return nil, err
+	}
+	{{- else -}}
+	var err error
+	if _, ok := exec.(boil.ContextBeginner); ok {
+		err = boil.Transact(ctx, exec, run)
+	} else {
+		err = run(ctx, exec)
+	}
+	if err != nil {
+		return nil, err
+	}
+	{{- end}}
+
+	return o, nil
+	{{- end}}
+}
+
 {{if .AddGlobal -}}
 // DeleteAllG deletes all rows in the slice.
 func (o {{$alias.UpSingular}}Slice) DeleteAllG({{if not .NoContext}}ctx context.Context{{if $soft}}, hardDelete bool{{end}}{{else}}{{if $soft}}hardDelete bool{{end}}{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
@@ -236,12 +353,19 @@ func (o {{$alias.UpSingular}}Slice) DeleteAllGP({{if not .NoContext}}ctx context
 
 {{end -}}
 
-// DeleteAll deletes all rows in the slice, using an executor.
+// DeleteAll deletes all rows in the slice, using an executor. Slices with more
+// primary key values than the dialect allows in one statement are deleted with
+// several, see DeleteAllChunked.
 func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
 	if len(o) == 0 {
 		return {{if not .NoRowsAffected}}0, {{end -}} nil
 	}
 
+	// Split slices whose primary keys don't fit in a single statement
+	if size := queries.RowsPerStatement(&dialect, 1, len({{$alias.DownSingular}}PrimaryKeyColumns)); size > 0 && len(o) > size {
+		return o.DeleteAllChunked({{if not .NoContext}}ctx, {{end -}} exec, size, 0{{if $soft}}, hardDelete{{end}})
+	}
+
 	{{if not .NoHooks -}}
 	if len({{$alias.DownSingular}}BeforeDeleteHooks) != 0 {
 		for _, obj := range o {
@@ -263,18 +387,18 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
     		args = append(args, pkeyArgs...)
     	}
 		sql = "DELETE FROM {{$schemaTable}} WHERE " +
-			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o))
+			dialect.WhereClauseRepeated(1, {{$alias.DownSingular}}PrimaryKeyColumns, len(o))
 	} else {
 		currTime := time.Now().In(boil.GetLocation())
 		for _, obj := range o {
 			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$alias.DownSingular}}PrimaryKeyMapping)
 			args = append(args, pkeyArgs...)
-			obj.DeletedAt = null.TimeFrom(currTime)
+			{{if .NullablePointers}}queries.SetScanner(&obj.DeletedAt, currTime){{else}}obj.DeletedAt = null.TimeFrom(currTime){{end}}
 		}
 		wl := []string{"deleted_at"}
 		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE " +
-			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)),
-			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
+			dialect.WhereClauseRepeated(2, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)),
+			dialect.SetParamNames(1, wl),
 		)
 		args = append([]interface{}{currTime}, args...)
 	}
@@ -286,7 +410,7 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 	}
 
 	sql := "DELETE FROM {{$schemaTable}} WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o))
+		dialect.WhereClauseRepeated(1, {{$alias.DownSingular}}PrimaryKeyColumns, len(o))
 	{{- end}}
 
 	{{if .NoContext -}}
@@ -294,25 +418,19 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 		fmt.Fprintln(boil.DebugWriter, sql)
 		fmt.Fprintln(boil.DebugWriter, args)
 	}
-	{{else -}}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args)
-	}
 	{{end -}}
 
 	{{if .NoRowsAffected -}}
 		{{if .NoContext -}}
 	_, err := exec.Exec(sql, args...)
 		{{else -}}
-	_, err := exec.ExecContext(ctx, sql, args...)
+	_, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	result, err := exec.Exec(sql, args...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, sql, args...)
+	result, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{end -}}
 	if err != nil {
@@ -339,3 +457,108 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 }
+
+{{if .AddGlobal -}}
+// DeleteAllChunkedG deletes all rows in the slice in chunks, using the global executor.
+func (o {{$alias.UpSingular}}Slice) DeleteAllChunkedG({{if not .NoContext}}ctx context.Context, {{end -}} chunkSize int, pause time.Duration{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	return o.DeleteAllChunked({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, chunkSize, pause{{if $soft}}, hardDelete{{end}})
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// DeleteAllChunkedP deletes all rows in the slice in chunks, and panics on error.
+func (o {{$alias.UpSingular}}Slice) DeleteAllChunkedP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, chunkSize int, pause time.Duration{{if $soft}}, hardDelete bool{{end}}) {{if not .NoRowsAffected}}int64{{end -}} {
+	{{if not .NoRowsAffected}}rowsAff, {{end -}} err := o.DeleteAllChunked({{if not .NoContext}}ctx, {{end -}} exec, chunkSize, pause{{if $soft}}, hardDelete{{end}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- if not .NoRowsAffected}}
+
+	return rowsAff
+	{{end -}}
+}
+
+{{end -}}
+
+// DeleteAllChunked deletes all rows in the slice with DeleteAll, chunkSize rows
+// at a time, so purging a lot of rows doesn't hold locks for one long statement.
+// It waits pause between chunks, zero doesn't wait. Each chunk is committed on
+// its own unless exec is a transaction, so when an error is returned the chunks
+// before it have been deleted already{{if not .NoRowsAffected}} and are counted in the rows affected{{end}}.
+func (o {{$alias.UpSingular}}Slice) DeleteAllChunked({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, chunkSize int, pause time.Duration{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	if chunkSize <= 0 {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: chunk size for deleting {{.Table.Name}} must be positive")
+	}
+
+	{{if not .NoRowsAffected -}}
+	var rowsAff int64
+	{{end -}}
+	for start := 0; start < len(o); start += chunkSize {
+		if start != 0 && pause > 0 {
+			{{if .NoContext -}}
+			time.Sleep(pause)
+			{{- else -}}
+			select {
+			case <-ctx.Done():
+				return {{if not .NoRowsAffected}}rowsAff, {{end -}} ctx.Err()
+			case <-time.After(pause):
+			}
+			{{- end}}
+		}
+
+		end := start + chunkSize
+		if end > len(o) {
+			end = len(o)
+		}
+
+		{{if .NoRowsAffected -}}
+		if err := o[start:end].DeleteAll({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}}); err != nil {
+			return err
+		}
+		{{- else -}}
+		n, err := o[start:end].DeleteAll({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}})
+		rowsAff += n
+		if err != nil {
+			return rowsAff, err
+		}
+		{{- end}}
+	}
+
+	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
+}
+
+{{if .AddGlobal -}}
+// Truncate{{$alias.UpPlural}}G empties the {{.Table.Name}} table, using the global executor.
+func Truncate{{$alias.UpPlural}}G({{if not .NoContext}}ctx context.Context, {{end -}} opts boil.TruncateOptions) error {
+	return Truncate{{$alias.UpPlural}}({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, opts)
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// Truncate{{$alias.UpPlural}}P empties the {{.Table.Name}} table, and panics on error.
+func Truncate{{$alias.UpPlural}}P({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, opts boil.TruncateOptions) {
+	if err := Truncate{{$alias.UpPlural}}({{if not .NoContext}}ctx, {{end -}} exec, opts); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// Truncate{{$alias.UpPlural}} empties the {{.Table.Name}} table with TRUNCATE TABLE, which
+// is quicker than deleting every row, eg. to reset data between tests. See
+// boil.TruncateOptions for restarting identities, cascading and turning off
+// foreign key checks. Hooks aren't run.
+func Truncate{{$alias.UpPlural}}({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, opts boil.TruncateOptions) error {
+	{{if .NoContext -}}
+	err := queries.Truncate(exec, &dialect, "{{$schemaTable}}", opts)
+	{{- else -}}
+	err := queries.TruncateContext(ctx, exec, &dialect, "{{$schemaTable}}", opts)
+	{{- end}}
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to truncate {{.Table.Name}}")
+	}
+
+	return nil
+}
@@ -48,6 +48,8 @@ func (o *{{$alias.UpSingular}}) Reload({{if .NoContext}}exec boil.Executor{{else
 {{if .AddGlobal -}}
 // ReloadAllG refetches every row with matching primary key column values
 // and overwrites the original object slice with the newly updated slice.
+// Slices with more primary key values than the dialect allows in one statement
+// are fetched with several.
 func (o *{{$alias.UpSingular}}Slice) ReloadAllG({{if not .NoContext}}ctx context.Context{{end}}) error {
 	if o == nil {
 		return errors.New("{{.PkgName}}: empty {{$alias.UpSingular}}Slice provided for reload all")
@@ -89,6 +91,26 @@ func (o *{{$alias.UpSingular}}Slice) ReloadAll({{if .NoContext}}exec boil.Execut
 		return nil
 	}
 
+	// Split slices whose primary keys don't fit in a single statement
+	if size := queries.RowsPerStatement(&dialect, 0, len({{$alias.DownSingular}}PrimaryKeyColumns)); size > 0 && len(*o) > size {
+		slice := make({{$alias.UpSingular}}Slice, 0, len(*o))
+		for start := 0; start < len(*o); start += size {
+			end := start + size
+			if end > len(*o) {
+				end = len(*o)
+			}
+
+			chunk := (*o)[start:end]
+			if err := chunk.ReloadAll({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+				return err
+			}
+			slice = append(slice, chunk...)
+		}
+
+		*o = slice
+		return nil
+	}
+
 	slice := {{$alias.UpSingular}}Slice{}
 	var args []interface{}
 	for _, obj := range *o {
@@ -97,7 +119,7 @@ func (o *{{$alias.UpSingular}}Slice) ReloadAll({{if .NoContext}}exec boil.Execut
 	}
 
 	sql := "SELECT {{$schemaTable}}.* FROM {{$schemaTable}} WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(*o)){{if and .AddSoftDeletes $canSoftDelete}} +
+		dialect.WhereClauseRepeated(1, {{$alias.DownSingular}}PrimaryKeyColumns, len(*o)){{if and .AddSoftDeletes $canSoftDelete}} +
 		"and {{"deleted_at" | $.Quotes}} is null"
 		{{- end}}
 
@@ -0,0 +1,301 @@
+{{- if .Table.IsJoinTable -}}
+{{- else -}}
+	{{- range $poly := .PolymorphicSources -}}
+		{{- $ltable := $.Aliases.Table $poly.Table -}}
+		{{- $typeField := $ltable.Column $poly.TypeColumn -}}
+		{{- $idField := $ltable.Column $poly.IDColumn -}}
+		{{- $idColumn := (getTable $.Tables $poly.Table).GetColumn $poly.IDColumn -}}
+		{{- $arg := printf "maybe%s" $ltable.UpSingular }}
+// {{$poly.Name}} finds the row that {{$poly.TypeColumn}} and {{$poly.IDColumn}} refer to,
+// it is one of: {{range $i, $type := $poly.Types}}{{if $i}}, {{end}}*{{($.Aliases.Table $type.Table).UpSingular}}{{end}}.
+func (o *{{$ltable.UpSingular}}) {{$poly.Name}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (interface{}, error) {
+	switch {
+	{{range $type := $poly.Types -}}
+	case queries.Equal(o.{{$typeField}}, {{printf "%q" $type.Value}}):
+		obj, err := o.{{$type.Local}}().One({{if not $.NoContext}}ctx, {{end -}} exec)
+		if err != nil {
+			return nil, err
+		}
+		return obj, nil
+	{{end -}}
+	}
+
+	return nil, errors.Errorf("{{$.PkgName}}: unknown {{$poly.TypeColumn}} %v", o.{{$typeField}})
+}
+
+// Load{{$poly.Name}} eager loads the rows of every type {{$poly.TypeColumn}}
+// can refer to, each type is loaded with a single query. The mods are
+// applied to the query of each type.
+func ({{$ltable.DownSingular}}L) Load{{$poly.Name}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
+	var l {{$ltable.DownSingular}}L
+	{{range $type := $poly.Types -}}
+	if err := l.Load{{$type.Local}}({{if not $.NoContext}}ctx, {{end -}} e, singular, {{$arg}}, mods); err != nil {
+		return err
+	}
+	{{end -}}
+	return nil
+}
+		{{range $type := $poly.Types -}}
+			{{- $ftable := $.Aliases.Table $type.Table -}}
+			{{- $pkField := $ftable.Column $type.Column -}}
+			{{- $schemaForeignTable := $type.Table | $.SchemaTable -}}
+			{{- $canSoftDelete := (getTable $.Tables $type.Table).CanSoftDelete }}
+// {{$type.Local}} pointed to by {{$poly.IDColumn}} when {{$poly.TypeColumn}} is "{{$type.Value}}",
+// the query finds nothing for the other types.
+func (o *{{$ltable.UpSingular}}) {{$type.Local}}(mods ...qm.QueryMod) ({{$ftable.DownSingular}}Query) {
+	queryMods := []qm.QueryMod{
+		qm.Where("{{$type.Column | $.Quotes}} = ?", o.{{$idField}}),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereIsNull("deleted_at"),
+		{{- end}}
+	}
+	if !queries.Equal(o.{{$typeField}}, {{printf "%q" $type.Value}}) {
+		queryMods = append(queryMods, qm.Where("1=0"))
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := {{$ftable.UpPlural}}(queryMods...)
+	queries.SetFrom(query.Query, "{{$schemaForeignTable}}")
+
+	return query
+}
+
+// Load{{$type.Local}} allows an eager lookup of values, cached into the
+// loaded structs of the objects. Only objects whose {{$poly.TypeColumn}} is
+// "{{$type.Value}}" are loaded into.
+func ({{$ltable.DownSingular}}L) Load{{$type.Local}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
+	var slice []*{{$ltable.UpSingular}}
+
+	if singular {
+		slice = []*{{$ltable.UpSingular}}{ {{- $arg}}.(*{{$ltable.UpSingular}})}
+	} else {
+		slice = *{{$arg}}.(*[]*{{$ltable.UpSingular}})
+	}
+
+	args := make([]interface{}, 0, 1)
+	Outer:
+	for _, obj := range slice {
+		if obj.R == nil {
+			obj.R = &{{$ltable.DownSingular}}R{}
+		}
+		if !queries.Equal(obj.{{$typeField}}, {{printf "%q" $type.Value}}) {
+			continue
+		}
+		{{if $idColumn.Nullable -}}
+		if queries.IsNil(obj.{{$idField}}) {
+			continue
+		}
+		{{end -}}
+
+		for _, a := range args {
+			if queries.Equal(a, obj.{{$idField}}) {
+				continue Outer
+			}
+		}
+
+		args = append(args, obj.{{$idField}})
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From("{{$schemaForeignTable}}"),
+		qm.WhereIn("{{$schemaForeignTable}}.{{$type.Column | $.Quotes}} in ?", args...),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{"deleted_at" | $.Quotes}}"),
+		{{- end}}
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	var resultSlice []*{{$ftable.UpSingular}}
+	for _, query := range queries.SplitIn(query) {
+		{{if $.NoContext -}}
+		results, err := query.Query(e)
+		{{else -}}
+		results, err := query.QueryContext(ctx, e)
+		{{end -}}
+		if err != nil {
+			return errors.Wrap(err, "failed to eager load {{$ftable.UpSingular}}")
+		}
+
+		if err = queries.Bind(results, &resultSlice); err != nil {
+			return errors.Wrap(err, "failed to bind eager loaded slice {{$ftable.UpSingular}}")
+		}
+
+		if err = results.Close(); err != nil {
+			return errors.Wrap(err, "failed to close results of eager load for {{$type.Table}}")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{$type.Table}}")
+		}
+	}
+
+	{{if not $.NoHooks -}}
+	if len({{$ftable.DownSingular}}AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks({{if $.NoContext}}e{{else}}ctx, e{{end}}); err != nil {
+				return err
+			}
+		}
+	}
+	{{- end}}
+
+	for _, local := range slice {
+		if !queries.Equal(local.{{$typeField}}, {{printf "%q" $type.Value}}) {
+			continue
+		}
+
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.{{$idField}}, foreign.{{$pkField}}) {
+				local.R.{{$type.Local}} = foreign
+				{{if not $.NoBackReferencing -}}
+				if foreign.R == nil {
+					foreign.R = &{{$ftable.DownSingular}}R{}
+				}
+				foreign.R.{{$type.Foreign}} = append(foreign.R.{{$type.Foreign}}, local)
+				{{end -}}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+		{{end -}}{{/* range types */}}
+	{{- end -}}{{/* range sources */}}
+
+	{{- range $target := .PolymorphicTargets -}}
+		{{- $ltable := $.Aliases.Table $target.Table -}}
+		{{- $ftable := $.Aliases.Table $target.Type.Table -}}
+		{{- $pkField := $ftable.Column $target.Type.Column -}}
+		{{- $idField := $ltable.Column $target.IDColumn -}}
+		{{- $schemaLocalTable := $target.Table | $.SchemaTable -}}
+		{{- $canSoftDelete := (getTable $.Tables $target.Table).CanSoftDelete -}}
+		{{- $arg := printf "maybe%s" $ftable.UpSingular }}
+// {{$target.Type.Foreign}} retrieves all the {{$ltable.DownPlural}} whose {{$target.TypeColumn}} is
+// "{{$target.Type.Value}}" and whose {{$target.IDColumn}} refers to this {{$ftable.DownSingular}}.
+func (o *{{$ftable.UpSingular}}) {{$target.Type.Foreign}}(mods ...qm.QueryMod) {{$ltable.DownSingular}}Query {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("{{$schemaLocalTable}}.{{$target.TypeColumn | $.Quotes}}=?", {{printf "%q" $target.Type.Value}}),
+		qm.Where("{{$schemaLocalTable}}.{{$target.IDColumn | $.Quotes}}=?", o.{{$pkField}}),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereIsNull("{{$schemaLocalTable}}.{{"deleted_at" | $.Quotes}}"),
+		{{- end}}
+	)
+
+	query := {{$ltable.UpPlural}}(queryMods...)
+	queries.SetFrom(query.Query, "{{$schemaLocalTable}}")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"{{$schemaLocalTable}}.*"})
+	}
+
+	return query
+}
+
+// Load{{$target.Type.Foreign}} allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for the "{{$target.Type.Value}}" type of the
+// polymorphic {{$target.Name}} association.
+func ({{$ftable.DownSingular}}L) Load{{$target.Type.Foreign}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
+	var slice []*{{$ftable.UpSingular}}
+
+	if singular {
+		slice = []*{{$ftable.UpSingular}}{ {{- $arg}}.(*{{$ftable.UpSingular}})}
+	} else {
+		slice = *{{$arg}}.(*[]*{{$ftable.UpSingular}})
+	}
+
+	args := make([]interface{}, 0, 1)
+	Outer:
+	for _, obj := range slice {
+		if obj.R == nil {
+			obj.R = &{{$ftable.DownSingular}}R{}
+		}
+
+		for _, a := range args {
+			if queries.Equal(a, obj.{{$pkField}}) {
+				continue Outer
+			}
+		}
+
+		args = append(args, obj.{{$pkField}})
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From("{{$schemaLocalTable}}"),
+		qm.Where("{{$schemaLocalTable}}.{{$target.TypeColumn | $.Quotes}}=?", {{printf "%q" $target.Type.Value}}),
+		qm.WhereIn("{{$schemaLocalTable}}.{{$target.IDColumn | $.Quotes}} in ?", args...),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereIsNull("{{$schemaLocalTable}}.{{"deleted_at" | $.Quotes}}"),
+		{{- end}}
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	var resultSlice []*{{$ltable.UpSingular}}
+	for _, query := range queries.SplitIn(query) {
+		{{if $.NoContext -}}
+		results, err := query.Query(e)
+		{{else -}}
+		results, err := query.QueryContext(ctx, e)
+		{{end -}}
+		if err != nil {
+			return errors.Wrap(err, "failed to eager load {{$target.Table}}")
+		}
+
+		if err = queries.Bind(results, &resultSlice); err != nil {
+			return errors.Wrap(err, "failed to bind eager loaded slice {{$target.Table}}")
+		}
+
+		if err = results.Close(); err != nil {
+			return errors.Wrap(err, "failed to close results in eager load on {{$target.Table}}")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{$target.Table}}")
+		}
+	}
+
+	{{if not $.NoHooks -}}
+	if len({{$ltable.DownSingular}}AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks({{if $.NoContext}}e{{else}}ctx, e{{end}}); err != nil {
+				return err
+			}
+		}
+	}
+	{{- end}}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.{{$pkField}}, foreign.{{$idField}}) {
+				local.R.{{$target.Type.Foreign}} = append(local.R.{{$target.Type.Foreign}}, foreign)
+				{{if not $.NoBackReferencing -}}
+				if foreign.R == nil {
+					foreign.R = &{{$ltable.DownSingular}}R{}
+				}
+				foreign.R.{{$target.Type.Local}} = local
+				{{end -}}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+	{{end -}}{{/* range targets */}}
+{{- end -}}{{/* join table */}}
@@ -0,0 +1,664 @@
+{{- if .Table.IsJoinTable -}}
+{{- else -}}
+	{{- range $fkey := .Table.CompositeFKeys -}}
+		{{- $ltable := $.Aliases.Table $fkey.Table -}}
+		{{- $ftable := $.Aliases.Table $fkey.ForeignTable -}}
+		{{- $rel := $ltable.Relationship $fkey.Name -}}
+		{{- $localTable := getTable $.Tables $fkey.Table -}}
+		{{- $arg := printf "maybe%s" $ltable.UpSingular -}}
+		{{- $schemaTable := $fkey.Table | $.SchemaTable -}}
+		{{- $schemaForeignTable := $fkey.ForeignTable | $.SchemaTable -}}
+		{{- $canSoftDelete := (getTable $.Tables $fkey.ForeignTable).CanSoftDelete }}
+// {{$rel.Foreign}} pointed to by the foreign key on {{$fkey.Columns | join ", "}}.
+func (o *{{$ltable.UpSingular}}) {{$rel.Foreign}}(mods ...qm.QueryMod) ({{$ftable.DownSingular}}Query) {
+	queryMods := []qm.QueryMod{
+		{{range $i, $col := $fkey.Columns -}}
+		qm.Where("{{index $fkey.ForeignColumns $i | $.Quotes}} = ?", o.{{$ltable.Column $col}}),
+		{{end -}}
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereIsNull("deleted_at"),
+		{{- end}}
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := {{$ftable.UpPlural}}(queryMods...)
+	queries.SetFrom(query.Query, "{{$schemaForeignTable}}")
+
+	return query
+}
+
+// Load{{$rel.Foreign}} allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship over a
+// foreign key with several columns.
+func ({{$ltable.DownSingular}}L) Load{{$rel.Foreign}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
+	var slice []*{{$ltable.UpSingular}}
+
+	if singular {
+		slice = []*{{$ltable.UpSingular}}{ {{- $arg}}.(*{{$ltable.UpSingular}})}
+	} else {
+		slice = *{{$arg}}.(*[]*{{$ltable.UpSingular}})
+	}
+
+	var args [][]interface{}
+	Outer:
+	for _, obj := range slice {
+		if obj.R == nil {
+			obj.R = &{{$ltable.DownSingular}}R{}
+		}
+		{{range $col := $fkey.Columns -}}
+		{{if ($localTable.GetColumn $col).Nullable -}}
+		if queries.IsNil(obj.{{$ltable.Column $col}}) {
+			continue
+		}
+		{{end -}}
+		{{end}}
+		for _, a := range args {
+			if {{range $i, $col := $fkey.Columns}}{{if $i}} && {{end}}queries.Equal(a[{{$i}}], obj.{{$ltable.Column $col}}){{end}} {
+				continue Outer
+			}
+		}
+
+		args = append(args, []interface{}{ {{- range $i, $col := $fkey.Columns}}{{if $i}}, {{end}}obj.{{$ltable.Column $col}}{{end -}} })
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From("{{$schemaForeignTable}}"),
+		qmhelper.WhereTuplesIn([]string{ {{- range $i, $col := $fkey.ForeignColumns}}{{if $i}}, {{end}}"{{$schemaForeignTable}}.{{$col | $.Quotes}}"{{end -}} }, args),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{"deleted_at" | $.Quotes}}"),
+		{{- end}}
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	var resultSlice []*{{$ftable.UpSingular}}
+	for _, query := range queries.SplitIn(query) {
+		{{if $.NoContext -}}
+		results, err := query.Query(e)
+		{{else -}}
+		results, err := query.QueryContext(ctx, e)
+		{{end -}}
+		if err != nil {
+			return errors.Wrap(err, "failed to eager load {{$ftable.UpSingular}}")
+		}
+
+		if err = queries.Bind(results, &resultSlice); err != nil {
+			return errors.Wrap(err, "failed to bind eager loaded slice {{$ftable.UpSingular}}")
+		}
+
+		if err = results.Close(); err != nil {
+			return errors.Wrap(err, "failed to close results of eager load for {{$fkey.ForeignTable}}")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{$fkey.ForeignTable}}")
+		}
+	}
+
+	{{if not $.NoHooks -}}
+	if len({{$ftable.DownSingular}}AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks({{if $.NoContext}}e{{else}}ctx, e{{end}}); err != nil {
+				return err
+			}
+		}
+	}
+	{{- end}}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if {{range $i, $col := $fkey.Columns}}{{if $i}} && {{end}}queries.Equal(local.{{$ltable.Column $col}}, foreign.{{$ftable.Column (index $fkey.ForeignColumns $i)}}){{end}} {
+				local.R.{{$rel.Foreign}} = foreign
+				{{if not $.NoBackReferencing -}}
+				if foreign.R == nil {
+					foreign.R = &{{$ftable.DownSingular}}R{}
+				}
+					{{if $fkey.Unique -}}
+				foreign.R.{{$rel.Local}} = local
+					{{else -}}
+				foreign.R.{{$rel.Local}} = append(foreign.R.{{$rel.Local}}, local)
+					{{end -}}
+				{{end -}}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+{{if $.AddGlobal -}}
+// Set{{$rel.Foreign}}G of the {{$ltable.DownSingular}} to the related item.
+// Sets o.R.{{$rel.Foreign}} to related.
+// Adds o to related.R.{{$rel.Local}}.
+// Uses the global database handle.
+func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}G({{if not $.NoContext}}ctx context.Context, {{end -}} insert bool, related *{{$ftable.UpSingular}}) error {
+	return o.Set{{$rel.Foreign}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, insert, related)
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// Set{{$rel.Foreign}}P of the {{$ltable.DownSingular}} to the related item.
+// Sets o.R.{{$rel.Foreign}} to related.
+// Adds o to related.R.{{$rel.Local}}.
+// Panics on error.
+func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related *{{$ftable.UpSingular}}) {
+	if err := o.Set{{$rel.Foreign}}({{if not $.NoContext}}ctx, {{end -}} exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// Set{{$rel.Foreign}}GP of the {{$ltable.DownSingular}} to the related item.
+// Sets o.R.{{$rel.Foreign}} to related.
+// Adds o to related.R.{{$rel.Local}}.
+// Uses the global database handle and panics on error.
+func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}GP({{if not $.NoContext}}ctx context.Context, {{end -}} insert bool, related *{{$ftable.UpSingular}}) {
+	if err := o.Set{{$rel.Foreign}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// Set{{$rel.Foreign}} of the {{$ltable.DownSingular}} to the related item.
+// Sets o.R.{{$rel.Foreign}} to related.
+// Adds o to related.R.{{$rel.Local}}.
+func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related *{{$ftable.UpSingular}}) error {
+	var err error
+	if insert {
+		if err = related.Insert({{if not $.NoContext}}ctx, {{end -}} exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE {{$schemaTable}} SET %s WHERE %s",
+		dialect.SetParamNames(1, []string{ {{- range $i, $col := $fkey.Columns}}{{if $i}}, {{end}}"{{$col}}"{{end -}} }),
+		dialect.WhereClause({{len $fkey.Columns}}+1, {{$ltable.DownSingular}}PrimaryKeyColumns),
+	)
+	values := []interface{}{ {{- range $i, $col := $fkey.ForeignColumns}}related.{{$ftable.Column $col}}, {{end}}o.{{$.Table.PKey.Columns | stringMap (aliasCols $ltable) | join ", o."}}{{"}"}}
+
+	{{if $.NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	{{end -}}
+
+	{{if $.NoContext -}}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+	{{- else -}}
+	if _, err = boil.ExecContext(ctx, exec, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+	{{- end}}
+
+	{{range $i, $col := $fkey.Columns -}}
+	queries.Assign(&o.{{$ltable.Column $col}}, related.{{$ftable.Column (index $fkey.ForeignColumns $i)}})
+	{{end}}
+	if o.R == nil {
+		o.R = &{{$ltable.DownSingular}}R{
+			{{$rel.Foreign}}: related,
+		}
+	} else {
+		o.R.{{$rel.Foreign}} = related
+	}
+
+	{{if $fkey.Unique -}}
+	if related.R == nil {
+		related.R = &{{$ftable.DownSingular}}R{
+			{{$rel.Local}}: o,
+		}
+	} else {
+		related.R.{{$rel.Local}} = o
+	}
+	{{else -}}
+	if related.R == nil {
+		related.R = &{{$ftable.DownSingular}}R{
+			{{$rel.Local}}: {{$ltable.UpSingular}}Slice{{"{"}}o{{"}"}},
+		}
+	} else {
+		related.R.{{$rel.Local}} = append(related.R.{{$rel.Local}}, o)
+	}
+	{{- end}}
+
+	return nil
+}
+
+		{{- if $fkey.Nullable}}
+{{if $.AddGlobal -}}
+// Remove{{$rel.Foreign}}G relationship.
+// Sets o.R.{{$rel.Foreign}} to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}G({{if not $.NoContext}}ctx context.Context, {{end -}} related *{{$ftable.UpSingular}}) error {
+	return o.Remove{{$rel.Foreign}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, related)
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// Remove{{$rel.Foreign}}P relationship.
+// Sets o.R.{{$rel.Foreign}} to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Panics on error.
+func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related *{{$ftable.UpSingular}}) {
+	if err := o.Remove{{$rel.Foreign}}({{if not $.NoContext}}ctx, {{end -}} exec, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// Remove{{$rel.Foreign}}GP relationship.
+// Sets o.R.{{$rel.Foreign}} to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle and panics on error.
+func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}GP({{if not $.NoContext}}ctx context.Context, {{end -}} related *{{$ftable.UpSingular}}) {
+	if err := o.Remove{{$rel.Foreign}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// Remove{{$rel.Foreign}} relationship by setting the nullable columns of the
+// foreign key to null.
+// Sets o.R.{{$rel.Foreign}} to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related *{{$ftable.UpSingular}}) error {
+	var err error
+
+	{{range $col := $fkey.Columns -}}
+	{{if ($localTable.GetColumn $col).Nullable -}}
+	queries.SetScanner(&o.{{$ltable.Column $col}}, nil)
+	{{end -}}
+	{{end -}}
+	if {{if not $.NoRowsAffected}}_, {{end -}} err = o.Update({{if not $.NoContext}}ctx, {{end -}} exec, boil.Whitelist({{range $col := $fkey.Columns}}{{if ($localTable.GetColumn $col).Nullable}}"{{$col}}", {{end}}{{end}})); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.{{$rel.Foreign}} = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.{{$rel.Local}} {
+		if ri != o {
+			continue
+		}
+
+		ln := len(related.R.{{$rel.Local}})
+		if ln > 1 && i < ln-1 {
+			related.R.{{$rel.Local}}[i] = related.R.{{$rel.Local}}[ln-1]
+		}
+		related.R.{{$rel.Local}} = related.R.{{$rel.Local}}[:ln-1]
+		break
+	}
+
+	return nil
+}
+		{{end -}}{{/* if nullable */}}
+	{{end -}}{{/* range composite fkeys */}}
+
+	{{- range $fkey := .CompositeFKeysTo -}}
+		{{- $ltable := $.Aliases.Table $fkey.ForeignTable -}}
+		{{- $ftable := $.Aliases.Table $fkey.Table -}}
+		{{- $rel := $ftable.Relationship $fkey.Name -}}
+		{{- $table := getTable $.Tables $fkey.ForeignTable -}}
+		{{- $arg := printf "maybe%s" $ltable.UpSingular -}}
+		{{- $schemaForeignTable := $fkey.Table | $.SchemaTable -}}
+		{{- $foreignPKeyCols := (getTable $.Tables $fkey.Table).PKey.Columns -}}
+		{{- $canSoftDelete := (getTable $.Tables $fkey.Table).CanSoftDelete }}
+// {{$rel.Local}} retrieves {{if $fkey.Unique}}the {{$ftable.DownSingular}}{{else}}all the {{$ftable.DownPlural}}{{end}} whose foreign key on
+// {{$fkey.Columns | join ", "}} refers to this {{$ltable.DownSingular}}.
+func (o *{{$ltable.UpSingular}}) {{$rel.Local}}(mods ...qm.QueryMod) {{$ftable.DownSingular}}Query {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		{{range $i, $col := $fkey.Columns -}}
+		qm.Where("{{$schemaForeignTable}}.{{$col | $.Quotes}}=?", o.{{$ltable.Column (index $fkey.ForeignColumns $i)}}),
+		{{end -}}
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{"deleted_at" | $.Quotes}}"),
+		{{- end}}
+	)
+
+	query := {{$ftable.UpPlural}}(queryMods...)
+	queries.SetFrom(query.Query, "{{$schemaForeignTable}}")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"{{$schemaForeignTable}}.*"})
+	}
+
+	return query
+}
+
+// Load{{$rel.Local}} allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a {{if $fkey.Unique}}1-1{{else}}1-M{{end}} relationship over a
+// foreign key with several columns.
+func ({{$ltable.DownSingular}}L) Load{{$rel.Local}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
+	var slice []*{{$ltable.UpSingular}}
+
+	if singular {
+		slice = []*{{$ltable.UpSingular}}{ {{- $arg}}.(*{{$ltable.UpSingular}})}
+	} else {
+		slice = *{{$arg}}.(*[]*{{$ltable.UpSingular}})
+	}
+
+	var args [][]interface{}
+	Outer:
+	for _, obj := range slice {
+		if obj.R == nil {
+			obj.R = &{{$ltable.DownSingular}}R{}
+		}
+		{{range $col := $fkey.ForeignColumns -}}
+		{{if ($table.GetColumn $col).Nullable -}}
+		if queries.IsNil(obj.{{$ltable.Column $col}}) {
+			continue
+		}
+		{{end -}}
+		{{end}}
+		for _, a := range args {
+			if {{range $i, $col := $fkey.ForeignColumns}}{{if $i}} && {{end}}queries.Equal(a[{{$i}}], obj.{{$ltable.Column $col}}){{end}} {
+				continue Outer
+			}
+		}
+
+		args = append(args, []interface{}{ {{- range $i, $col := $fkey.ForeignColumns}}{{if $i}}, {{end}}obj.{{$ltable.Column $col}}{{end -}} })
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From("{{$schemaForeignTable}}"),
+		qmhelper.WhereTuplesIn([]string{ {{- range $i, $col := $fkey.Columns}}{{if $i}}, {{end}}"{{$schemaForeignTable}}.{{$col | $.Quotes}}"{{end -}} }, args),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{"deleted_at" | $.Quotes}}"),
+		{{- end}}
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	var resultSlice []*{{$ftable.UpSingular}}
+	for _, query := range queries.SplitIn(query) {
+		{{if $.NoContext -}}
+		results, err := query.Query(e)
+		{{else -}}
+		results, err := query.QueryContext(ctx, e)
+		{{end -}}
+		if err != nil {
+			return errors.Wrap(err, "failed to eager load {{$fkey.Table}}")
+		}
+
+		if err = queries.Bind(results, &resultSlice); err != nil {
+			return errors.Wrap(err, "failed to bind eager loaded slice {{$fkey.Table}}")
+		}
+
+		if err = results.Close(); err != nil {
+			return errors.Wrap(err, "failed to close results in eager load on {{$fkey.Table}}")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{$fkey.Table}}")
+		}
+	}
+
+	{{if not $.NoHooks -}}
+	if len({{$ftable.DownSingular}}AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks({{if $.NoContext}}e{{else}}ctx, e{{end}}); err != nil {
+				return err
+			}
+		}
+	}
+	{{- end}}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if {{range $i, $col := $fkey.Columns}}{{if $i}} && {{end}}queries.Equal(local.{{$ltable.Column (index $fkey.ForeignColumns $i)}}, foreign.{{$ftable.Column $col}}){{end}} {
+				{{if $fkey.Unique -}}
+				local.R.{{$rel.Local}} = foreign
+				{{else -}}
+				local.R.{{$rel.Local}} = append(local.R.{{$rel.Local}}, foreign)
+				{{end -}}
+				{{if not $.NoBackReferencing -}}
+				if foreign.R == nil {
+					foreign.R = &{{$ftable.DownSingular}}R{}
+				}
+				foreign.R.{{$rel.Foreign}} = local
+				{{end -}}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+{{if $fkey.Unique -}}
+{{if $.AddGlobal -}}
+// Set{{$rel.Local}}G of the {{$ltable.DownSingular}} to the related item.
+// Sets o.R.{{$rel.Local}} to related.
+// Adds o to related.R.{{$rel.Foreign}}.
+// Uses the global database handle.
+func (o *{{$ltable.UpSingular}}) Set{{$rel.Local}}G({{if not $.NoContext}}ctx context.Context, {{end -}} insert bool, related *{{$ftable.UpSingular}}) error {
+	return o.Set{{$rel.Local}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, insert, related)
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// Set{{$rel.Local}}P of the {{$ltable.DownSingular}} to the related item.
+// Sets o.R.{{$rel.Local}} to related.
+// Adds o to related.R.{{$rel.Foreign}}.
+// Panics on error.
+func (o *{{$ltable.UpSingular}}) Set{{$rel.Local}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related *{{$ftable.UpSingular}}) {
+	if err := o.Set{{$rel.Local}}({{if not $.NoContext}}ctx, {{end -}} exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// Set{{$rel.Local}}GP of the {{$ltable.DownSingular}} to the related item.
+// Sets o.R.{{$rel.Local}} to related.
+// Adds o to related.R.{{$rel.Foreign}}.
+// Uses the global database handle and panics on error.
+func (o *{{$ltable.UpSingular}}) Set{{$rel.Local}}GP({{if not $.NoContext}}ctx context.Context, {{end -}} insert bool, related *{{$ftable.UpSingular}}) {
+	if err := o.Set{{$rel.Local}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// Set{{$rel.Local}} of the {{$ltable.DownSingular}} to the related item.
+// Sets o.R.{{$rel.Local}} to related.
+// Adds o to related.R.{{$rel.Foreign}}.
+func (o *{{$ltable.UpSingular}}) Set{{$rel.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related *{{$ftable.UpSingular}}) error {
+	var err error
+
+	if insert {
+		{{range $i, $col := $fkey.Columns -}}
+		queries.Assign(&related.{{$ftable.Column $col}}, o.{{$ltable.Column (index $fkey.ForeignColumns $i)}})
+		{{end -}}
+		if err = related.Insert({{if not $.NoContext}}ctx, {{end -}} exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE {{$schemaForeignTable}} SET %s WHERE %s",
+			dialect.SetParamNames(1, []string{ {{- range $i, $col := $fkey.Columns}}{{if $i}}, {{end}}"{{$col}}"{{end -}} }),
+			dialect.WhereClause({{len $fkey.Columns}}+1, {{$ftable.DownSingular}}PrimaryKeyColumns),
+		)
+		values := []interface{}{ {{- range $col := $fkey.ForeignColumns}}o.{{$ltable.Column $col}}, {{end}}related.{{$foreignPKeyCols | stringMap (aliasCols $ftable) | join ", related."}}{{"}"}}
+
+		{{if $.NoContext -}}
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		{{end -}}
+
+		{{if $.NoContext -}}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+		{{else -}}
+		if _, err = boil.ExecContext(ctx, exec, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+		{{end}}
+		{{range $i, $col := $fkey.Columns -}}
+		queries.Assign(&related.{{$ftable.Column $col}}, o.{{$ltable.Column (index $fkey.ForeignColumns $i)}})
+		{{end -}}
+	}
+
+	if o.R == nil {
+		o.R = &{{$ltable.DownSingular}}R{
+			{{$rel.Local}}: related,
+		}
+	} else {
+		o.R.{{$rel.Local}} = related
+	}
+
+	if related.R == nil {
+		related.R = &{{$ftable.DownSingular}}R{
+			{{$rel.Foreign}}: o,
+		}
+	} else {
+		related.R.{{$rel.Foreign}} = o
+	}
+	return nil
+}
+
+{{else -}}
+{{if $.AddGlobal -}}
+// Add{{$rel.Local}}G adds the given related objects to the existing relationships
+// of the {{$ltable.DownSingular}}, optionally inserting them as new records.
+// Appends related to o.R.{{$rel.Local}}.
+// Sets related.R.{{$rel.Foreign}} appropriately.
+// Uses the global database handle.
+func (o *{{$ltable.UpSingular}}) Add{{$rel.Local}}G({{if not $.NoContext}}ctx context.Context, {{end -}} insert bool, related ...*{{$ftable.UpSingular}}) error {
+	return o.Add{{$rel.Local}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, insert, related...)
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// Add{{$rel.Local}}P adds the given related objects to the existing relationships
+// of the {{$ltable.DownSingular}}, optionally inserting them as new records.
+// Appends related to o.R.{{$rel.Local}}.
+// Sets related.R.{{$rel.Foreign}} appropriately.
+// Panics on error.
+func (o *{{$ltable.UpSingular}}) Add{{$rel.Local}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related ...*{{$ftable.UpSingular}}) {
+	if err := o.Add{{$rel.Local}}({{if not $.NoContext}}ctx, {{end -}} exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// Add{{$rel.Local}}GP adds the given related objects to the existing relationships
+// of the {{$ltable.DownSingular}}, optionally inserting them as new records.
+// Appends related to o.R.{{$rel.Local}}.
+// Sets related.R.{{$rel.Foreign}} appropriately.
+// Uses the global database handle and panics on error.
+func (o *{{$ltable.UpSingular}}) Add{{$rel.Local}}GP({{if not $.NoContext}}ctx context.Context, {{end -}} insert bool, related ...*{{$ftable.UpSingular}}) {
+	if err := o.Add{{$rel.Local}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// Add{{$rel.Local}} adds the given related objects to the existing relationships
+// of the {{$ltable.DownSingular}}, optionally inserting them as new records.
+// Appends related to o.R.{{$rel.Local}}.
+// Sets related.R.{{$rel.Foreign}} appropriately.
+func (o *{{$ltable.UpSingular}}) Add{{$rel.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related ...*{{$ftable.UpSingular}}) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			{{range $i, $col := $fkey.Columns -}}
+			queries.Assign(&rel.{{$ftable.Column $col}}, o.{{$ltable.Column (index $fkey.ForeignColumns $i)}})
+			{{end -}}
+			if err = rel.Insert({{if not $.NoContext}}ctx, {{end -}} exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE {{$schemaForeignTable}} SET %s WHERE %s",
+				dialect.SetParamNames(1, []string{ {{- range $i, $col := $fkey.Columns}}{{if $i}}, {{end}}"{{$col}}"{{end -}} }),
+				dialect.WhereClause({{len $fkey.Columns}}+1, {{$ftable.DownSingular}}PrimaryKeyColumns),
+			)
+			values := []interface{}{ {{- range $col := $fkey.ForeignColumns}}o.{{$ltable.Column $col}}, {{end}}rel.{{$foreignPKeyCols | stringMap (aliasCols $ftable) | join ", rel."}}{{"}"}}
+
+			{{if $.NoContext -}}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			{{end -}}
+
+			{{if $.NoContext -}}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+			{{else -}}
+			if _, err = boil.ExecContext(ctx, exec, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+			{{end}}
+			{{range $i, $col := $fkey.Columns -}}
+			queries.Assign(&rel.{{$ftable.Column $col}}, o.{{$ltable.Column (index $fkey.ForeignColumns $i)}})
+			{{end -}}
+		}
+	}
+
+	if o.R == nil {
+		o.R = &{{$ltable.DownSingular}}R{
+			{{$rel.Local}}: related,
+		}
+	} else {
+		o.R.{{$rel.Local}} = append(o.R.{{$rel.Local}}, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &{{$ftable.DownSingular}}R{
+				{{$rel.Foreign}}: o,
+			}
+		} else {
+			rel.R.{{$rel.Foreign}} = o
+		}
+	}
+
+	return nil
+}
+
+{{end -}}{{/* if unique */}}
+	{{end -}}{{/* range composite fkeys to */}}
+{{- end -}}{{/* join table */}}
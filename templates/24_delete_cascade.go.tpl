@@ -0,0 +1,203 @@
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $soft := and .AddSoftDeletes .Table.CanSoftDelete -}}
+{{- $pkFields := .Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", " }}
+{{if .AddGlobal -}}
+// DeleteCascadeG deletes the {{$alias.DownSingular}} and the rows that refer to it
+// using the global database handle.
+func (o *{{$alias.UpSingular}}) DeleteCascadeG({{if not .NoContext}}ctx context.Context{{if $soft}}, hardDelete bool{{end}}{{else}}{{if $soft}}hardDelete bool{{end}}{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	return o.DeleteCascade({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}{{if $soft}}, hardDelete{{end}})
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// DeleteCascadeP deletes the {{$alias.DownSingular}} and the rows that refer to it,
+// and panics on error.
+func (o *{{$alias.UpSingular}}) DeleteCascadeP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if not .NoRowsAffected}}int64{{end -}} {
+	{{if not .NoRowsAffected}}rowsAff, {{end}}err := o.DeleteCascade({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- if not .NoRowsAffected}}
+
+	return rowsAff
+	{{- end}}
+}
+
+{{end -}}
+
+{{if and .AddGlobal .AddPanic -}}
+// DeleteCascadeGP deletes the {{$alias.DownSingular}} and the rows that refer to it
+// using the global database handle, and panics on error.
+func (o *{{$alias.UpSingular}}) DeleteCascadeGP({{if not .NoContext}}ctx context.Context{{if $soft}}, hardDelete bool{{end}}{{else}}{{if $soft}}hardDelete bool{{end}}{{end}}) {{if not .NoRowsAffected}}int64{{end -}} {
+	{{if not .NoRowsAffected}}rowsAff, {{end}}err := o.DeleteCascade({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}{{if $soft}}, hardDelete{{end}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- if not .NoRowsAffected}}
+
+	return rowsAff
+	{{- end}}
+}
+
+{{end -}}
+
+// DeleteCascade deletes the {{$alias.DownSingular}} together with the rows that refer
+// to it through foreign keys, children before their parents. It's meant for
+// databases where the foreign keys aren't ON DELETE CASCADE. When exec can
+// begin a transaction everything is deleted inside one, otherwise exec
+// should already be a transaction.
+{{- if $soft}}
+//
+// A soft delete only cascades to the models that can be soft deleted too,
+// the other rows keep referring to a row that's still there.
+{{- end}}
+func (o *{{$alias.UpSingular}}) DeleteCascade({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	if o == nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: no {{$alias.UpSingular}} provided for cascading delete")
+	}
+
+	{{if not .NoRowsAffected -}}
+	var rowsAff int64
+	{{end -}}
+	var err error
+	{{if .NoContext -}}
+	if beginner, ok := exec.(boil.Beginner); ok {
+		tx, err := beginner.Begin()
+		if err != nil {
+			return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to begin transaction for cascading delete")
+		}
+
+		{{if $.NoRowsAffected}}_{{else}}rowsAff{{end}}, err = o.deleteCascade(tx, {{if $soft}}hardDelete{{else}}true{{end}}, make(map[string]bool))
+		if err != nil {
+			_ = tx.Rollback()
+			return {{if not .NoRowsAffected}}0, {{end -}} err
+		}
+		if err = tx.Commit(); err != nil {
+			return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to commit cascading delete")
+		}
+
+		return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
+	}
+
+	{{if $.NoRowsAffected}}_{{else}}rowsAff{{end}}, err = o.deleteCascade(exec, {{if $soft}}hardDelete{{else}}true{{end}}, make(map[string]bool))
+	{{- else -}}
+	if _, ok := exec.(boil.ContextBeginner); ok {
+		err = boil.Transact(ctx, exec, func(ctx context.Context, tx boil.ContextExecutor) error {
+			var err error
+			{{if $.NoRowsAffected}}_{{else}}rowsAff{{end}}, err = o.deleteCascade(ctx, tx, {{if $soft}}hardDelete{{else}}true{{end}}, make(map[string]bool))
+			return err
+		})
+	} else {
+		{{if $.NoRowsAffected}}_{{else}}rowsAff{{end}}, err = o.deleteCascade(ctx, exec, {{if $soft}}hardDelete{{else}}true{{end}}, make(map[string]bool))
+	}
+	{{- end}}
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+
+	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
+}
+
+// deleteCascade deletes the rows that refer to the {{$alias.DownSingular}} and then the
+// {{$alias.DownSingular}} itself. seen holds the rows that are already being deleted
+// so cycles in the data end.
+func (o *{{$alias.UpSingular}}) deleteCascade({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, hardDelete bool, seen map[string]bool) (int64, error) {
+	key := fmt.Sprint("{{.Table.Name}}", {{$pkFields}})
+	if seen[key] {
+		return 0, nil
+	}
+	seen[key] = true
+
+	var rowsAff int64
+	{{range $child := .CascadeChildren -}}
+		{{- $ftable := $.Aliases.Table $child.Table -}}
+		{{- $schemaForeignTable := $child.Table | $.SchemaTable -}}
+		{{- $childSoft := and $.AddSoftDeletes (getTable $.Tables $child.Table).CanSoftDelete -}}
+		{{- $guard := and $.AddSoftDeletes (not $childSoft)}}
+	{{if $guard}}if hardDelete {{end}}{
+		mods := []qm.QueryMod{
+			qm.From("{{$schemaForeignTable}}"),
+			{{range $i, $col := $child.Columns -}}
+			qm.Where("{{$schemaForeignTable}}.{{$col | $.Quotes}}=?", o.{{$alias.Column (index $child.ForeignColumns $i)}}),
+			{{end -}}
+		}
+		{{- if $childSoft}}
+		if !hardDelete {
+			mods = append(mods, qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{"deleted_at" | $.Quotes}}"))
+		}
+		{{- end}}
+
+		children, err := {{$ftable.DownSingular}}Query{NewQuery(mods...)}.All({{if not $.NoContext}}ctx, {{end -}} exec)
+		if err != nil {
+			return 0, errors.Wrap(err, "{{$.PkgName}}: unable to find {{$child.Table}} rows to delete for {{$child.Name}}")
+		}
+		for _, child := range children {
+			n, err := child.deleteCascade({{if not $.NoContext}}ctx, {{end -}} exec, hardDelete, seen)
+			if err != nil {
+				return 0, err
+			}
+			rowsAff += n
+		}
+	}
+
+	{{end -}}
+
+	{{- range $rel := .Table.ToManyRelationships -}}
+		{{- if $rel.ToJoinTable}}
+	{{if $.AddSoftDeletes}}if hardDelete {{end}}{
+		query := "delete from {{$rel.JoinTable | $.SchemaTable}} where {{$rel.JoinLocalColumn | $.Quotes}} = {{$.Dialect.Placeholder 1}}"
+		values := []interface{}{{"{"}}o.{{$alias.Column $rel.Column}}}
+
+		{{if $.NoContext -}}
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, query)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		{{end -}}
+
+		{{if $.NoRowsAffected -}}
+		{{if $.NoContext -}}
+		_, err := exec.Exec(query, values...)
+		{{else -}}
+		_, err := boil.ExecContext(ctx, exec, query, values...)
+		{{end -}}
+		if err != nil {
+			return 0, errors.Wrap(err, "{{$.PkgName}}: unable to delete from {{$rel.JoinTable}}")
+		}
+		{{- else -}}
+		{{if $.NoContext -}}
+		result, err := exec.Exec(query, values...)
+		{{else -}}
+		result, err := boil.ExecContext(ctx, exec, query, values...)
+		{{end -}}
+		if err != nil {
+			return 0, errors.Wrap(err, "{{$.PkgName}}: unable to delete from {{$rel.JoinTable}}")
+		}
+
+		n, err := result.RowsAffected()
+		if err != nil {
+			return 0, errors.Wrap(err, "{{$.PkgName}}: failed to get rows affected by delete from {{$rel.JoinTable}}")
+		}
+		rowsAff += n
+		{{- end}}
+	}
+
+		{{end -}}
+	{{- end}}
+
+	{{if .NoRowsAffected -}}
+	if err := o.Delete({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}}); err != nil {
+		return 0, err
+	}
+
+	return rowsAff + 1, nil
+	{{- else -}}
+	n, err := o.Delete({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}})
+	if err != nil {
+		return 0, err
+	}
+
+	return rowsAff + n, nil
+	{{- end}}
+}
@@ -0,0 +1,30 @@
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{range $i, $column := .Table.Columns -}}
+{{- $colAlias := $alias.Column $column.Name -}}
+// Set{{$colAlias}} sets {{$colAlias}} and marks it as changed, see ChangedColumns.
+func (o *{{$alias.UpSingular}}) Set{{$colAlias}}(v {{$column.Type}}) {
+	o.{{$colAlias}} = v
+	o.changed[{{$i}}] = true
+}
+
+{{end -}}
+
+// ChangedColumns returns the columns that were set through the SetX methods
+// since the {{$alias.DownSingular}} was loaded, inserted or updated. Update with
+// boil.Infer only sends these columns when there are any. Fields that are
+// assigned directly aren't tracked.
+func (o *{{$alias.UpSingular}}) ChangedColumns() []string {
+	var cols []string
+	for i, changed := range o.changed {
+		if changed {
+			cols = append(cols, {{$alias.DownSingular}}AllColumns[i])
+		}
+	}
+
+	return cols
+}
+
+// ClearChanges forgets which columns were set through the SetX methods.
+func (o *{{$alias.UpSingular}}) ClearChanges() {
+	o.changed = [{{len .Table.Columns}}]bool{}
+}
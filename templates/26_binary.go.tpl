@@ -0,0 +1,47 @@
+{{- if .AddBinaryMarshal -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+// {{$alias.DownSingular}}Binary holds the columns of a {{$alias.UpSingular}} as they're
+// encoded by MarshalBinary.
+type {{$alias.DownSingular}}Binary struct {
+	{{range $column := .Table.Columns -}}
+	{{$alias.Column $column.Name}} {{$column.Type}}
+	{{end -}}
+}
+
+// MarshalBinary encodes the columns of the {{$alias.DownSingular}} with encoding/gob,
+// leaving out the loaded relationships. It implements encoding.BinaryMarshaler
+// so a {{$alias.UpSingular}} can be cached as a blob without going through json.
+func (o *{{$alias.UpSingular}}) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode({{$alias.DownSingular}}Binary{
+		{{range $column := .Table.Columns -}}
+		{{- $colAlias := $alias.Column $column.Name -}}
+		{{$colAlias}}: o.{{$colAlias}},
+		{{end -}}
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "{{.PkgName}}: unable to encode {{$alias.DownSingular}}")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes columns encoded by MarshalBinary into the {{$alias.DownSingular}},
+// replacing all of its fields. Null columns stay null, whatever the {{$alias.DownSingular}}
+// held before.
+func (o *{{$alias.UpSingular}}) UnmarshalBinary(data []byte) error {
+	var b {{$alias.DownSingular}}Binary
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&b); err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to decode {{$alias.DownSingular}}")
+	}
+
+	*o = {{$alias.UpSingular}}{
+		{{range $column := .Table.Columns -}}
+		{{- $colAlias := $alias.Column $column.Name -}}
+		{{$colAlias}}: b.{{$colAlias}},
+		{{end -}}
+	}
+	return nil
+}
+
+{{end -}}
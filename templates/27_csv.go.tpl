@@ -0,0 +1,28 @@
+{{- $alias := .Aliases.Table .Table.Name -}}
+// WriteCSV writes the {{$alias.DownPlural}} to w as csv, with a header row of the column
+// names. All columns are written if none are given. Null values are written as
+// empty cells and times in queries.CSVTimeFormat.
+func (o {{$alias.UpSingular}}Slice) WriteCSV(w io.Writer, columns ...string) error {
+	if len(columns) == 0 {
+		columns = {{$alias.DownSingular}}AllColumns
+	}
+
+	if err := queries.WriteCSV(w, {{$alias.DownSingular}}Mapping, o, columns); err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to write {{.Table.Name}} csv")
+	}
+
+	return nil
+}
+
+// Read{{$alias.UpPlural}}CSV reads {{$alias.DownPlural}} from csv written by WriteCSV. The
+// header row decides which column each cell goes into, columns that aren't in
+// it are left at their zero value. Empty cells are read as null for nullable
+// columns.
+func Read{{$alias.UpPlural}}CSV(r io.Reader) ({{$alias.UpSingular}}Slice, error) {
+	var o {{$alias.UpSingular}}Slice
+	if err := queries.ReadCSV(r, {{$alias.DownSingular}}Mapping, &o); err != nil {
+		return nil, errors.Wrap(err, "{{.PkgName}}: unable to read {{.Table.Name}} csv")
+	}
+
+	return o, nil
+}
@@ -0,0 +1,22 @@
+{{- if .AddParquet -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+// {{$alias.UpSingular}}ParquetSchema describes how the columns of a {{$alias.UpSingular}} are written to
+// parquet, in the order of the columns of the table.
+var {{$alias.UpSingular}}ParquetSchema = []queries.ParquetColumn{
+	{{range $column := .Table.Columns -}}
+	{{parquetColumn $column}},
+	{{end -}}
+}
+
+// WriteParquet writes the {{$alias.DownPlural}} to w as a parquet file with
+// {{$alias.UpSingular}}ParquetSchema. Times are written in UTC and decimals have to fit the
+// precision and scale of their column.
+func (o {{$alias.UpSingular}}Slice) WriteParquet(w io.Writer) error {
+	if err := queries.WriteParquet(w, {{$alias.DownSingular}}Mapping, o, {{$alias.UpSingular}}ParquetSchema); err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to write {{.Table.Name}} parquet")
+	}
+
+	return nil
+}
+
+{{end -}}
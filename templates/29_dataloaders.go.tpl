@@ -0,0 +1,110 @@
+{{- if and .AddDataloaders (not .Table.IsJoinTable) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $schemaTable := .Table.Name | .SchemaTable -}}
+{{- $singlePK := eq (len .Table.PKey.Columns) 1 -}}
+{{- if $singlePK -}}
+{{- $pkName := index .Table.PKey.Columns 0 -}}
+{{- $pkType := (.Table.GetColumn $pkName).Type -}}
+{{- $pkAlias := $alias.Column $pkName -}}
+// Load{{$alias.UpPlural}}By{{$pkAlias}} finds the {{$alias.DownPlural}} with the given keys in a single
+// query, for use as the batch function of a dataloader. The {{$alias.UpSingular}} of every
+// key is at its position in the result, keys without one get a nil {{$alias.UpSingular}}
+// and a boil.NotFoundError. When the query fails its error is the only one
+// returned.
+func Load{{$alias.UpPlural}}By{{$pkAlias}}({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, keys []{{$pkType}}) ([]*{{$alias.UpSingular}}, []error) {
+	results := make([]*{{$alias.UpSingular}}, len(keys))
+	if len(keys) == 0 {
+		return results, nil
+	}
+
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = key
+	}
+
+	found, err := {{$alias.UpPlural}}(qm.WhereIn("{{$schemaTable}}.{{$pkName | $.Quotes}} in ?", args...)).All({{if .NoContext}}exec{{else}}ctx, exec{{end}})
+	if err != nil {
+		return nil, []error{errors.Wrap(err, "{{.PkgName}}: unable to load {{.Table.Name}}")}
+	}
+
+	{{if isPrimitive $pkType -}}
+	byKey := make(map[{{$pkType}}]*{{$alias.UpSingular}}, len(found))
+	for _, o := range found {
+		byKey[o.{{$pkAlias}}] = o
+	}
+
+	{{end -}}
+	var errs []error
+	for i, key := range keys {
+		{{if isPrimitive $pkType -}}
+		results[i] = byKey[key]
+		{{- else -}}
+		for _, o := range found {
+			if queries.Equal(o.{{$pkAlias}}, key) {
+				results[i] = o
+				break
+			}
+		}
+		{{- end}}
+		if results[i] == nil {
+			if errs == nil {
+				errs = make([]error, len(keys))
+			}
+			errs[i] = boil.NewNotFoundError("{{.Table.Name}}")
+		}
+	}
+
+	return results, errs
+}
+
+{{end -}}
+{{- range $fkey := .Table.FKeys -}}
+{{- if not (and $singlePK (eq $fkey.Column (index $.Table.PKey.Columns 0))) -}}
+{{- $colAlias := $alias.Column $fkey.Column -}}
+{{- $keyType := ((getTable $.Tables $fkey.ForeignTable).GetColumn $fkey.ForeignColumn).Type -}}
+{{- $primitive := usesPrimitives $.Tables $.Table.Name $fkey.Column $fkey.ForeignTable $fkey.ForeignColumn -}}
+// Load{{$alias.UpPlural}}By{{$colAlias}} finds the {{$alias.DownPlural}} whose {{$fkey.Column}} is one of the given
+// keys in a single query, for use as the batch function of a dataloader. The
+// {{$alias.DownPlural}} of every key are at its position in the result, keys without any
+// get a nil slice. When the query fails its error is the only one returned.
+func Load{{$alias.UpPlural}}By{{$colAlias}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, keys []{{$keyType}}) ([][]*{{$alias.UpSingular}}, []error) {
+	results := make([][]*{{$alias.UpSingular}}, len(keys))
+	if len(keys) == 0 {
+		return results, nil
+	}
+
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = key
+	}
+
+	found, err := {{$alias.UpPlural}}(qm.WhereIn("{{$schemaTable}}.{{$fkey.Column | $.Quotes}} in ?", args...)).All({{if $.NoContext}}exec{{else}}ctx, exec{{end}})
+	if err != nil {
+		return nil, []error{errors.Wrap(err, "{{$.PkgName}}: unable to load {{$.Table.Name}}")}
+	}
+
+	{{if $primitive -}}
+	byKey := make(map[{{$keyType}}][]*{{$alias.UpSingular}}, len(keys))
+	for _, o := range found {
+		byKey[o.{{$colAlias}}] = append(byKey[o.{{$colAlias}}], o)
+	}
+
+	for i, key := range keys {
+		results[i] = byKey[key]
+	}
+	{{- else -}}
+	for i, key := range keys {
+		for _, o := range found {
+			if queries.Equal(o.{{$colAlias}}, key) {
+				results[i] = append(results[i], o)
+			}
+		}
+	}
+	{{- end}}
+
+	return results, nil
+}
+
+{{end -}}
+{{- end -}}
+{{- end -}}
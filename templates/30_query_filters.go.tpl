@@ -0,0 +1,32 @@
+{{- if .AddQueryFilters -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $schemaTable := .Table.Name | .SchemaTable -}}
+// {{$alias.DownSingular}}FilterColumns are the columns of {{.Table.Name}} that can be filtered on with
+// Parse{{$alias.UpPlural}}Filter, by name.
+var {{$alias.DownSingular}}FilterColumns = map[string]qmhelper.FilterColumn{
+	{{range $column := .Table.Columns -}}
+	{{- with filterKind $column -}}
+	"{{$column.Name}}": {Name: "{{$schemaTable}}.{{$column.Name | $.Quotes}}", Kind: qmhelper.{{.}}},
+	{{end -}}
+	{{- end -}}
+}
+
+// Parse{{$alias.UpPlural}}Filter converts query parameters like ?column__gte=value into
+// query mods for a list endpoint, only the columns named in allowed can be
+// filtered on. See qmhelper.ParseFilter for the operators, the errors are
+// *qmhelper.FilterError.
+func Parse{{$alias.UpPlural}}Filter(values map[string][]string, allowed ...string) ([]qm.QueryMod, error) {
+	wheres, err := qmhelper.ParseFilter(values, {{$alias.DownSingular}}FilterColumns, allowed)
+	if err != nil {
+		return nil, err
+	}
+
+	mods := make([]qm.QueryMod, len(wheres))
+	for i, where := range wheres {
+		mods[i] = where
+	}
+
+	return mods, nil
+}
+
+{{end -}}
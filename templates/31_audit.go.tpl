@@ -0,0 +1,51 @@
+{{- if containsAny .AuditTables .Table.Name -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $auditTable := printf "%s_audit" .Table.Name -}}
+// audit adds an entry for o to {{$auditTable}}, before and after are the row
+// before and after the change, nil when there's none.
+func (o *{{$alias.UpSingular}}) audit(ctx context.Context, exec boil.ContextExecutor, action string, before, after *{{$alias.UpSingular}}) error {
+	if boil.AuditIsSkipped(ctx) {
+		return nil
+	}
+
+	var b, a interface{}
+	if before != nil {
+		b = before
+	}
+	if after != nil {
+		a = after
+	}
+
+	return insertAuditEntry(ctx, exec, "{{$auditTable | .SchemaTable}}", action, o.auditKey(), b, a)
+}
+
+// auditUpdate adds an entry for the update of o to {{$auditTable}}, with the
+// row as it is in the database before the update.
+func (o *{{$alias.UpSingular}}) auditUpdate(ctx context.Context, exec boil.ContextExecutor) error {
+	if boil.AuditIsSkipped(ctx) {
+		return nil
+	}
+
+	before, err := Find{{$alias.UpSingular}}(ctx, exec, {{range $i, $col := .Table.PKey.Columns}}{{if $i}}, {{end}}o.{{$alias.Column $col}}{{end}})
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to find the {{.Table.Name}} row to audit")
+	}
+
+	return o.audit(ctx, exec, AuditUpdate, before, o)
+}
+
+func (o *{{$alias.UpSingular}}) auditKey() map[string]interface{} {
+	return map[string]interface{}{
+		{{range $col := .Table.PKey.Columns -}}
+		"{{$col}}": o.{{$alias.Column $col}},
+		{{end -}}
+	}
+}
+
+// AuditEntries returns the entries of {{$auditTable}} that recorded the changes
+// to o, oldest first.
+func (o *{{$alias.UpSingular}}) AuditEntries(ctx context.Context, exec boil.ContextExecutor) ([]*AuditEntry, error) {
+	return auditEntries(ctx, exec, "{{$auditTable | .SchemaTable}}", o.auditKey())
+}
+
+{{end -}}
@@ -0,0 +1,51 @@
+{{- if containsAny .OutboxTables .Table.Name -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+// {{$alias.UpSingular}}ChangedEvent is the payload of the outbox messages published for the
+// changes to {{.Table.Name}}. Before is nil for inserts and upserts, After is nil for
+// deletes.
+type {{$alias.UpSingular}}ChangedEvent struct {
+	Op string `json:"op"`
+	Key map[string]interface{} `json:"key"`
+	Before *{{$alias.UpSingular}} `json:"before"`
+	After *{{$alias.UpSingular}} `json:"after"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// publishChange adds a {{$alias.UpSingular}}ChangedEvent for the change to o to the outbox.
+func (o *{{$alias.UpSingular}}) publishChange(ctx context.Context, exec boil.ContextExecutor, op string, before, after *{{$alias.UpSingular}}) error {
+	if boil.OutboxIsSkipped(ctx) {
+		return nil
+	}
+
+	key := map[string]interface{}{
+		{{range $col := .Table.PKey.Columns -}}
+		"{{$col}}": o.{{$alias.Column $col}},
+		{{end -}}
+	}
+	event := &{{$alias.UpSingular}}ChangedEvent{
+		Op: op,
+		Key: key,
+		Before: before,
+		After: after,
+		Timestamp: time.Now().In(boil.GetLocation()),
+	}
+
+	return insertOutboxMessage(ctx, exec, "{{.Table.Name}}", key, event)
+}
+
+// publishUpdate adds a {{$alias.UpSingular}}ChangedEvent for the update of o to the outbox,
+// with the row as it is in the database before the update.
+func (o *{{$alias.UpSingular}}) publishUpdate(ctx context.Context, exec boil.ContextExecutor) error {
+	if boil.OutboxIsSkipped(ctx) {
+		return nil
+	}
+
+	before, err := Find{{$alias.UpSingular}}(ctx, exec, {{range $i, $col := .Table.PKey.Columns}}{{if $i}}, {{end}}o.{{$alias.Column $col}}{{end}})
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to find the {{.Table.Name}} row to publish")
+	}
+
+	return o.publishChange(ctx, exec, ChangeUpdate, before, o)
+}
+
+{{end -}}
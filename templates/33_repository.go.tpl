@@ -0,0 +1,70 @@
+{{- if and .AddRepositories (not .Table.IsJoinTable) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $colDefs := sqlColDefinitions .Table.Columns .Table.PKey.Columns -}}
+{{- $pkNames := $colDefs.Names | stringMap (aliasCols $alias) | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved -}}
+{{- $pkArgs := joinSlices " " $pkNames $colDefs.Types | join ", " -}}
+{{- $soft := and .AddSoftDeletes .Table.CanSoftDelete -}}
+{{- $ctxArg := "" -}}
+{{- $ctx := "" -}}
+{{- if not .NoContext -}}
+{{- $ctxArg = "ctx context.Context, " -}}
+{{- $ctx = "ctx, " -}}
+{{- end -}}
+// {{$alias.UpSingular}}Repository stores {{$alias.DownPlural}}. Code depending on it instead of the
+// model functions can be given a mock in tests, New{{$alias.UpSingular}}Repository returns
+// the implementation using the model functions.
+type {{$alias.UpSingular}}Repository interface {
+	// Find returns the {{$alias.UpSingular}} with the given primary key, a boil.NotFoundError
+	// matching boil.ErrNotFound and sql.ErrNoRows if there's none.
+	Find({{$ctxArg}}{{$pkArgs}}) (*{{$alias.UpSingular}}, error)
+	// List returns the {{$alias.DownPlural}} matching the query mods.
+	List({{$ctxArg}}mods ...qm.QueryMod) ({{$alias.UpSingular}}Slice, error)
+	// Create inserts o.
+	Create({{$ctxArg}}o *{{$alias.UpSingular}}) error
+	// Update saves o to its row, with the columns boil.Infer picks.
+	Update({{$ctxArg}}o *{{$alias.UpSingular}}) error
+	// Delete deletes o{{if $soft}}, it's soft deleted{{end}}.
+	Delete({{$ctxArg}}o *{{$alias.UpSingular}}) error
+}
+
+// New{{$alias.UpSingular}}Repository returns a {{$alias.UpSingular}}Repository running its queries with
+// exec, eg. a *sql.DB or a *sql.Tx.
+func New{{$alias.UpSingular}}Repository(exec {{if .NoContext}}boil.Executor{{else}}boil.ContextExecutor{{end}}) {{$alias.UpSingular}}Repository {
+	return {{$alias.DownSingular}}Repository{exec: exec}
+}
+
+type {{$alias.DownSingular}}Repository struct {
+	exec {{if .NoContext}}boil.Executor{{else}}boil.ContextExecutor{{end}}
+}
+
+func (r {{$alias.DownSingular}}Repository) Find({{$ctxArg}}{{$pkArgs}}) (*{{$alias.UpSingular}}, error) {
+	return Find{{$alias.UpSingular}}({{$ctx}}r.exec, {{$pkNames | join ", "}})
+}
+
+func (r {{$alias.DownSingular}}Repository) List({{$ctxArg}}mods ...qm.QueryMod) ({{$alias.UpSingular}}Slice, error) {
+	return {{$alias.UpPlural}}(mods...).All({{$ctx}}r.exec)
+}
+
+func (r {{$alias.DownSingular}}Repository) Create({{$ctxArg}}o *{{$alias.UpSingular}}) error {
+	return o.Insert({{$ctx}}r.exec, boil.Infer())
+}
+
+func (r {{$alias.DownSingular}}Repository) Update({{$ctxArg}}o *{{$alias.UpSingular}}) error {
+	{{if .NoRowsAffected -}}
+	return o.Update({{$ctx}}r.exec, boil.Infer())
+	{{- else -}}
+	_, err := o.Update({{$ctx}}r.exec, boil.Infer())
+	return err
+	{{- end}}
+}
+
+func (r {{$alias.DownSingular}}Repository) Delete({{$ctxArg}}o *{{$alias.UpSingular}}) error {
+	{{if .NoRowsAffected -}}
+	return o.Delete({{$ctx}}r.exec{{if $soft}}, false{{end}})
+	{{- else -}}
+	_, err := o.Delete({{$ctx}}r.exec{{if $soft}}, false{{end}})
+	return err
+	{{- end}}
+}
+
+{{end -}}
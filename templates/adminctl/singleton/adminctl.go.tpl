@@ -0,0 +1,521 @@
+// Opener opens the database the commands run against. It's called when a
+// command runs, after its flags are parsed, and the executor is closed
+// afterwards if it's an io.Closer.
+type Opener func(ctx context.Context) (boil.ContextExecutor, error)
+
+// NewCommand creates the root command of an admin CLI named use, with
+// commands to list, count, get, create, update and delete the rows of every
+// table through the {{.PkgName}} package:
+//
+//	appctl pilots list --where "name__ilike=jo%" --limit 10
+//	appctl pilots get 42
+//	appctl pilots update 42 --set "name=Joe"
+//	appctl pilots delete 42
+//
+// The filters of --where are column=value pairs, the column can have any of
+// the operators of qmhelper.ParseFilter. Changes ask for confirmation unless
+// --yes is given, and --dry-run prints the statements changing rows instead
+// of running them.
+func NewCommand(use string, open Opener) *cobra.Command {
+	root := &cobra.Command{
+		Use:          use,
+		Short:        "Inspect and change the rows of the database",
+		SilenceUsage: true,
+	}
+	root.PersistentFlags().Bool("dry-run", false, "Print the statements changing rows instead of running them")
+	root.PersistentFlags().BoolP("yes", "y", false, "Don't ask for confirmation before changing rows")
+
+	for _, t := range tables {
+		root.AddCommand(tableCommand(t, open))
+	}
+
+	return root
+}
+
+// table is what the commands of a table need to know about it
+type table struct {
+	name    string
+	columns map[string]qmhelper.FilterColumn
+	pkey    []string
+	// soft is set for tables that are soft deleted unless --hard is given
+	soft bool
+
+	all    func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) ([]interface{}, error)
+	count  func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) (int64, error)
+	insert func(ctx context.Context, exec boil.ContextExecutor, data []byte) (interface{}, error)
+	update func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod, cols {{.PkgName}}.M) (int64, error)
+	delete func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod, hard bool) (int64, error)
+}
+
+var tables = []table{
+	{{- range $table := .Tables}}
+	{{- if not $table.IsJoinTable}}
+	{{- $alias := $.Aliases.Table $table.Name}}
+	{{- $schemaTable := $table.Name | $.SchemaTable}}
+	{{- $soft := and $.AddSoftDeletes $table.CanSoftDelete}}
+	{
+		name: "{{$table.Name}}",
+		columns: map[string]qmhelper.FilterColumn{
+			{{range $column := $table.Columns -}}
+			{{- with filterKind $column -}}
+			"{{$column.Name}}": {Name: "{{$schemaTable}}.{{$column.Name | $.Quotes}}", Kind: qmhelper.{{.}}},
+			{{end -}}
+			{{- end -}}
+		},
+		pkey: []string{ {{- range $i, $pk := $table.PKey.Columns}}{{if $i}}, {{end}}"{{$pk}}"{{end -}} },
+		soft: {{$soft}},
+
+		all: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) ([]interface{}, error) {
+			rows, err := {{$.PkgName}}.{{$alias.UpPlural}}(mods...).All({{if $.NoContext}}exec{{else}}ctx, exec{{end}})
+			if err != nil {
+				return nil, err
+			}
+			out := make([]interface{}, len(rows))
+			for i, row := range rows {
+				out[i] = row
+			}
+			return out, nil
+		},
+		count: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) (int64, error) {
+			return {{$.PkgName}}.{{$alias.UpPlural}}(mods...).Count({{if $.NoContext}}exec{{else}}ctx, exec{{end}})
+		},
+		insert: func(ctx context.Context, exec boil.ContextExecutor, data []byte) (interface{}, error) {
+			var o {{$.PkgName}}.{{$alias.UpSingular}}
+			if err := json.Unmarshal(data, &o); err != nil {
+				return nil, errors.Wrap(err, "invalid {{$table.Name}} json")
+			}
+			return &o, o.Insert({{if $.NoContext}}exec{{else}}ctx, exec{{end}}, boil.Infer())
+		},
+		update: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod, cols {{$.PkgName}}.M) (int64, error) {
+			{{if $.NoRowsAffected -}}
+			return -1, {{$.PkgName}}.{{$alias.UpPlural}}(mods...).UpdateAll({{if $.NoContext}}exec{{else}}ctx, exec{{end}}, cols)
+			{{- else -}}
+			return {{$.PkgName}}.{{$alias.UpPlural}}(mods...).UpdateAll({{if $.NoContext}}exec{{else}}ctx, exec{{end}}, cols)
+			{{- end}}
+		},
+		delete: func(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod, hard bool) (int64, error) {
+			// Deleting the rows as a slice runs their hooks
+			rows, err := {{$.PkgName}}.{{$alias.UpPlural}}(mods...).All({{if $.NoContext}}exec{{else}}ctx, exec{{end}})
+			if err != nil {
+				return 0, err
+			}
+			{{if $.NoRowsAffected -}}
+			return int64(len(rows)), rows.DeleteAll({{if $.NoContext}}exec{{else}}ctx, exec{{end}}{{if $soft}}, hard{{end}})
+			{{- else -}}
+			return rows.DeleteAll({{if $.NoContext}}exec{{else}}ctx, exec{{end}}{{if $soft}}, hard{{end}})
+			{{- end}}
+		},
+	},
+	{{- end}}
+	{{- end}}
+}
+
+func tableCommand(t table, open Opener) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   t.name,
+		Short: "Work with the rows of " + t.name,
+	}
+
+	var where []string
+	var orderBy string
+	var desc bool
+	var limit, offset int
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List the " + t.name + " matching the filters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mods, err := t.filter(where)
+			if err != nil {
+				return err
+			}
+			order, err := t.order(orderBy, desc)
+			if err != nil {
+				return err
+			}
+			if len(order) != 0 {
+				mods = append(mods, qm.OrderBy(order))
+			}
+			if limit > 0 {
+				mods = append(mods, qm.Limit(limit))
+			}
+			if offset > 0 {
+				mods = append(mods, qm.Offset(offset))
+			}
+
+			return run(cmd, open, func(ctx context.Context, exec boil.ContextExecutor) error {
+				rows, err := t.all(ctx, exec, mods)
+				if err != nil {
+					return err
+				}
+				return printRows(cmd.OutOrStdout(), rows)
+			})
+		},
+	}
+	list.Flags().StringArrayVarP(&where, "where", "w", nil, "Filter on a column, eg. name__ilike=jo%")
+	list.Flags().StringVar(&orderBy, "order-by", "", "Column to order by, the primary key by default")
+	list.Flags().BoolVar(&desc, "desc", false, "Order in descending order")
+	list.Flags().IntVar(&limit, "limit", 100, "Maximum number of rows, 0 for all of them")
+	list.Flags().IntVar(&offset, "offset", 0, "Number of rows to skip")
+	cmd.AddCommand(list)
+
+	var countWhere []string
+	count := &cobra.Command{
+		Use:   "count",
+		Short: "Count the " + t.name + " matching the filters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mods, err := t.filter(countWhere)
+			if err != nil {
+				return err
+			}
+
+			return run(cmd, open, func(ctx context.Context, exec boil.ContextExecutor) error {
+				n, err := t.count(ctx, exec, mods)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), n)
+				return err
+			})
+		},
+	}
+	count.Flags().StringArrayVarP(&countWhere, "where", "w", nil, "Filter on a column, eg. name__ilike=jo%")
+	cmd.AddCommand(count)
+
+	var data string
+	create := &cobra.Command{
+		Use:   "create",
+		Short: "Insert a row into " + t.name + " from a json object",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			object := []byte(data)
+			if len(data) == 0 {
+				var err error
+				if object, err = ioutil.ReadAll(cmd.InOrStdin()); err != nil {
+					return err
+				}
+			}
+
+			if ok, err := confirm(cmd, "Insert a row into "+t.name+"?"); !ok || err != nil {
+				return err
+			}
+			return run(cmd, open, func(ctx context.Context, exec boil.ContextExecutor) error {
+				row, err := t.insert(ctx, exec, object)
+				if err != nil {
+					return err
+				}
+				return printRows(cmd.OutOrStdout(), []interface{}{row})
+			})
+		},
+	}
+	create.Flags().StringVarP(&data, "data", "d", "", "The row as a json object, read from stdin when empty, which needs --yes")
+	cmd.AddCommand(create)
+
+	// The rest needs a primary key
+	if len(t.pkey) == 0 {
+		return cmd
+	}
+	for _, pk := range t.pkey {
+		if _, ok := t.columns[pk]; !ok {
+			return cmd
+		}
+	}
+	keys := strings.Join(t.pkey, " ")
+
+	get := &cobra.Command{
+		Use:   "get " + keys,
+		Short: "Show a row of " + t.name,
+		Args:  cobra.ExactArgs(len(t.pkey)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mods, err := t.find(args)
+			if err != nil {
+				return err
+			}
+
+			return run(cmd, open, func(ctx context.Context, exec boil.ContextExecutor) error {
+				rows, err := t.all(ctx, exec, mods)
+				if err != nil {
+					return err
+				}
+				if len(rows) == 0 {
+					return errors.Errorf("%s %s not found", t.name, strings.Join(args, " "))
+				}
+				b, err := json.MarshalIndent(rows[0], "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", b)
+				return err
+			})
+		},
+	}
+	cmd.AddCommand(get)
+
+	var set, setNull []string
+	update := &cobra.Command{
+		Use:   "update " + keys,
+		Short: "Change the columns of a row of " + t.name,
+		Args:  cobra.ExactArgs(len(t.pkey)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mods, err := t.find(args)
+			if err != nil {
+				return err
+			}
+			cols, err := t.set(set, setNull)
+			if err != nil {
+				return err
+			}
+
+			return run(cmd, open, func(ctx context.Context, exec boil.ContextExecutor) error {
+				if ok, err := t.confirmRow(ctx, cmd, exec, mods, "Update", args); !ok || err != nil {
+					return err
+				}
+				n, err := t.update(ctx, exec, mods, cols)
+				if err != nil {
+					return err
+				}
+				return printAffected(cmd, "updated", n)
+			})
+		},
+	}
+	update.Flags().StringArrayVarP(&set, "set", "s", nil, "Set a column to a value, eg. name=Joe")
+	update.Flags().StringArrayVar(&setNull, "null", nil, "Set a column to null")
+	cmd.AddCommand(update)
+
+	var hard bool
+	del := &cobra.Command{
+		Use:   "delete " + keys,
+		Short: "Delete a row of " + t.name,
+		Args:  cobra.ExactArgs(len(t.pkey)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mods, err := t.find(args)
+			if err != nil {
+				return err
+			}
+
+			return run(cmd, open, func(ctx context.Context, exec boil.ContextExecutor) error {
+				if ok, err := t.confirmRow(ctx, cmd, exec, mods, "Delete", args); !ok || err != nil {
+					return err
+				}
+				n, err := t.delete(ctx, exec, mods, hard)
+				if err != nil {
+					return err
+				}
+				return printAffected(cmd, "deleted", n)
+			})
+		},
+	}
+	if t.soft {
+		del.Flags().BoolVar(&hard, "hard", false, "Delete the row instead of marking it deleted")
+	}
+	cmd.AddCommand(del)
+
+	return cmd
+}
+
+// filter turns column=value filters into query mods
+func (t table) filter(where []string) ([]qm.QueryMod, error) {
+	values := make(map[string][]string)
+	allowed := make([]string, 0, len(t.columns))
+	for _, w := range where {
+		i := strings.IndexByte(w, '=')
+		if i < 0 {
+			return nil, errors.Errorf("invalid filter %q, it must be column=value", w)
+		}
+		param := w[:i]
+
+		field := param
+		if j := strings.LastIndex(param, "__"); j > 0 {
+			if _, ok := t.columns[param[:j]]; ok {
+				field = param[:j]
+			}
+		}
+		if _, ok := t.columns[field]; !ok {
+			return nil, errors.Errorf("invalid filter %q, %s can't be filtered on", w, field)
+		}
+
+		values[param] = append(values[param], w[i+1:])
+		allowed = append(allowed, field)
+	}
+
+	wheres, err := qmhelper.ParseFilter(values, t.columns, allowed)
+	if err != nil {
+		return nil, err
+	}
+	mods := make([]qm.QueryMod, len(wheres))
+	for i, where := range wheres {
+		mods[i] = where
+	}
+	return mods, nil
+}
+
+// find returns the query mods for the row with the primary key args
+func (t table) find(args []string) ([]qm.QueryMod, error) {
+	where := make([]string, len(args))
+	for i, arg := range args {
+		where[i] = t.pkey[i] + "=" + arg
+	}
+	return t.filter(where)
+}
+
+// order returns the order by clause for a column, or the primary key
+func (t table) order(column string, desc bool) (string, error) {
+	var columns []string
+	if len(column) != 0 {
+		columns = []string{column}
+	} else {
+		columns = t.pkey
+	}
+
+	clauses := make([]string, 0, len(columns))
+	for _, c := range columns {
+		col, ok := t.columns[c]
+		if !ok {
+			if len(column) == 0 {
+				return "", nil
+			}
+			return "", errors.Errorf("can't order by %s", c)
+		}
+		if desc {
+			clauses = append(clauses, col.Name+" desc")
+		} else {
+			clauses = append(clauses, col.Name)
+		}
+	}
+	return strings.Join(clauses, ", "), nil
+}
+
+// set turns column=value pairs and the columns set to null into the columns
+// of an update
+func (t table) set(set, setNull []string) ({{.PkgName}}.M, error) {
+	cols := make({{.PkgName}}.M, len(set)+len(setNull))
+	for _, s := range set {
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			return nil, errors.Errorf("invalid value %q, it must be column=value", s)
+		}
+		col, ok := t.columns[s[:i]]
+		if !ok {
+			return nil, errors.Errorf("invalid value %q, %s can't be set", s, s[:i])
+		}
+		value, err := col.Parse(s[i+1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %s", s[:i])
+		}
+		cols[s[:i]] = value
+	}
+	for _, c := range setNull {
+		if _, ok := t.columns[c]; !ok {
+			return nil, errors.Errorf("%s can't be set", c)
+		}
+		cols[c] = nil
+	}
+
+	if len(cols) == 0 {
+		return nil, errors.New("nothing to update, use --set or --null")
+	}
+	return cols, nil
+}
+
+// confirmRow shows the row about to be changed and asks for confirmation
+func (t table) confirmRow(ctx context.Context, cmd *cobra.Command, exec boil.ContextExecutor, mods []qm.QueryMod, verb string, args []string) (bool, error) {
+	rows, err := t.all(ctx, exec, mods)
+	if err != nil {
+		return false, err
+	}
+	if len(rows) == 0 {
+		return false, errors.Errorf("%s %s not found", t.name, strings.Join(args, " "))
+	}
+	if err := printRows(cmd.ErrOrStderr(), rows); err != nil {
+		return false, err
+	}
+
+	return confirm(cmd, verb+" "+t.name+" "+strings.Join(args, " ")+"?")
+}
+
+// run opens the database and calls fn with it. With --dry-run the changes
+// are printed instead of made.
+func run(cmd *cobra.Command, open Opener, fn func(ctx context.Context, exec boil.ContextExecutor) error) error {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	exec, err := open(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to open the database")
+	}
+	if closer, ok := exec.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); !dryRun {
+		return fn(ctx, exec)
+	}
+
+	dry := &boil.DryRunExecutor{Reads: exec}
+	err = fn(ctx, dry)
+	// Statements returning rows, like inserts, get none when they're not run
+	if errors.Cause(err) == sql.ErrNoRows {
+		err = nil
+	}
+	for _, s := range dry.Statements() {
+		if isRead(s.Query) {
+			continue
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "%s\n%s\n", s.Query, boil.DebugArgs(s.Args))
+	}
+	return err
+}
+
+// isRead reports whether a statement only reads rows
+func isRead(query string) bool {
+	query = strings.ToLower(strings.TrimSpace(query))
+	return strings.HasPrefix(query, "select") && !strings.Contains(query, " for update")
+}
+
+// confirm asks whether to go ahead with a change, unless --yes or --dry-run
+// is given
+func confirm(cmd *cobra.Command, question string) (bool, error) {
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return true, nil
+	}
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		return true, nil
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "%s [y/N] ", question)
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		fmt.Fprintln(cmd.ErrOrStderr(), "Nothing was changed")
+		return false, nil
+	}
+}
+
+// printRows writes rows as json, one per line
+func printRows(w io.Writer, rows []interface{}) error {
+	enc := json.NewEncoder(w)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printAffected reports the number of rows a change affected, if it's known
+func printAffected(cmd *cobra.Command, verb string, n int64) error {
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun || n < 0 {
+		return nil
+	}
+	_, err := fmt.Fprintf(cmd.ErrOrStderr(), "%s %d rows\n", verb, n)
+	return err
+}
@@ -0,0 +1,45 @@
+{{- $alias := .Aliases.Table .Table.Name -}}
+# {{.Table.Name}}
+
+Generated as `{{.PkgName}}.{{$alias.UpSingular}}`.
+
+## Columns
+
+| Column | Type | Nullable | Default | Go type | Comment |
+| --- | --- | --- | --- | --- | --- |
+{{range $column := .Table.Columns -}}
+| `{{$column.Name}}`{{if $.Table.PKey}}{{if setInclude $column.Name $.Table.PKey.Columns}} (PK){{end}}{{end}}{{if $column.Unique}} (unique){{end -}}
+{{- " "}}| {{markdownCell (or $column.FullDBType $column.DBType)}}
+{{- " "}}| {{if $column.Nullable}}yes{{else}}no{{end}}
+{{- " "}}| {{if $column.Default}}`{{markdownCell $column.Default}}`{{end}}
+{{- " "}}| `{{$alias.Column $column.Name}} {{$column.Type}}`
+{{- " "}}| {{markdownCell $column.Comment}} |
+{{end -}}
+{{- if or .Table.FKeys .Table.CompositeFKeys}}
+## Foreign keys
+
+| Name | Columns | References |
+| --- | --- | --- |
+{{range $fkey := .Table.FKeys -}}
+| `{{$fkey.Name}}` | `{{$fkey.Column}}` | [{{$fkey.ForeignTable}}]({{$fkey.ForeignTable}}.md) (`{{$fkey.ForeignColumn}}`) |
+{{end -}}
+{{range $fkey := .Table.CompositeFKeys -}}
+| `{{$fkey.Name}}` | `{{join "`, `" $fkey.Columns}}` | [{{$fkey.ForeignTable}}]({{$fkey.ForeignTable}}.md) (`{{join "`, `" $fkey.ForeignColumns}}`) |
+{{end -}}
+{{- end}}
+{{- if or .Table.ToOneRelationships .Table.ToManyRelationships}}
+## Referenced by
+
+| Table | Columns |
+| --- | --- |
+{{range $rel := .Table.ToOneRelationships -}}
+| [{{$rel.ForeignTable}}]({{$rel.ForeignTable}}.md) | `{{$rel.ForeignColumn}}` (unique) |
+{{end -}}
+{{range $rel := .Table.ToManyRelationships -}}
+{{- if $rel.ToJoinTable -}}
+| [{{$rel.ForeignTable}}]({{$rel.ForeignTable}}.md) | `{{$rel.ForeignColumn}}`, through `{{$rel.JoinTable}}` |
+{{else -}}
+| [{{$rel.ForeignTable}}]({{$rel.ForeignTable}}.md) | `{{$rel.ForeignColumn}}` |
+{{end -}}
+{{end -}}
+{{- end -}}
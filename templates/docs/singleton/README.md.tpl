@@ -0,0 +1,15 @@
+# {{if .Schema}}{{.Schema}}{{else}}Database{{end}} schema
+
+Generated by sqlboiler along with the `{{.PkgName}}` models, regenerate them
+instead of editing this documentation.
+
+| Table | Model | Columns |
+| --- | --- | --- |
+{{range $table := .Tables -}}
+{{- if $table.IsJoinTable -}}
+| {{$table.Name}} (join table) | | {{len $table.Columns}} |
+{{else -}}
+{{- $alias := $.Aliases.Table $table.Name -}}
+| [{{$table.Name}}]({{$table.Name}}.md) | `{{$.PkgName}}.{{$alias.UpSingular}}` | {{len $table.Columns}} |
+{{end -}}
+{{end -}}
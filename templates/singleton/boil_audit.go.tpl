@@ -0,0 +1,67 @@
+// Actions recorded by audit entries
+const (
+	AuditInsert = "insert"
+	AuditUpdate = "update"
+	AuditUpsert = "upsert"
+	AuditDelete = "delete"
+)
+
+// AuditEntry is a row of the audit table of an audited table, eg. users_audit
+// for users. The hooks of the audited models add one for every insert,
+// update, upsert and delete, with the row as json before and after the
+// change and the actor set on the context with boil.WithAuditActor.
+type AuditEntry struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Action    string    `boil:"action" json:"action" toml:"action" yaml:"action"`
+	RowKey    string    `boil:"row_key" json:"row_key" toml:"row_key" yaml:"row_key"`
+	Before    null.JSON `boil:"before" json:"before" toml:"before" yaml:"before"`
+	After     null.JSON `boil:"after" json:"after" toml:"after" yaml:"after"`
+	Actor     string    `boil:"actor" json:"actor" toml:"actor" yaml:"actor"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+}
+
+// insertAuditEntry adds an entry for the row with the primary key key to the
+// audit table, before and after are encoded as json, nil is null.
+func insertAuditEntry(ctx context.Context, exec boil.ContextExecutor, table, action string, key map[string]interface{}, before, after interface{}) error {
+	rowKey, err := json.Marshal(key)
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to encode the key of the audited row")
+	}
+
+	values := make([]interface{}, 2)
+	for i, v := range []interface{}{before, after} {
+		if v == nil {
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrap(err, "{{.PkgName}}: unable to encode the audited row")
+		}
+		values[i] = string(b)
+	}
+
+	query := "INSERT INTO " + table + " ({{"action" | .Quotes}}, {{"row_key" | .Quotes}}, {{"before" | .Quotes}}, {{"after" | .Quotes}}, {{"actor" | .Quotes}}, {{"created_at" | .Quotes}}) VALUES (" + dialect.Placeholders(6, 1, 1) + ")"
+	_, err = boil.ExecContext(ctx, exec, query, action, string(rowKey), values[0], values[1], boil.AuditActorFrom(ctx), time.Now().In(boil.GetLocation()))
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to insert into "+table)
+	}
+
+	return nil
+}
+
+// auditEntries returns the entries of the audit table for the row with the
+// primary key key, oldest first.
+func auditEntries(ctx context.Context, exec boil.ContextExecutor, table string, key map[string]interface{}) ([]*AuditEntry, error) {
+	rowKey, err := json.Marshal(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "{{.PkgName}}: unable to encode the key of the audited row")
+	}
+
+	var entries []*AuditEntry
+	query := "SELECT * FROM " + table + " WHERE {{"row_key" | .Quotes}} = " + dialect.Placeholder(1) + " ORDER BY {{"id" | .Quotes}}"
+	if err := queries.Raw(query, string(rowKey)).Bind(ctx, exec, &entries); err != nil {
+		return nil, errors.Wrap(err, "{{.PkgName}}: unable to select from "+table)
+	}
+
+	return entries, nil
+}
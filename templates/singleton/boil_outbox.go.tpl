@@ -0,0 +1,77 @@
+// Operations of change events
+const (
+	ChangeInsert = "insert"
+	ChangeUpdate = "update"
+	ChangeUpsert = "upsert"
+	ChangeDelete = "delete"
+)
+
+// OutboxMessage is a row of the outbox table. The hooks of the models of the
+// tables that publish change events add one for every insert, update, upsert
+// and delete in the transaction of the change, a relay reads them with
+// OutboxMessages, publishes their payload to a broker and deletes them with
+// DeleteOutboxMessages.
+type OutboxMessage struct {
+	ID        int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Topic     string     `boil:"topic" json:"topic" toml:"topic" yaml:"topic"`
+	RowKey    string     `boil:"row_key" json:"row_key" toml:"row_key" yaml:"row_key"`
+	Payload   types.JSON `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+}
+
+// insertOutboxMessage adds a message with event encoded as json to the
+// outbox, the topic is the name of the changed table and the key the primary
+// key of the changed row.
+func insertOutboxMessage(ctx context.Context, exec boil.ContextExecutor, topic string, key map[string]interface{}, event interface{}) error {
+	rowKey, err := json.Marshal(key)
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to encode the key of the changed row")
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to encode the change event")
+	}
+
+	query := "INSERT INTO {{"outbox" | .SchemaTable}} ({{"topic" | .Quotes}}, {{"row_key" | .Quotes}}, {{"payload" | .Quotes}}, {{"created_at" | .Quotes}}) VALUES (" + dialect.Placeholders(4, 1, 1) + ")"
+	_, err = boil.ExecContext(ctx, exec, query, topic, string(rowKey), string(payload), time.Now().In(boil.GetLocation()))
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to insert into outbox")
+	}
+
+	return nil
+}
+
+// OutboxMessages returns up to limit messages of the outbox, oldest first.
+func OutboxMessages(ctx context.Context, exec boil.ContextExecutor, limit int) ([]*OutboxMessage, error) {
+	var messages []*OutboxMessage
+	q := NewQuery(
+		qm.From("{{"outbox" | .SchemaTable}}"),
+		qm.OrderBy("{{"id" | .Quotes}}"),
+		qm.Limit(limit),
+	)
+	if err := q.Bind(ctx, exec, &messages); err != nil {
+		return nil, errors.Wrap(err, "{{.PkgName}}: unable to select from outbox")
+	}
+
+	return messages, nil
+}
+
+// DeleteOutboxMessages deletes the messages of the outbox with the given ids,
+// once they've been published.
+func DeleteOutboxMessages(ctx context.Context, exec boil.ContextExecutor, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	query := "DELETE FROM {{"outbox" | .SchemaTable}} WHERE {{"id" | .Quotes}} IN (" + dialect.Placeholders(len(ids), 1, 1) + ")"
+	if _, err := boil.ExecContext(ctx, exec, query, args...); err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to delete from outbox")
+	}
+
+	return nil
+}
@@ -0,0 +1,515 @@
+// The converters below translate between the null types of the models and
+// the types protoc generates for nullable fields: the well known wrapper
+// types, eg. google.protobuf.Int64Value, and proto3 optional fields. Null
+// becomes nil and nil becomes null. Integers that are narrower in Go than in
+// protobuf are converted like Go conversions, so out of range values wrap.
+
+// NullStringToProto converts a null.String to a *wrapperspb.StringValue.
+func NullStringToProto(v null.String) *wrapperspb.StringValue {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.String(v.String)
+}
+
+// NullStringFromProto converts a *wrapperspb.StringValue to a null.String.
+func NullStringFromProto(v *wrapperspb.StringValue) null.String {
+	if v == nil {
+		return null.String{}
+	}
+	return null.StringFrom(v.Value)
+}
+
+// NullStringToOptional converts a null.String to an optional string field.
+func NullStringToOptional(v null.String) *string {
+	if !v.Valid {
+		return nil
+	}
+	o := v.String
+	return &o
+}
+
+// NullStringFromOptional converts an optional string field to a null.String.
+func NullStringFromOptional(v *string) null.String {
+	if v == nil {
+		return null.String{}
+	}
+	return null.StringFrom(*v)
+}
+
+// NullBoolToProto converts a null.Bool to a *wrapperspb.BoolValue.
+func NullBoolToProto(v null.Bool) *wrapperspb.BoolValue {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.Bool(v.Bool)
+}
+
+// NullBoolFromProto converts a *wrapperspb.BoolValue to a null.Bool.
+func NullBoolFromProto(v *wrapperspb.BoolValue) null.Bool {
+	if v == nil {
+		return null.Bool{}
+	}
+	return null.BoolFrom(v.Value)
+}
+
+// NullBoolToOptional converts a null.Bool to an optional bool field.
+func NullBoolToOptional(v null.Bool) *bool {
+	if !v.Valid {
+		return nil
+	}
+	o := v.Bool
+	return &o
+}
+
+// NullBoolFromOptional converts an optional bool field to a null.Bool.
+func NullBoolFromOptional(v *bool) null.Bool {
+	if v == nil {
+		return null.Bool{}
+	}
+	return null.BoolFrom(*v)
+}
+
+// NullIntToProto converts a null.Int to a *wrapperspb.Int64Value.
+func NullIntToProto(v null.Int) *wrapperspb.Int64Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.Int64(int64(v.Int))
+}
+
+// NullIntFromProto converts a *wrapperspb.Int64Value to a null.Int.
+func NullIntFromProto(v *wrapperspb.Int64Value) null.Int {
+	if v == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(int(v.Value))
+}
+
+// NullIntToOptional converts a null.Int to an optional int64 field.
+func NullIntToOptional(v null.Int) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	o := int64(v.Int)
+	return &o
+}
+
+// NullIntFromOptional converts an optional int64 field to a null.Int.
+func NullIntFromOptional(v *int64) null.Int {
+	if v == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(int(*v))
+}
+
+// NullInt8ToProto converts a null.Int8 to a *wrapperspb.Int32Value.
+func NullInt8ToProto(v null.Int8) *wrapperspb.Int32Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.Int32(int32(v.Int8))
+}
+
+// NullInt8FromProto converts a *wrapperspb.Int32Value to a null.Int8.
+func NullInt8FromProto(v *wrapperspb.Int32Value) null.Int8 {
+	if v == nil {
+		return null.Int8{}
+	}
+	return null.Int8From(int8(v.Value))
+}
+
+// NullInt8ToOptional converts a null.Int8 to an optional int32 field.
+func NullInt8ToOptional(v null.Int8) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	o := int32(v.Int8)
+	return &o
+}
+
+// NullInt8FromOptional converts an optional int32 field to a null.Int8.
+func NullInt8FromOptional(v *int32) null.Int8 {
+	if v == nil {
+		return null.Int8{}
+	}
+	return null.Int8From(int8(*v))
+}
+
+// NullInt16ToProto converts a null.Int16 to a *wrapperspb.Int32Value.
+func NullInt16ToProto(v null.Int16) *wrapperspb.Int32Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.Int32(int32(v.Int16))
+}
+
+// NullInt16FromProto converts a *wrapperspb.Int32Value to a null.Int16.
+func NullInt16FromProto(v *wrapperspb.Int32Value) null.Int16 {
+	if v == nil {
+		return null.Int16{}
+	}
+	return null.Int16From(int16(v.Value))
+}
+
+// NullInt16ToOptional converts a null.Int16 to an optional int32 field.
+func NullInt16ToOptional(v null.Int16) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	o := int32(v.Int16)
+	return &o
+}
+
+// NullInt16FromOptional converts an optional int32 field to a null.Int16.
+func NullInt16FromOptional(v *int32) null.Int16 {
+	if v == nil {
+		return null.Int16{}
+	}
+	return null.Int16From(int16(*v))
+}
+
+// NullInt32ToProto converts a null.Int32 to a *wrapperspb.Int32Value.
+func NullInt32ToProto(v null.Int32) *wrapperspb.Int32Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.Int32(v.Int32)
+}
+
+// NullInt32FromProto converts a *wrapperspb.Int32Value to a null.Int32.
+func NullInt32FromProto(v *wrapperspb.Int32Value) null.Int32 {
+	if v == nil {
+		return null.Int32{}
+	}
+	return null.Int32From(v.Value)
+}
+
+// NullInt32ToOptional converts a null.Int32 to an optional int32 field.
+func NullInt32ToOptional(v null.Int32) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	o := v.Int32
+	return &o
+}
+
+// NullInt32FromOptional converts an optional int32 field to a null.Int32.
+func NullInt32FromOptional(v *int32) null.Int32 {
+	if v == nil {
+		return null.Int32{}
+	}
+	return null.Int32From(*v)
+}
+
+// NullInt64ToProto converts a null.Int64 to a *wrapperspb.Int64Value.
+func NullInt64ToProto(v null.Int64) *wrapperspb.Int64Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.Int64(v.Int64)
+}
+
+// NullInt64FromProto converts a *wrapperspb.Int64Value to a null.Int64.
+func NullInt64FromProto(v *wrapperspb.Int64Value) null.Int64 {
+	if v == nil {
+		return null.Int64{}
+	}
+	return null.Int64From(v.Value)
+}
+
+// NullInt64ToOptional converts a null.Int64 to an optional int64 field.
+func NullInt64ToOptional(v null.Int64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	o := v.Int64
+	return &o
+}
+
+// NullInt64FromOptional converts an optional int64 field to a null.Int64.
+func NullInt64FromOptional(v *int64) null.Int64 {
+	if v == nil {
+		return null.Int64{}
+	}
+	return null.Int64From(*v)
+}
+
+// NullUintToProto converts a null.Uint to a *wrapperspb.UInt64Value.
+func NullUintToProto(v null.Uint) *wrapperspb.UInt64Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.UInt64(uint64(v.Uint))
+}
+
+// NullUintFromProto converts a *wrapperspb.UInt64Value to a null.Uint.
+func NullUintFromProto(v *wrapperspb.UInt64Value) null.Uint {
+	if v == nil {
+		return null.Uint{}
+	}
+	return null.UintFrom(uint(v.Value))
+}
+
+// NullUintToOptional converts a null.Uint to an optional uint64 field.
+func NullUintToOptional(v null.Uint) *uint64 {
+	if !v.Valid {
+		return nil
+	}
+	o := uint64(v.Uint)
+	return &o
+}
+
+// NullUintFromOptional converts an optional uint64 field to a null.Uint.
+func NullUintFromOptional(v *uint64) null.Uint {
+	if v == nil {
+		return null.Uint{}
+	}
+	return null.UintFrom(uint(*v))
+}
+
+// NullUint8ToProto converts a null.Uint8 to a *wrapperspb.UInt32Value.
+func NullUint8ToProto(v null.Uint8) *wrapperspb.UInt32Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32(v.Uint8))
+}
+
+// NullUint8FromProto converts a *wrapperspb.UInt32Value to a null.Uint8.
+func NullUint8FromProto(v *wrapperspb.UInt32Value) null.Uint8 {
+	if v == nil {
+		return null.Uint8{}
+	}
+	return null.Uint8From(uint8(v.Value))
+}
+
+// NullUint8ToOptional converts a null.Uint8 to an optional uint32 field.
+func NullUint8ToOptional(v null.Uint8) *uint32 {
+	if !v.Valid {
+		return nil
+	}
+	o := uint32(v.Uint8)
+	return &o
+}
+
+// NullUint8FromOptional converts an optional uint32 field to a null.Uint8.
+func NullUint8FromOptional(v *uint32) null.Uint8 {
+	if v == nil {
+		return null.Uint8{}
+	}
+	return null.Uint8From(uint8(*v))
+}
+
+// NullUint16ToProto converts a null.Uint16 to a *wrapperspb.UInt32Value.
+func NullUint16ToProto(v null.Uint16) *wrapperspb.UInt32Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32(v.Uint16))
+}
+
+// NullUint16FromProto converts a *wrapperspb.UInt32Value to a null.Uint16.
+func NullUint16FromProto(v *wrapperspb.UInt32Value) null.Uint16 {
+	if v == nil {
+		return null.Uint16{}
+	}
+	return null.Uint16From(uint16(v.Value))
+}
+
+// NullUint16ToOptional converts a null.Uint16 to an optional uint32 field.
+func NullUint16ToOptional(v null.Uint16) *uint32 {
+	if !v.Valid {
+		return nil
+	}
+	o := uint32(v.Uint16)
+	return &o
+}
+
+// NullUint16FromOptional converts an optional uint32 field to a null.Uint16.
+func NullUint16FromOptional(v *uint32) null.Uint16 {
+	if v == nil {
+		return null.Uint16{}
+	}
+	return null.Uint16From(uint16(*v))
+}
+
+// NullUint32ToProto converts a null.Uint32 to a *wrapperspb.UInt32Value.
+func NullUint32ToProto(v null.Uint32) *wrapperspb.UInt32Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.UInt32(v.Uint32)
+}
+
+// NullUint32FromProto converts a *wrapperspb.UInt32Value to a null.Uint32.
+func NullUint32FromProto(v *wrapperspb.UInt32Value) null.Uint32 {
+	if v == nil {
+		return null.Uint32{}
+	}
+	return null.Uint32From(v.Value)
+}
+
+// NullUint32ToOptional converts a null.Uint32 to an optional uint32 field.
+func NullUint32ToOptional(v null.Uint32) *uint32 {
+	if !v.Valid {
+		return nil
+	}
+	o := v.Uint32
+	return &o
+}
+
+// NullUint32FromOptional converts an optional uint32 field to a null.Uint32.
+func NullUint32FromOptional(v *uint32) null.Uint32 {
+	if v == nil {
+		return null.Uint32{}
+	}
+	return null.Uint32From(*v)
+}
+
+// NullUint64ToProto converts a null.Uint64 to a *wrapperspb.UInt64Value.
+func NullUint64ToProto(v null.Uint64) *wrapperspb.UInt64Value {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.UInt64(v.Uint64)
+}
+
+// NullUint64FromProto converts a *wrapperspb.UInt64Value to a null.Uint64.
+func NullUint64FromProto(v *wrapperspb.UInt64Value) null.Uint64 {
+	if v == nil {
+		return null.Uint64{}
+	}
+	return null.Uint64From(v.Value)
+}
+
+// NullUint64ToOptional converts a null.Uint64 to an optional uint64 field.
+func NullUint64ToOptional(v null.Uint64) *uint64 {
+	if !v.Valid {
+		return nil
+	}
+	o := v.Uint64
+	return &o
+}
+
+// NullUint64FromOptional converts an optional uint64 field to a null.Uint64.
+func NullUint64FromOptional(v *uint64) null.Uint64 {
+	if v == nil {
+		return null.Uint64{}
+	}
+	return null.Uint64From(*v)
+}
+
+// NullFloat32ToProto converts a null.Float32 to a *wrapperspb.FloatValue.
+func NullFloat32ToProto(v null.Float32) *wrapperspb.FloatValue {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.Float(v.Float32)
+}
+
+// NullFloat32FromProto converts a *wrapperspb.FloatValue to a null.Float32.
+func NullFloat32FromProto(v *wrapperspb.FloatValue) null.Float32 {
+	if v == nil {
+		return null.Float32{}
+	}
+	return null.Float32From(v.Value)
+}
+
+// NullFloat32ToOptional converts a null.Float32 to an optional float32 field.
+func NullFloat32ToOptional(v null.Float32) *float32 {
+	if !v.Valid {
+		return nil
+	}
+	o := v.Float32
+	return &o
+}
+
+// NullFloat32FromOptional converts an optional float32 field to a null.Float32.
+func NullFloat32FromOptional(v *float32) null.Float32 {
+	if v == nil {
+		return null.Float32{}
+	}
+	return null.Float32From(*v)
+}
+
+// NullFloat64ToProto converts a null.Float64 to a *wrapperspb.DoubleValue.
+func NullFloat64ToProto(v null.Float64) *wrapperspb.DoubleValue {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.Double(v.Float64)
+}
+
+// NullFloat64FromProto converts a *wrapperspb.DoubleValue to a null.Float64.
+func NullFloat64FromProto(v *wrapperspb.DoubleValue) null.Float64 {
+	if v == nil {
+		return null.Float64{}
+	}
+	return null.Float64From(v.Value)
+}
+
+// NullFloat64ToOptional converts a null.Float64 to an optional float64 field.
+func NullFloat64ToOptional(v null.Float64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	o := v.Float64
+	return &o
+}
+
+// NullFloat64FromOptional converts an optional float64 field to a null.Float64.
+func NullFloat64FromOptional(v *float64) null.Float64 {
+	if v == nil {
+		return null.Float64{}
+	}
+	return null.Float64From(*v)
+}
+
+// NullBytesToProto converts a null.Bytes to a *wrapperspb.BytesValue.
+func NullBytesToProto(v null.Bytes) *wrapperspb.BytesValue {
+	if !v.Valid {
+		return nil
+	}
+	return wrapperspb.Bytes(v.Bytes)
+}
+
+// NullBytesFromProto converts a *wrapperspb.BytesValue to a null.Bytes.
+func NullBytesFromProto(v *wrapperspb.BytesValue) null.Bytes {
+	if v == nil {
+		return null.Bytes{}
+	}
+	return null.BytesFrom(v.Value)
+}
+
+// NullBytesToOptional converts a null.Bytes to an optional bytes field.
+func NullBytesToOptional(v null.Bytes) []byte {
+	if !v.Valid {
+		return nil
+	}
+	return v.Bytes
+}
+
+// NullBytesFromOptional converts an optional bytes field to a null.Bytes.
+func NullBytesFromOptional(v []byte) null.Bytes {
+	if v == nil {
+		return null.Bytes{}
+	}
+	return null.BytesFrom(v)
+}
+
+// NullTimeToProto converts a null.Time to a *timestamppb.Timestamp.
+func NullTimeToProto(v null.Time) *timestamppb.Timestamp {
+	if !v.Valid {
+		return nil
+	}
+	return timestamppb.New(v.Time)
+}
+
+// NullTimeFromProto converts a *timestamppb.Timestamp to a null.Time, in UTC.
+func NullTimeFromProto(v *timestamppb.Timestamp) null.Time {
+	if v == nil {
+		return null.Time{}
+	}
+	return null.TimeFrom(v.AsTime())
+}
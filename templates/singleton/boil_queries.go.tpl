@@ -1,4 +1,8 @@
 var dialect = drivers.Dialect{
+	{{- if .Dialect.Name}}
+	Name: {{printf "%q" .Dialect.Name}},
+
+	{{end -}}
 	LQ: 0x{{printf "%x" .Dialect.LQ}},
 	RQ: 0x{{printf "%x" .Dialect.RQ}},
 
@@ -6,10 +10,20 @@ var dialect = drivers.Dialect{
 	UseLastInsertID:         {{.Dialect.UseLastInsertID}},
 	UseSchema:               {{.Dialect.UseSchema}},
 	UseDefaultKeyword:       {{.Dialect.UseDefaultKeyword}},
+	UseLateralJoin:          {{.Dialect.UseLateralJoin}},
 	UseAutoColumns:          {{.Dialect.UseAutoColumns}},
 	UseTopClause:            {{.Dialect.UseTopClause}},
 	UseOutputClause:         {{.Dialect.UseOutputClause}},
 	UseCaseWhenExistsClause: {{.Dialect.UseCaseWhenExistsClause}},
+	{{- if .Dialect.IndexPlaceholderPrefix}}
+	IndexPlaceholderPrefix:  {{printf "%q" .Dialect.IndexPlaceholderPrefix}},
+	{{- end}}
+	{{- if .Dialect.NamedPlaceholderPrefix}}
+	NamedPlaceholderPrefix:  {{printf "%q" .Dialect.NamedPlaceholderPrefix}},
+	{{- end}}
+	{{- if .Dialect.MaxParameters}}
+	MaxParameters:           {{.Dialect.MaxParameters}},
+	{{- end}}
 }
 
 // NewQuery initializes a new Query using the passed in QueryMods
@@ -20,3 +34,19 @@ func NewQuery(mods ...qm.QueryMod) *queries.Query {
 
 	return q
 }
+
+// NewSchemaExecutor wraps exec so that queries run with a context from
+// boil.WithSchema target that schema instead of the one the models were
+// generated against.{{if not .Dialect.UseSchema}} The models don't qualify
+// table names with a schema so the wrapper qualifies them itself.{{end}}
+func NewSchemaExecutor(exec boil.ContextExecutor) boil.ContextExecutor {
+	{{if .Dialect.UseSchema -}}
+	return boil.NewSchemaExecutor(exec, "{{.LQ}}", "{{.RQ}}", {{printf "%q" .Schema}})
+	{{- else -}}
+	return boil.NewSchemaExecutor(exec, "{{.LQ}}", "{{.RQ}}", "",
+		{{- range $i, $table := .Tables}}{{if $i}},{{end}}
+		{{printf "%q" $table.Name}}
+		{{- end}},
+	)
+	{{- end}}
+}
@@ -0,0 +1,100 @@
+// SchemaHash identifies the tables and columns the models were generated
+// from, it changes whenever they do.
+const SchemaHash = "{{schemaHash .Tables}}"
+
+type verifySchemaColumn struct {
+	name     string
+	dbType   string
+	nullable bool
+}
+
+// verifySchemaTables are the columns of every table as they were when the
+// models were generated.
+var verifySchemaTables = []struct {
+	name    string
+	columns []verifySchemaColumn
+}{
+	{{range $table := .Tables -}}
+	{"{{$table.Name}}", []verifySchemaColumn{
+		{{range $column := $table.Columns -}}
+		{"{{$column.Name}}", {{printf "%q" $column.DBType}}, {{$column.Nullable}}},
+		{{end -}}
+	}},
+	{{end -}}
+}
+
+// VerifySchema checks that the database has every table and column the models
+// were generated from with the same types, and that columns the models can't
+// hold nulls for aren't nullable. Call it at startup so a database that
+// wasn't migrated, or models that weren't regenerated, fail fast instead of
+// with scan errors later on. Tables and columns the models don't know about
+// are ignored.
+func VerifySchema({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	{{if .Dialect.UseSchema -}}
+	query := "SELECT table_name, column_name, data_type, is_nullable FROM information_schema.columns WHERE table_schema = " + dialect.Placeholder(1)
+	args := []interface{}{ {{- printf "%q" .Schema -}} }
+	{{- else -}}
+	query := "SELECT table_name, column_name, data_type, is_nullable FROM information_schema.columns WHERE table_schema = {{if eq .Dialect.Name "mysql"}}DATABASE(){{else}}current_schema(){{end}}"
+	var args []interface{}
+	{{- end}}
+
+	{{if .NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	rows, err := exec.Query(query, args...)
+	{{- else -}}
+	rows, err := boil.QueryContext(ctx, exec, query, args...)
+	{{- end}}
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to read the database schema")
+	}
+	defer rows.Close()
+
+	found := make(map[string]map[string]verifySchemaColumn)
+	for rows.Next() {
+		var table, nullable string
+		var column verifySchemaColumn
+		if err := rows.Scan(&table, &column.name, &column.dbType, &nullable); err != nil {
+			return errors.Wrap(err, "{{.PkgName}}: unable to scan the database schema")
+		}
+		column.nullable = strings.EqualFold(nullable, "YES")
+
+		if found[table] == nil {
+			found[table] = make(map[string]verifySchemaColumn)
+		}
+		found[table][column.name] = column
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to read the database schema")
+	}
+
+	var problems []string
+	for _, table := range verifySchemaTables {
+		columns, ok := found[table.name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("table %s is missing", table.name))
+			continue
+		}
+
+		for _, want := range table.columns {
+			got, ok := columns[want.name]
+			switch {
+			case !ok:
+				problems = append(problems, fmt.Sprintf("column %s.%s is missing", table.name, want.name))
+			// Enum types are named after their values, only check they exist
+			case !strings.HasPrefix(want.dbType, "enum") && !strings.EqualFold(got.dbType, want.dbType):
+				problems = append(problems, fmt.Sprintf("column %s.%s is %s, want %s", table.name, want.name, got.dbType, want.dbType))
+			case got.nullable && !want.nullable:
+				problems = append(problems, fmt.Sprintf("column %s.%s is nullable", table.name, want.name))
+			}
+		}
+	}
+
+	if len(problems) != 0 {
+		return errors.Errorf("{{.PkgName}}: database doesn't match the models generated from schema %s: %s", SchemaHash, strings.Join(problems, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// SequenceNames are the names of the schema's sequences
+var SequenceNames = struct {
+	{{range $seq := .Sequences -}}
+	{{titleCase $seq.Name}} string
+	{{end -}}
+}{
+	{{range $seq := .Sequences -}}
+	{{titleCase $seq.Name}}: "{{$seq.Name}}",
+	{{end -}}
+}
+
+// SequenceColumns are the table.column each sequence generates values for, by
+// sequence name. Sequences that aren't owned by a column aren't included.
+var SequenceColumns = map[string]string{
+	{{range $seq := .Sequences -}}
+	{{if $seq.Table -}}
+	"{{$seq.Name}}": "{{$seq.Table}}.{{$seq.Column}}",
+	{{end -}}
+	{{end -}}
+}
+
+// nextSequenceValue runs query, which selects the next value of the sequence
+// name.
+func nextSequenceValue({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, name, query string) (int64, error) {
+	var value int64
+	{{if .NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+	}
+	err := exec.QueryRow(query).Scan(&value)
+	{{- else -}}
+	err := boil.QueryRowContext(ctx, exec, query).Scan(&value)
+	{{- end}}
+	if err != nil {
+		return 0, errors.Wrapf(err, "{{.PkgName}}: unable to get the next value of %s", name)
+	}
+
+	return value, nil
+}
+{{range $seq := .Sequences}}
+{{- $fn := printf "Next%s" (titleCase $seq.Name) -}}
+{{- if $seq.Table -}}
+{{- $alias := $.Aliases.Table $seq.Table -}}
+{{- $fn = printf "Next%s%s" $alias.UpSingular ($alias.Column $seq.Column) -}}
+{{- end}}
+// {{$fn}} allocates the next value of the {{$seq.Name}} sequence
+{{- if $seq.Table}}, which
+// generates {{$seq.Table}}.{{$seq.Column}}. Set the column to it to know the id before inserting.
+{{- else}}.
+{{- end}}
+func {{$fn}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (int64, error) {
+	return nextSequenceValue({{if not $.NoContext}}ctx, {{end}}exec, "{{$seq.Name}}",
+		{{- if eq $.Dialect.Name "mssql"}} "SELECT NEXT VALUE FOR {{$.SchemaTable $seq.Name}}")
+		{{- else}} "SELECT nextval('{{$.SchemaTable $seq.Name}}')")
+		{{- end}}
+}
+{{if $.AddGlobal}}
+// {{$fn}}G allocates the next value of the {{$seq.Name}} sequence, using the global executor.
+func {{$fn}}G({{if not $.NoContext}}ctx context.Context{{end}}) (int64, error) {
+	return {{$fn}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}})
+}
+{{end -}}
+{{if $.AddPanic}}
+// {{$fn}}P allocates the next value of the {{$seq.Name}} sequence, and panics on error.
+func {{$fn}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) int64 {
+	value, err := {{$fn}}({{if not $.NoContext}}ctx, {{end}}exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return value
+}
+{{end -}}
+{{if and $.AddGlobal $.AddPanic}}
+// {{$fn}}GP allocates the next value of the {{$seq.Name}} sequence, using the global executor, and panics on error.
+func {{$fn}}GP({{if not $.NoContext}}ctx context.Context{{end}}) int64 {
+	value, err := {{$fn}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return value
+}
+{{end -}}
+{{end -}}
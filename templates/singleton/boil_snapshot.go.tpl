@@ -0,0 +1,235 @@
+// snapshotTables are all tables, every table comes after the tables its
+// foreign keys reference so rows can be inserted in this order and deleted
+// in the reverse order. The columns are the ones values can be inserted
+// into, identity is set when one of them is an identity column.
+var snapshotTables = []snapshotTableInfo{
+	{{range $table := tablesByDependency .Tables -}}
+	{{- $identity := false -}}
+	{{- range $table.Columns -}}
+	{{- if $.Dialect.UseOutputClause -}}
+	{{- if and (eq .Default "auto") (not .AutoGenerated)}}{{$identity = true}}{{end -}}
+	{{- else if eq .Default "IDENTITY"}}{{$identity = true}}{{end -}}
+	{{- end -}}
+	{
+		name:       "{{$table.Name}}",
+		quoted:     "{{$.SchemaTable $table.Name}}",
+		columns:    []string{ {{- range $table.Columns}}{{if not .AutoGenerated}}"{{.Name}}", {{end}}{{end -}} },
+		references: []string{ {{- range $table.FKeys}}"{{.ForeignTable}}", {{end}}{{range $table.CompositeFKeys}}"{{.ForeignTable}}", {{end -}} },
+		identity:   {{$identity}},
+	},
+	{{end -}}
+}
+
+type snapshotTableInfo struct {
+	name       string
+	quoted     string
+	columns    []string
+	references []string
+	identity   bool
+}
+
+// Snapshot holds the rows of a set of tables, see TakeSnapshot.
+type Snapshot struct {
+	tables []tableSnapshot
+}
+
+type tableSnapshot struct {
+	snapshotTableInfo
+	rows [][]interface{}
+}
+
+// TakeSnapshot saves the rows of the given tables, or of every table if none
+// are given, so that Restore can put them back after a test has changed
+// them. Tables with foreign keys referencing a given table are saved too
+// since its rows can't be deleted while theirs remain. The rows are held in
+// memory so this is meant for test databases.
+func TakeSnapshot({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, tables ...string) (*Snapshot, error) {
+	selected, err := selectSnapshotTables(tables)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &Snapshot{tables: make([]tableSnapshot, 0, len(selected))}
+	for _, info := range selected {
+		{{if .NoContext -}}
+		table, err := snapshotTable(exec, info)
+		{{- else -}}
+		table, err := snapshotTable(ctx, exec, info)
+		{{- end}}
+		if err != nil {
+			return nil, err
+		}
+		snap.tables = append(snap.tables, table)
+	}
+
+	return snap, nil
+}
+
+// Restore replaces the rows of the snapshotted tables with the saved ones.
+// Rows are deleted from referencing tables first and inserted into
+// referenced tables first so foreign keys hold, but rows of a table that
+// references itself are inserted in the order they were read. Identity
+// columns get their saved values back, columns the database generates
+// (eg. rowversion) get new ones. Run it in a transaction so a failure
+// leaves the tables as they were.
+func (s *Snapshot) Restore({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	for i := len(s.tables) - 1; i >= 0; i-- {
+		query := "DELETE FROM " + s.tables[i].quoted
+		{{if .NoContext -}}
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, query)
+		}
+		_, err := exec.Exec(query)
+		{{- else -}}
+		_, err := boil.ExecContext(ctx, exec, query)
+		{{- end}}
+		if err != nil {
+			return errors.Wrapf(err, "{{.PkgName}}: unable to delete rows of %s", s.tables[i].quoted)
+		}
+	}
+
+	for _, table := range s.tables {
+		if len(table.rows) == 0 {
+			continue
+		}
+
+		{{if .Dialect.UseOutputClause -}}
+		query := fmt.Sprintf("INSERT INTO %s ({{.LQ}}%s{{.RQ}}) VALUES (%s)",
+			table.quoted,
+			strings.Join(table.columns, "{{.RQ}},{{.LQ}}"),
+			dialect.Placeholders(len(table.columns), 1, 1),
+		)
+		if table.identity {
+			// IDENTITY_INSERT only lasts for the session, so it's turned on
+			// in the same batch as the insert
+			query = fmt.Sprintf("SET IDENTITY_INSERT %[1]s ON; %[2]s; SET IDENTITY_INSERT %[1]s OFF", table.quoted, query)
+		}
+		{{- else -}}
+		overriding := ""
+		if table.identity {
+			overriding = " OVERRIDING SYSTEM VALUE"
+		}
+		query := fmt.Sprintf("INSERT INTO %s ({{.LQ}}%s{{.RQ}}){{"%s"}} VALUES (%s)",
+			table.quoted,
+			strings.Join(table.columns, "{{.RQ}},{{.LQ}}"),
+			overriding,
+			dialect.Placeholders(len(table.columns), 1, 1),
+		)
+		{{- end}}
+		for _, row := range table.rows {
+			{{if .NoContext -}}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, query)
+				fmt.Fprintln(boil.DebugWriter, row...)
+			}
+			_, err := exec.Exec(query, row...)
+			{{- else -}}
+			_, err := boil.ExecContext(ctx, exec, query, row...)
+			{{- end}}
+			if err != nil {
+				return errors.Wrapf(err, "{{.PkgName}}: unable to restore rows of %s", table.quoted)
+			}
+		}
+	}
+
+	return nil
+}
+
+// TruncateTables deletes all rows of the given tables, or of every table if
+// none are given, referencing tables first so foreign keys hold. Like
+// TakeSnapshot the tables referencing the given ones are emptied too.
+func TruncateTables({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, tables ...string) error {
+	selected, err := selectSnapshotTables(tables)
+	if err != nil {
+		return err
+	}
+
+	snap := &Snapshot{tables: make([]tableSnapshot, len(selected))}
+	for i, info := range selected {
+		snap.tables[i].snapshotTableInfo = info
+	}
+
+	return snap.Restore({{if not .NoContext}}ctx, {{end}}exec)
+}
+
+// selectSnapshotTables returns the given tables and the tables referencing
+// them in dependency order, all of them when tables is empty.
+func selectSnapshotTables(tables []string) ([]snapshotTableInfo, error) {
+	want := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		want[t] = true
+	}
+
+	known := make(map[string]bool, len(snapshotTables))
+	for _, t := range snapshotTables {
+		known[t.name] = true
+	}
+	for _, t := range tables {
+		if !known[t] {
+			return nil, errors.Errorf("{{.PkgName}}: unknown table %s", t)
+		}
+	}
+
+	// Tables usually come after the ones they reference, but foreign key
+	// cycles need another pass
+	for added := true; added; {
+		added = false
+		for _, t := range snapshotTables {
+			if want[t.name] {
+				continue
+			}
+			for _, ref := range t.references {
+				if want[ref] {
+					want[t.name] = true
+					added = true
+					break
+				}
+			}
+		}
+	}
+
+	selected := make([]snapshotTableInfo, 0, len(snapshotTables))
+	for _, t := range snapshotTables {
+		if len(tables) == 0 || want[t.name] {
+			selected = append(selected, t)
+		}
+	}
+
+	return selected, nil
+}
+
+func snapshotTable({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, info snapshotTableInfo) (tableSnapshot, error) {
+	table := tableSnapshot{snapshotTableInfo: info}
+
+	query := fmt.Sprintf("SELECT {{.LQ}}%s{{.RQ}} FROM %s", strings.Join(info.columns, "{{.RQ}},{{.LQ}}"), info.quoted)
+	{{if .NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+	}
+	rows, err := exec.Query(query)
+	{{- else -}}
+	rows, err := boil.QueryContext(ctx, exec, query)
+	{{- end}}
+	if err != nil {
+		return table, errors.Wrapf(err, "{{.PkgName}}: unable to read rows of %s", info.quoted)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		row := make([]interface{}, len(info.columns))
+		ptrs := make([]interface{}, len(row))
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+		if err = rows.Scan(ptrs...); err != nil {
+			return table, errors.Wrapf(err, "{{.PkgName}}: unable to read rows of %s", info.quoted)
+		}
+		table.rows = append(table.rows, row)
+	}
+
+	if err = rows.Err(); err != nil {
+		return table, errors.Wrapf(err, "{{.PkgName}}: unable to read rows of %s", info.quoted)
+	}
+
+	return table, nil
+}
@@ -19,7 +19,7 @@ type updateCache struct {
 }
 
 func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
-	buf := strmangle.GetBuffer()
+	buf := boil.GetBuffer()
 
 	buf.WriteString(strconv.Itoa(cols.Kind))
 	for _, w := range cols.Cols {
@@ -34,7 +34,7 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	}
 
 	str := buf.String()
-	strmangle.PutBuffer(buf)
+	boil.PutBuffer(buf)
 	return str
 }
 
@@ -0,0 +1,9 @@
+// Package templates embeds sqlboiler's default templates, see templatebin.
+package templates
+
+import "embed"
+
+// FS holds the templates, the files are named relative to this directory.
+//
+//go:embed *.tpl singleton docs adminctl typescript
+var FS embed.FS
@@ -0,0 +1,52 @@
+{{- if not .NoContext -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $soft := and .AddSoftDeletes .Table.CanSoftDelete -}}
+func test{{$alias.UpPlural}}DebugOutput(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len({{$alias.DownSingular}}AllColumns) == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := testSeed
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	buf := &bytes.Buffer{}
+	ctx := boil.WithDebugWriter(context.Background(), buf)
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("INSERT INTO")) {
+		t.Errorf("want the insert in the debug output, got:\n%s", buf)
+	}
+
+	buf.Reset()
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("UPDATE")) {
+		t.Errorf("want the update in the debug output, got:\n%s", buf)
+	}
+
+	buf.Reset()
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.Delete(ctx, tx{{if $soft}}, true{{end}}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("DELETE FROM")) {
+		t.Errorf("want the delete in the debug output, got:\n%s", buf)
+	}
+}
+{{end -}}
@@ -5,7 +5,7 @@
 func test{{$alias.UpPlural}}SoftDelete(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -46,7 +46,7 @@ func test{{$alias.UpPlural}}SoftDelete(t *testing.T) {
 func test{{$alias.UpPlural}}QuerySoftDeleteAll(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -87,7 +87,7 @@ func test{{$alias.UpPlural}}QuerySoftDeleteAll(t *testing.T) {
 func test{{$alias.UpPlural}}SliceSoftDeleteAll(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -132,7 +132,7 @@ func test{{$alias.UpPlural}}SliceSoftDeleteAll(t *testing.T) {
 func test{{$alias.UpPlural}}Delete(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -173,7 +173,7 @@ func test{{$alias.UpPlural}}Delete(t *testing.T) {
 func test{{$alias.UpPlural}}QueryDeleteAll(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -211,10 +211,45 @@ func test{{$alias.UpPlural}}QueryDeleteAll(t *testing.T) {
 	}
 }
 
+func test{{$alias.UpPlural}}QueryDeleteAllReturning(t *testing.T) {
+	t.Parallel()
+
+	seed := testSeed
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	deleted, err := {{$alias.UpPlural}}().DeleteAllReturning({{if not .NoContext}}ctx, {{end -}} tx {{- if $soft}}, true{{end}})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(deleted) != 1 {
+		t.Error("want one deleted record, got:", len(deleted))
+	}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
 func test{{$alias.UpPlural}}SliceDeleteAll(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -253,3 +288,53 @@ func test{{$alias.UpPlural}}SliceDeleteAll(t *testing.T) {
 		t.Error("want zero records, got:", count)
 	}
 }
+
+func test{{$alias.UpPlural}}SliceDeleteAllChunked(t *testing.T) {
+	t.Parallel()
+
+	seed := testSeed
+	var err error
+	o1 := &{{$alias.UpSingular}}{}
+	o2 := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o1, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomize.Struct(seed, o2, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o1.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = o2.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := {{$alias.UpSingular}}Slice{{"{"}}o1, o2{{"}"}}
+
+	{{if .NoRowsAffected -}}
+	if err = slice.DeleteAllChunked({{if not .NoContext}}ctx, {{end -}} tx, 1, 0 {{- if $soft}}, true{{end}}); err != nil {
+		t.Error(err)
+	}
+
+	{{else -}}
+	if rowsAff, err := slice.DeleteAllChunked({{if not .NoContext}}ctx, {{end -}} tx, 1, 0 {{- if $soft}}, true{{end}}); err != nil {
+		t.Error(err)
+	} else if rowsAff != 2 {
+		t.Error("should have deleted two rows, but affected:", rowsAff)
+	}
+
+	{{end -}}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
@@ -0,0 +1,42 @@
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $soft := and .AddSoftDeletes .Table.CanSoftDelete }}
+func test{{$alias.UpPlural}}DeleteCascade(t *testing.T) {
+	t.Parallel()
+
+	seed := testSeed
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	{{if .NoRowsAffected -}}
+	if err = o.DeleteCascade({{if not .NoContext}}ctx, {{end -}} tx{{if $soft}}, true{{end}}); err != nil {
+		t.Error(err)
+	}
+
+	{{else -}}
+	if rowsAff, err := o.DeleteCascade({{if not .NoContext}}ctx, {{end -}} tx{{if $soft}}, true{{end}}); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	{{end -}}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
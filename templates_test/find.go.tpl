@@ -2,7 +2,7 @@
 func test{{$alias.UpPlural}}Find(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
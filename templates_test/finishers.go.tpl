@@ -2,7 +2,7 @@
 func test{{$alias.UpPlural}}Bind(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -24,7 +24,7 @@ func test{{$alias.UpPlural}}Bind(t *testing.T) {
 func test{{$alias.UpPlural}}One(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -48,7 +48,7 @@ func test{{$alias.UpPlural}}One(t *testing.T) {
 func test{{$alias.UpPlural}}All(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	{{$alias.DownSingular}}One := &{{$alias.UpSingular}}{}
 	{{$alias.DownSingular}}Two := &{{$alias.UpSingular}}{}
@@ -83,7 +83,7 @@ func test{{$alias.UpPlural}}Count(t *testing.T) {
 	t.Parallel()
 
 	var err error
-	seed := randomize.NewSeed()
+	seed := testSeed
 	{{$alias.DownSingular}}One := &{{$alias.UpSingular}}{}
 	{{$alias.DownSingular}}Two := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, {{$alias.DownSingular}}One, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
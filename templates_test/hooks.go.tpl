@@ -51,10 +51,16 @@ func test{{$alias.UpPlural}}Hooks(t *testing.T) {
 	var err error
 
 	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{- if containsAny .AuditTables .Table.Name}}
+	ctx = boil.SkipAudit(ctx)
+	{{- end}}
+	{{- if containsAny .OutboxTables .Table.Name}}
+	ctx = boil.SkipOutbox(ctx)
+	{{- end}}
 	empty := &{{$alias.UpSingular}}{}
 	o := &{{$alias.UpSingular}}{}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, false); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} object: %s", err)
 	}
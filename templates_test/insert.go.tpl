@@ -2,7 +2,7 @@
 func test{{$alias.UpPlural}}Insert(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -29,7 +29,7 @@ func test{{$alias.UpPlural}}Insert(t *testing.T) {
 func test{{$alias.UpPlural}}InsertWhitelist(t *testing.T) {
 	t.Parallel()
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true); err != nil {
@@ -52,3 +52,63 @@ func test{{$alias.UpPlural}}InsertWhitelist(t *testing.T) {
 		t.Error("want one record, got:", count)
 	}
 }
+
+func test{{$alias.UpPlural}}InsertUnknownColumn(t *testing.T) {
+	t.Parallel()
+
+	o := &{{$alias.UpSingular}}{}
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err := o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Whitelist("not_a_column")); err == nil {
+		t.Error("want an error for an unknown column")
+	}
+}
+
+func test{{$alias.UpPlural}}InsertFromQuery(t *testing.T) {
+	t.Parallel()
+
+	if len({{$alias.DownSingular}}ColumnsWithoutDefault) == 0 {
+		t.Skip("Skipping table with no columns to insert into")
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+
+	// Copying the table's rows into itself would conflict, so nothing is selected
+	query := {{$alias.UpPlural}}().Query
+	queries.AppendWhere(query, "1=0")
+
+	{{if .Dialect.UseLastInsertID -}}
+	{{if .NoRowsAffected -}}
+	if err := Insert{{$alias.UpPlural}}FromQuery({{if not .NoContext}}ctx, {{end -}} tx, {{$alias.DownSingular}}ColumnsWithoutDefault, query); err != nil {
+		t.Error(err)
+	}
+	{{- else -}}
+	n, err := Insert{{$alias.UpPlural}}FromQuery({{if not .NoContext}}ctx, {{end -}} tx, {{$alias.DownSingular}}ColumnsWithoutDefault, query)
+	if err != nil {
+		t.Error(err)
+	}
+	if n != 0 {
+		t.Error("want no rows inserted, got:", n)
+	}
+	{{- end}}
+	{{- else -}}
+	o, err := Insert{{$alias.UpPlural}}FromQuery({{if not .NoContext}}ctx, {{end -}} tx, {{$alias.DownSingular}}ColumnsWithoutDefault, query)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(o) != 0 {
+		t.Error("want no rows inserted, got:", len(o))
+	}
+	{{- end}}
+
+	{{if and .Dialect.UseLastInsertID .NoRowsAffected -}}
+	if err := Insert{{$alias.UpPlural}}FromQuery({{if not .NoContext}}ctx, {{end -}} tx, []string{"not_a_column"}, query); err == nil {
+	{{- else -}}
+	if _, err := Insert{{$alias.UpPlural}}FromQuery({{if not .NoContext}}ctx, {{end -}} tx, []string{"not_a_column"}, query); err == nil {
+	{{- end}}
+		t.Error("want an error for an unknown column")
+	}
+}
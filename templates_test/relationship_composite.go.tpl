@@ -0,0 +1,156 @@
+{{- if .Table.IsJoinTable -}}
+{{- else -}}
+	{{- range $fkey := .Table.CompositeFKeys -}}
+		{{- $ltable := $.Aliases.Table $fkey.Table -}}
+		{{- $ftable := $.Aliases.Table $fkey.ForeignTable -}}
+		{{- $rel := $ltable.Relationship $fkey.Name }}
+func test{{$ltable.UpSingular}}CompositeToOne{{$ftable.UpSingular}}Using{{$rel.Foreign}}(t *testing.T) {
+	{{if not $.NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if $.NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+
+	var local, other {{$ltable.UpSingular}}
+	var foreign, next {{$ftable.UpSingular}}
+
+	seed := testSeed
+	for _, x := range []*{{$ltable.UpSingular}}{&local, &other} {
+		if err := randomize.Struct(seed, x, {{$ltable.DownSingular}}DBTypes, {{if $fkey.Nullable}}true{{else}}false{{end}}, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
+			t.Errorf("Unable to randomize {{$ltable.UpSingular}} struct: %s", err)
+		}
+	}
+	for _, x := range []*{{$ftable.UpSingular}}{&foreign, &next} {
+		if err := randomize.Struct(seed, x, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
+			t.Errorf("Unable to randomize {{$ftable.UpSingular}} struct: %s", err)
+		}
+	}
+
+	if err := foreign.Insert({{if not $.NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	{{range $i, $col := $fkey.Columns -}}
+	queries.Assign(&local.{{$ltable.Column $col}}, foreign.{{$ftable.Column (index $fkey.ForeignColumns $i)}})
+	{{end -}}
+	if err := local.Insert({{if not $.NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.{{$rel.Foreign}}().One({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	{{range $col := $fkey.ForeignColumns -}}
+	if !queries.Equal(check.{{$ftable.Column $col}}, foreign.{{$ftable.Column $col}}) {
+		t.Errorf("want: %v, got %v", foreign.{{$ftable.Column $col}}, check.{{$ftable.Column $col}})
+	}
+	{{end -}}
+
+	count, err := foreign.{{$rel.Local}}().Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("want 1 {{$ltable.DownSingular}}, got %d", count)
+	}
+
+	slice := {{$ltable.UpSingular}}Slice{&local}
+	if err = local.L.Load{{$rel.Foreign}}({{if not $.NoContext}}ctx, {{end -}} tx, false, (*[]*{{$ltable.UpSingular}})(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.{{$rel.Foreign}} == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.{{$rel.Foreign}} = nil
+	if err = local.L.Load{{$rel.Foreign}}({{if not $.NoContext}}ctx, {{end -}} tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.{{$rel.Foreign}} == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	foreignSlice := {{$ftable.UpSingular}}Slice{&foreign}
+	if err = foreign.L.Load{{$rel.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, false, (*[]*{{$ftable.UpSingular}})(&foreignSlice), nil); err != nil {
+		t.Fatal(err)
+	}
+	{{if $fkey.Unique -}}
+	if foreign.R.{{$rel.Local}} == nil {
+		t.Error("struct should have been eager loaded")
+	}
+	{{- else -}}
+	if len(foreign.R.{{$rel.Local}}) != 1 {
+		t.Error("number of eager loaded records wrong, got:", len(foreign.R.{{$rel.Local}}))
+	}
+	{{- end}}
+
+	if err = local.Set{{$rel.Foreign}}({{if not $.NoContext}}ctx, {{end -}} tx, true, &next); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.{{$rel.Foreign}} != &next {
+		t.Error("relationship struct not set to correct value")
+	}
+	{{range $i, $col := $fkey.Columns -}}
+	if !queries.Equal(local.{{$ltable.Column $col}}, next.{{$ftable.Column (index $fkey.ForeignColumns $i)}}) {
+		t.Error("foreign key was wrong value", local.{{$ltable.Column $col}})
+	}
+	{{end -}}
+
+	{{if $fkey.Unique -}}
+	if next.R.{{$rel.Local}} != &local {
+		t.Error("failed to append to foreign relationship struct")
+	}
+
+	if err = foreign.Set{{$rel.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, true, &other); err != nil {
+		t.Fatal(err)
+	}
+	if other.R.{{$rel.Foreign}} != &foreign {
+		t.Error("relationship was not set")
+	}
+	if foreign.R.{{$rel.Local}} != &other {
+		t.Error("relationship struct not set to correct value")
+	}
+
+	count, err = foreign.{{$rel.Local}}().Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("want 1 {{$ltable.DownSingular}}, got %d", count)
+	}
+	{{- else -}}
+	if err = next.Add{{$rel.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, true, &other); err != nil {
+		t.Fatal(err)
+	}
+	if other.R.{{$rel.Foreign}} != &next {
+		t.Error("relationship was not added")
+	}
+
+	count, err = next.{{$rel.Local}}().Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("want 2 {{$ltable.DownPlural}}, got %d", count)
+	}
+	{{- end}}
+	{{- if $fkey.Nullable}}
+
+	if err = local.Remove{{$rel.Foreign}}({{if not $.NoContext}}ctx, {{end -}} tx, &next); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.{{$rel.Foreign}} != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	count, err = next.{{$rel.Local}}().Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("want 1 {{$ltable.DownSingular}}, got %d", count)
+	}
+	{{- end}}
+}
+
+	{{end -}}{{/* range */}}
+{{- end -}}{{/* join table */}}
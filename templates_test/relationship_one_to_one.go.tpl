@@ -15,7 +15,7 @@ func test{{$ltable.UpSingular}}OneToOne{{$ftable.UpSingular}}Using{{$relAlias.Lo
 	var foreign {{$ftable.UpSingular}}
 	var local {{$ltable.UpSingular}}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err := randomize.Struct(seed, &foreign, {{$ftable.DownSingular}}DBTypes, true, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$ftable.UpSingular}} struct: %s", err)
 	}
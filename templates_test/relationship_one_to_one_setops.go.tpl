@@ -19,7 +19,7 @@ func test{{$ltable.UpSingular}}OneToOneSetOp{{$ftable.UpSingular}}Using{{$relAli
 	var a {{$ltable.UpSingular}}
 	var b, c {{$ftable.UpSingular}}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, false, strmangle.SetComplement({{$ltable.DownSingular}}PrimaryKeyColumns, {{$ltable.DownSingular}}ColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
@@ -98,7 +98,7 @@ func test{{$ltable.UpSingular}}OneToOneRemoveOp{{$ftable.UpSingular}}Using{{$rel
 	var a {{$ltable.UpSingular}}
 	var b {{$ftable.UpSingular}}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, false, strmangle.SetComplement({{$ltable.DownSingular}}PrimaryKeyColumns, {{$ltable.DownSingular}}ColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
@@ -130,7 +130,7 @@ func test{{$ltable.UpSingular}}OneToOneRemoveOp{{$ftable.UpSingular}}Using{{$rel
 		t.Error("R struct entry should be nil")
 	}
 
-	if !queries.IsValuerNil(b.{{$fcolField}}) {
+	if !queries.IsNil(b.{{$fcolField}}) {
 		t.Error("foreign key column should be nil")
 	}
 
@@ -0,0 +1,88 @@
+{{- if .Table.IsJoinTable -}}
+{{- else -}}
+	{{- range $poly := .PolymorphicSources -}}
+		{{- $ltable := $.Aliases.Table $poly.Table -}}
+		{{- $typeField := $ltable.Column $poly.TypeColumn -}}
+		{{- $idField := $ltable.Column $poly.IDColumn -}}
+		{{- $idColumn := (getTable $.Tables $poly.Table).GetColumn $poly.IDColumn -}}
+		{{- range $type := $poly.Types -}}
+			{{- $ftable := $.Aliases.Table $type.Table -}}
+			{{- $pkField := $ftable.Column $type.Column }}
+func test{{$ltable.UpSingular}}Polymorphic{{$type.Local}}(t *testing.T) {
+	{{if not $.NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if $.NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+
+	var local {{$ltable.UpSingular}}
+	var foreign {{$ftable.UpSingular}}
+
+	seed := testSeed
+	if err := randomize.Struct(seed, &local, {{$ltable.DownSingular}}DBTypes, {{if $idColumn.Nullable}}true{{else}}false{{end}}, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$ltable.UpSingular}} struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$ftable.UpSingular}} struct: %s", err)
+	}
+
+	if err := foreign.Insert({{if not $.NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.{{$typeField}}, {{printf "%q" $type.Value}})
+	queries.Assign(&local.{{$idField}}, foreign.{{$pkField}})
+	if err := local.Insert({{if not $.NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.{{$type.Local}}().One({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !queries.Equal(check.{{$pkField}}, foreign.{{$pkField}}) {
+		t.Errorf("want: %v, got %v", foreign.{{$pkField}}, check.{{$pkField}})
+	}
+
+	obj, err := local.{{$poly.Name}}({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := obj.(*{{$ftable.UpSingular}}); !ok {
+		t.Errorf("want a *{{$ftable.UpSingular}}, got %T", obj)
+	}
+
+	count, err := foreign.{{$type.Foreign}}().Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("want 1 {{$ltable.DownSingular}}, got %d", count)
+	}
+
+	slice := {{$ltable.UpSingular}}Slice{&local}
+	if err = local.L.Load{{$type.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, false, (*[]*{{$ltable.UpSingular}})(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.{{$type.Local}} == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.{{$type.Local}} = nil
+	if err = local.L.Load{{$type.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.{{$type.Local}} == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	foreignSlice := {{$ftable.UpSingular}}Slice{&foreign}
+	if err = foreign.L.Load{{$type.Foreign}}({{if not $.NoContext}}ctx, {{end -}} tx, false, (*[]*{{$ftable.UpSingular}})(&foreignSlice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(foreign.R.{{$type.Foreign}}) != 1 {
+		t.Error("number of eager loaded records wrong, got:", len(foreign.R.{{$type.Foreign}}))
+	}
+}
+
+		{{end -}}{{/* types */}}
+	{{- end -}}{{/* range */}}
+{{- end -}}{{/* join table */}}
@@ -18,7 +18,7 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 	var a {{$ltable.UpSingular}}
 	var b, c {{$ftable.UpSingular}}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, true, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$ltable.UpSingular}} struct: %s", err)
 	}
@@ -27,6 +27,14 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	has, err := a.Has{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if has {
+		t.Error("expected no {{$relAlias.Local}} yet")
+	}
+
 	if err = randomize.Struct(seed, &b, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
@@ -92,6 +100,30 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 		t.Error("expected to find c")
 	}
 
+	has, err = a.Has{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !has {
+		t.Error("expected to have {{$relAlias.Local}}")
+	}
+
+	count, err := a.{{$relAlias.Local}}Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong, got:", count)
+	}
+
+	counts, err := {{$ltable.UpSingular}}Slice{&a}.Count{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := counts[a.{{$colField}}]; got != 2 {
+		t.Error("batched count was wrong, got:", got)
+	}
+
 	slice := {{$ltable.UpSingular}}Slice{&a}
 	if err = a.L.Load{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, false, (*[]*{{$ltable.UpSingular}})(&slice), nil); err != nil {
 		t.Fatal(err)
@@ -108,6 +140,34 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
+	{{if not (hasPrefix ((getTable $.Tables $rel.Table).GetColumn $rel.Column).Type "[]") -}}
+	byKey, err := {{$ltable.UpSingular}}Slice{&a}.{{$relAlias.Local}}Map({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(byKey[a.{{$colField}}]); got != 2 {
+		t.Error("number of mapped records wrong, got:", got)
+	}
+
+	{{end -}}
+	{{$soft := and $.AddSoftDeletes (getTable $.Tables $rel.Table).CanSoftDelete -}}
+	{{if $.NoRowsAffected -}}
+	err = a.DeleteCascade({{if not $.NoContext}}ctx, {{end -}} tx{{if $soft}}, true{{end}})
+	{{- else -}}
+	_, err = a.DeleteCascade({{if not $.NoContext}}ctx, {{end -}} tx{{if $soft}}, true{{end}})
+	{{- end}}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.{{$relAlias.Local}}Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Error("cascading delete left {{$relAlias.Local}} behind, got:", count)
+	}
+
 	if t.Failed() {
 		t.Logf("%#v", check)
 	}
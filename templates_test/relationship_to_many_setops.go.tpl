@@ -18,7 +18,7 @@ func test{{$ltable.UpSingular}}ToManyAddOp{{$relAlias.Local}}(t *testing.T) {
 	var a {{$ltable.UpSingular}}
 	var b, c, d, e {{$ftable.UpSingular}}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, false, strmangle.SetComplement({{$ltable.DownSingular}}PrimaryKeyColumns, {{$ltable.DownSingular}}ColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
@@ -114,7 +114,7 @@ func test{{$ltable.UpSingular}}ToManySetOp{{$relAlias.Local}}(t *testing.T) {
 	var a {{$ltable.UpSingular}}
 	var b, c, d, e {{$ftable.UpSingular}}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, false, strmangle.SetComplement({{$ltable.DownSingular}}PrimaryKeyColumns, {{$ltable.DownSingular}}ColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
@@ -181,10 +181,10 @@ func test{{$ltable.UpSingular}}ToManySetOp{{$relAlias.Local}}(t *testing.T) {
 	}
 	{{- else}}
 
-	if !queries.IsValuerNil(b.{{$fcolField}}) {
+	if !queries.IsNil(b.{{$fcolField}}) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.{{$fcolField}}) {
+	if !queries.IsNil(c.{{$fcolField}}) {
 		t.Error("want c's foreign key value to be nil")
 	}
 	{{if $usesPrimitives -}}
@@ -235,7 +235,7 @@ func test{{$ltable.UpSingular}}ToManyRemoveOp{{$relAlias.Local}}(t *testing.T) {
 	var a {{$ltable.UpSingular}}
 	var b, c, d, e {{$ftable.UpSingular}}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, false, strmangle.SetComplement({{$ltable.DownSingular}}PrimaryKeyColumns, {{$ltable.DownSingular}}ColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
@@ -292,10 +292,10 @@ func test{{$ltable.UpSingular}}ToManyRemoveOp{{$relAlias.Local}}(t *testing.T) {
 	}
 	{{- else}}
 
-	if !queries.IsValuerNil(b.{{$fcolField}}) {
+	if !queries.IsNil(b.{{$fcolField}}) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.{{$fcolField}}) {
+	if !queries.IsNil(c.{{$fcolField}}) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
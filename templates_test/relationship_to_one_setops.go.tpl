@@ -17,7 +17,7 @@ func test{{$ltable.UpSingular}}ToOneSetOp{{$ftable.UpSingular}}Using{{$rel.Forei
 	var a {{$ltable.UpSingular}}
 	var b, c {{$ftable.UpSingular}}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, false, strmangle.SetComplement({{$ltable.DownSingular}}PrimaryKeyColumns, {{$ltable.DownSingular}}ColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
@@ -99,7 +99,7 @@ func test{{$ltable.UpSingular}}ToOneRemoveOp{{$ftable.UpSingular}}Using{{$rel.Fo
 	var a {{$ltable.UpSingular}}
 	var b {{$ftable.UpSingular}}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, false, strmangle.SetComplement({{$ltable.DownSingular}}PrimaryKeyColumns, {{$ltable.DownSingular}}ColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
@@ -131,7 +131,7 @@ func test{{$ltable.UpSingular}}ToOneRemoveOp{{$ftable.UpSingular}}Using{{$rel.Fo
 		t.Error("R struct entry should be nil")
 	}
 
-	if !queries.IsValuerNil(a.{{$colField}}) {
+	if !queries.IsNil(a.{{$colField}}) {
 		t.Error("foreign key value should be nil")
 	}
 
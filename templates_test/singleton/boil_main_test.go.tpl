@@ -1,5 +1,9 @@
 var flagDebugMode = flag.Bool("test.sqldebug", false, "Turns on debug mode for SQL statements")
 var flagConfigFile = flag.String("test.config", "", "Overrides the default config")
+var flagContainer = flag.String("test.container", "", "Runs the tests against a throwaway database in a docker container of this image, eg. postgres:13")
+var flagSchema = flag.String("test.schema", "", "Creates the schema of the test database from this sql file, or the .sql files of this directory in name order, instead of a dump of the configured database")
+var flagParallelGroups = flag.Bool("test.parallel-groups", false, "Runs the groups of tests (Insert, Delete, ...) in parallel with each other instead of one after the other")
+var flagSeed = flag.Int64("test.seed", 0, "Starts the random values at this seed to reproduce an earlier run, overrides $"+randomize.SeedEnv)
 
 const outputDirDepth = {{.OutputDirDepth}}
 
@@ -7,6 +11,21 @@ var (
 	dbMain tester
 )
 
+// testSeed is shared by all tests so the values it hands out are unique
+// across the whole run, tests running in parallel would otherwise insert
+// the same values into unique columns and block each other. It's created
+// by TestMain from -test.seed or $SQLBOILER_SEED so a run can be repeated.
+var testSeed *randomize.Seed
+
+// parallelGroup lets a group of tests run alongside the other groups when
+// -test.parallel-groups is given. Every test works in its own transaction
+// that is rolled back, so the groups don't see each other's rows.
+func parallelGroup(t *testing.T) {
+	if *flagParallelGroups {
+		t.Parallel()
+	}
+}
+
 type tester interface {
 	setup() error
 	conn() (*sql.DB, error)
@@ -19,10 +38,20 @@ func TestMain(m *testing.M) {
 		os.Exit(-1)
 	}
 
-	rand.Seed(time.Now().UnixNano())
-
 	flag.Parse()
 
+	seed := *flagSeed
+	if seed == 0 {
+		var err error
+		if seed, err = randomize.InitialSeed(); err != nil {
+			fmt.Println(err)
+			os.Exit(-1)
+		}
+	}
+	testSeed = randomize.NewSeedFrom(seed)
+	rand.Seed(seed)
+	fmt.Printf("random seed: %d\n", seed)
+
 	var err error
 
 	// Load configuration
@@ -49,6 +78,10 @@ func TestMain(m *testing.M) {
 	boil.SetDB(conn)
 	code = m.Run()
 
+	if code != 0 {
+		fmt.Printf("random seed: %d, rerun with -test.seed %d to use the same values\n", seed, seed)
+	}
+
 	if err = dbMain.teardown(); err != nil {
 		fmt.Println("Unable to execute teardown:", err)
 		os.Exit(-5)
@@ -97,3 +130,113 @@ func initViper() error {
 
 	return nil
 }
+
+// dockerContainer is a database started for the -test.container flag, the
+// schema is loaded into it with a client inside the container so only
+// docker and the dump tool for the source database are needed.
+type dockerContainer struct {
+	id   string
+	port int
+}
+
+// startContainer runs image in the background with env set and publishes
+// port on a random port of localhost.
+func startContainer(image string, port int, env ...string) (*dockerContainer, error) {
+	args := []string{"run", "--detach", "--rm", "--publish", fmt.Sprintf("127.0.0.1::%d", port)}
+	for _, e := range env {
+		args = append(args, "--env", e)
+	}
+	args = append(args, image)
+
+	out, err := dockerOutput(args...)
+	if err != nil {
+		return nil, err
+	}
+	c := &dockerContainer{id: strings.TrimSpace(out)}
+
+	// The output looks like 127.0.0.1:49153, possibly followed by more lines
+	out, err = dockerOutput("port", c.id, fmt.Sprintf("%d/tcp", port))
+	if err != nil {
+		_ = c.remove()
+		return nil, err
+	}
+	addr := strings.SplitN(strings.TrimSpace(out), "\n", 2)[0]
+	if c.port, err = strconv.Atoi(addr[strings.LastIndexByte(addr, ':')+1:]); err != nil {
+		_ = c.remove()
+		return nil, fmt.Errorf("unexpected docker port output %q: %v", out, err)
+	}
+
+	return c, nil
+}
+
+// command creates a command that runs inside the container, reading from
+// its stdin.
+func (c *dockerContainer) command(name string, args ...string) *exec.Cmd {
+	return exec.Command("docker", append([]string{"exec", "--interactive", c.id, name}, args...)...)
+}
+
+// waitFor runs a command in the container until it succeeds, databases take
+// a while before they accept connections.
+func (c *dockerContainer) waitFor(timeout time.Duration, name string, args ...string) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		out, err := c.command(name, args...).CombinedOutput()
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("database in container did not start: %v\n%s", err, out)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func (c *dockerContainer) remove() error {
+	_, err := dockerOutput("rm", "--force", c.id)
+	return err
+}
+
+// readSchemaFiles reads the sql of -test.schema, which is a file or a
+// directory of plain sql migrations that are run in name order.
+func readSchemaFiles(path string) (io.Reader, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		b, err := ioutil.ReadFile(path)
+		return bytes.NewReader(b), err
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no .sql files in %s", path)
+	}
+	sort.Strings(files)
+
+	buf := &bytes.Buffer{}
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+		buf.WriteByte('\n')
+	}
+
+	return buf, nil
+}
+
+func dockerOutput(args ...string) (string, error) {
+	cmd := exec.Command("docker", args...)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("docker %s failed: %v\n%s", args[0], err, stderr.String())
+	}
+	return string(out), nil
+}
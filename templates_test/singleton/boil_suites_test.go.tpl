@@ -2,10 +2,12 @@
 // Example, if your database has 3 tables, the suite will run:
 // table1, table2 and table3 Delete in parallel
 // table1, table2 and table3 Insert in parallel, and so forth.
-// It does NOT run each operation group in parallel.
-// Separating the tests thusly grants avoidance of Postgres deadlocks.
+// The operation groups only run in parallel with each other when the
+// -test.parallel-groups flag is given. Hooks are global so TestHooks always
+// runs on its own.
 func TestParent(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -16,7 +18,8 @@ func TestParent(t *testing.T) {
 
 {{if .AddSoftDeletes -}}
 func TestSoftDelete(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   	{{- if .CanSoftDelete -}}
@@ -28,7 +31,8 @@ func TestSoftDelete(t *testing.T) {
 }
 
 func TestQuerySoftDeleteAll(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   	{{- if .CanSoftDelete -}}
@@ -40,7 +44,8 @@ func TestQuerySoftDeleteAll(t *testing.T) {
 }
 
 func TestSliceSoftDeleteAll(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   	{{- if .CanSoftDelete -}}
@@ -53,7 +58,8 @@ func TestSliceSoftDeleteAll(t *testing.T) {
 {{- end}}
 
 func TestDelete(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -62,28 +68,44 @@ func TestDelete(t *testing.T) {
   {{- end -}}
 }
 
+func TestDeleteCascade(t *testing.T) {
+  parallelGroup(t)
+  {{range .Tables}}
+  {{- if .IsJoinTable -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}DeleteCascade)
+  {{end -}}
+  {{- end -}}
+}
+
 func TestQueryDeleteAll(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}QueryDeleteAll)
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}QueryDeleteAllReturning)
   {{end -}}
   {{- end -}}
 }
 
 func TestSliceDeleteAll(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}SliceDeleteAll)
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}SliceDeleteAllChunked)
   {{end -}}
   {{- end -}}
 }
 
 func TestExists(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -93,7 +115,8 @@ func TestExists(t *testing.T) {
 }
 
 func TestFind(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -103,7 +126,8 @@ func TestFind(t *testing.T) {
 }
 
 func TestBind(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -113,7 +137,8 @@ func TestBind(t *testing.T) {
 }
 
 func TestOne(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -123,7 +148,8 @@ func TestOne(t *testing.T) {
 }
 
 func TestAll(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -133,7 +159,8 @@ func TestAll(t *testing.T) {
 }
 
 func TestCount(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -155,12 +182,15 @@ func TestHooks(t *testing.T) {
 {{- end}}
 
 func TestInsert(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Insert)
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}InsertWhitelist)
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}InsertUnknownColumn)
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}InsertFromQuery)
   {{end -}}
   {{- end -}}
 }
@@ -168,7 +198,8 @@ func TestInsert(t *testing.T) {
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
-{{- range .Tables}}
+  parallelGroup(t)
+{{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
     {{- range $fkey := .FKeys -}}
@@ -184,7 +215,8 @@ func TestToOne(t *testing.T) {
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
 	{{- if .IsJoinTable -}}
 	{{- else -}}
 	  {{- range $rel := .ToOneRelationships -}}
@@ -200,7 +232,8 @@ func TestOneToOne(t *testing.T) {
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
     {{- if .IsJoinTable -}}
     {{- else -}}
       {{- range $rel := .ToManyRelationships -}}
@@ -212,10 +245,40 @@ func TestToMany(t *testing.T) {
   {{- end -}}{{- /* outer tables range */ -}}
 }
 
+// TestPolymorphic tests cannot be run in parallel
+// or deadlocks can occur.
+func TestPolymorphic(t *testing.T) {
+  parallelGroup(t)
+  {{range $poly := .Polymorphic}}
+    {{- $ltable := $.Aliases.Table $poly.Table -}}
+    {{- range $type := $poly.Types -}}
+  t.Run("{{$ltable.UpSingular}}To{{$type.Local}}", test{{$ltable.UpSingular}}Polymorphic{{$type.Local}})
+    {{end -}}{{- /* types range */ -}}
+  {{- end -}}{{- /* polymorphic range */ -}}
+}
+
+// TestComposite tests cannot be run in parallel
+// or deadlocks can occur.
+func TestComposite(t *testing.T) {
+  parallelGroup(t)
+  {{range .Tables}}
+    {{- if .IsJoinTable -}}
+    {{- else -}}
+      {{- range $fkey := .CompositeFKeys -}}
+        {{- $ltable := $.Aliases.Table $fkey.Table -}}
+        {{- $ftable := $.Aliases.Table $fkey.ForeignTable -}}
+        {{- $relAlias := $ltable.Relationship $fkey.Name -}}
+  t.Run("{{$ltable.UpSingular}}To{{$ftable.UpSingular}}Using{{$relAlias.Foreign}}", test{{$ltable.UpSingular}}CompositeToOne{{$ftable.UpSingular}}Using{{$relAlias.Foreign}})
+      {{end -}}{{- /* fkey range */ -}}
+    {{- end -}}{{- /* if join table */ -}}
+  {{- end -}}{{- /* tables range */ -}}
+}
+
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
-{{- range .Tables}}
+  parallelGroup(t)
+{{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
     {{- range $fkey := .FKeys -}}
@@ -231,7 +294,8 @@ func TestToOneSet(t *testing.T) {
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
-{{- range .Tables}}
+  parallelGroup(t)
+{{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
     {{- range $fkey := .FKeys -}}
@@ -249,7 +313,8 @@ func TestToOneRemove(t *testing.T) {
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
 	{{- if .IsJoinTable -}}
 	{{- else -}}
 	  {{- range $rel := .ToOneRelationships -}}
@@ -265,7 +330,8 @@ func TestOneToOneSet(t *testing.T) {
 // TestOneToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOneRemove(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
 	{{- if .IsJoinTable -}}
 	{{- else -}}
 	  {{- range $rel := .ToOneRelationships -}}
@@ -283,7 +349,8 @@ func TestOneToOneRemove(t *testing.T) {
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
     {{- if .IsJoinTable -}}
     {{- else -}}
       {{- range $rel := .ToManyRelationships -}}
@@ -298,7 +365,8 @@ func TestToManyAdd(t *testing.T) {
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
     {{- if .IsJoinTable -}}
     {{- else -}}
       {{- range $rel := .ToManyRelationships -}}
@@ -316,7 +384,8 @@ func TestToManySet(t *testing.T) {
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
     {{- if .IsJoinTable -}}
     {{- else -}}
       {{- range $rel := .ToManyRelationships -}}
@@ -332,7 +401,8 @@ func TestToManyRemove(t *testing.T) {
 }
 
 func TestReload(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -342,7 +412,8 @@ func TestReload(t *testing.T) {
 }
 
 func TestReloadAll(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -352,7 +423,8 @@ func TestReloadAll(t *testing.T) {
 }
 
 func TestSelect(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -362,17 +434,33 @@ func TestSelect(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Update)
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}UpdateChanged)
+  {{end -}}
+  {{- end -}}
+}
+
+{{if not .NoContext -}}
+func TestDebugOutput(t *testing.T) {
+  parallelGroup(t)
+  {{range .Tables}}
+  {{- if .IsJoinTable -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}DebugOutput)
   {{end -}}
   {{- end -}}
 }
 
+{{end -}}
 func TestSliceUpdateAll(t *testing.T) {
-  {{- range .Tables}}
+  parallelGroup(t)
+  {{range .Tables}}
   {{- if .IsJoinTable -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
@@ -0,0 +1,10 @@
+// Package templatestest embeds the templates of the tests sqlboiler
+// generates, see templatebin.
+package templatestest
+
+import "embed"
+
+// FS holds the templates, the files are named relative to this directory.
+//
+//go:embed *.tpl singleton
+var FS embed.FS
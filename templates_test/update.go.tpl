@@ -9,7 +9,7 @@ func test{{$alias.UpPlural}}Update(t *testing.T) {
 		t.Skip("Skipping table with only primary key columns")
 	}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -46,9 +46,83 @@ func test{{$alias.UpPlural}}Update(t *testing.T) {
 	} else if rowsAff != 1 {
 		t.Error("should only affect one row but affected", rowsAff)
 	}
+	{{end}}
+	exprCols := boil.Infer()
+	for _, col := range strmangle.SetComplement({{$alias.DownSingular}}AllColumns, {{$alias.DownSingular}}PrimaryKeyColumns) {
+		{{- if .Dialect.UseAutoColumns}}
+		if strmangle.SetInclude(col, {{$alias.DownSingular}}ColumnsWithAuto) {
+			continue
+		}
+		{{- end}}
+		exprCols = exprCols.WithExpr(col, boil.Expr("{{.LQ}}"+col+"{{.RQ}}"))
+	}
+	{{if .NoRowsAffected -}}
+	if err = o.Update({{if not .NoContext}}ctx, {{end -}} tx, exprCols); err != nil {
+		t.Error(err)
+	}
+	{{else -}}
+	if rowsAff, err := o.Update({{if not .NoContext}}ctx, {{end -}} tx, exprCols); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
 	{{end -}}
 }
 
+{{- $changed := "" -}}
+{{- if .Table.PKey -}}
+{{- range $column := .Table.Columns -}}
+{{- if and (not $changed) (not (containsAny $.Table.PKey.Columns $column.Name)) -}}
+{{- $changed = $column.Name -}}
+{{- end -}}
+{{- end -}}
+{{- end}}
+func test{{$alias.UpPlural}}UpdateChanged(t *testing.T) {
+	t.Parallel()
+
+	{{if not $changed -}}
+	t.Skip("Skipping table without columns outside the primary key")
+	{{- else -}}
+	{{- $colAlias := $alias.Column $changed -}}
+	seed := testSeed
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if cols := o.ChangedColumns(); len(cols) != 0 {
+		t.Error("inserted {{$alias.DownSingular}} has changed columns:", cols)
+	}
+
+	o.Set{{$colAlias}}(o.{{$colAlias}})
+	if cols := o.ChangedColumns(); len(cols) != 1 || cols[0] != "{{$changed}}" {
+		t.Error("wrong changed columns:", cols)
+	}
+
+	{{if .NoRowsAffected -}}
+	if err = o.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	{{else -}}
+	if rowsAff, err := o.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+	{{end -}}
+	if cols := o.ChangedColumns(); len(cols) != 0 {
+		t.Error("changed columns were not cleared:", cols)
+	}
+	{{- end}}
+}
+
 func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
 	t.Parallel()
 
@@ -56,7 +130,7 @@ func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
 		t.Skip("Skipping table with only primary key columns")
 	}
 
-	seed := randomize.NewSeed()
+	seed := testSeed
 	var err error
 	o := &{{$alias.UpSingular}}{}
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
@@ -125,5 +199,20 @@ func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
 	} else if rowsAff != 1 {
 		t.Error("wanted one record updated but got", rowsAff)
 	}
+	{{end}}
+	exprMap := M{}
+	for _, col := range fields {
+		exprMap[col] = boil.Expr("{{.LQ}}" + col + "{{.RQ}}")
+	}
+	{{if .NoRowsAffected -}}
+	if err = slice.UpdateAll({{if not .NoContext}}ctx, {{end -}} tx, exprMap); err != nil {
+		t.Error(err)
+	}
+	{{else -}}
+	if rowsAff, err := slice.UpdateAll({{if not .NoContext}}ctx, {{end -}} tx, exprMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
 	{{end -}}
 }
@@ -38,6 +38,23 @@ import (
 	"github.com/volatiletech/randomize"
 )
 
+var (
+	_ driver.Valuer = BoolArray{}
+	_ driver.Valuer = BytesArray{}
+	_ driver.Valuer = Float64Array{}
+	_ driver.Valuer = GenericArray{}
+	_ driver.Valuer = Int64Array{}
+	_ driver.Valuer = StringArray{}
+	_ driver.Valuer = DecimalArray{}
+	_ sql.Scanner   = &BoolArray{}
+	_ sql.Scanner   = &BytesArray{}
+	_ sql.Scanner   = &Float64Array{}
+	_ sql.Scanner   = &GenericArray{}
+	_ sql.Scanner   = &Int64Array{}
+	_ sql.Scanner   = &StringArray{}
+	_ sql.Scanner   = &DecimalArray{}
+)
+
 type parameterStatus struct {
 	// server version in the same format as server_version_num, or 0 if
 	// unavailable
@@ -1,11 +1,17 @@
 package types
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
 )
 
+var (
+	_ driver.Valuer = Byte(0)
+	_ sql.Scanner   = new(Byte)
+)
+
 // Byte is an alias for byte.
 // Byte implements Marshal and Unmarshal.
 type Byte byte
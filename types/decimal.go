@@ -78,6 +78,40 @@ func (d *Decimal) UnmarshalJSON(data []byte) error {
 	return d.Big.UnmarshalJSON(data)
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler so the decimal can be
+// encoded with encoding/gob.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	return decimalMarshalBinary(d.Big)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	newD, err := decimalUnmarshalBinary(data)
+	if err != nil {
+		return err
+	}
+
+	d.Big = newD
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, the decimal is written as a string
+// so it keeps its precision.
+func (d Decimal) MarshalYAML() (interface{}, error) {
+	return decimalMarshalYAML(d.Big)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (d *Decimal) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	newD, err := decimalUnmarshalYAML(unmarshal)
+	if err != nil {
+		return err
+	}
+
+	d.Big = newD
+	return nil
+}
+
 // Randomize implements sqlboiler's randomize interface
 func (d *Decimal) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	d.Big = randomDecimal(nextInt, fieldType, false)
@@ -108,6 +142,40 @@ func (n *NullDecimal) UnmarshalJSON(data []byte) error {
 	return n.Big.UnmarshalJSON(data)
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler so the decimal can be
+// encoded with encoding/gob. A nil Big encodes to no bytes.
+func (n NullDecimal) MarshalBinary() ([]byte, error) {
+	return decimalMarshalBinary(n.Big)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, no bytes decode to a
+// nil Big.
+func (n *NullDecimal) UnmarshalBinary(data []byte) error {
+	newD, err := decimalUnmarshalBinary(data)
+	if err != nil {
+		return err
+	}
+
+	n.Big = newD
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, a nil Big is written as null.
+func (n NullDecimal) MarshalYAML() (interface{}, error) {
+	return decimalMarshalYAML(n.Big)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (n *NullDecimal) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	newD, err := decimalUnmarshalYAML(unmarshal)
+	if err != nil {
+		return err
+	}
+
+	n.Big = newD
+	return nil
+}
+
 // IsZero implements qmhelper.Nullable
 func (n NullDecimal) IsZero() bool {
 	return n.Big == nil
@@ -132,6 +200,53 @@ func randomDecimal(nextInt func() int64, fieldType string, shouldBeNull bool) *d
 	return random
 }
 
+func decimalMarshalBinary(d *decimal.Big) ([]byte, error) {
+	if d == nil {
+		return []byte{}, nil
+	}
+
+	return d.MarshalText()
+}
+
+func decimalUnmarshalBinary(data []byte) (*decimal.Big, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	d := decimal.WithContext(DecimalContext)
+	if err := d.UnmarshalText(data); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func decimalMarshalYAML(d *decimal.Big) (interface{}, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	return d.String(), nil
+}
+
+func decimalUnmarshalYAML(unmarshal func(interface{}) error) (*decimal.Big, error) {
+	var s *string
+	if err := unmarshal(&s); err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, nil
+	}
+
+	d := decimal.WithContext(DecimalContext)
+	if _, ok := d.SetString(*s); !ok {
+		if err := d.Context.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("invalid decimal syntax: %q", *s)
+	}
+	return d, nil
+}
+
 func decimalValue(d *decimal.Big, canNull bool) (driver.Value, error) {
 	if canNull && d == nil {
 		return nil, nil
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -177,6 +179,79 @@ func TestNullDecimal_JSON(t *testing.T) {
 	}
 }
 
+func TestDecimal_Gob(t *testing.T) {
+	t.Parallel()
+
+	type decimals struct {
+		D Decimal
+		N NullDecimal
+	}
+
+	want, _ := new(decimal.Big).SetString("54.45")
+	in := decimals{D: NewDecimal(want), N: NewNullDecimal(want)}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+
+	var out decimals
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	if out.D.Cmp(want) != 0 {
+		t.Error("D was wrong:", out.D)
+	}
+	if out.N.Cmp(want) != 0 {
+		t.Error("N was wrong:", out.N)
+	}
+
+	buf.Reset()
+	if err := gob.NewEncoder(&buf).Encode(NullDecimal{}); err != nil {
+		t.Fatal(err)
+	}
+	null := NewNullDecimal(want)
+	if err := gob.NewDecoder(&buf).Decode(&null); err != nil {
+		t.Fatal(err)
+	}
+	if null.Big != nil {
+		t.Error("null decimal should decode to nil:", null)
+	}
+}
+
+func TestDecimal_YAML(t *testing.T) {
+	t.Parallel()
+
+	want, _ := new(decimal.Big).SetString("54.45")
+	if v, err := NewDecimal(want).MarshalYAML(); v != "54.45" || err != nil {
+		t.Errorf("want 54.45, got %#v %v", v, err)
+	}
+	if v, err := (NullDecimal{}).MarshalYAML(); v != nil || err != nil {
+		t.Errorf("want null, got %#v %v", v, err)
+	}
+
+	var d Decimal
+	if err := d.UnmarshalYAML(func(v interface{}) error { return json.Unmarshal([]byte(`"54.45"`), v) }); err != nil {
+		t.Fatal(err)
+	}
+	if d.Cmp(want) != 0 {
+		t.Error("D was wrong:", d)
+	}
+
+	n := NewNullDecimal(want)
+	if err := n.UnmarshalYAML(func(v interface{}) error { return json.Unmarshal([]byte(`null`), v) }); err != nil {
+		t.Fatal(err)
+	}
+	if n.Big != nil {
+		t.Error("N should be null:", n)
+	}
+
+	if err := d.UnmarshalYAML(func(v interface{}) error { return json.Unmarshal([]byte(`"abc"`), v) }); err == nil {
+		t.Error("want an error for an invalid decimal")
+	}
+}
+
 func TestNullDecimal_IsZero(t *testing.T) {
 	t.Parallel()
 
@@ -28,6 +28,11 @@ import (
 	"github.com/volatiletech/randomize"
 )
 
+var (
+	_ driver.Valuer = HStore{}
+	_ sql.Scanner   = &HStore{}
+)
+
 // HStore is a wrapper for transferring HStore values back and forth easily.
 type HStore map[string]null.String
 
@@ -1,13 +1,21 @@
 package types
 
 import (
+	"bytes"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/volatiletech/randomize"
 )
 
+var (
+	_ driver.Valuer = JSON{}
+	_ sql.Scanner   = &JSON{}
+)
+
 // JSON is an alias for json.RawMessage, which is
 // a []byte underneath.
 // JSON implements Marshal and Unmarshal.
@@ -49,6 +57,86 @@ func (j JSON) MarshalJSON() ([]byte, error) {
 	return j, nil
 }
 
+// MarshalYAML implements yaml.Marshaler, the json is written as the yaml
+// equivalent of the document rather than as bytes.
+func (j JSON) MarshalYAML() (interface{}, error) {
+	if len(j) == 0 {
+		return nil, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return yamlFromJSON(v), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, the yaml document is stored as
+// json.
+func (j *JSON) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	res, err := json.Marshal(jsonFromYAML(v))
+	if err != nil {
+		return err
+	}
+
+	*j = res
+	return nil
+}
+
+// yamlFromJSON turns the json.Numbers in v into ints where they fit and
+// floats otherwise, so they're written as yaml numbers.
+func yamlFromJSON(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = yamlFromJSON(e)
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = yamlFromJSON(e)
+		}
+	}
+
+	return v
+}
+
+// jsonFromYAML turns the map[interface{}]interface{} some yaml decoders
+// produce into map[string]interface{}, which can be encoded as json.
+func jsonFromYAML(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			m[fmt.Sprint(k)] = jsonFromYAML(e)
+		}
+		return m
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = jsonFromYAML(e)
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = jsonFromYAML(e)
+		}
+	}
+
+	return v
+}
+
 // Value returns j as a value.
 // Unmarshal into RawMessage for validation.
 func (j JSON) Value() (driver.Value, error) {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"reflect"
 	"testing"
 )
 
@@ -117,3 +118,35 @@ func TestJSONScan(t *testing.T) {
 		t.Errorf("bad []byte: %#v ≠ %#v\n", j, string([]byte(`"hello"`)))
 	}
 }
+
+func TestJSONYAML(t *testing.T) {
+	t.Parallel()
+
+	j := JSON(`{"name":"hi","age":15,"tags":["a"],"score":1.5}`)
+	v, err := j.MarshalYAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{"name": "hi", "age": int64(15), "tags": []interface{}{"a"}, "score": 1.5}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("want %#v, got %#v", want, v)
+	}
+
+	// yaml.v2 decodes maps with interface{} keys
+	var got JSON
+	err = got.UnmarshalYAML(func(v interface{}) error {
+		*v.(*interface{}) = map[interface{}]interface{}{"age": 15, "tags": []interface{}{map[interface{}]interface{}{"a": true}}}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"age":15,"tags":[{"a":true}]}`; got.String() != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+
+	if v, err := JSON(nil).MarshalYAML(); v != nil || err != nil {
+		t.Errorf("want empty json written as null, got %#v %v", v, err)
+	}
+}
@@ -9,6 +9,15 @@ import (
 	"strings"
 )
 
+// number matches the floats postgres writes, including ones with exponents
+const number = `-?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+)(?:[eE][-+]?[0-9]+)?`
+
+var (
+	rgxPoint  = regexp.MustCompile(`^\((` + number + `),(` + number + `)\)$`)
+	rgxPoints = regexp.MustCompile(`\(` + number + `,` + number + `\)`)
+	rgxLine   = regexp.MustCompile(`^\{(` + number + `),(` + number + `),(` + number + `)\}$`)
+)
+
 func iToS(src interface{}) (string, error) {
 	var val string
 	var err error
@@ -55,7 +64,7 @@ func parsePoint(pt string) (Point, error) {
 	var point = Point{}
 	var err error
 
-	pdzs := regexp.MustCompile(`^\((-?[0-9]+(?:\.[0-9]+)?),(-?[0-9]+(?:\.[0-9]+)?)\)$`).FindStringSubmatch(pt)
+	pdzs := rgxPoint.FindStringSubmatch(pt)
 	if len(pdzs) != 3 {
 		return point, errors.New("wrong point")
 	}
@@ -74,7 +83,7 @@ func parsePoint(pt string) (Point, error) {
 func parsePoints(pts string) ([]Point, error) {
 	var points = []Point{}
 
-	pdzs := regexp.MustCompile(`\((?:-?[0-9]+(?:\.[0-9]+)?),(?:-?[0-9]+(?:\.[0-9]+)?)\)`).FindAllString(pts, -1)
+	pdzs := rgxPoints.FindAllString(pts, -1)
 	for _, pt := range pdzs {
 		point, err := parsePoint(pt)
 		if err != nil {
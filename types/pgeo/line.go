@@ -4,7 +4,6 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
-	"regexp"
 )
 
 // Line represents a infinite line with the linear equation Ax + By + C = 0, where A and B are not both zero.
@@ -39,7 +38,7 @@ func scanLine(l *Line, src interface{}) error {
 		return err
 	}
 
-	pdzs := regexp.MustCompile(`^\{(-?[0-9]+(?:\.[0-9]+)?),(-?[0-9]+(?:\.[0-9]+)?),(-?[0-9]+(?:\.[0-9]+)?)\}$`).FindStringSubmatch(val)
+	pdzs := rgxLine.FindStringSubmatch(val)
 	if len(pdzs) != 4 {
 		return errors.New("wrong line")
 	}
@@ -7,6 +7,42 @@
 // https://github.com/saulortega/pgeo
 package pgeo
 
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+var (
+	_ driver.Valuer = Box{}
+	_ driver.Valuer = Circle{}
+	_ driver.Valuer = Line{}
+	_ driver.Valuer = Lseg{}
+	_ driver.Valuer = Path{}
+	_ driver.Valuer = Point{}
+	_ driver.Valuer = Polygon{}
+	_ driver.Valuer = NullBox{}
+	_ driver.Valuer = NullCircle{}
+	_ driver.Valuer = NullLine{}
+	_ driver.Valuer = NullLseg{}
+	_ driver.Valuer = NullPath{}
+	_ driver.Valuer = NullPoint{}
+	_ driver.Valuer = NullPolygon{}
+	_ sql.Scanner   = &Box{}
+	_ sql.Scanner   = &Circle{}
+	_ sql.Scanner   = &Line{}
+	_ sql.Scanner   = &Lseg{}
+	_ sql.Scanner   = &Path{}
+	_ sql.Scanner   = &Point{}
+	_ sql.Scanner   = &Polygon{}
+	_ sql.Scanner   = &NullBox{}
+	_ sql.Scanner   = &NullCircle{}
+	_ sql.Scanner   = &NullLine{}
+	_ sql.Scanner   = &NullLseg{}
+	_ sql.Scanner   = &NullPath{}
+	_ sql.Scanner   = &NullPoint{}
+	_ sql.Scanner   = &NullPolygon{}
+)
+
 // NewPoint creates a point
 func NewPoint(X, Y float64) Point {
 	return Point{X, Y}
@@ -50,7 +50,7 @@ func scanPath(p *Path, src interface{}) error {
 		return err
 	}
 
-	if len((*p).Points) < 2 {
+	if len((*p).Points) == 0 {
 		return errors.New("wrong path")
 	}
 
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types/pgeo"
+)
+
+// TestValueScan checks that what every type hands the database driver can be
+// scanned back into it, both as it's returned by Value and as the []byte a
+// driver usually returns for it.
+func TestValueScan(t *testing.T) {
+	t.Parallel()
+
+	dec, _ := new(decimal.Big).SetString("12.5")
+	point := pgeo.NewPoint(1.5, -2)
+
+	tests := []driver.Valuer{
+		BoolArray{true, false},
+		BytesArray{[]byte("a"), []byte(`b"c`)},
+		Float64Array{1.5, -2},
+		Int64Array{1, -2},
+		StringArray{"a", "b c", `d"e`},
+		DecimalArray{NewDecimal(dec)},
+		Byte('a'),
+		NewDecimal(dec),
+		NewNullDecimal(dec),
+		NullDecimal{},
+		// One key each, the order of the keys in the value isn't stable
+		HStore{"a": null.StringFrom("b")},
+		HStore{"c": null.String{}},
+		JSON(`{"a":1}`),
+		pgeo.Box{point, pgeo.NewPoint(3, 4)},
+		pgeo.NewCircle(point, 2),
+		pgeo.NewLine(1, 2, 3),
+		pgeo.NewLine(1e21, -2.5e-7, 0),
+		pgeo.NewPoint(-1e100, .5),
+		pgeo.Lseg{point, pgeo.NewPoint(3, 4)},
+		pgeo.NewPath([]pgeo.Point{point, pgeo.NewPoint(3, 4)}, true),
+		point,
+		pgeo.Polygon{point, pgeo.NewPoint(3, 4), pgeo.NewPoint(5, 6)},
+		pgeo.NullBox{Box: pgeo.Box{point, point}, Valid: true},
+		pgeo.NullCircle{Circle: pgeo.NewCircle(point, 2), Valid: true},
+		pgeo.NullLine{Line: pgeo.NewLine(1, 2, 3), Valid: true},
+		pgeo.NullLseg{Lseg: pgeo.Lseg{point, point}, Valid: true},
+		pgeo.NullPath{Path: pgeo.NewPath([]pgeo.Point{point}, false), Valid: true},
+		pgeo.NullPoint{Point: point, Valid: true},
+		pgeo.NullPolygon{Polygon: pgeo.Polygon{point}, Valid: true},
+		pgeo.NullPoint{},
+	}
+
+	for _, test := range tests {
+		value, err := test.Value()
+		if err != nil {
+			t.Errorf("%T: %v", test, err)
+			continue
+		}
+
+		values := []driver.Value{value}
+		if s, ok := value.(string); ok {
+			values = append(values, []byte(s))
+		}
+
+		for _, v := range values {
+			scanned := reflect.New(reflect.TypeOf(test))
+			if err := scanned.Interface().(sql.Scanner).Scan(v); err != nil {
+				t.Errorf("%T: unable to scan %#v: %v", test, v, err)
+				continue
+			}
+
+			got, err := scanned.Elem().Interface().(driver.Valuer).Value()
+			if err != nil {
+				t.Errorf("%T: %v", test, err)
+				continue
+			}
+			if !reflect.DeepEqual(driverString(got), driverString(value)) {
+				t.Errorf("%T: scanning %#v gave %#v", test, v, got)
+			}
+		}
+	}
+}
+
+func driverString(v driver.Value) driver.Value {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v
+}